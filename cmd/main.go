@@ -43,7 +43,7 @@ func main() {
 
 	var grpcServer *grpc.Server
 	if *useGrpc {
-		grpcServer, err := pvzv1.New(cfg.GRPCServerCfg, &app.Service.PvzService)
+		grpcServer, err := pvzv1.New(cfg.GRPCServerCfg, &app.Service.PvzService, &app.Service.ReceptionService, &app.Service.ReceptionService, &app.Service.ReceptionService, app.Auth, &app.Service.IdempotencyService)
 		if err != nil {
 			log.Fatalf("failed to create grpc server: %v", err)
 		}
@@ -0,0 +1,10 @@
+CREATE TYPE role_enum AS ENUM ('employee', 'moderator');
+
+ALTER TABLE users DROP CONSTRAINT IF EXISTS users_role_fkey;
+ALTER TABLE users ALTER COLUMN "role" TYPE role_enum USING "role"::role_enum;
+
+DROP TABLE IF EXISTS role_permissions;
+
+DROP TABLE IF EXISTS permissions;
+
+DROP TABLE IF EXISTS roles;
@@ -0,0 +1,35 @@
+CREATE TABLE IF NOT EXISTS roles (
+    "name" varchar PRIMARY KEY,
+    "description" varchar NOT NULL DEFAULT('')
+);
+
+CREATE TABLE IF NOT EXISTS permissions (
+    "name" varchar PRIMARY KEY,
+    "description" varchar NOT NULL DEFAULT('')
+);
+
+CREATE TABLE IF NOT EXISTS role_permissions (
+    "role" varchar REFERENCES roles ("name") ON DELETE CASCADE NOT NULL,
+    "permission" varchar REFERENCES permissions ("name") ON DELETE CASCADE NOT NULL,
+    PRIMARY KEY ("role", "permission")
+);
+
+INSERT INTO roles ("name", "description") VALUES
+('employee', 'Сотрудник ПВЗ'),
+('moderator', 'Модератор');
+
+INSERT INTO permissions ("name", "description") VALUES
+('pvz:create', 'Создание ПВЗ'),
+('reception:write', 'Создание и закрытие приемок, добавление и удаление товаров'),
+('report:read', 'Просмотр ПВЗ и приемок');
+
+INSERT INTO role_permissions ("role", "permission") VALUES
+('employee', 'reception:write'),
+('employee', 'report:read'),
+('moderator', 'pvz:create'),
+('moderator', 'report:read');
+
+ALTER TABLE users ALTER COLUMN "role" TYPE varchar USING "role"::text;
+ALTER TABLE users ADD CONSTRAINT users_role_fkey FOREIGN KEY ("role") REFERENCES roles ("name");
+
+DROP TYPE IF EXISTS role_enum;
@@ -0,0 +1,4 @@
+DELETE FROM permissions WHERE "name" = 'user:manage';
+
+ALTER TABLE users DROP COLUMN IF EXISTS "created_at";
+ALTER TABLE users DROP COLUMN IF EXISTS "active";
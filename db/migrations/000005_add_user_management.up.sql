@@ -0,0 +1,10 @@
+ALTER TABLE users ADD COLUMN "active" boolean NOT NULL DEFAULT(true);
+ALTER TABLE users ADD COLUMN "created_at" TIMESTAMPTZ NOT NULL DEFAULT(NOW());
+
+CREATE INDEX ON users ("created_at");
+
+INSERT INTO permissions ("name", "description") VALUES
+('user:manage', 'Просмотр и изменение пользователей');
+
+INSERT INTO role_permissions ("role", "permission") VALUES
+('moderator', 'user:manage');
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS user_pvz;
+DROP TABLE IF EXISTS invites;
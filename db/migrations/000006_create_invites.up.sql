@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS invites (
+    "id" UUID PRIMARY KEY,
+    "token_hash" varchar UNIQUE NOT NULL,
+    "email" varchar NOT NULL CHECK("email" LIKE '%@%'),
+    "role" varchar NOT NULL REFERENCES roles ("name"),
+    "pvz_ids" UUID[] NOT NULL DEFAULT('{}'),
+    "expires_at" TIMESTAMPTZ NOT NULL,
+    "used_at" TIMESTAMPTZ,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT(NOW())
+);
+
+CREATE TABLE IF NOT EXISTS user_pvz (
+    "user_id" UUID NOT NULL REFERENCES users ("id") ON DELETE CASCADE,
+    "pvz_id" UUID NOT NULL REFERENCES pvz ("id") ON DELETE CASCADE,
+    PRIMARY KEY ("user_id", "pvz_id")
+);
+CREATE INDEX ON user_pvz ("pvz_id");
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS password_reset_codes;
+
+ALTER TABLE users DROP COLUMN IF EXISTS "token_version";
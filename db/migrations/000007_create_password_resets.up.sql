@@ -0,0 +1,11 @@
+ALTER TABLE users ADD COLUMN "token_version" integer NOT NULL DEFAULT(0);
+
+CREATE TABLE IF NOT EXISTS password_reset_codes (
+    "id" UUID PRIMARY KEY,
+    "user_id" UUID NOT NULL REFERENCES users ("id") ON DELETE CASCADE,
+    "code_hash" varchar UNIQUE NOT NULL,
+    "expires_at" TIMESTAMPTZ NOT NULL,
+    "used_at" TIMESTAMPTZ,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT(NOW())
+);
+CREATE INDEX ON password_reset_codes ("user_id");
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS api_keys (
+    "id" UUID PRIMARY KEY,
+    "name" varchar NOT NULL,
+    "prefix" varchar NOT NULL,
+    "key_hash" varchar UNIQUE NOT NULL,
+    "permissions" varchar[] NOT NULL DEFAULT('{}'),
+    "pvz_ids" UUID[] NOT NULL DEFAULT('{}'),
+    "expires_at" TIMESTAMPTZ,
+    "last_used_at" TIMESTAMPTZ,
+    "revoked_at" TIMESTAMPTZ,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT(NOW())
+);
+CREATE INDEX ON api_keys ("created_at");
+
+INSERT INTO permissions ("name", "description") VALUES
+('apikey:manage', 'Управление API-ключами');
+
+INSERT INTO role_permissions ("role", "permission") VALUES
+('moderator', 'apikey:manage');
@@ -0,0 +1,10 @@
+ALTER TABLE users ADD COLUMN "mfa_secret" varchar;
+ALTER TABLE users ADD COLUMN "mfa_enabled" boolean NOT NULL DEFAULT(false);
+
+CREATE TABLE IF NOT EXISTS mfa_recovery_codes (
+    "user_id" UUID NOT NULL REFERENCES users ("id") ON DELETE CASCADE,
+    "code_hash" varchar NOT NULL,
+    "used_at" TIMESTAMPTZ,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT(NOW()),
+    PRIMARY KEY ("user_id", "code_hash")
+);
@@ -0,0 +1,7 @@
+DELETE FROM permissions WHERE "name" = 'audit:read';
+
+DROP TRIGGER IF EXISTS trigger_audit_log_no_truncate ON audit_log;
+DROP TRIGGER IF EXISTS trigger_audit_log_append_only ON audit_log;
+DROP FUNCTION IF EXISTS audit_log_append_only();
+
+DROP TABLE IF EXISTS audit_log;
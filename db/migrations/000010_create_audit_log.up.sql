@@ -0,0 +1,44 @@
+CREATE TABLE IF NOT EXISTS audit_log (
+    "id" BIGSERIAL PRIMARY KEY,
+    "action" varchar NOT NULL,
+    "actor_id" UUID,
+    "actor_role" varchar,
+    "api_key_id" UUID,
+    "ip" varchar NOT NULL DEFAULT(''),
+    "user_agent" varchar NOT NULL DEFAULT(''),
+    "request_id" varchar NOT NULL DEFAULT(''),
+    "payload" JSONB NOT NULL DEFAULT('{}'),
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT(NOW())
+);
+CREATE INDEX ON audit_log ("action");
+CREATE INDEX ON audit_log ("actor_id");
+CREATE INDEX ON audit_log ("created_at");
+
+CREATE OR REPLACE FUNCTION audit_log_append_only()
+    RETURNS TRIGGER
+    LANGUAGE plpgsql
+    AS
+$$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only'
+            USING ERRCODE = '20003';
+END;
+$$;
+
+CREATE TRIGGER trigger_audit_log_append_only
+    BEFORE UPDATE OR DELETE
+    ON audit_log
+    FOR EACH ROW
+    EXECUTE PROCEDURE audit_log_append_only();
+
+CREATE TRIGGER trigger_audit_log_no_truncate
+    BEFORE TRUNCATE
+    ON audit_log
+    FOR EACH STATEMENT
+    EXECUTE PROCEDURE audit_log_append_only();
+
+INSERT INTO permissions ("name", "description") VALUES
+('audit:read', 'Просмотр журнала аудита');
+
+INSERT INTO role_permissions ("role", "permission") VALUES
+('moderator', 'audit:read');
@@ -0,0 +1,12 @@
+DELETE FROM permissions WHERE "name" = 'pvz:manage';
+
+DROP TRIGGER IF EXISTS trigger_add_reception_pvz_status ON receptions;
+DROP FUNCTION IF EXISTS add_reception_pvz_status_check();
+
+DROP TRIGGER IF EXISTS trigger_pvz_status ON pvz;
+DROP FUNCTION IF EXISTS pvz_status_check();
+
+DROP TABLE IF EXISTS pvz_status_history;
+
+ALTER TABLE pvz DROP COLUMN IF EXISTS "status";
+DROP TYPE IF EXISTS pvz_status_enum;
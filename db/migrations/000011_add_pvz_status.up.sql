@@ -0,0 +1,72 @@
+CREATE TYPE pvz_status_enum AS ENUM ('active', 'temporarily_closed', 'decommissioned');
+
+ALTER TABLE pvz ADD COLUMN "status" pvz_status_enum NOT NULL DEFAULT('active');
+CREATE INDEX ON pvz ("status");
+
+CREATE TABLE IF NOT EXISTS pvz_status_history (
+    "id" BIGSERIAL PRIMARY KEY,
+    "pvz_id" UUID REFERENCES pvz ("id") NOT NULL,
+    "from_status" pvz_status_enum NOT NULL,
+    "to_status" pvz_status_enum NOT NULL,
+    "changed_by" UUID,
+    "reason" varchar NOT NULL DEFAULT(''),
+    "changed_at" TIMESTAMPTZ NOT NULL DEFAULT(NOW())
+);
+CREATE INDEX ON pvz_status_history ("pvz_id", "changed_at");
+
+CREATE OR REPLACE FUNCTION pvz_status_check()
+    RETURNS TRIGGER
+    LANGUAGE plpgsql
+    AS
+$$
+BEGIN
+    IF OLD.status = 'decommissioned' AND NEW.status != OLD.status THEN
+        RAISE EXCEPTION 'cannot change status of decommissioned pvz'
+                USING ERRCODE = '20005';
+    END IF;
+
+    IF NEW.status = 'decommissioned' AND NEW.status != OLD.status AND EXISTS (
+        SELECT 1 FROM receptions
+        WHERE pvz_id = NEW.id AND status = 'in_progress'
+    ) THEN
+        RAISE EXCEPTION 'cannot decommission pvz while reception in progress'
+                USING ERRCODE = '20004';
+    END IF;
+
+    RETURN NEW;
+END;
+$$;
+
+CREATE TRIGGER trigger_pvz_status
+    BEFORE UPDATE OF status
+    ON pvz
+    FOR EACH ROW
+    EXECUTE PROCEDURE pvz_status_check();
+
+CREATE OR REPLACE FUNCTION add_reception_pvz_status_check()
+    RETURNS TRIGGER
+    LANGUAGE plpgsql
+    AS
+$$
+BEGIN
+    -- FOR SHARE waits for concurrent status change to finish.
+    IF (SELECT status FROM pvz WHERE id = NEW.pvz_id FOR SHARE) != 'active' THEN
+        RAISE EXCEPTION 'cannot create reception in not active pvz'
+                USING ERRCODE = '20006';
+    END IF;
+
+    RETURN NEW;
+END;
+$$;
+
+CREATE TRIGGER trigger_add_reception_pvz_status
+    BEFORE INSERT
+    ON receptions
+    FOR EACH ROW
+    EXECUTE PROCEDURE add_reception_pvz_status_check();
+
+INSERT INTO permissions ("name", "description") VALUES
+('pvz:manage', 'Изменение статуса ПВЗ');
+
+INSERT INTO role_permissions ("role", "permission") VALUES
+('moderator', 'pvz:manage');
@@ -0,0 +1,8 @@
+DELETE FROM permissions WHERE "name" = 'city:manage';
+
+CREATE TYPE city_enum AS ENUM('Москва', 'Санкт-Петербург', 'Казань');
+
+ALTER TABLE pvz DROP CONSTRAINT IF EXISTS pvz_city_fkey;
+ALTER TABLE pvz ALTER COLUMN "city" TYPE city_enum USING "city"::city_enum;
+
+DROP TABLE IF EXISTS cities;
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS cities (
+    "code" varchar PRIMARY KEY,
+    "name" varchar UNIQUE NOT NULL,
+    "name_en" varchar NOT NULL DEFAULT(''),
+    "region" varchar NOT NULL DEFAULT(''),
+    "timezone" varchar NOT NULL DEFAULT('Europe/Moscow'),
+    "enabled" boolean NOT NULL DEFAULT(true),
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT(NOW())
+);
+
+INSERT INTO cities ("code", "name", "name_en", "region", "timezone") VALUES
+('moscow', 'Москва', 'Moscow', 'Москва', 'Europe/Moscow'),
+('saint-petersburg', 'Санкт-Петербург', 'Saint Petersburg', 'Санкт-Петербург', 'Europe/Moscow'),
+('kazan', 'Казань', 'Kazan', 'Республика Татарстан', 'Europe/Moscow');
+
+ALTER TABLE pvz ALTER COLUMN "city" TYPE varchar USING "city"::text;
+ALTER TABLE pvz ADD CONSTRAINT pvz_city_fkey FOREIGN KEY ("city") REFERENCES cities ("name") ON UPDATE CASCADE;
+
+DROP TYPE IF EXISTS city_enum;
+
+INSERT INTO permissions ("name", "description") VALUES
+('city:manage', 'Добавление и включение городов');
+
+INSERT INTO role_permissions ("role", "permission") VALUES
+('moderator', 'city:manage');
@@ -0,0 +1,9 @@
+DELETE FROM permissions WHERE "name" = 'product_type:manage';
+
+CREATE TYPE product_type_enum AS ENUM ('электроника', 'одежда', 'обувь');
+
+ALTER TABLE products DROP COLUMN IF EXISTS "attributes";
+ALTER TABLE products DROP CONSTRAINT IF EXISTS products_type_fkey;
+ALTER TABLE products ALTER COLUMN "type" TYPE product_type_enum USING "type"::product_type_enum;
+
+DROP TABLE IF EXISTS product_types;
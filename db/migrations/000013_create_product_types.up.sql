@@ -0,0 +1,28 @@
+CREATE TABLE IF NOT EXISTS product_types (
+    "code" varchar PRIMARY KEY,
+    "name" varchar UNIQUE NOT NULL,
+    "name_en" varchar NOT NULL DEFAULT(''),
+    "attributes" jsonb NOT NULL DEFAULT('[]'),
+    "fragile" boolean NOT NULL DEFAULT(false),
+    "high_value" boolean NOT NULL DEFAULT(false),
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT(NOW())
+);
+
+INSERT INTO product_types ("code", "name", "name_en", "attributes", "fragile", "high_value") VALUES
+('electronics', 'электроника', 'Electronics', '[{"name": "imei", "required": false, "pattern": "^[0-9]{15}$"}]', true, true),
+('clothes', 'одежда', 'Clothes', '[]', false, false),
+('shoes', 'обувь', 'Shoes', '[]', false, false);
+
+-- product type name is referenced by products and can't be changed,
+-- products of closed receptions are read-only.
+ALTER TABLE products ALTER COLUMN "type" TYPE varchar USING "type"::text;
+ALTER TABLE products ADD CONSTRAINT products_type_fkey FOREIGN KEY ("type") REFERENCES product_types ("name");
+ALTER TABLE products ADD COLUMN "attributes" jsonb NOT NULL DEFAULT('{}');
+
+DROP TYPE IF EXISTS product_type_enum;
+
+INSERT INTO permissions ("name", "description") VALUES
+('product_type:manage', 'Управление типами товаров');
+
+INSERT INTO role_permissions ("role", "permission") VALUES
+('moderator', 'product_type:manage');
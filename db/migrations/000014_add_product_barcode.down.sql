@@ -0,0 +1,4 @@
+ALTER TABLE products DROP CONSTRAINT IF EXISTS products_reception_barcode_key;
+
+ALTER TABLE products DROP COLUMN IF EXISTS "order_id";
+ALTER TABLE products DROP COLUMN IF EXISTS "barcode";
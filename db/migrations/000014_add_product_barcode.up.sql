@@ -0,0 +1,6 @@
+-- products accepted before barcodes were introduced have none
+ALTER TABLE products ADD COLUMN "barcode" varchar;
+ALTER TABLE products ADD COLUMN "order_id" varchar;
+
+ALTER TABLE products ADD CONSTRAINT products_reception_barcode_key UNIQUE ("reception_id", "barcode");
+CREATE INDEX ON products ("barcode", "date_time");
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    "scope" varchar NOT NULL,
+    "key" varchar NOT NULL,
+    "request_hash" varchar NOT NULL,
+    -- status_code is NULL while first request is in progress
+    "status_code" integer,
+    "response_body" bytea,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT(NOW()),
+    "expires_at" TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY ("scope", "key")
+);
+CREATE INDEX ON idempotency_keys ("expires_at");
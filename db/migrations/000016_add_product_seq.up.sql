@@ -0,0 +1,6 @@
+-- products of one batch share date_time, seq keeps
+-- their order for LIFO deletion
+ALTER TABLE products DROP COLUMN IF EXISTS "seq";
+ALTER TABLE products ADD COLUMN "seq" bigserial NOT NULL;
+
+CREATE INDEX ON products ("reception_id", "date_time", "seq");
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS receptions_pvz_history_idx;
@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS receptions_pvz_history_idx ON receptions ("pvz_id", "date_time" DESC, "id" DESC);
@@ -0,0 +1,4 @@
+DELETE FROM permissions WHERE "name" = 'reception:manage';
+
+DROP TABLE IF EXISTS reception_reconciliations;
+DROP TABLE IF EXISTS reception_manifests;
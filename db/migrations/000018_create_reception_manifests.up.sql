@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS reception_manifests (
+    "reception_id" uuid PRIMARY KEY REFERENCES receptions(id) ON DELETE CASCADE,
+    "barcodes" text[] NOT NULL DEFAULT('{}'),
+    "type_counts" jsonb NOT NULL DEFAULT('{}'),
+    "created_at" timestamptz NOT NULL DEFAULT(NOW())
+);
+
+CREATE TABLE IF NOT EXISTS reception_reconciliations (
+    "reception_id" uuid PRIMARY KEY REFERENCES receptions(id) ON DELETE CASCADE,
+    "discrepancies" jsonb NOT NULL DEFAULT('[]'),
+    "overridden" boolean NOT NULL DEFAULT(false),
+    "created_at" timestamptz NOT NULL DEFAULT(NOW())
+);
+
+INSERT INTO permissions ("name", "description") VALUES
+('reception:manage', 'Управление приемками: закрытие с расхождениями');
+
+INSERT INTO role_permissions ("role", "permission") VALUES
+('moderator', 'reception:manage');
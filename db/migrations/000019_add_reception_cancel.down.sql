@@ -0,0 +1,24 @@
+DROP TRIGGER IF EXISTS trigger_reception_status ON receptions;
+DROP FUNCTION IF EXISTS reception_status_check();
+
+CREATE OR REPLACE FUNCTION add_reception_check()
+    RETURNS TRIGGER
+    LANGUAGE plpgsql
+    AS
+$$
+BEGIN
+    IF (SELECT COUNT(*) FROM receptions
+        WHERE pvz_id = NEW.pvz_id AND status = 'in_progress' AND id != NEW.id) > 0 THEN
+        RAISE EXCEPTION 'cannot create new reception while other in progress'
+                USING ERRCODE = '20002';
+    END IF;
+
+    RETURN NEW;
+END;
+$$;
+
+DROP TABLE IF EXISTS reception_status_history;
+
+-- enum value can't be dropped, cancelled receptions
+-- are marked closed instead
+UPDATE receptions SET status = 'close' WHERE status = 'cancelled';
@@ -0,0 +1,66 @@
+ALTER TYPE status_enum ADD VALUE IF NOT EXISTS 'cancelled';
+
+CREATE TABLE IF NOT EXISTS reception_status_history (
+    "id" BIGSERIAL PRIMARY KEY,
+    "reception_id" UUID REFERENCES receptions ("id") ON DELETE CASCADE NOT NULL,
+    "from_status" status_enum NOT NULL,
+    "to_status" status_enum NOT NULL,
+    "changed_by" UUID,
+    "reason" varchar NOT NULL DEFAULT(''),
+    "changed_at" TIMESTAMPTZ NOT NULL DEFAULT(NOW())
+);
+CREATE INDEX ON reception_status_history ("reception_id", "changed_at");
+
+-- only reception becoming in_progress can conflict with
+-- other open reception, closing or cancelling never does
+CREATE OR REPLACE FUNCTION add_reception_check()
+    RETURNS TRIGGER
+    LANGUAGE plpgsql
+    AS
+$$
+BEGIN
+    IF NEW.status = 'in_progress' AND (SELECT COUNT(*) FROM receptions
+        WHERE pvz_id = NEW.pvz_id AND status = 'in_progress' AND id != NEW.id) > 0 THEN
+        RAISE EXCEPTION 'cannot create new reception while other in progress'
+                USING ERRCODE = '20002';
+    END IF;
+
+    RETURN NEW;
+END;
+$$;
+
+CREATE OR REPLACE FUNCTION reception_status_check()
+    RETURNS TRIGGER
+    LANGUAGE plpgsql
+    AS
+$$
+BEGIN
+    IF OLD.status = 'cancelled' AND NEW.status != OLD.status THEN
+        RAISE EXCEPTION 'cannot change status of cancelled reception'
+                USING ERRCODE = '20008';
+    END IF;
+
+    IF NEW.status = 'in_progress' AND OLD.status != 'in_progress' THEN
+        IF (SELECT status FROM pvz WHERE id = NEW.pvz_id FOR SHARE) != 'active' THEN
+            RAISE EXCEPTION 'cannot reopen reception in not active pvz'
+                    USING ERRCODE = '20006';
+        END IF;
+
+        IF EXISTS (
+            SELECT 1 FROM receptions
+            WHERE pvz_id = NEW.pvz_id AND date_time > NEW.date_time
+        ) THEN
+            RAISE EXCEPTION 'cannot reopen reception, newer reception exists'
+                    USING ERRCODE = '20007';
+        END IF;
+    END IF;
+
+    RETURN NEW;
+END;
+$$;
+
+CREATE TRIGGER trigger_reception_status
+    BEFORE UPDATE OF status
+    ON receptions
+    FOR EACH ROW
+    EXECUTE PROCEDURE reception_status_check();
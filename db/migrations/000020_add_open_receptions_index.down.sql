@@ -0,0 +1 @@
+DROP INDEX IF EXISTS receptions_open_date_time_idx;
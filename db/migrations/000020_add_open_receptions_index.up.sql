@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS receptions_open_date_time_idx ON receptions ("date_time") WHERE status = 'in_progress';
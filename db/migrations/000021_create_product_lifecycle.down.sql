@@ -0,0 +1,18 @@
+DELETE FROM permissions WHERE "name" = 'product:issue';
+
+DROP TRIGGER IF EXISTS trigger_check_reception_status ON products;
+CREATE TRIGGER trigger_check_reception_status
+    BEFORE INSERT OR UPDATE
+    ON products
+    FOR EACH ROW
+    EXECUTE PROCEDURE check_reception_status_before_product_insert();
+
+DROP TRIGGER IF EXISTS trigger_product_received ON products;
+DROP FUNCTION IF EXISTS record_product_received();
+
+DROP TABLE IF EXISTS product_events;
+
+ALTER TABLE products DROP COLUMN IF EXISTS "pickup_code_hash";
+ALTER TABLE products DROP COLUMN IF EXISTS "state";
+
+DROP TYPE IF EXISTS product_state;
@@ -0,0 +1,54 @@
+CREATE TYPE product_state AS ENUM ('stored', 'issued', 'returned_to_sender');
+
+ALTER TABLE products ADD COLUMN "state" product_state NOT NULL DEFAULT('stored');
+-- products accepted before pickup codes were introduced have none
+ALTER TABLE products ADD COLUMN "pickup_code_hash" varchar;
+
+CREATE INDEX ON products ("reception_id", "state");
+
+CREATE TABLE IF NOT EXISTS product_events (
+    "id" BIGSERIAL PRIMARY KEY,
+    "product_id" UUID REFERENCES products ("id") ON DELETE CASCADE NOT NULL,
+    "pvz_id" UUID REFERENCES pvz ("id") NOT NULL,
+    "type" varchar NOT NULL,
+    "actor_id" UUID,
+    "details" JSONB NOT NULL DEFAULT('{}'),
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT(NOW())
+);
+CREATE INDEX ON product_events ("product_id", "created_at");
+
+-- intake is recorded for both single and batch insert
+CREATE OR REPLACE FUNCTION record_product_received()
+    RETURNS TRIGGER
+    LANGUAGE plpgsql
+    AS
+$$
+BEGIN
+    INSERT INTO product_events (product_id, pvz_id, type)
+    SELECT NEW.id, r.pvz_id, 'received' FROM receptions r
+    WHERE r.id = NEW.reception_id;
+
+    RETURN NEW;
+END;
+$$;
+
+CREATE TRIGGER trigger_product_received
+    AFTER INSERT
+    ON products
+    FOR EACH ROW
+    EXECUTE PROCEDURE record_product_received();
+
+-- products of closed reception change state on issue,
+-- only adding product to reception is checked
+DROP TRIGGER IF EXISTS trigger_check_reception_status ON products;
+CREATE TRIGGER trigger_check_reception_status
+    BEFORE INSERT OR UPDATE OF reception_id
+    ON products
+    FOR EACH ROW
+    EXECUTE PROCEDURE check_reception_status_before_product_insert();
+
+INSERT INTO permissions ("name", "description") VALUES
+('product:issue', 'Выдача товаров получателям');
+
+INSERT INTO role_permissions ("role", "permission") VALUES
+('employee', 'product:issue');
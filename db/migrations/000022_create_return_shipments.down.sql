@@ -0,0 +1,10 @@
+DELETE FROM permissions WHERE "name" = 'product:return';
+
+DROP INDEX IF EXISTS products_stored_date_time_idx;
+
+DROP TABLE IF EXISTS return_shipment_products;
+DROP TABLE IF EXISTS return_shipments;
+
+-- enum value can't be dropped, products waiting
+-- for return are stored again
+UPDATE products SET state = 'stored' WHERE state = 'to_return';
@@ -0,0 +1,26 @@
+ALTER TYPE product_state ADD VALUE IF NOT EXISTS 'to_return';
+
+CREATE TABLE IF NOT EXISTS return_shipments (
+    "id" UUID PRIMARY KEY,
+    "pvz_id" UUID REFERENCES pvz ("id") NOT NULL,
+    "created_by" UUID,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT(NOW())
+);
+CREATE INDEX ON return_shipments ("pvz_id", "created_at");
+
+-- product is sent back once, so it belongs to one shipment
+CREATE TABLE IF NOT EXISTS return_shipment_products (
+    "shipment_id" UUID REFERENCES return_shipments ("id") ON DELETE CASCADE NOT NULL,
+    "product_id" UUID REFERENCES products ("id") ON DELETE CASCADE NOT NULL UNIQUE,
+    PRIMARY KEY ("shipment_id", "product_id")
+);
+
+-- expiry job looks up stored products by acceptance time
+CREATE INDEX products_stored_date_time_idx ON products ("date_time")
+    WHERE state = 'stored';
+
+INSERT INTO permissions ("name", "description") VALUES
+('product:return', 'Возврат товаров отправителю');
+
+INSERT INTO role_permissions ("role", "permission") VALUES
+('employee', 'product:return');
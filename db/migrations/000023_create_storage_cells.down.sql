@@ -0,0 +1,14 @@
+DELETE FROM permissions WHERE "name" IN ('cell:manage', 'product:move');
+
+DROP TRIGGER IF EXISTS trigger_free_cancelled_reception_cells ON receptions;
+DROP FUNCTION IF EXISTS free_cancelled_reception_cells();
+
+DROP TRIGGER IF EXISTS trigger_update_cell_occupancy ON products;
+DROP FUNCTION IF EXISTS update_cell_occupancy();
+
+ALTER TABLE products DROP COLUMN IF EXISTS "cell_id";
+ALTER TABLE products DROP COLUMN IF EXISTS "size_class";
+
+DROP TABLE IF EXISTS storage_cells;
+
+DROP TYPE IF EXISTS size_class;
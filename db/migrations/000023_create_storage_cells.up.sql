@@ -0,0 +1,80 @@
+-- enum order is used to fit products into cells of
+-- the same or bigger size class
+CREATE TYPE size_class AS ENUM ('small', 'medium', 'large');
+
+CREATE TABLE IF NOT EXISTS storage_cells (
+    "id" UUID PRIMARY KEY,
+    "pvz_id" UUID REFERENCES pvz ("id") NOT NULL,
+    "zone" varchar NOT NULL,
+    "rack" integer NOT NULL,
+    "shelf" integer NOT NULL,
+    "size_class" size_class NOT NULL,
+    "capacity" integer NOT NULL CHECK ("capacity" > 0),
+    -- kept by trigger on products, check guards
+    -- capacity against concurrent placements
+    "occupied" integer NOT NULL DEFAULT(0),
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT(NOW()),
+    CONSTRAINT storage_cells_capacity_check CHECK ("occupied" BETWEEN 0 AND "capacity"),
+    UNIQUE ("pvz_id", "zone", "rack", "shelf")
+);
+
+ALTER TABLE products ADD COLUMN "size_class" size_class NOT NULL DEFAULT('medium');
+ALTER TABLE products ADD COLUMN "cell_id" UUID REFERENCES storage_cells ("id");
+CREATE INDEX ON products ("cell_id");
+
+-- product occupies its cell while it is on hand
+CREATE OR REPLACE FUNCTION update_cell_occupancy()
+    RETURNS TRIGGER
+    LANGUAGE plpgsql
+    AS
+$$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') AND OLD.cell_id IS NOT NULL
+        AND OLD.state IN ('stored', 'to_return') THEN
+        UPDATE storage_cells SET occupied = occupied - 1 WHERE id = OLD.cell_id;
+    END IF;
+
+    IF TG_OP IN ('INSERT', 'UPDATE') AND NEW.cell_id IS NOT NULL
+        AND NEW.state IN ('stored', 'to_return') THEN
+        UPDATE storage_cells SET occupied = occupied + 1 WHERE id = NEW.cell_id;
+    END IF;
+
+    RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER trigger_update_cell_occupancy
+    AFTER INSERT OR UPDATE OF cell_id, state OR DELETE
+    ON products
+    FOR EACH ROW
+    EXECUTE PROCEDURE update_cell_occupancy();
+
+-- products of cancelled reception are not accepted
+-- and free their cells
+CREATE OR REPLACE FUNCTION free_cancelled_reception_cells()
+    RETURNS TRIGGER
+    LANGUAGE plpgsql
+    AS
+$$
+BEGIN
+    UPDATE products SET cell_id = NULL
+    WHERE reception_id = NEW.id AND cell_id IS NOT NULL;
+
+    RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER trigger_free_cancelled_reception_cells
+    AFTER UPDATE OF status
+    ON receptions
+    FOR EACH ROW
+    WHEN (NEW.status = 'cancelled')
+    EXECUTE PROCEDURE free_cancelled_reception_cells();
+
+INSERT INTO permissions ("name", "description") VALUES
+('cell:manage', 'Управление ячейками хранения ПВЗ'),
+('product:move', 'Перемещение товаров между ячейками');
+
+INSERT INTO role_permissions ("role", "permission") VALUES
+('moderator', 'cell:manage'),
+('employee', 'product:move');
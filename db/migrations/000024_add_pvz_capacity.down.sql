@@ -0,0 +1,10 @@
+DROP TRIGGER IF EXISTS trigger_release_cancelled_reception_stock ON receptions;
+DROP FUNCTION IF EXISTS release_cancelled_reception_stock();
+
+DROP TRIGGER IF EXISTS trigger_update_pvz_stock ON products;
+DROP FUNCTION IF EXISTS update_pvz_stock();
+DROP FUNCTION IF EXISTS adjust_pvz_stock(UUID, integer);
+
+ALTER TABLE pvz DROP COLUMN IF EXISTS "stock_count";
+ALTER TABLE pvz DROP COLUMN IF EXISTS "soft_capacity";
+ALTER TABLE pvz DROP COLUMN IF EXISTS "capacity";
@@ -0,0 +1,101 @@
+-- capacity is max number of products on hand, NULL is unlimited.
+-- soft_capacity only warns when intake reaches it.
+ALTER TABLE pvz ADD COLUMN "capacity" integer CHECK ("capacity" > 0);
+ALTER TABLE pvz ADD COLUMN "soft_capacity" integer CHECK ("soft_capacity" > 0);
+-- kept by triggers on products and receptions
+ALTER TABLE pvz ADD COLUMN "stock_count" integer NOT NULL DEFAULT(0);
+
+UPDATE pvz SET stock_count = s.cnt
+FROM (
+    SELECT r.pvz_id, COUNT(*) AS cnt
+    FROM products p
+    JOIN receptions r ON r.id = p.reception_id
+    WHERE p.state IN ('stored', 'to_return') AND r.status != 'cancelled'
+    GROUP BY r.pvz_id
+) s
+WHERE pvz.id = s.pvz_id;
+
+-- intake is refused if it would exceed capacity, row lock
+-- on pvz serializes concurrent intakes into the same PVZ
+CREATE OR REPLACE FUNCTION adjust_pvz_stock(p_reception_id UUID, p_delta integer)
+    RETURNS VOID
+    LANGUAGE plpgsql
+    AS
+$$
+DECLARE
+    v_pvz_id UUID;
+BEGIN
+    SELECT pvz_id INTO v_pvz_id FROM receptions
+    WHERE id = p_reception_id AND status != 'cancelled';
+    IF NOT FOUND THEN
+        RETURN;
+    END IF;
+
+    IF p_delta > 0 THEN
+        UPDATE pvz SET stock_count = stock_count + p_delta
+        WHERE id = v_pvz_id AND (capacity IS NULL OR stock_count + p_delta <= capacity);
+        IF NOT FOUND THEN
+            RAISE EXCEPTION 'pvz % is at capacity', v_pvz_id
+                USING ERRCODE = '20009';
+        END IF;
+    ELSE
+        UPDATE pvz SET stock_count = GREATEST(stock_count + p_delta, 0)
+        WHERE id = v_pvz_id;
+    END IF;
+END;
+$$;
+
+CREATE OR REPLACE FUNCTION update_pvz_stock()
+    RETURNS TRIGGER
+    LANGUAGE plpgsql
+    AS
+$$
+DECLARE
+    old_on_hand boolean := TG_OP != 'INSERT' AND OLD.state IN ('stored', 'to_return');
+    new_on_hand boolean := TG_OP != 'DELETE' AND NEW.state IN ('stored', 'to_return');
+BEGIN
+    IF TG_OP = 'UPDATE' AND OLD.reception_id = NEW.reception_id
+        AND old_on_hand = new_on_hand THEN
+        RETURN NULL;
+    END IF;
+
+    IF old_on_hand THEN
+        PERFORM adjust_pvz_stock(OLD.reception_id, -1);
+    END IF;
+    IF new_on_hand THEN
+        PERFORM adjust_pvz_stock(NEW.reception_id, 1);
+    END IF;
+
+    RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER trigger_update_pvz_stock
+    AFTER INSERT OR UPDATE OF state, reception_id OR DELETE
+    ON products
+    FOR EACH ROW
+    EXECUTE PROCEDURE update_pvz_stock();
+
+-- products of cancelled reception are not on hand anymore
+CREATE OR REPLACE FUNCTION release_cancelled_reception_stock()
+    RETURNS TRIGGER
+    LANGUAGE plpgsql
+    AS
+$$
+BEGIN
+    UPDATE pvz SET stock_count = GREATEST(stock_count - (
+        SELECT COUNT(*) FROM products
+        WHERE reception_id = NEW.id AND state IN ('stored', 'to_return')
+    ), 0)
+    WHERE id = NEW.pvz_id;
+
+    RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER trigger_release_cancelled_reception_stock
+    AFTER UPDATE OF status
+    ON receptions
+    FOR EACH ROW
+    WHEN (OLD.status != 'cancelled' AND NEW.status = 'cancelled')
+    EXECUTE PROCEDURE release_cancelled_reception_stock();
@@ -0,0 +1,10 @@
+DELETE FROM permissions WHERE "name" = 'product:transfer';
+
+DROP TABLE IF EXISTS transfer_products;
+DROP TABLE IF EXISTS transfers;
+
+DROP TYPE IF EXISTS transfer_status;
+
+-- enum value can't be dropped, products on the way
+-- stay in source PVZ
+UPDATE products SET state = 'stored' WHERE state = 'in_transit';
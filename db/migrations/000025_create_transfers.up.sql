@@ -0,0 +1,36 @@
+ALTER TYPE product_state ADD VALUE IF NOT EXISTS 'in_transit';
+
+CREATE TYPE transfer_status AS ENUM ('created', 'in_transit', 'received');
+
+CREATE TABLE IF NOT EXISTS transfers (
+    "id" UUID PRIMARY KEY,
+    "from_pvz_id" UUID REFERENCES pvz ("id") NOT NULL,
+    "to_pvz_id" UUID REFERENCES pvz ("id") NOT NULL,
+    "status" transfer_status NOT NULL DEFAULT('created'),
+    -- reception of destination PVZ products were received into
+    "reception_id" UUID REFERENCES receptions ("id"),
+    "created_by" UUID,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT(NOW()),
+    "dispatched_by" UUID,
+    "dispatched_at" TIMESTAMPTZ,
+    "received_by" UUID,
+    "received_at" TIMESTAMPTZ,
+    CHECK ("from_pvz_id" != "to_pvz_id")
+);
+CREATE INDEX ON transfers ("from_pvz_id", "created_at");
+CREATE INDEX ON transfers ("to_pvz_id", "created_at");
+
+-- product may travel many times, but only dispatch of
+-- stored product succeeds, so it is in one trip at a time
+CREATE TABLE IF NOT EXISTS transfer_products (
+    "transfer_id" UUID REFERENCES transfers ("id") ON DELETE CASCADE NOT NULL,
+    "product_id" UUID REFERENCES products ("id") ON DELETE CASCADE NOT NULL,
+    PRIMARY KEY ("transfer_id", "product_id")
+);
+CREATE INDEX ON transfer_products ("product_id");
+
+INSERT INTO permissions ("name", "description") VALUES
+('product:transfer', 'Перемещение товаров между ПВЗ');
+
+INSERT INTO role_permissions ("role", "permission") VALUES
+('employee', 'product:transfer');
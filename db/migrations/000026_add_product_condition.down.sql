@@ -0,0 +1,6 @@
+DROP TABLE IF EXISTS product_attachments;
+
+ALTER TABLE products DROP COLUMN IF EXISTS "notes";
+ALTER TABLE products DROP COLUMN IF EXISTS "condition";
+
+DROP TYPE IF EXISTS product_condition;
@@ -0,0 +1,18 @@
+CREATE TYPE product_condition AS ENUM ('ok', 'damaged', 'opened');
+
+-- condition is set by employee on intake, products accepted
+-- before it was introduced are considered intact
+ALTER TABLE products ADD COLUMN "condition" product_condition NOT NULL DEFAULT('ok');
+ALTER TABLE products ADD COLUMN "notes" varchar;
+
+CREATE TABLE IF NOT EXISTS product_attachments (
+    "id" UUID PRIMARY KEY,
+    "product_id" UUID REFERENCES products ("id") ON DELETE CASCADE NOT NULL,
+    -- key of file in blob store
+    "blob_key" varchar NOT NULL UNIQUE,
+    "content_type" varchar NOT NULL,
+    "size" BIGINT NOT NULL CHECK ("size" > 0),
+    "uploaded_by" UUID,
+    "created_at" TIMESTAMPTZ NOT NULL DEFAULT(NOW())
+);
+CREATE INDEX ON product_attachments ("product_id", "created_at");
@@ -0,0 +1,3 @@
+-- last TOTP time step accepted for user, codes
+-- of this and earlier steps can't be used again
+ALTER TABLE users ADD COLUMN "mfa_last_step" bigint;
@@ -0,0 +1,12 @@
+-- time reception was opened or last reopened, only
+-- products added since then can be deleted by LIFO
+ALTER TABLE receptions ADD COLUMN "opened_at" TIMESTAMPTZ;
+
+UPDATE receptions r
+SET opened_at = COALESCE((
+    SELECT MAX(h.changed_at) FROM reception_status_history h
+    WHERE h.reception_id = r.id AND h.to_status = 'in_progress'
+), r.date_time);
+
+ALTER TABLE receptions ALTER COLUMN "opened_at" SET DEFAULT(NOW());
+ALTER TABLE receptions ALTER COLUMN "opened_at" SET NOT NULL;
@@ -0,0 +1,8 @@
+DROP TRIGGER IF EXISTS trigger_product_deleted ON products;
+DROP FUNCTION IF EXISTS record_product_deleted;
+
+DELETE FROM product_events e
+WHERE NOT EXISTS (SELECT 1 FROM products p WHERE p.id = e.product_id);
+
+ALTER TABLE product_events ADD CONSTRAINT product_events_product_id_fkey
+    FOREIGN KEY ("product_id") REFERENCES products ("id") ON DELETE CASCADE;
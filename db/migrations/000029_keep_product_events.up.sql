@@ -0,0 +1,23 @@
+-- product history outlives product removed by LIFO
+-- deletion, the removal is recorded as its last event
+ALTER TABLE product_events DROP CONSTRAINT IF EXISTS product_events_product_id_fkey;
+
+CREATE OR REPLACE FUNCTION record_product_deleted()
+    RETURNS TRIGGER
+    LANGUAGE plpgsql
+    AS
+$$
+BEGIN
+    INSERT INTO product_events (product_id, pvz_id, type)
+    SELECT OLD.id, r.pvz_id, 'deleted' FROM receptions r
+    WHERE r.id = OLD.reception_id;
+
+    RETURN OLD;
+END;
+$$;
+
+CREATE TRIGGER trigger_product_deleted
+    AFTER DELETE
+    ON products
+    FOR EACH ROW
+    EXECUTE PROCEDURE record_product_deleted();
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS reception_stale_alerts;
@@ -0,0 +1,6 @@
+-- last stale alert of reception, it is alerted again
+-- only if it was reopened after that
+CREATE TABLE IF NOT EXISTS reception_stale_alerts (
+    "reception_id" UUID PRIMARY KEY REFERENCES receptions ("id") ON DELETE CASCADE,
+    "alerted_at" TIMESTAMPTZ NOT NULL DEFAULT(NOW())
+);
@@ -0,0 +1,69 @@
+CREATE OR REPLACE FUNCTION free_cancelled_reception_cells()
+    RETURNS TRIGGER
+    LANGUAGE plpgsql
+    AS
+$$
+BEGIN
+    UPDATE products SET cell_id = NULL
+    WHERE reception_id = NEW.id AND cell_id IS NOT NULL;
+
+    RETURN NULL;
+END;
+$$;
+
+CREATE OR REPLACE FUNCTION release_cancelled_reception_stock()
+    RETURNS TRIGGER
+    LANGUAGE plpgsql
+    AS
+$$
+BEGIN
+    UPDATE pvz SET stock_count = GREATEST(stock_count - (
+        SELECT COUNT(*) FROM products
+        WHERE reception_id = NEW.id AND state IN ('stored', 'to_return')
+    ), 0)
+    WHERE id = NEW.pvz_id;
+
+    RETURN NULL;
+END;
+$$;
+
+CREATE OR REPLACE FUNCTION update_pvz_stock()
+    RETURNS TRIGGER
+    LANGUAGE plpgsql
+    AS
+$$
+DECLARE
+    old_on_hand boolean := TG_OP != 'INSERT' AND OLD.state IN ('stored', 'to_return');
+    new_on_hand boolean := TG_OP != 'DELETE' AND NEW.state IN ('stored', 'to_return');
+BEGIN
+    IF TG_OP = 'UPDATE' AND OLD.reception_id = NEW.reception_id
+        AND old_on_hand = new_on_hand THEN
+        RETURN NULL;
+    END IF;
+
+    IF old_on_hand THEN
+        PERFORM adjust_pvz_stock(OLD.reception_id, -1);
+    END IF;
+    IF new_on_hand THEN
+        PERFORM adjust_pvz_stock(NEW.reception_id, 1);
+    END IF;
+
+    RETURN NULL;
+END;
+$$;
+
+DROP TRIGGER IF EXISTS trigger_check_transfer_reception ON transfers;
+DROP FUNCTION IF EXISTS check_transfer_reception();
+
+-- products go back to reception holding them,
+-- stock is already counted there
+ALTER TABLE products DISABLE TRIGGER trigger_check_reception_status;
+ALTER TABLE products DISABLE TRIGGER trigger_update_pvz_stock;
+UPDATE products SET reception_id = product_reception_id(id, reception_id)
+WHERE EXISTS (SELECT 1 FROM transfer_products tp WHERE tp.product_id = products.id);
+ALTER TABLE products ENABLE TRIGGER trigger_update_pvz_stock;
+ALTER TABLE products ENABLE TRIGGER trigger_check_reception_status;
+
+DROP FUNCTION IF EXISTS product_reception_id(UUID, UUID);
+DROP VIEW IF EXISTS reception_products;
+DROP INDEX IF EXISTS transfers_reception_id_idx;
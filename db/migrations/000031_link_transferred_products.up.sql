@@ -0,0 +1,112 @@
+-- received transfer links its products to reception of destination
+-- PVZ, products keep reception they were accepted by
+CREATE INDEX ON transfers ("reception_id");
+
+CREATE OR REPLACE VIEW reception_products AS
+SELECT reception_id, id AS product_id FROM products
+UNION ALL
+SELECT t.reception_id, tp.product_id FROM transfer_products tp
+JOIN transfers t ON t.id = tp.transfer_id
+WHERE t.status = 'received';
+
+-- reception holding product now: the one it was received
+-- into by its last transfer, or the one it was accepted by
+CREATE OR REPLACE FUNCTION product_reception_id(p_product_id UUID, p_reception_id UUID)
+    RETURNS UUID
+    LANGUAGE sql
+    STABLE
+    AS
+$$
+    SELECT COALESCE((
+        SELECT t.reception_id FROM transfer_products tp
+        JOIN transfers t ON t.id = tp.transfer_id
+        WHERE tp.product_id = p_product_id AND t.status = 'received'
+        ORDER BY t.received_at DESC
+        LIMIT 1
+    ), p_reception_id);
+$$;
+
+-- transfer is received only into reception in progress,
+-- as products are added by intake
+CREATE OR REPLACE FUNCTION check_transfer_reception()
+    RETURNS TRIGGER
+    LANGUAGE plpgsql
+    AS
+$$
+BEGIN
+    IF (SELECT status FROM receptions WHERE id = NEW.reception_id) IS DISTINCT FROM 'in_progress' THEN
+        RAISE EXCEPTION 'cannot receive transfer into finished reception'
+            USING ERRCODE = '20001';
+    END IF;
+
+    RETURN NEW;
+END;
+$$;
+
+CREATE TRIGGER trigger_check_transfer_reception
+    BEFORE UPDATE OF reception_id
+    ON transfers
+    FOR EACH ROW
+    WHEN (NEW.reception_id IS NOT NULL)
+    EXECUTE PROCEDURE check_transfer_reception();
+
+-- stock is counted in PVZ of reception holding product,
+-- so received transfer adds it to destination PVZ
+CREATE OR REPLACE FUNCTION update_pvz_stock()
+    RETURNS TRIGGER
+    LANGUAGE plpgsql
+    AS
+$$
+DECLARE
+    old_on_hand boolean := TG_OP != 'INSERT' AND OLD.state IN ('stored', 'to_return');
+    new_on_hand boolean := TG_OP != 'DELETE' AND NEW.state IN ('stored', 'to_return');
+BEGIN
+    IF TG_OP = 'UPDATE' AND OLD.reception_id = NEW.reception_id
+        AND old_on_hand = new_on_hand THEN
+        RETURN NULL;
+    END IF;
+
+    IF old_on_hand THEN
+        PERFORM adjust_pvz_stock(product_reception_id(OLD.id, OLD.reception_id), -1);
+    END IF;
+    IF new_on_hand THEN
+        PERFORM adjust_pvz_stock(product_reception_id(NEW.id, NEW.reception_id), 1);
+    END IF;
+
+    RETURN NULL;
+END;
+$$;
+
+CREATE OR REPLACE FUNCTION release_cancelled_reception_stock()
+    RETURNS TRIGGER
+    LANGUAGE plpgsql
+    AS
+$$
+BEGIN
+    UPDATE pvz SET stock_count = GREATEST(stock_count - (
+        SELECT COUNT(*) FROM reception_products rp
+        JOIN products p ON p.id = rp.product_id
+        WHERE rp.reception_id = NEW.id AND p.state IN ('stored', 'to_return')
+            AND product_reception_id(p.id, p.reception_id) = NEW.id
+    ), 0)
+    WHERE id = NEW.pvz_id;
+
+    RETURN NULL;
+END;
+$$;
+
+CREATE OR REPLACE FUNCTION free_cancelled_reception_cells()
+    RETURNS TRIGGER
+    LANGUAGE plpgsql
+    AS
+$$
+BEGIN
+    UPDATE products SET cell_id = NULL
+    FROM reception_products rp
+    WHERE rp.reception_id = NEW.id AND products.id = rp.product_id
+        AND products.cell_id IS NOT NULL
+        AND product_reception_id(products.id, products.reception_id) = NEW.id;
+
+    RETURN NULL;
+END;
+$$;
@@ -0,0 +1,13 @@
+-- name: CreateAuditLog :exec
+INSERT INTO audit_log (action, actor_id, actor_role, api_key_id, ip, user_agent, request_id, payload) VALUES
+($1, $2, $3, $4, $5, $6, $7, $8::text::jsonb);
+
+-- name: ListAuditLog :many
+SELECT * FROM audit_log
+WHERE (sqlc.narg('before_id')::bigint IS NULL OR id < sqlc.narg('before_id')::bigint)
+    AND (sqlc.narg('action')::varchar IS NULL OR action = sqlc.narg('action')::varchar)
+    AND (sqlc.narg('actor_id')::uuid IS NULL OR actor_id = sqlc.narg('actor_id')::uuid)
+    AND (sqlc.narg('from')::timestamptz IS NULL OR created_at >= sqlc.narg('from')::timestamptz)
+    AND (sqlc.narg('to')::timestamptz IS NULL OR created_at < sqlc.narg('to')::timestamptz)
+ORDER BY id DESC
+LIMIT sqlc.arg('limit');
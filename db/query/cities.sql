@@ -0,0 +1,15 @@
+-- name: ListCities :many
+SELECT * FROM cities
+WHERE ($1::boolean IS NULL OR enabled = $1::boolean)
+ORDER BY name;
+
+-- name: CreateCity :one
+INSERT INTO cities (code, name, name_en, region, timezone, enabled) VALUES
+($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: UpdateCity :one
+UPDATE cities
+SET enabled = $2
+WHERE code = $1
+RETURNING *;
@@ -0,0 +1,22 @@
+-- name: CreateInvite :one
+INSERT INTO invites (id, token_hash, email, role, pvz_ids, expires_at) VALUES
+($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: AcceptInvite :one
+WITH inv AS (
+    UPDATE invites
+    SET used_at = NOW()
+    WHERE token_hash = sqlc.arg('token_hash')
+        AND used_at IS NULL
+        AND expires_at > NOW()
+    RETURNING email, role, pvz_ids
+), usr AS (
+    INSERT INTO users (id, email, password, role)
+    SELECT sqlc.arg('id')::uuid, inv.email, sqlc.arg('password')::varchar, inv.role FROM inv
+    RETURNING id, email, role, active, created_at
+), assigned AS (
+    INSERT INTO user_pvz (user_id, pvz_id)
+    SELECT usr.id, unnest(inv.pvz_ids) FROM usr, inv
+)
+SELECT id, email, role, active, created_at FROM usr;
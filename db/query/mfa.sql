@@ -0,0 +1,31 @@
+-- name: SetUserMFASecret :execrows
+UPDATE users
+SET mfa_secret = $2
+WHERE id = $1 AND NOT mfa_enabled;
+
+-- name: EnableUserMFA :execrows
+WITH usr AS (
+    UPDATE users
+    SET mfa_enabled = true,
+        token_version = token_version + 1
+    WHERE id = sqlc.arg('id')
+        AND mfa_secret IS NOT NULL
+        AND NOT mfa_enabled
+    RETURNING id
+)
+INSERT INTO mfa_recovery_codes (user_id, code_hash)
+SELECT usr.id, unnest(sqlc.arg('code_hashes')::varchar[])
+FROM usr;
+
+-- name: UseMFARecoveryCode :execrows
+UPDATE mfa_recovery_codes
+SET used_at = NOW()
+WHERE user_id = $1
+    AND code_hash = $2
+    AND used_at IS NULL;
+
+-- name: UseUserTOTPStep :execrows
+UPDATE users
+SET mfa_last_step = sqlc.arg('step')::bigint
+WHERE id = sqlc.arg('id')
+    AND (mfa_last_step IS NULL OR mfa_last_step < sqlc.arg('step')::bigint);
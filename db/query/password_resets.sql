@@ -0,0 +1,22 @@
+-- name: CreatePasswordResetCode :one
+INSERT INTO password_reset_codes (id, user_id, code_hash, expires_at)
+SELECT sqlc.arg('id')::uuid, users.id, sqlc.arg('code_hash')::varchar, sqlc.arg('expires_at')::timestamptz
+FROM users
+WHERE users.email = sqlc.arg('email') AND users.active
+RETURNING user_id;
+
+-- name: ResetPasswordByCode :one
+WITH code AS (
+    UPDATE password_reset_codes
+    SET used_at = NOW()
+    WHERE code_hash = sqlc.arg('code_hash')
+        AND used_at IS NULL
+        AND expires_at > NOW()
+    RETURNING user_id
+)
+UPDATE users
+SET password = sqlc.arg('password'),
+    token_version = token_version + 1
+FROM code
+WHERE users.id = code.user_id AND users.active
+RETURNING users.id;
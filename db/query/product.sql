@@ -0,0 +1,90 @@
+-- name: GetProductByID :one
+SELECT * FROM products
+WHERE id = $1;
+
+-- name: UpdateProductState :one
+WITH upd AS (
+    UPDATE products
+    SET state = sqlc.arg('state')
+    FROM receptions r
+    WHERE products.id = sqlc.arg('id') AND products.state = sqlc.arg('from_state')
+        AND r.id = product_reception_id(products.id, products.reception_id) AND r.status = 'close'
+    RETURNING products.*, r.pvz_id
+), event AS (
+    INSERT INTO product_events (product_id, pvz_id, type, actor_id, details)
+    SELECT upd.id, upd.pvz_id, sqlc.arg('event'), sqlc.narg('actor_id')::uuid, sqlc.arg('details')::text::jsonb FROM upd
+)
+SELECT id, date_time, type, reception_id, attributes, barcode, order_id, seq, state, pickup_code_hash, size_class, cell_id, condition, notes FROM upd;
+
+-- name: SetProductPickupCode :one
+-- code is set once, for stored product accepted without it
+UPDATE products
+SET pickup_code_hash = sqlc.arg('pickup_code_hash')
+WHERE id = sqlc.arg('id') AND state = 'stored' AND pickup_code_hash IS NULL
+RETURNING *;
+
+-- name: ListPvzStock :many
+-- products of in_progress receptions and products waiting
+-- for return are on hand too, they just can't be issued
+SELECT p.* FROM products p
+JOIN receptions r ON r.id = product_reception_id(p.id, p.reception_id)
+WHERE r.pvz_id = $1 AND p.state IN ('stored', 'to_return') AND r.status != 'cancelled'
+ORDER BY p.date_time, p.seq
+LIMIT $2 OFFSET $3;
+
+-- name: ListProductEvents :many
+SELECT * FROM product_events
+WHERE product_id = $1
+ORDER BY created_at, id;
+
+-- name: ListStoredProductsBefore :many
+SELECT p.id, p.date_time, p.type, p.reception_id, r.pvz_id, pvz.city FROM products p
+JOIN receptions r ON r.id = product_reception_id(p.id, p.reception_id)
+JOIN pvz ON pvz.id = r.pvz_id
+WHERE p.state = 'stored' AND r.status = 'close' AND p.date_time < $1
+ORDER BY p.date_time;
+
+-- name: GetPvzExpiryStats :one
+-- product expires when accepted before the time of its
+-- type, or before default_before if type isn't listed
+SELECT
+    COUNT(*) FILTER (WHERE p.state = 'stored'
+        AND p.date_time < COALESCE(t.before_time, sqlc.arg('default_before'))) AS expiring_count,
+    COUNT(*) FILTER (WHERE p.state = 'to_return') AS to_return_count
+FROM products p
+JOIN receptions r ON r.id = product_reception_id(p.id, p.reception_id)
+LEFT JOIN unnest(sqlc.arg('types')::varchar[], sqlc.arg('type_befores')::timestamptz[])
+    AS t(type, before_time) ON t.type = p.type
+WHERE r.pvz_id = sqlc.arg('pvz_id') AND r.status = 'close';
+
+-- name: CreateReturnShipment :one
+-- shipment takes all products of PVZ waiting for return,
+-- nothing is created if there are none
+WITH returned AS (
+    UPDATE products
+    SET state = 'returned_to_sender'
+    FROM receptions r
+    WHERE r.id = product_reception_id(products.id, products.reception_id) AND r.pvz_id = sqlc.arg('pvz_id')
+        AND products.state = 'to_return'
+    RETURNING products.id
+), shipment AS (
+    INSERT INTO return_shipments (id, pvz_id, created_by)
+    SELECT sqlc.arg('id'), sqlc.arg('pvz_id'), sqlc.narg('created_by')::uuid
+    WHERE EXISTS (SELECT 1 FROM returned)
+    RETURNING *
+), items AS (
+    INSERT INTO return_shipment_products (shipment_id, product_id)
+    SELECT shipment.id, returned.id FROM shipment, returned
+), events AS (
+    INSERT INTO product_events (product_id, pvz_id, type, actor_id, details)
+    SELECT returned.id, shipment.pvz_id, 'returned_to_sender', shipment.created_by,
+        jsonb_build_object('shipment_id', shipment.id)
+    FROM shipment, returned
+)
+SELECT id, pvz_id, created_by, created_at FROM shipment;
+
+-- name: ListReturnShipmentProducts :many
+SELECT p.* FROM products p
+JOIN return_shipment_products s ON s.product_id = p.id
+WHERE s.shipment_id = $1
+ORDER BY p.date_time, p.seq;
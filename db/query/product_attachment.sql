@@ -0,0 +1,13 @@
+-- name: CreateProductAttachment :one
+INSERT INTO product_attachments (id, product_id, blob_key, content_type, size, uploaded_by) VALUES
+($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: ListProductAttachments :many
+SELECT * FROM product_attachments
+WHERE product_id = $1
+ORDER BY created_at, id;
+
+-- name: GetProductAttachment :one
+SELECT * FROM product_attachments
+WHERE id = $1 AND product_id = $2;
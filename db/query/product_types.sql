@@ -0,0 +1,21 @@
+-- name: ListProductTypes :many
+SELECT * FROM product_types
+ORDER BY name;
+
+-- name: CreateProductType :one
+INSERT INTO product_types (code, name, name_en, attributes, fragile, high_value) VALUES
+($1, $2, $3, $4::text::jsonb, $5, $6)
+RETURNING *;
+
+-- name: UpdateProductType :one
+UPDATE product_types
+SET name_en = COALESCE($2::varchar, name_en),
+    attributes = COALESCE($3::text::jsonb, attributes),
+    fragile = COALESCE($4::boolean, fragile),
+    high_value = COALESCE($5::boolean, high_value)
+WHERE code = $1
+RETURNING *;
+
+-- name: DeleteProductType :execrows
+DELETE FROM product_types
+WHERE code = $1;
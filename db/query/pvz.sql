@@ -1,8 +1,47 @@
 -- name: CreatePVZ :one
-INSERT INTO pvz (id, registration_date, city) VALUES
-($1, $2, $3)
+INSERT INTO pvz (id, registration_date, city, capacity, soft_capacity) VALUES
+($1, $2, $3, $4, $5)
 RETURNING *;
 
+-- name: GetPVZByID :one
+SELECT * FROM pvz
+WHERE id = $1
+LIMIT 1;
+
 -- name: SearchPVZ :many
 SELECT * FROM pvz
-OFFSET $1 LIMIT $2;
\ No newline at end of file
+WHERE ($1::varchar IS NULL OR status = $1::pvz_status_enum)
+    AND ($2::uuid[] IS NULL OR id = ANY($2::uuid[]))
+OFFSET $3 LIMIT $4;
+
+-- name: CountPvzByIDs :one
+SELECT COUNT(*) FROM pvz
+WHERE id = ANY(sqlc.arg('ids')::uuid[]);
+
+-- name: UpdatePVZStatus :one
+WITH old AS (
+    SELECT id, status FROM pvz
+    WHERE id = sqlc.arg('id')
+    FOR UPDATE
+), upd AS (
+    UPDATE pvz
+    SET status = sqlc.arg('status')
+    FROM old
+    WHERE pvz.id = old.id
+    RETURNING pvz.id, pvz.registration_date, pvz.city, pvz.status, pvz.capacity, pvz.soft_capacity, pvz.stock_count, old.status AS old_status
+), history AS (
+    INSERT INTO pvz_status_history (pvz_id, from_status, to_status, changed_by, reason)
+    SELECT upd.id, upd.old_status, upd.status, sqlc.narg('changed_by')::uuid, sqlc.arg('reason')::varchar FROM upd
+    WHERE upd.old_status != upd.status
+)
+SELECT id, registration_date, city, status, capacity, soft_capacity, stock_count FROM upd;
+
+-- name: UpdatePVZCapacity :one
+UPDATE pvz
+SET capacity = sqlc.narg('capacity'), soft_capacity = sqlc.narg('soft_capacity')
+WHERE id = sqlc.arg('id')
+RETURNING *;
+
+-- name: ListPVZStockCounts :many
+SELECT id, capacity, stock_count FROM pvz
+WHERE status != 'decommissioned';
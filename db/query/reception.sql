@@ -8,35 +8,217 @@ SELECT * FROM receptions
 WHERE pvz_id = $1 AND status = 'in_progress'
 LIMIT 1;
 
+-- name: GetLastClosedReceptionByPvzID :one
+SELECT * FROM receptions
+WHERE pvz_id = $1 AND status = 'close'
+ORDER BY date_time DESC
+LIMIT 1;
+
+-- name: GetPvzStatsSince :one
+SELECT
+    (SELECT COUNT(*) FROM receptions r
+        WHERE r.pvz_id = @pvz_id AND r.date_time >= @since AND r.status != 'cancelled') AS receptions_count,
+    (SELECT COUNT(*) FROM products p
+        JOIN receptions r ON r.id = p.reception_id
+        WHERE r.pvz_id = @pvz_id AND p.date_time >= @since AND r.status != 'cancelled') AS products_count;
+
 -- name: SearchReceptionsByTime :many
 SELECT * FROM receptions
 WHERE date_time BETWEEN $1 AND $2;
 
 -- name: SearchReceptionsByPvzsAndTime :many
 SELECT * FROM receptions
-WHERE pvz_id = ANY(@pvz_ids::uuid[]) AND date_time BETWEEN @start_date AND @end_date;
+WHERE pvz_id = ANY(@pvz_ids::uuid[]) AND date_time BETWEEN @start_date AND @end_date
+    AND status != 'cancelled';
 
 -- name: AddProductToReception :one
-INSERT INTO products (id, type, reception_id) VALUES
-($1, $2, $3)
+INSERT INTO products (id, type, reception_id, attributes, barcode, order_id, pickup_code_hash, size_class, condition, notes) VALUES
+($1, $2, $3, $4::text::jsonb, $5, $6, $7, $8, $9, $10)
 RETURNING *;
 
--- name: GetProductsFromReception :many
+-- name: AddProductsToReception :many
+INSERT INTO products (id, type, reception_id, attributes, barcode, order_id, pickup_code_hash, size_class, condition, notes)
+SELECT u.id, u.type, @reception_id::uuid, u.attributes::jsonb, NULLIF(u.barcode, ''), NULLIF(u.order_id, ''), NULLIF(u.pickup_code_hash, ''),
+    u.size_class::size_class, u.condition::product_condition, NULLIF(u.notes, '')
+FROM unnest(
+    @ids::uuid[],
+    @types::varchar[],
+    @attributes::text[],
+    @barcodes::varchar[],
+    @order_ids::varchar[],
+    @pickup_code_hashes::varchar[],
+    @size_classes::varchar[],
+    @conditions::varchar[],
+    @notes::varchar[]
+) WITH ORDINALITY AS u(id, type, attributes, barcode, order_id, pickup_code_hash, size_class, condition, notes, n)
+ORDER BY u.n
+RETURNING *;
+
+-- name: FindProductsByBarcodes :many
+-- products issued, returned or moved out of PVZ and
+-- products of other PVZs may be legitimately received again
+SELECT p.* FROM products p
+JOIN receptions r ON r.id = product_reception_id(p.id, p.reception_id)
+WHERE p.barcode = ANY(@barcodes::varchar[])
+    AND (p.reception_id = @reception_id
+        OR (r.pvz_id = @pvz_id AND p.state = 'stored' AND r.status != 'cancelled'
+            AND @since::timestamptz IS NOT NULL AND p.date_time >= @since::timestamptz))
+ORDER BY p.date_time DESC, p.seq DESC;
+
+-- name: GetProductInReceptionByBarcode :one
 SELECT * FROM products
-WHERE reception_id IN ($1);
+WHERE reception_id = $1 AND barcode = $2;
+
+-- name: SearchProductsByBarcode :many
+SELECT p.* FROM products p
+JOIN receptions r ON r.id = product_reception_id(p.id, p.reception_id)
+WHERE p.barcode = $1
+    AND ($2::uuid[] IS NULL OR r.pvz_id = ANY($2::uuid[]))
+    AND ($3::timestamptz IS NULL OR p.date_time >= $3::timestamptz)
+ORDER BY p.date_time DESC;
+
+-- name: GetProductsFromReception :many
+-- products accepted by reception or received into it by transfer
+SELECT p.* FROM products p
+JOIN reception_products rp ON rp.product_id = p.id
+WHERE rp.reception_id IN ($1)
+ORDER BY p.date_time, p.seq;
 
 -- name: GetLastProductInReception :one
-SELECT * FROM products
-WHERE reception_id = $1
-ORDER BY date_time DESC
+-- only products accepted by intake since reception was
+-- opened are deleted by LIFO, not issued ones or ones
+-- added before reopen
+SELECT p.* FROM products p
+JOIN receptions r ON r.id = p.reception_id
+WHERE p.reception_id = $1
+    AND p.state = 'stored'
+    AND p.date_time >= r.opened_at
+ORDER BY p.date_time DESC, p.seq DESC
 LIMIT 1;
 
--- name: DeleteProduct :exec
+-- name: DeleteProduct :execrows
 DELETE FROM products
-WHERE id = $1;
+WHERE id = $1 AND state = 'stored';
+
+-- name: DeleteEmptyReception :execrows
+-- reception started for transfer which failed to be received
+DELETE FROM receptions
+WHERE id = $1 AND status = 'in_progress'
+    AND NOT EXISTS (SELECT 1 FROM reception_products WHERE reception_id = $1);
 
 -- name: FinishReception :one
 UPDATE receptions
 SET status='close'
 WHERE id=(SELECT id FROM receptions R WHERE R.pvz_id=$1 AND R.status='in_progress' LIMIT 1)
-RETURNING *;
\ No newline at end of file
+RETURNING *;
+
+-- name: GetReceptionByID :one
+SELECT * FROM receptions
+WHERE id = $1;
+
+-- name: GetProductReception :one
+-- reception holding product now
+SELECT r.* FROM receptions r
+JOIN products p ON r.id = product_reception_id(p.id, p.reception_id)
+WHERE p.id = $1;
+
+-- name: GetProductsFromReceptionLIFO :many
+SELECT p.* FROM products p
+JOIN reception_products rp ON rp.product_id = p.id
+WHERE rp.reception_id = $1
+ORDER BY p.date_time DESC, p.seq DESC;
+
+-- name: ListPvzReceptions :many
+SELECT * FROM receptions
+WHERE pvz_id = sqlc.arg('pvz_id')
+    AND (sqlc.narg('status')::status_enum IS NULL OR status = sqlc.narg('status')::status_enum)
+    AND (sqlc.narg('from')::timestamptz IS NULL OR date_time >= sqlc.narg('from')::timestamptz)
+    AND (sqlc.narg('to')::timestamptz IS NULL OR date_time < sqlc.narg('to')::timestamptz)
+    AND (sqlc.narg('before_date_time')::timestamptz IS NULL
+        OR (date_time, id) < (sqlc.narg('before_date_time')::timestamptz, sqlc.narg('before_id')::uuid))
+ORDER BY date_time DESC, id DESC
+LIMIT sqlc.arg('limit');
+
+-- name: CountProductsByType :many
+SELECT rp.reception_id, p.type, COUNT(*) AS count
+FROM reception_products rp
+JOIN products p ON p.id = rp.product_id
+WHERE rp.reception_id = ANY(@reception_ids::uuid[])
+GROUP BY rp.reception_id, p.type;
+
+-- name: CountProductsByCondition :many
+SELECT rp.reception_id,
+    COUNT(*) FILTER (WHERE p.condition = 'damaged') AS damaged,
+    COUNT(*) FILTER (WHERE p.condition = 'opened') AS opened
+FROM reception_products rp
+JOIN products p ON p.id = rp.product_id
+WHERE rp.reception_id = ANY(@reception_ids::uuid[]) AND p.condition != 'ok'
+GROUP BY rp.reception_id;
+
+-- name: CreateReceptionWithManifest :one
+WITH r AS (
+    INSERT INTO receptions (id, date_time, pvz_id) VALUES
+    (sqlc.arg('id'), sqlc.arg('date_time'), sqlc.arg('pvz_id'))
+    RETURNING *
+), manifest AS (
+    INSERT INTO reception_manifests (reception_id, barcodes, type_counts)
+    SELECT r.id, sqlc.arg('barcodes')::text[], sqlc.arg('type_counts')::text::jsonb FROM r
+)
+SELECT * FROM r;
+
+-- name: GetReceptionManifest :one
+SELECT * FROM reception_manifests
+WHERE reception_id = $1;
+
+-- name: FinishReceptionWithReconciliation :one
+WITH r AS (
+    UPDATE receptions
+    SET status = 'close'
+    WHERE id = sqlc.arg('id') AND status = 'in_progress'
+    RETURNING *
+), reconciliation AS (
+    INSERT INTO reception_reconciliations (reception_id, discrepancies, overridden)
+    SELECT r.id, sqlc.arg('discrepancies')::text::jsonb, sqlc.arg('overridden') FROM r
+    ON CONFLICT (reception_id) DO UPDATE
+    SET discrepancies = EXCLUDED.discrepancies,
+        overridden = EXCLUDED.overridden,
+        created_at = NOW()
+)
+SELECT * FROM r;
+
+-- name: GetReceptionReconciliation :one
+SELECT * FROM reception_reconciliations
+WHERE reception_id = $1;
+
+-- name: UpdateReceptionStatus :one
+WITH old AS (
+    SELECT id, status FROM receptions
+    WHERE id = sqlc.arg('id')
+    FOR UPDATE
+), upd AS (
+    UPDATE receptions
+    SET status = sqlc.arg('status'),
+        opened_at = CASE WHEN sqlc.arg('status') = 'in_progress' THEN NOW() ELSE receptions.opened_at END
+    FROM old
+    WHERE receptions.id = old.id AND old.status = sqlc.arg('from_status')
+    RETURNING receptions.id, receptions.date_time, receptions.pvz_id, receptions.status, receptions.opened_at, old.status AS old_status
+), history AS (
+    INSERT INTO reception_status_history (reception_id, from_status, to_status, changed_by, reason)
+    SELECT upd.id, upd.old_status, upd.status, sqlc.narg('changed_by')::uuid, sqlc.arg('reason')::varchar FROM upd
+), stale AS (
+    -- reopened reception will be reconciled again on close
+    DELETE FROM reception_reconciliations
+    WHERE reception_id IN (SELECT upd.id FROM upd WHERE upd.status = 'in_progress')
+)
+SELECT id, date_time, pvz_id, status, opened_at FROM upd;
+
+-- name: ListOpenReceptionsBefore :many
+SELECT r.id, r.date_time, r.pvz_id, r.status, r.opened_at, p.city, a.alerted_at FROM receptions r
+JOIN pvz p ON p.id = r.pvz_id
+LEFT JOIN reception_stale_alerts a ON a.reception_id = r.id
+WHERE r.status = 'in_progress' AND r.opened_at < @before
+ORDER BY r.opened_at;
+
+-- name: MarkReceptionStaleAlerted :exec
+INSERT INTO reception_stale_alerts (reception_id) VALUES ($1)
+ON CONFLICT (reception_id) DO UPDATE SET alerted_at = NOW();
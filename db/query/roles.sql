@@ -0,0 +1,7 @@
+-- name: ListRolePermissions :many
+SELECT r.name AS role, rp.permission FROM roles r
+LEFT JOIN role_permissions rp ON rp.role = r.name;
+
+-- name: CountPermissionsByNames :one
+SELECT COUNT(*) FROM permissions
+WHERE name = ANY(sqlc.arg('names')::varchar[]);
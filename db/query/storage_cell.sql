@@ -0,0 +1,56 @@
+-- name: CreateStorageCell :one
+INSERT INTO storage_cells (id, pvz_id, zone, rack, shelf, size_class, capacity) VALUES
+($1, $2, $3, $4, $5, $6, $7)
+RETURNING *;
+
+-- name: GetStorageCellByID :one
+SELECT * FROM storage_cells
+WHERE id = $1;
+
+-- name: ListPvzStorageCells :many
+SELECT * FROM storage_cells
+WHERE pvz_id = $1
+ORDER BY zone, rack, shelf;
+
+-- name: AssignFreeStorageCell :one
+-- free cell of the same or closest bigger size class is
+-- locked, so concurrent placements pick different cells
+WITH cell AS (
+    SELECT * FROM storage_cells c
+    WHERE c.pvz_id = @pvz_id AND c.size_class >= @size_class
+        AND c.occupied < c.capacity
+    ORDER BY c.size_class, c.zone, c.rack, c.shelf
+    LIMIT 1
+    FOR UPDATE SKIP LOCKED
+), placed AS (
+    UPDATE products
+    SET cell_id = cell.id
+    FROM cell
+    WHERE products.id = @product_id
+    RETURNING products.cell_id
+)
+SELECT cell.id, cell.pvz_id, cell.zone, cell.rack, cell.shelf, cell.size_class, cell.capacity, cell.occupied + 1 AS occupied, cell.created_at
+FROM cell
+JOIN placed ON placed.cell_id = cell.id;
+
+-- name: MoveProductToCell :one
+-- storage_cells_capacity_check fails if target cell is full
+WITH prev AS (
+    SELECT cell_id FROM products
+    WHERE id = @id
+), moved AS (
+    UPDATE products
+    SET cell_id = c.id
+    FROM storage_cells c, receptions r
+    WHERE products.id = @id AND c.id = @cell_id
+        AND r.id = product_reception_id(products.id, products.reception_id) AND c.pvz_id = r.pvz_id
+        AND c.size_class >= products.size_class
+        AND products.state IN ('stored', 'to_return')
+    RETURNING products.*, r.pvz_id
+), event AS (
+    INSERT INTO product_events (product_id, pvz_id, type, actor_id, details)
+    SELECT moved.id, moved.pvz_id, 'moved', @actor_id::uuid,
+        jsonb_build_object('from_cell_id', prev.cell_id, 'to_cell_id', moved.cell_id)
+    FROM moved, prev
+)
+SELECT id, date_time, type, reception_id, attributes, barcode, order_id, seq, state, pickup_code_hash, size_class, cell_id, condition, notes FROM moved;
@@ -0,0 +1,102 @@
+-- name: CreateTransfer :one
+WITH transfer AS (
+    INSERT INTO transfers (id, from_pvz_id, to_pvz_id, created_by)
+    VALUES (sqlc.arg('id'), sqlc.arg('from_pvz_id'), sqlc.arg('to_pvz_id'), sqlc.narg('created_by'))
+    RETURNING *
+), items AS (
+    INSERT INTO transfer_products (transfer_id, product_id)
+    SELECT transfer.id, unnest(sqlc.arg('product_ids')::uuid[]) FROM transfer
+)
+SELECT * FROM transfer;
+
+-- name: GetTransferByID :one
+SELECT * FROM transfers
+WHERE id = $1;
+
+-- name: ListTransferProducts :many
+SELECT p.* FROM products p
+JOIN transfer_products tp ON tp.product_id = p.id
+WHERE tp.transfer_id = $1
+ORDER BY p.date_time, p.seq;
+
+-- name: ListTransferCandidates :many
+-- product is in transfer until it is received
+SELECT p.id, p.state, r.pvz_id, r.status AS reception_status,
+    EXISTS (
+        SELECT 1 FROM transfer_products tp
+        JOIN transfers t ON t.id = tp.transfer_id
+        WHERE tp.product_id = p.id AND t.status != 'received'
+    ) AS in_transfer
+FROM products p
+JOIN receptions r ON r.id = product_reception_id(p.id, p.reception_id)
+WHERE p.id = ANY(sqlc.arg('ids')::uuid[]);
+
+-- name: DispatchTransfer :one
+-- transfer is dispatched only if all its products are
+-- still stored in source PVZ, they leave their cells
+WITH transfer AS (
+    SELECT * FROM transfers
+    WHERE transfers.id = sqlc.arg('id') AND transfers.status = 'created'
+    FOR UPDATE
+), items AS (
+    SELECT p.id, p.state, r.pvz_id, r.status AS reception_status
+    FROM transfer_products tp
+    JOIN products p ON p.id = tp.product_id
+    JOIN receptions r ON r.id = product_reception_id(p.id, p.reception_id)
+    WHERE tp.transfer_id = sqlc.arg('id')
+    FOR UPDATE OF p
+), dispatched AS (
+    UPDATE transfers
+    SET status = 'in_transit', dispatched_by = sqlc.narg('actor_id'), dispatched_at = NOW()
+    FROM transfer
+    WHERE transfers.id = transfer.id AND NOT EXISTS (
+        SELECT 1 FROM items
+        WHERE items.state != 'stored' OR items.reception_status != 'close'
+            OR items.pvz_id != transfer.from_pvz_id
+    )
+    RETURNING transfers.*
+), moved AS (
+    UPDATE products
+    SET state = 'in_transit', cell_id = NULL
+    FROM dispatched, items
+    WHERE products.id = items.id
+    RETURNING products.id, dispatched.id AS transfer_id, dispatched.from_pvz_id,
+        dispatched.to_pvz_id, dispatched.dispatched_by
+), events AS (
+    INSERT INTO product_events (product_id, pvz_id, type, actor_id, details)
+    SELECT moved.id, moved.from_pvz_id, 'transfer_dispatched', moved.dispatched_by,
+        jsonb_build_object('transfer_id', moved.transfer_id, 'to_pvz_id', moved.to_pvz_id)
+    FROM moved
+)
+SELECT * FROM dispatched;
+
+-- name: ReceiveTransfer :one
+-- products keep reception they were accepted by, transfer
+-- links them to reception of destination PVZ, which must be
+-- in progress, and stock trigger checks its capacity
+WITH received AS (
+    UPDATE transfers
+    SET status = 'received', reception_id = sqlc.arg('reception_id'),
+        received_by = sqlc.narg('actor_id'), received_at = NOW()
+    WHERE transfers.id = sqlc.arg('id') AND transfers.status = 'in_transit'
+        AND EXISTS (
+            SELECT 1 FROM receptions r
+            WHERE r.id = sqlc.arg('reception_id') AND r.pvz_id = transfers.to_pvz_id
+        )
+    RETURNING *
+), moved AS (
+    UPDATE products
+    SET state = 'stored'
+    FROM received, transfer_products tp
+    WHERE tp.transfer_id = received.id AND products.id = tp.product_id
+        AND products.state = 'in_transit'
+    RETURNING products.id, received.id AS transfer_id, received.from_pvz_id,
+        received.to_pvz_id, received.reception_id, received.received_by
+), events AS (
+    INSERT INTO product_events (product_id, pvz_id, type, actor_id, details)
+    SELECT moved.id, moved.to_pvz_id, 'transfer_received', moved.received_by,
+        jsonb_build_object('transfer_id', moved.transfer_id, 'from_pvz_id', moved.from_pvz_id,
+            'reception_id', moved.reception_id)
+    FROM moved
+)
+SELECT * FROM received;
@@ -7,3 +7,52 @@ RETURNING *;
 SELECT * FROM users
 WHERE email = $1
 LIMIT 1;
+
+-- name: GetUserByID :one
+SELECT * FROM users
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListUsers :many
+SELECT * FROM users
+WHERE (sqlc.narg('role')::varchar IS NULL OR role = sqlc.narg('role')::varchar)
+    AND (sqlc.narg('active')::boolean IS NULL OR active = sqlc.narg('active')::boolean)
+    AND (sqlc.narg('email')::varchar IS NULL OR email ILIKE '%' || sqlc.narg('email')::varchar || '%')
+ORDER BY created_at DESC, id
+OFFSET sqlc.arg('offset') LIMIT sqlc.arg('limit');
+
+-- name: UpdateUser :one
+-- pvz_ids replaces PVZs user is assigned to, NULL keeps them
+WITH unassigned AS (
+    DELETE FROM user_pvz
+    WHERE user_id = sqlc.arg('id') AND sqlc.narg('pvz_ids')::uuid[] IS NOT NULL
+        AND NOT (pvz_id = ANY(sqlc.narg('pvz_ids')::uuid[]))
+), assigned AS (
+    INSERT INTO user_pvz (user_id, pvz_id)
+    SELECT u.id, unnest(sqlc.narg('pvz_ids')::uuid[]) FROM users u
+    WHERE u.id = sqlc.arg('id')
+    ON CONFLICT DO NOTHING
+)
+UPDATE users
+SET role = COALESCE(sqlc.narg('role')::varchar, role),
+    active = COALESCE(sqlc.narg('active')::boolean, active),
+    token_version = token_version + 1
+WHERE id = sqlc.arg('id')
+RETURNING *;
+
+-- name: UpdateUserPassword :one
+UPDATE users
+SET password = $2,
+    token_version = token_version + 1
+WHERE id = $1
+RETURNING *;
+
+-- name: GetUserTokenVersion :one
+SELECT token_version FROM users
+WHERE id = $1 AND active
+LIMIT 1;
+
+-- name: ListUserPvzIDs :many
+SELECT pvz_id FROM user_pvz
+WHERE user_id = $1
+ORDER BY pvz_id;
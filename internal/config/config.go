@@ -1,16 +1,44 @@
 package config
 
 import (
+	"errors"
+	"fmt"
 	"log"
+	"time"
 
 	"github.com/spf13/viper"
 
 	pvzv1 "github.com/myacey/avito-backend-assignment-pvz/internal/grpc/pvz/v1"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/blobstore"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/jwttoken"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/mailer"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/ratelimit"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/rbac"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web"
 )
 
+// Env is an environment mode service runs in.
+type Env string
+
+const (
+	EnvDev  Env = "dev"
+	EnvTest Env = "test"
+	EnvProd Env = "prod"
+)
+
+const (
+	redacted = "[redacted]"
+
+	insecureJWTSecret        = "secret"
+	insecurePostgresPassword = "secret"
+	insecurePickupCodeKey    = "secret"
+	minJWTSecretLen          = 32
+	minPickupCodeKeyLen      = 32
+)
+
 type AppConfig struct {
+	Env Env `mapstructure:"env"`
+
 	PostgresHost     string `mapstructure:"POSTGRES_HOST"`
 	PostgresPort     string `mapstructure:"POSTGRES_PORT"`
 	PostgresUser     string `mapstructure:"POSTGRES_USER"`
@@ -20,20 +48,192 @@ type AppConfig struct {
 	HTTPServerCfg web.ServerConfig `mapstructure:"httpserver"`
 	GRPCServerCfg pvzv1.Config     `mapstructure:"grpcserver"`
 
-	TokenService jwttoken.TokenServiceConfig `mapstructure:"auth"`
+	TokenService  jwttoken.TokenServiceConfig `mapstructure:"auth"`
+	RBAC          rbac.Config                 `mapstructure:"rbac"`
+	Mailer        mailer.Config               `mapstructure:"mailer"`
+	Invite        InviteConfig                `mapstructure:"invite"`
+	PasswordReset PasswordResetConfig         `mapstructure:"password_reset"`
+	MFA           MFAConfig                   `mapstructure:"mfa"`
+	Cities        CitiesConfig                `mapstructure:"cities"`
+	ProductTypes  ProductTypesConfig          `mapstructure:"product_types"`
+	Pvz           PvzConfig                   `mapstructure:"pvz"`
+	Products      ProductsConfig              `mapstructure:"products"`
+	Receptions    ReceptionsConfig            `mapstructure:"receptions"`
+	Idempotency   IdempotencyConfig           `mapstructure:"idempotency"`
+	Attachments   AttachmentsConfig           `mapstructure:"attachments"`
+}
+
+type CitiesConfig struct {
+	CacheTTL time.Duration `mapstructure:"cache_ttl"`
+}
+
+type ProductTypesConfig struct {
+	CacheTTL time.Duration `mapstructure:"cache_ttl"`
+}
+
+type PvzConfig struct {
+	// StockMetricsInterval is how often PVZ stock
+	// and utilization gauges are refreshed.
+	StockMetricsInterval time.Duration `mapstructure:"stock_metrics_interval"`
+}
+
+type ProductsConfig struct {
+	// DuplicateWindow is how far back barcode is looked
+	// up in other receptions, 0 disables the check.
+	DuplicateWindow time.Duration `mapstructure:"duplicate_window"`
+	// BatchLimit is max number of products in one batch.
+	BatchLimit int           `mapstructure:"batch_limit"`
+	Storage    StorageConfig `mapstructure:"storage"`
+	// PickupCodeKey is HMAC key of stored pickup codes hashes.
+	PickupCodeKey string `mapstructure:"pickup_code_key"`
+	// PickupRateLimit is per product pickup code is checked for.
+	PickupRateLimit ratelimit.Config `mapstructure:"pickup_rate_limit"`
+}
+
+type StorageConfig struct {
+	// Period is how long product is stored since acceptance,
+	// Cities and Types override it, type wins over city.
+	Period time.Duration       `mapstructure:"period"`
+	Cities []CityStoragePeriod `mapstructure:"cities"`
+	Types  []TypeStoragePeriod `mapstructure:"types"`
+	// ExpiringWindow is how soon storage period must end for
+	// product to be shown as expiring in PVZ details.
+	ExpiringWindow time.Duration `mapstructure:"expiring_window"`
+	CheckInterval  time.Duration `mapstructure:"check_interval"`
+}
+
+type CityStoragePeriod struct {
+	City   string        `mapstructure:"city"`
+	Period time.Duration `mapstructure:"period"`
+}
+
+type TypeStoragePeriod struct {
+	Type   string        `mapstructure:"type"`
+	Period time.Duration `mapstructure:"period"`
+}
+
+type ReceptionsConfig struct {
+	// BlockOnDiscrepancy forbids closing reception which
+	// doesn't match its manifest without moderator override.
+	BlockOnDiscrepancy bool                  `mapstructure:"block_on_discrepancy"`
+	Stale              StaleReceptionsConfig `mapstructure:"stale"`
+}
+
+type StaleReceptionsConfig struct {
+	// Action is close, alert or both,
+	// empty disables the check.
+	Action        string        `mapstructure:"action"`
+	CheckInterval time.Duration `mapstructure:"check_interval"`
+	// Threshold is how long reception may stay open,
+	// Cities override it for listed cities.
+	Threshold time.Duration   `mapstructure:"threshold"`
+	Cities    []CityThreshold `mapstructure:"cities"`
+}
+
+// CityThreshold is a list item rather than map key,
+// because viper lowercases keys and city is a name.
+type CityThreshold struct {
+	City      string        `mapstructure:"city"`
+	Threshold time.Duration `mapstructure:"threshold"`
+}
+
+type IdempotencyConfig struct {
+	// TTL is how long response is kept for retries.
+	TTL             time.Duration `mapstructure:"ttl"`
+	CleanupInterval time.Duration `mapstructure:"cleanup_interval"`
+	// MaxBodySize is max request body size in bytes.
+	MaxBodySize int64 `mapstructure:"max_body_size"`
+}
+
+type AttachmentsConfig struct {
+	Store blobstore.Config `mapstructure:"store"`
+	// MaxSize is max file size in bytes.
+	MaxSize int64 `mapstructure:"max_size"`
+	// AllowedTypes are MIME types file content
+	// may be detected as.
+	AllowedTypes []string `mapstructure:"allowed_types"`
+}
+
+type InviteConfig struct {
+	TTL time.Duration `mapstructure:"ttl"`
+}
+
+type PasswordResetConfig struct {
+	CodeTTL   time.Duration    `mapstructure:"code_ttl"`
+	RateLimit ratelimit.Config `mapstructure:"rate_limit"`
+	// EmailRateLimit limits reset codes sent to one email.
+	EmailRateLimit ratelimit.Config `mapstructure:"email_rate_limit"`
+}
+
+type MFAConfig struct {
+	Issuer               string           `mapstructure:"issuer"`
+	RequiredForModerator bool             `mapstructure:"required_for_moderator"`
+	RateLimit            ratelimit.Config `mapstructure:"rate_limit"`
+	// UserRateLimit limits code attempts per user.
+	UserRateLimit ratelimit.Config `mapstructure:"user_rate_limit"`
 }
 
 func LoadConfig(cfgPath string) (config AppConfig, err error) {
 	viper.SetConfigFile(".env")
 	viper.ReadInConfig()
 	viper.AutomaticEnv()
+	viper.BindEnv("env")
 
 	viper.SetConfigFile(cfgPath)
 	viper.MergeInConfig()
 
 	viper.Unmarshal(&config)
 
-	log.Println("config:", config)
+	// unset env must not enable dev-only
+	// features like /dummyLogin, so it's prod
+	if config.Env == "" {
+		config.Env = EnvProd
+	}
+
+	log.Println("config:", config.Redacted())
+
+	err = config.Validate()
 
 	return
 }
+
+// Redacted returns a copy of config with secrets
+// replaced, so it can be logged.
+func (c AppConfig) Redacted() AppConfig {
+	for _, v := range []*string{
+		&c.PostgresPassword,
+		&c.TokenService.SecretKey,
+		&c.Products.PickupCodeKey,
+	} {
+		if *v != "" {
+			*v = redacted
+		}
+	}
+
+	return c
+}
+
+// Validate checks that environment mode is known
+// and insecure defaults are not used in prod.
+func (c AppConfig) Validate() error {
+	switch c.Env {
+	case EnvDev, EnvTest:
+		return nil
+	case EnvProd:
+	default:
+		return fmt.Errorf("unknown env: %q", c.Env)
+	}
+
+	var errs []error
+	if c.TokenService.SecretKey == insecureJWTSecret || len(c.TokenService.SecretKey) < minJWTSecretLen {
+		errs = append(errs, fmt.Errorf("auth.jwt_secret_key must be at least %d characters and not default in prod", minJWTSecretLen))
+	}
+	if c.PostgresPassword == insecurePostgresPassword {
+		errs = append(errs, errors.New("POSTGRES_PASSWORD must not be default in prod"))
+	}
+	if c.Products.PickupCodeKey == insecurePickupCodeKey || len(c.Products.PickupCodeKey) < minPickupCodeKeyLen {
+		errs = append(errs, fmt.Errorf("products.pickup_code_key must be at least %d characters and not default in prod", minPickupCodeKeyLen))
+	}
+
+	return errors.Join(errs...)
+}
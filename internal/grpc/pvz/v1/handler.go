@@ -2,37 +2,287 @@ package pvzv1
 
 import (
 	context "context"
-	"math"
+	"errors"
 	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type PVZServer struct {
 	UnimplementedPVZServiceServer
-	srv PvzFinder
+	srv           PvzFinder
+	detailsSrv    PvzDetailsFinder
+	productsSrv   ProductsBatchAdder
+	receptionsSrv ReceptionFinder
 }
 
-func (s *PVZServer) GetPVZList(ctx context.Context, _ *GetPVZListRequest) (*GetPVZListResponse, error) {
-	pvzs, err := s.srv.SearchPvz(ctx, &request.SearchPvz{
+const (
+	defaultReceptionsLimit = 50
+	maxReceptionsLimit     = 100
+)
+
+func (s *PVZServer) GetPVZList(ctx context.Context, req *GetPVZListRequest) (*GetPVZListResponse, error) {
+	searchReq := &request.SearchPvz{
 		StartDate: time.Date(0, 0, 0, 0, 0, 0, 0, time.Local),
 		EndDate:   time.Now(),
 		Page:      1,
-		Limit:     math.MaxInt32,
-	})
+	}
+	if st := req.GetStatus(); st != "" {
+		if _, ok := entity.PvzStatuses[entity.PvzStatus(st)]; !ok {
+			return nil, status.Error(codes.InvalidArgument, "invalid status: "+st)
+		}
+		searchReq.Status = &st
+	}
+
+	if p, ok := principal.FromContext(ctx); ok {
+		searchReq.PvzIDs = p.PvzScope()
+	}
+
+	pvzs, err := s.srv.SearchPvz(ctx, searchReq)
 	if err != nil {
 		return nil, err
 	}
 
 	var res []*PVZ
 	for _, pvz := range pvzs {
-		res = append(res, &PVZ{
-			Id:               pvz.ID.String(),
-			City:             string(pvz.City),
-			RegistrationDate: timestamppb.New(pvz.RegistrationDate),
-		})
+		res = append(res, toProtoPvz(pvz))
 	}
 
 	return &GetPVZListResponse{Pvzs: res}, nil
 }
+
+func (s *PVZServer) GetPVZ(ctx context.Context, req *GetPVZRequest) (*GetPVZResponse, error) {
+	pvzID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid pvz id")
+	}
+
+	if p, ok := principal.FromContext(ctx); ok && !p.CanAccessPvz(pvzID) {
+		return nil, status.Error(codes.PermissionDenied, "no access to pvz")
+	}
+
+	details, err := s.detailsSrv.GetPvzDetails(ctx, pvzID)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	res := &GetPVZResponse{
+		Pvz:                 toProtoPvz(details.Pvz),
+		OpenReception:       toProtoReception(details.OpenReception),
+		LastClosedReception: toProtoReception(details.LastClosedReception),
+		ReceptionsToday:     details.ReceptionsToday,
+		ProductsToday:       details.ProductsToday,
+		ProductsExpiring:    details.ProductsExpiring,
+		ProductsToReturn:    details.ProductsToReturn,
+		StockCount:          int64(details.Pvz.StockCount),
+		Capacity:            int64(details.Pvz.Capacity),
+		Utilization:         details.Pvz.Utilization(),
+		CapacityWarning:     details.Pvz.OverSoftCapacity(),
+	}
+	for _, p := range details.OpenReceptionProducts {
+		res.Products = append(res.Products, toProtoProduct(p))
+	}
+
+	return res, nil
+}
+
+func (s *PVZServer) AddProducts(ctx context.Context, req *AddProductsRequest) (*AddProductsResponse, error) {
+	pvzID, err := uuid.Parse(req.GetPvzId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid pvz id")
+	}
+	if len(req.GetProducts()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "no products in batch")
+	}
+
+	if p, ok := principal.FromContext(ctx); ok && !p.CanAccessPvz(pvzID) {
+		return nil, status.Error(codes.PermissionDenied, "no access to pvz")
+	}
+
+	batchReq := &request.AddProductsBatch{
+		PvzID:    pvzID,
+		Products: make([]request.BatchProduct, len(req.GetProducts())),
+	}
+	for i, p := range req.GetProducts() {
+		if p.GetType() == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "product %d: type is required", i)
+		}
+		if utf8.RuneCountInString(p.GetNotes()) > 1000 {
+			return nil, status.Errorf(codes.InvalidArgument, "product %d: notes are too long", i)
+		}
+		batchReq.Products[i] = request.BatchProduct{
+			Type:       p.GetType(),
+			Barcode:    p.GetBarcode(),
+			OrderID:    p.GetOrderId(),
+			PickupCode: p.GetPickupCode(),
+			Attributes: p.GetAttributes(),
+			SizeClass:  p.GetSizeClass(),
+			Condition:  p.GetCondition(),
+			Notes:      p.GetNotes(),
+		}
+	}
+
+	batch, err := s.productsSrv.AddProductsBatch(ctx, batchReq)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	res := &AddProductsResponse{Accepted: batch.Accepted()}
+	for _, r := range batch.Results {
+		resp := r.ToResponse()
+		item := &BatchProductResult{
+			Status: resp.Status,
+			Error:  resp.Error,
+		}
+		if r.Product != nil {
+			item.Product = toProtoProduct(r.Product)
+		}
+		var dup *entity.DuplicateProductError
+		if errors.As(r.Err, &dup) {
+			item.Existing = toProtoProduct(dup.Existing)
+		}
+		res.Results = append(res.Results, item)
+	}
+
+	return res, nil
+}
+
+func (s *PVZServer) GetReception(ctx context.Context, req *GetReceptionRequest) (*GetReceptionResponse, error) {
+	receptionID, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid reception id")
+	}
+
+	details, err := s.receptionsSrv.GetReception(ctx, receptionID)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	res := &GetReceptionResponse{Reception: toProtoReception(details.Reception)}
+	for _, p := range details.Products {
+		res.Products = append(res.Products, toProtoProduct(p))
+	}
+
+	return res, nil
+}
+
+func (s *PVZServer) ListReceptions(ctx context.Context, req *ListReceptionsRequest) (*ListReceptionsResponse, error) {
+	pvzID, err := uuid.Parse(req.GetPvzId())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid pvz id")
+	}
+	if req.GetLimit() < 0 || req.GetLimit() > maxReceptionsLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d", maxReceptionsLimit)
+	}
+
+	if p, ok := principal.FromContext(ctx); ok && !p.CanAccessPvz(pvzID) {
+		return nil, status.Error(codes.PermissionDenied, "no access to pvz")
+	}
+
+	listReq := &request.ListReceptions{
+		PvzID: pvzID,
+		Limit: int(req.GetLimit()),
+	}
+	if listReq.Limit == 0 {
+		listReq.Limit = defaultReceptionsLimit
+	}
+	if st := req.GetStatus(); st != "" {
+		listReq.Status = &st
+	}
+	if req.From != nil {
+		from := req.GetFrom().AsTime()
+		listReq.From = &from
+	}
+	if req.To != nil {
+		to := req.GetTo().AsTime()
+		listReq.To = &to
+	}
+	if c := req.GetCursor(); c != "" {
+		listReq.Cursor, err = request.DecodeReceptionCursor(c)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid cursor")
+		}
+	}
+
+	receptions, err := s.receptionsSrv.ListPvzReceptions(ctx, listReq)
+	if err != nil {
+		return nil, toStatusError(err)
+	}
+
+	res := &ListReceptionsResponse{}
+	for _, r := range receptions {
+		counts := make(map[string]int64, len(r.ProductCounts))
+		for t, c := range r.ProductCounts {
+			counts[string(t)] = c
+		}
+		res.Receptions = append(res.Receptions, &ReceptionSummary{
+			Reception:     toProtoReception(r.Reception),
+			ProductCounts: counts,
+			DamagedCount:  r.DamageCounts.Damaged,
+			OpenedCount:   r.DamageCounts.Opened,
+		})
+	}
+	if len(receptions) == listReq.Limit {
+		last := receptions[len(receptions)-1].Reception
+		res.NextCursor = (&request.ReceptionCursor{DateTime: last.DateTime, ID: last.ID}).Encode()
+	}
+
+	return res, nil
+}
+
+func toProtoPvz(pvz *entity.Pvz) *PVZ {
+	return &PVZ{
+		Id:               pvz.ID.String(),
+		City:             string(pvz.City),
+		RegistrationDate: timestamppb.New(pvz.RegistrationDate),
+		Status:           string(pvz.Status),
+	}
+}
+
+func toProtoProduct(p *entity.Product) *Product {
+	res := &Product{
+		Id:          p.ID.String(),
+		DateTime:    timestamppb.New(p.DateTime),
+		Type:        string(p.Type),
+		ReceptionId: p.ReceptionID.String(),
+		Barcode:     p.Barcode,
+		OrderId:     p.OrderID,
+		Attributes:  p.Attributes,
+		Condition:   string(p.Condition),
+		Notes:       p.Notes,
+		SizeClass:   string(p.SizeClass),
+	}
+	if p.CellID != uuid.Nil {
+		res.CellId = p.CellID.String()
+	}
+	return res
+}
+
+func toProtoReception(r *entity.Reception) *Reception {
+	if r == nil {
+		return nil
+	}
+
+	st := ReceptionStatus_RECEPTION_STATUS_CLOSED
+	switch r.Status {
+	case entity.StatusInProgress:
+		st = ReceptionStatus_RECEPTION_StatusInProgress
+	case entity.StatusCancelled:
+		st = ReceptionStatus_RECEPTION_STATUS_CANCELLED
+	}
+
+	return &Reception{
+		Id:       r.ID.String(),
+		DateTime: timestamppb.New(r.DateTime),
+		PvzId:    r.PvzID.String(),
+		Status:   st,
+	}
+}
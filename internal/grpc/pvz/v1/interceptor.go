@@ -0,0 +1,193 @@
+package pvzv1
+
+import (
+	context "context"
+	"errors"
+	"log"
+	"net/http"
+
+	grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+)
+
+const (
+	metadataAPIKey         = "x-api-key"
+	metadataAuthorization  = "authorization"
+	metadataIdempotencyKey = "idempotency-key"
+)
+
+// methodPermissions maps gRPC methods to permissions
+// needed to call them.
+var methodPermissions = map[string][]entity.Permission{
+	PVZService_GetPVZList_FullMethodName:     {entity.PermReportRead},
+	PVZService_GetPVZ_FullMethodName:         {entity.PermReportRead},
+	PVZService_AddProducts_FullMethodName:    {entity.PermReceptionWrite},
+	PVZService_GetReception_FullMethodName:   {entity.PermReportRead},
+	PVZService_ListReceptions_FullMethodName: {entity.PermReportRead},
+}
+
+// mutatingMethods lists gRPC methods which honour
+// idempotency-key metadata.
+var mutatingMethods = map[string]bool{
+	PVZService_AddProducts_FullMethodName: true,
+}
+
+// Authorizer authenticates caller by api key or bearer token.
+type Authorizer interface {
+	Authorize(ctx context.Context, apiKey, authHeader string, needed ...entity.Permission) (*principal.Principal, error)
+}
+
+// authInterceptor checks caller's credentials from metadata
+// and puts principal into request context.
+func authInterceptor(authSrv Authorizer) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		needed, ok := methodPermissions[info.FullMethod]
+		if !ok {
+			return nil, status.Error(codes.PermissionDenied, "permission denied")
+		}
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		p, err := authSrv.Authorize(ctx, firstValue(md, metadataAPIKey), firstValue(md, metadataAuthorization), needed...)
+		if err != nil {
+			return nil, toStatusError(err)
+		}
+
+		return handler(principal.NewContext(ctx, p), req)
+	}
+}
+
+type IdempotencyStore interface {
+	Begin(ctx context.Context, req *entity.IdempotentRequest) (*entity.IdempotentResponse, error)
+	Complete(ctx context.Context, req *entity.IdempotentRequest, resp *entity.IdempotentResponse) error
+	Release(ctx context.Context, req *entity.IdempotentRequest) error
+}
+
+// idempotencyInterceptor replays stored response of mutating method
+// called again with the same idempotency-key metadata and request.
+// Failed calls are not stored, so they can be retried with the same key.
+// It must run after authInterceptor, which puts caller into context.
+func idempotencyInterceptor(store IdempotencyStore, methods map[string]bool) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		key := firstValue(md, metadataIdempotencyKey)
+		msg, isMsg := req.(proto.Message)
+		p, ok := principal.FromContext(ctx)
+		if !methods[info.FullMethod] || key == "" || !isMsg || !ok {
+			return handler(ctx, req)
+		}
+
+		body, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid request")
+		}
+
+		idemReq := &entity.IdempotentRequest{
+			Key:       key,
+			Operation: info.FullMethod,
+			Caller:    p.Subject(),
+			Body:      body,
+		}
+
+		stored, err := store.Begin(ctx, idemReq)
+		if err != nil {
+			return nil, toStatusError(err)
+		}
+		if stored != nil {
+			var res anypb.Any
+			if err := proto.Unmarshal(stored.Body, &res); err != nil {
+				return nil, toStatusError(apperror.NewInternal("failed to decode stored response", err))
+			}
+			resp, err := res.UnmarshalNew()
+			if err != nil {
+				return nil, toStatusError(apperror.NewInternal("failed to decode stored response", err))
+			}
+			return resp, nil
+		}
+
+		// key is released if handler fails, panics or response
+		// can't be stored, so the call can be retried with it
+		storeCtx := context.WithoutCancel(ctx)
+		completed := false
+		defer func() {
+			if completed {
+				return
+			}
+			if relErr := store.Release(storeCtx, idemReq); relErr != nil {
+				log.Printf("idempotency key %q: %v", key, relErr)
+			}
+		}()
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := saveIdempotentResponse(storeCtx, store, idemReq, resp); err != nil {
+			log.Printf("idempotency key %q: %v", key, err)
+			return resp, nil
+		}
+		completed = true
+		return resp, nil
+	}
+}
+
+func saveIdempotentResponse(ctx context.Context, store IdempotencyStore, req *entity.IdempotentRequest, resp interface{}) error {
+	msg, ok := resp.(proto.Message)
+	if !ok {
+		return errors.New("response is not a proto message")
+	}
+
+	res, err := anypb.New(msg)
+	if err != nil {
+		return err
+	}
+	body, err := proto.Marshal(res)
+	if err != nil {
+		return err
+	}
+
+	return store.Complete(ctx, req, &entity.IdempotentResponse{StatusCode: int(codes.OK), Body: body})
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if v := md.Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
+
+func toStatusError(err error) error {
+	var httpErr apperror.HTTPError
+	if !errors.As(err, &httpErr) {
+		log.Printf("internal error: %v", err)
+		return status.Error(codes.Internal, "internal error")
+	}
+
+	switch httpErr.Code {
+	case http.StatusBadRequest:
+		return status.Error(codes.InvalidArgument, httpErr.Message)
+	case http.StatusUnauthorized:
+		return status.Error(codes.Unauthenticated, httpErr.Message)
+	case http.StatusForbidden:
+		return status.Error(codes.PermissionDenied, httpErr.Message)
+	case http.StatusNotFound:
+		return status.Error(codes.NotFound, httpErr.Message)
+	case http.StatusConflict:
+		return status.Error(codes.Aborted, httpErr.Message)
+	case http.StatusUnprocessableEntity:
+		return status.Error(codes.FailedPrecondition, httpErr.Message)
+	case http.StatusTooManyRequests:
+		return status.Error(codes.ResourceExhausted, httpErr.Message)
+	default:
+		log.Printf("internal error: %v | %v", httpErr.Message, httpErr.DebugError)
+		return status.Error(codes.Internal, httpErr.Message)
+	}
+}
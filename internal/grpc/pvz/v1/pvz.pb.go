@@ -27,6 +27,7 @@ type ReceptionStatus int32
 const (
 	ReceptionStatus_RECEPTION_StatusInProgress ReceptionStatus = 0
 	ReceptionStatus_RECEPTION_STATUS_CLOSED    ReceptionStatus = 1
+	ReceptionStatus_RECEPTION_STATUS_CANCELLED ReceptionStatus = 2
 )
 
 // Enum value maps for ReceptionStatus.
@@ -34,10 +35,12 @@ var (
 	ReceptionStatus_name = map[int32]string{
 		0: "RECEPTION_StatusInProgress",
 		1: "RECEPTION_STATUS_CLOSED",
+		2: "RECEPTION_STATUS_CANCELLED",
 	}
 	ReceptionStatus_value = map[string]int32{
 		"RECEPTION_StatusInProgress": 0,
 		"RECEPTION_STATUS_CLOSED":    1,
+		"RECEPTION_STATUS_CANCELLED": 2,
 	}
 )
 
@@ -73,8 +76,10 @@ type PVZ struct {
 	Id               string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	RegistrationDate *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=registration_date,json=registrationDate,proto3" json:"registration_date,omitempty"`
 	City             string                 `protobuf:"bytes,3,opt,name=city,proto3" json:"city,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// One of: active, temporarily_closed, decommissioned.
+	Status        string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *PVZ) Reset() {
@@ -128,8 +133,17 @@ func (x *PVZ) GetCity() string {
 	return ""
 }
 
+func (x *PVZ) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
 type GetPVZListRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Filters PVZ by status, empty means any.
+	Status        string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -164,6 +178,13 @@ func (*GetPVZListRequest) Descriptor() ([]byte, []int) {
 	return file_pvz_proto_rawDescGZIP(), []int{1}
 }
 
+func (x *GetPVZListRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
 type GetPVZListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Pvzs          []*PVZ                 `protobuf:"bytes,1,rep,name=pvzs,proto3" json:"pvzs,omitempty"`
@@ -208,25 +229,1092 @@ func (x *GetPVZListResponse) GetPvzs() []*PVZ {
 	return nil
 }
 
-var File_pvz_proto protoreflect.FileDescriptor
+type Reception struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DateTime      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_time,json=dateTime,proto3" json:"date_time,omitempty"`
+	PvzId         string                 `protobuf:"bytes,3,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	Status        ReceptionStatus        `protobuf:"varint,4,opt,name=status,proto3,enum=pvz.v1.ReceptionStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_pvz_proto_rawDesc = "" +
-	"\n" +
-	"\tpvz.proto\x12\x06pvz.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"r\n" +
-	"\x03PVZ\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12G\n" +
-	"\x11registration_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x10registrationDate\x12\x12\n" +
-	"\x04city\x18\x03 \x01(\tR\x04city\"\x13\n" +
-	"\x11GetPVZListRequest\"5\n" +
-	"\x12GetPVZListResponse\x12\x1f\n" +
-	"\x04pvzs\x18\x01 \x03(\v2\v.pvz.v1.PVZR\x04pvzs*N\n" +
+func (x *Reception) Reset() {
+	*x = Reception{}
+	mi := &file_pvz_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reception) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reception) ProtoMessage() {}
+
+func (x *Reception) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reception.ProtoReflect.Descriptor instead.
+func (*Reception) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Reception) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Reception) GetDateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTime
+	}
+	return nil
+}
+
+func (x *Reception) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *Reception) GetStatus() ReceptionStatus {
+	if x != nil {
+		return x.Status
+	}
+	return ReceptionStatus_RECEPTION_StatusInProgress
+}
+
+type Product struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Id          string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DateTime    *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=date_time,json=dateTime,proto3" json:"date_time,omitempty"`
+	Type        string                 `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	ReceptionId string                 `protobuf:"bytes,4,opt,name=reception_id,json=receptionId,proto3" json:"reception_id,omitempty"`
+	Barcode     string                 `protobuf:"bytes,5,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	OrderId     string                 `protobuf:"bytes,6,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Attributes  map[string]string      `protobuf:"bytes,7,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// One of: ok, damaged, opened.
+	Condition string `protobuf:"bytes,8,opt,name=condition,proto3" json:"condition,omitempty"`
+	Notes     string `protobuf:"bytes,9,opt,name=notes,proto3" json:"notes,omitempty"`
+	// One of: small, medium, large.
+	SizeClass string `protobuf:"bytes,10,opt,name=size_class,json=sizeClass,proto3" json:"size_class,omitempty"`
+	// Empty if product isn't placed into storage cell.
+	CellId        string `protobuf:"bytes,11,opt,name=cell_id,json=cellId,proto3" json:"cell_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
+	mi := &file_pvz_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Product) ProtoMessage() {}
+
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *Product) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Product) GetDateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateTime
+	}
+	return nil
+}
+
+func (x *Product) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Product) GetReceptionId() string {
+	if x != nil {
+		return x.ReceptionId
+	}
+	return ""
+}
+
+func (x *Product) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+func (x *Product) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *Product) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *Product) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *Product) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *Product) GetSizeClass() string {
+	if x != nil {
+		return x.SizeClass
+	}
+	return ""
+}
+
+func (x *Product) GetCellId() string {
+	if x != nil {
+		return x.CellId
+	}
+	return ""
+}
+
+type GetPVZRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPVZRequest) Reset() {
+	*x = GetPVZRequest{}
+	mi := &file_pvz_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPVZRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPVZRequest) ProtoMessage() {}
+
+func (x *GetPVZRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPVZRequest.ProtoReflect.Descriptor instead.
+func (*GetPVZRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetPVZRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetPVZResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Pvz   *PVZ                   `protobuf:"bytes,1,opt,name=pvz,proto3" json:"pvz,omitempty"`
+	// Not set if there is no reception in progress.
+	OpenReception       *Reception `protobuf:"bytes,2,opt,name=open_reception,json=openReception,proto3" json:"open_reception,omitempty"`
+	Products            []*Product `protobuf:"bytes,3,rep,name=products,proto3" json:"products,omitempty"`
+	LastClosedReception *Reception `protobuf:"bytes,4,opt,name=last_closed_reception,json=lastClosedReception,proto3" json:"last_closed_reception,omitempty"`
+	ReceptionsToday     int64      `protobuf:"varint,5,opt,name=receptions_today,json=receptionsToday,proto3" json:"receptions_today,omitempty"`
+	ProductsToday       int64      `protobuf:"varint,6,opt,name=products_today,json=productsToday,proto3" json:"products_today,omitempty"`
+	// Stored products whose storage period ends soon.
+	ProductsExpiring int64 `protobuf:"varint,7,opt,name=products_expiring,json=productsExpiring,proto3" json:"products_expiring,omitempty"`
+	// Expired products waiting for return to sender.
+	ProductsToReturn int64 `protobuf:"varint,8,opt,name=products_to_return,json=productsToReturn,proto3" json:"products_to_return,omitempty"`
+	// Products on hand, stored or waiting for return.
+	StockCount int64 `protobuf:"varint,9,opt,name=stock_count,json=stockCount,proto3" json:"stock_count,omitempty"`
+	// Max products on hand, 0 is unlimited.
+	Capacity int64 `protobuf:"varint,10,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// Share of capacity taken, 0 if unlimited.
+	Utilization     float64 `protobuf:"fixed64,11,opt,name=utilization,proto3" json:"utilization,omitempty"`
+	CapacityWarning bool    `protobuf:"varint,12,opt,name=capacity_warning,json=capacityWarning,proto3" json:"capacity_warning,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetPVZResponse) Reset() {
+	*x = GetPVZResponse{}
+	mi := &file_pvz_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPVZResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPVZResponse) ProtoMessage() {}
+
+func (x *GetPVZResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPVZResponse.ProtoReflect.Descriptor instead.
+func (*GetPVZResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetPVZResponse) GetPvz() *PVZ {
+	if x != nil {
+		return x.Pvz
+	}
+	return nil
+}
+
+func (x *GetPVZResponse) GetOpenReception() *Reception {
+	if x != nil {
+		return x.OpenReception
+	}
+	return nil
+}
+
+func (x *GetPVZResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *GetPVZResponse) GetLastClosedReception() *Reception {
+	if x != nil {
+		return x.LastClosedReception
+	}
+	return nil
+}
+
+func (x *GetPVZResponse) GetReceptionsToday() int64 {
+	if x != nil {
+		return x.ReceptionsToday
+	}
+	return 0
+}
+
+func (x *GetPVZResponse) GetProductsToday() int64 {
+	if x != nil {
+		return x.ProductsToday
+	}
+	return 0
+}
+
+func (x *GetPVZResponse) GetProductsExpiring() int64 {
+	if x != nil {
+		return x.ProductsExpiring
+	}
+	return 0
+}
+
+func (x *GetPVZResponse) GetProductsToReturn() int64 {
+	if x != nil {
+		return x.ProductsToReturn
+	}
+	return 0
+}
+
+func (x *GetPVZResponse) GetStockCount() int64 {
+	if x != nil {
+		return x.StockCount
+	}
+	return 0
+}
+
+func (x *GetPVZResponse) GetCapacity() int64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *GetPVZResponse) GetUtilization() float64 {
+	if x != nil {
+		return x.Utilization
+	}
+	return 0
+}
+
+func (x *GetPVZResponse) GetCapacityWarning() bool {
+	if x != nil {
+		return x.CapacityWarning
+	}
+	return false
+}
+
+type BatchProduct struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Type  string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// Optional, duplicates are checked only if set.
+	Barcode    string            `protobuf:"bytes,2,opt,name=barcode,proto3" json:"barcode,omitempty"`
+	OrderId    string            `protobuf:"bytes,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Attributes map[string]string `protobuf:"bytes,4,rep,name=attributes,proto3" json:"attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Without pickup code product can't be issued.
+	PickupCode string `protobuf:"bytes,5,opt,name=pickup_code,json=pickupCode,proto3" json:"pickup_code,omitempty"`
+	// One of: ok, damaged, opened. Empty means ok.
+	Condition string `protobuf:"bytes,6,opt,name=condition,proto3" json:"condition,omitempty"`
+	Notes     string `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
+	// One of: small, medium, large. Empty means medium.
+	// Product is placed into free cell of this size.
+	SizeClass     string `protobuf:"bytes,8,opt,name=size_class,json=sizeClass,proto3" json:"size_class,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchProduct) Reset() {
+	*x = BatchProduct{}
+	mi := &file_pvz_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchProduct) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchProduct) ProtoMessage() {}
+
+func (x *BatchProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchProduct.ProtoReflect.Descriptor instead.
+func (*BatchProduct) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BatchProduct) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *BatchProduct) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+func (x *BatchProduct) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+func (x *BatchProduct) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *BatchProduct) GetPickupCode() string {
+	if x != nil {
+		return x.PickupCode
+	}
+	return ""
+}
+
+func (x *BatchProduct) GetCondition() string {
+	if x != nil {
+		return x.Condition
+	}
+	return ""
+}
+
+func (x *BatchProduct) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *BatchProduct) GetSizeClass() string {
+	if x != nil {
+		return x.SizeClass
+	}
+	return ""
+}
+
+type AddProductsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PvzId         string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	Products      []*BatchProduct        `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductsRequest) Reset() {
+	*x = AddProductsRequest{}
+	mi := &file_pvz_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductsRequest) ProtoMessage() {}
+
+func (x *AddProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductsRequest.ProtoReflect.Descriptor instead.
+func (*AddProductsRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AddProductsRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *AddProductsRequest) GetProducts() []*BatchProduct {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type BatchProductResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// One of: created, invalid, duplicate, skipped.
+	Status  string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Product *Product `protobuf:"bytes,2,opt,name=product,proto3" json:"product,omitempty"`
+	Error   string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// Product accepted before, set for duplicate.
+	Existing      *Product `protobuf:"bytes,4,opt,name=existing,proto3" json:"existing,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchProductResult) Reset() {
+	*x = BatchProductResult{}
+	mi := &file_pvz_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchProductResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchProductResult) ProtoMessage() {}
+
+func (x *BatchProductResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchProductResult.ProtoReflect.Descriptor instead.
+func (*BatchProductResult) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *BatchProductResult) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *BatchProductResult) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *BatchProductResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BatchProductResult) GetExisting() *Product {
+	if x != nil {
+		return x.Existing
+	}
+	return nil
+}
+
+type AddProductsResponse struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	Accepted bool                   `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// In order of request products.
+	Results       []*BatchProductResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddProductsResponse) Reset() {
+	*x = AddProductsResponse{}
+	mi := &file_pvz_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProductsResponse) ProtoMessage() {}
+
+func (x *AddProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProductsResponse.ProtoReflect.Descriptor instead.
+func (*AddProductsResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AddProductsResponse) GetAccepted() bool {
+	if x != nil {
+		return x.Accepted
+	}
+	return false
+}
+
+func (x *AddProductsResponse) GetResults() []*BatchProductResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetReceptionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReceptionRequest) Reset() {
+	*x = GetReceptionRequest{}
+	mi := &file_pvz_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceptionRequest) ProtoMessage() {}
+
+func (x *GetReceptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceptionRequest.ProtoReflect.Descriptor instead.
+func (*GetReceptionRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetReceptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetReceptionResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Reception *Reception             `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	// Last added product goes first.
+	Products      []*Product `protobuf:"bytes,2,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReceptionResponse) Reset() {
+	*x = GetReceptionResponse{}
+	mi := &file_pvz_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceptionResponse) ProtoMessage() {}
+
+func (x *GetReceptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceptionResponse.ProtoReflect.Descriptor instead.
+func (*GetReceptionResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetReceptionResponse) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+func (x *GetReceptionResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type ListReceptionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	PvzId string                 `protobuf:"bytes,1,opt,name=pvz_id,json=pvzId,proto3" json:"pvz_id,omitempty"`
+	// One of: in_progress, close. Empty means any.
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// Inclusive.
+	From *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	// Exclusive.
+	To *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	// next_cursor of previous page.
+	Cursor string `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// 1..100, 0 means 50.
+	Limit         int32 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReceptionsRequest) Reset() {
+	*x = ListReceptionsRequest{}
+	mi := &file_pvz_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReceptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReceptionsRequest) ProtoMessage() {}
+
+func (x *ListReceptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReceptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListReceptionsRequest) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListReceptionsRequest) GetPvzId() string {
+	if x != nil {
+		return x.PvzId
+	}
+	return ""
+}
+
+func (x *ListReceptionsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListReceptionsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListReceptionsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListReceptionsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListReceptionsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ReceptionSummary struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Reception *Reception             `protobuf:"bytes,1,opt,name=reception,proto3" json:"reception,omitempty"`
+	// Number of products by product type.
+	ProductCounts map[string]int64 `protobuf:"bytes,2,rep,name=product_counts,json=productCounts,proto3" json:"product_counts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value"`
+	// Number of products accepted damaged or opened.
+	DamagedCount  int64 `protobuf:"varint,3,opt,name=damaged_count,json=damagedCount,proto3" json:"damaged_count,omitempty"`
+	OpenedCount   int64 `protobuf:"varint,4,opt,name=opened_count,json=openedCount,proto3" json:"opened_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceptionSummary) Reset() {
+	*x = ReceptionSummary{}
+	mi := &file_pvz_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceptionSummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceptionSummary) ProtoMessage() {}
+
+func (x *ReceptionSummary) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceptionSummary.ProtoReflect.Descriptor instead.
+func (*ReceptionSummary) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReceptionSummary) GetReception() *Reception {
+	if x != nil {
+		return x.Reception
+	}
+	return nil
+}
+
+func (x *ReceptionSummary) GetProductCounts() map[string]int64 {
+	if x != nil {
+		return x.ProductCounts
+	}
+	return nil
+}
+
+func (x *ReceptionSummary) GetDamagedCount() int64 {
+	if x != nil {
+		return x.DamagedCount
+	}
+	return 0
+}
+
+func (x *ReceptionSummary) GetOpenedCount() int64 {
+	if x != nil {
+		return x.OpenedCount
+	}
+	return 0
+}
+
+type ListReceptionsResponse struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Receptions []*ReceptionSummary    `protobuf:"bytes,1,rep,name=receptions,proto3" json:"receptions,omitempty"`
+	// Empty on the last page.
+	NextCursor    string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReceptionsResponse) Reset() {
+	*x = ListReceptionsResponse{}
+	mi := &file_pvz_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReceptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReceptionsResponse) ProtoMessage() {}
+
+func (x *ListReceptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pvz_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReceptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListReceptionsResponse) Descriptor() ([]byte, []int) {
+	return file_pvz_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ListReceptionsResponse) GetReceptions() []*ReceptionSummary {
+	if x != nil {
+		return x.Receptions
+	}
+	return nil
+}
+
+func (x *ListReceptionsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+var File_pvz_proto protoreflect.FileDescriptor
+
+const file_pvz_proto_rawDesc = "" +
+	"\n" +
+	"\tpvz.proto\x12\x06pvz.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8a\x01\n" +
+	"\x03PVZ\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12G\n" +
+	"\x11registration_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x10registrationDate\x12\x12\n" +
+	"\x04city\x18\x03 \x01(\tR\x04city\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\"+\n" +
+	"\x11GetPVZListRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"5\n" +
+	"\x12GetPVZListResponse\x12\x1f\n" +
+	"\x04pvzs\x18\x01 \x03(\v2\v.pvz.v1.PVZR\x04pvzs\"\x9c\x01\n" +
+	"\tReception\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x127\n" +
+	"\tdate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bdateTime\x12\x15\n" +
+	"\x06pvz_id\x18\x03 \x01(\tR\x05pvzId\x12/\n" +
+	"\x06status\x18\x04 \x01(\x0e2\x17.pvz.v1.ReceptionStatusR\x06status\"\xaa\x03\n" +
+	"\aProduct\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x127\n" +
+	"\tdate_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bdateTime\x12\x12\n" +
+	"\x04type\x18\x03 \x01(\tR\x04type\x12!\n" +
+	"\freception_id\x18\x04 \x01(\tR\vreceptionId\x12\x18\n" +
+	"\abarcode\x18\x05 \x01(\tR\abarcode\x12\x19\n" +
+	"\border_id\x18\x06 \x01(\tR\aorderId\x12?\n" +
+	"\n" +
+	"attributes\x18\a \x03(\v2\x1f.pvz.v1.Product.AttributesEntryR\n" +
+	"attributes\x12\x1c\n" +
+	"\tcondition\x18\b \x01(\tR\tcondition\x12\x14\n" +
+	"\x05notes\x18\t \x01(\tR\x05notes\x12\x1d\n" +
+	"\n" +
+	"size_class\x18\n" +
+	" \x01(\tR\tsizeClass\x12\x17\n" +
+	"\acell_id\x18\v \x01(\tR\x06cellId\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x1f\n" +
+	"\rGetPVZRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x94\x04\n" +
+	"\x0eGetPVZResponse\x12\x1d\n" +
+	"\x03pvz\x18\x01 \x01(\v2\v.pvz.v1.PVZR\x03pvz\x128\n" +
+	"\x0eopen_reception\x18\x02 \x01(\v2\x11.pvz.v1.ReceptionR\ropenReception\x12+\n" +
+	"\bproducts\x18\x03 \x03(\v2\x0f.pvz.v1.ProductR\bproducts\x12E\n" +
+	"\x15last_closed_reception\x18\x04 \x01(\v2\x11.pvz.v1.ReceptionR\x13lastClosedReception\x12)\n" +
+	"\x10receptions_today\x18\x05 \x01(\x03R\x0freceptionsToday\x12%\n" +
+	"\x0eproducts_today\x18\x06 \x01(\x03R\rproductsToday\x12+\n" +
+	"\x11products_expiring\x18\a \x01(\x03R\x10productsExpiring\x12,\n" +
+	"\x12products_to_return\x18\b \x01(\x03R\x10productsToReturn\x12\x1f\n" +
+	"\vstock_count\x18\t \x01(\x03R\n" +
+	"stockCount\x12\x1a\n" +
+	"\bcapacity\x18\n" +
+	" \x01(\x03R\bcapacity\x12 \n" +
+	"\vutilization\x18\v \x01(\x01R\vutilization\x12)\n" +
+	"\x10capacity_warning\x18\f \x01(\bR\x0fcapacityWarning\"\xd0\x02\n" +
+	"\fBatchProduct\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x18\n" +
+	"\abarcode\x18\x02 \x01(\tR\abarcode\x12\x19\n" +
+	"\border_id\x18\x03 \x01(\tR\aorderId\x12D\n" +
+	"\n" +
+	"attributes\x18\x04 \x03(\v2$.pvz.v1.BatchProduct.AttributesEntryR\n" +
+	"attributes\x12\x1f\n" +
+	"\vpickup_code\x18\x05 \x01(\tR\n" +
+	"pickupCode\x12\x1c\n" +
+	"\tcondition\x18\x06 \x01(\tR\tcondition\x12\x14\n" +
+	"\x05notes\x18\a \x01(\tR\x05notes\x12\x1d\n" +
+	"\n" +
+	"size_class\x18\b \x01(\tR\tsizeClass\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"]\n" +
+	"\x12AddProductsRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x120\n" +
+	"\bproducts\x18\x02 \x03(\v2\x14.pvz.v1.BatchProductR\bproducts\"\x9a\x01\n" +
+	"\x12BatchProductResult\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12)\n" +
+	"\aproduct\x18\x02 \x01(\v2\x0f.pvz.v1.ProductR\aproduct\x12\x14\n" +
+	"\x05error\x18\x03 \x01(\tR\x05error\x12+\n" +
+	"\bexisting\x18\x04 \x01(\v2\x0f.pvz.v1.ProductR\bexisting\"g\n" +
+	"\x13AddProductsResponse\x12\x1a\n" +
+	"\baccepted\x18\x01 \x01(\bR\baccepted\x124\n" +
+	"\aresults\x18\x02 \x03(\v2\x1a.pvz.v1.BatchProductResultR\aresults\"%\n" +
+	"\x13GetReceptionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"t\n" +
+	"\x14GetReceptionResponse\x12/\n" +
+	"\treception\x18\x01 \x01(\v2\x11.pvz.v1.ReceptionR\treception\x12+\n" +
+	"\bproducts\x18\x02 \x03(\v2\x0f.pvz.v1.ProductR\bproducts\"\xd0\x01\n" +
+	"\x15ListReceptionsRequest\x12\x15\n" +
+	"\x06pvz_id\x18\x01 \x01(\tR\x05pvzId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12.\n" +
+	"\x04from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12\x16\n" +
+	"\x06cursor\x18\x05 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\"\xa1\x02\n" +
+	"\x10ReceptionSummary\x12/\n" +
+	"\treception\x18\x01 \x01(\v2\x11.pvz.v1.ReceptionR\treception\x12R\n" +
+	"\x0eproduct_counts\x18\x02 \x03(\v2+.pvz.v1.ReceptionSummary.ProductCountsEntryR\rproductCounts\x12#\n" +
+	"\rdamaged_count\x18\x03 \x01(\x03R\fdamagedCount\x12!\n" +
+	"\fopened_count\x18\x04 \x01(\x03R\vopenedCount\x1a@\n" +
+	"\x12ProductCountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x03R\x05value:\x028\x01\"s\n" +
+	"\x16ListReceptionsResponse\x128\n" +
+	"\n" +
+	"receptions\x18\x01 \x03(\v2\x18.pvz.v1.ReceptionSummaryR\n" +
+	"receptions\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor*n\n" +
 	"\x0fReceptionStatus\x12\x1e\n" +
 	"\x1aRECEPTION_StatusInProgress\x10\x00\x12\x1b\n" +
-	"\x17RECEPTION_STATUS_CLOSED\x10\x012Q\n" +
+	"\x17RECEPTION_STATUS_CLOSED\x10\x01\x12\x1e\n" +
+	"\x1aRECEPTION_STATUS_CANCELLED\x10\x022\xee\x02\n" +
 	"\n" +
 	"PVZService\x12C\n" +
 	"\n" +
-	"GetPVZList\x12\x19.pvz.v1.GetPVZListRequest\x1a\x1a.pvz.v1.GetPVZListResponseBKZIgithub.com/myacey/avito-backend-assignment-pvz/internal/grpc/pvz/v1;pvzv1b\x06proto3"
+	"GetPVZList\x12\x19.pvz.v1.GetPVZListRequest\x1a\x1a.pvz.v1.GetPVZListResponse\x127\n" +
+	"\x06GetPVZ\x12\x15.pvz.v1.GetPVZRequest\x1a\x16.pvz.v1.GetPVZResponse\x12F\n" +
+	"\vAddProducts\x12\x1a.pvz.v1.AddProductsRequest\x1a\x1b.pvz.v1.AddProductsResponse\x12I\n" +
+	"\fGetReception\x12\x1b.pvz.v1.GetReceptionRequest\x1a\x1c.pvz.v1.GetReceptionResponse\x12O\n" +
+	"\x0eListReceptions\x12\x1d.pvz.v1.ListReceptionsRequest\x1a\x1e.pvz.v1.ListReceptionsResponseBKZIgithub.com/myacey/avito-backend-assignment-pvz/internal/grpc/pvz/v1;pvzv1b\x06proto3"
 
 var (
 	file_pvz_proto_rawDescOnce sync.Once
@@ -241,24 +1329,68 @@ func file_pvz_proto_rawDescGZIP() []byte {
 }
 
 var file_pvz_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_pvz_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_pvz_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_pvz_proto_goTypes = []any{
-	(ReceptionStatus)(0),          // 0: pvz.v1.ReceptionStatus
-	(*PVZ)(nil),                   // 1: pvz.v1.PVZ
-	(*GetPVZListRequest)(nil),     // 2: pvz.v1.GetPVZListRequest
-	(*GetPVZListResponse)(nil),    // 3: pvz.v1.GetPVZListResponse
-	(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+	(ReceptionStatus)(0),           // 0: pvz.v1.ReceptionStatus
+	(*PVZ)(nil),                    // 1: pvz.v1.PVZ
+	(*GetPVZListRequest)(nil),      // 2: pvz.v1.GetPVZListRequest
+	(*GetPVZListResponse)(nil),     // 3: pvz.v1.GetPVZListResponse
+	(*Reception)(nil),              // 4: pvz.v1.Reception
+	(*Product)(nil),                // 5: pvz.v1.Product
+	(*GetPVZRequest)(nil),          // 6: pvz.v1.GetPVZRequest
+	(*GetPVZResponse)(nil),         // 7: pvz.v1.GetPVZResponse
+	(*BatchProduct)(nil),           // 8: pvz.v1.BatchProduct
+	(*AddProductsRequest)(nil),     // 9: pvz.v1.AddProductsRequest
+	(*BatchProductResult)(nil),     // 10: pvz.v1.BatchProductResult
+	(*AddProductsResponse)(nil),    // 11: pvz.v1.AddProductsResponse
+	(*GetReceptionRequest)(nil),    // 12: pvz.v1.GetReceptionRequest
+	(*GetReceptionResponse)(nil),   // 13: pvz.v1.GetReceptionResponse
+	(*ListReceptionsRequest)(nil),  // 14: pvz.v1.ListReceptionsRequest
+	(*ReceptionSummary)(nil),       // 15: pvz.v1.ReceptionSummary
+	(*ListReceptionsResponse)(nil), // 16: pvz.v1.ListReceptionsResponse
+	nil,                            // 17: pvz.v1.Product.AttributesEntry
+	nil,                            // 18: pvz.v1.BatchProduct.AttributesEntry
+	nil,                            // 19: pvz.v1.ReceptionSummary.ProductCountsEntry
+	(*timestamppb.Timestamp)(nil),  // 20: google.protobuf.Timestamp
 }
 var file_pvz_proto_depIdxs = []int32{
-	4, // 0: pvz.v1.PVZ.registration_date:type_name -> google.protobuf.Timestamp
-	1, // 1: pvz.v1.GetPVZListResponse.pvzs:type_name -> pvz.v1.PVZ
-	2, // 2: pvz.v1.PVZService.GetPVZList:input_type -> pvz.v1.GetPVZListRequest
-	3, // 3: pvz.v1.PVZService.GetPVZList:output_type -> pvz.v1.GetPVZListResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	20, // 0: pvz.v1.PVZ.registration_date:type_name -> google.protobuf.Timestamp
+	1,  // 1: pvz.v1.GetPVZListResponse.pvzs:type_name -> pvz.v1.PVZ
+	20, // 2: pvz.v1.Reception.date_time:type_name -> google.protobuf.Timestamp
+	0,  // 3: pvz.v1.Reception.status:type_name -> pvz.v1.ReceptionStatus
+	20, // 4: pvz.v1.Product.date_time:type_name -> google.protobuf.Timestamp
+	17, // 5: pvz.v1.Product.attributes:type_name -> pvz.v1.Product.AttributesEntry
+	1,  // 6: pvz.v1.GetPVZResponse.pvz:type_name -> pvz.v1.PVZ
+	4,  // 7: pvz.v1.GetPVZResponse.open_reception:type_name -> pvz.v1.Reception
+	5,  // 8: pvz.v1.GetPVZResponse.products:type_name -> pvz.v1.Product
+	4,  // 9: pvz.v1.GetPVZResponse.last_closed_reception:type_name -> pvz.v1.Reception
+	18, // 10: pvz.v1.BatchProduct.attributes:type_name -> pvz.v1.BatchProduct.AttributesEntry
+	8,  // 11: pvz.v1.AddProductsRequest.products:type_name -> pvz.v1.BatchProduct
+	5,  // 12: pvz.v1.BatchProductResult.product:type_name -> pvz.v1.Product
+	5,  // 13: pvz.v1.BatchProductResult.existing:type_name -> pvz.v1.Product
+	10, // 14: pvz.v1.AddProductsResponse.results:type_name -> pvz.v1.BatchProductResult
+	4,  // 15: pvz.v1.GetReceptionResponse.reception:type_name -> pvz.v1.Reception
+	5,  // 16: pvz.v1.GetReceptionResponse.products:type_name -> pvz.v1.Product
+	20, // 17: pvz.v1.ListReceptionsRequest.from:type_name -> google.protobuf.Timestamp
+	20, // 18: pvz.v1.ListReceptionsRequest.to:type_name -> google.protobuf.Timestamp
+	4,  // 19: pvz.v1.ReceptionSummary.reception:type_name -> pvz.v1.Reception
+	19, // 20: pvz.v1.ReceptionSummary.product_counts:type_name -> pvz.v1.ReceptionSummary.ProductCountsEntry
+	15, // 21: pvz.v1.ListReceptionsResponse.receptions:type_name -> pvz.v1.ReceptionSummary
+	2,  // 22: pvz.v1.PVZService.GetPVZList:input_type -> pvz.v1.GetPVZListRequest
+	6,  // 23: pvz.v1.PVZService.GetPVZ:input_type -> pvz.v1.GetPVZRequest
+	9,  // 24: pvz.v1.PVZService.AddProducts:input_type -> pvz.v1.AddProductsRequest
+	12, // 25: pvz.v1.PVZService.GetReception:input_type -> pvz.v1.GetReceptionRequest
+	14, // 26: pvz.v1.PVZService.ListReceptions:input_type -> pvz.v1.ListReceptionsRequest
+	3,  // 27: pvz.v1.PVZService.GetPVZList:output_type -> pvz.v1.GetPVZListResponse
+	7,  // 28: pvz.v1.PVZService.GetPVZ:output_type -> pvz.v1.GetPVZResponse
+	11, // 29: pvz.v1.PVZService.AddProducts:output_type -> pvz.v1.AddProductsResponse
+	13, // 30: pvz.v1.PVZService.GetReception:output_type -> pvz.v1.GetReceptionResponse
+	16, // 31: pvz.v1.PVZService.ListReceptions:output_type -> pvz.v1.ListReceptionsResponse
+	27, // [27:32] is the sub-list for method output_type
+	22, // [22:27] is the sub-list for method input_type
+	22, // [22:22] is the sub-list for extension type_name
+	22, // [22:22] is the sub-list for extension extendee
+	0,  // [0:22] is the sub-list for field type_name
 }
 
 func init() { file_pvz_proto_init() }
@@ -272,7 +1404,7 @@ func file_pvz_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pvz_proto_rawDesc), len(file_pvz_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   3,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -7,6 +7,7 @@ import (
 	"net"
 	"time"
 
+	"github.com/google/uuid"
 	grpc "google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
@@ -28,6 +29,19 @@ type PvzFinder interface {
 	SearchPvz(ctx context.Context, req *request.SearchPvz) ([]*entity.Pvz, error)
 }
 
+type PvzDetailsFinder interface {
+	GetPvzDetails(ctx context.Context, pvzID uuid.UUID) (*entity.PvzDetails, error)
+}
+
+type ProductsBatchAdder interface {
+	AddProductsBatch(ctx context.Context, req *request.AddProductsBatch) (*entity.ProductsBatch, error)
+}
+
+type ReceptionFinder interface {
+	GetReception(ctx context.Context, id uuid.UUID) (*entity.ReceptionDetails, error)
+	ListPvzReceptions(ctx context.Context, req *request.ListReceptions) ([]*entity.ReceptionSummary, error)
+}
+
 type Server struct {
 	cfg    Config
 	srv    PvzFinder
@@ -35,12 +49,31 @@ type Server struct {
 	lis    net.Listener
 }
 
-func New(cfg Config, service PvzFinder) (*Server, error) {
+func New(cfg Config, service PvzFinder, detailsSrv PvzDetailsFinder, productsSrv ProductsBatchAdder, receptionsSrv ReceptionFinder, authSrv Authorizer, idempotencySrv IdempotencyStore) (*Server, error) {
 	if service == nil {
 		return nil, errors.New("pvz service can't be nil")
 	}
+	if detailsSrv == nil {
+		return nil, errors.New("pvz details service can't be nil")
+	}
+	if productsSrv == nil {
+		return nil, errors.New("products service can't be nil")
+	}
+	if receptionsSrv == nil {
+		return nil, errors.New("receptions service can't be nil")
+	}
+	if authSrv == nil {
+		return nil, errors.New("auth service can't be nil")
+	}
+	if idempotencySrv == nil {
+		return nil, errors.New("idempotency service can't be nil")
+	}
 
 	var options []grpc.ServerOption
+	options = append(options, grpc.ChainUnaryInterceptor(
+		authInterceptor(authSrv),
+		idempotencyInterceptor(idempotencySrv, mutatingMethods),
+	))
 	options = append(options, grpc.KeepaliveParams(keepalive.ServerParameters{
 		Time:    cfg.KeepAliveTime,
 		Timeout: cfg.KeepAliveTimeout,
@@ -55,7 +88,7 @@ func New(cfg Config, service PvzFinder) (*Server, error) {
 	}
 
 	grpcServer := grpc.NewServer(options...)
-	handler := &PVZServer{srv: service}
+	handler := &PVZServer{srv: service, detailsSrv: detailsSrv, productsSrv: productsSrv, receptionsSrv: receptionsSrv}
 	RegisterPVZServiceServer(grpcServer, handler)
 
 	return &Server{
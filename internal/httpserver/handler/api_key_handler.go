@@ -0,0 +1,71 @@
+//go:generate mockgen -source=./api_key_handler.go -destination=./mocks/api_key_handler.go -package=mocks
+
+package handler
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+)
+
+type APIKeyService interface {
+	CreateAPIKey(context.Context, *request.CreateAPIKey) (*entity.APIKey, error)
+	ListAPIKeys(context.Context) ([]*entity.APIKey, error)
+	RevokeAPIKey(context.Context, uuid.UUID) error
+}
+
+// PostApiKeys creates api key. Key value is shown only once.
+func (h Handler) PostApiKeys(ctx *gin.Context) {
+	log.SetPrefix("http-server.handler.CreateAPIKey")
+
+	var req request.CreateAPIKey
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		wrapCtxWithError(ctx, apperror.NewBadReq("invalid req: "+err.Error()))
+		return
+	}
+
+	key, err := h.apiKeySrv.CreateAPIKey(ctx, &req)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, key.ToResponse())
+}
+
+// GetApiKeys returns all api keys without their values.
+func (h Handler) GetApiKeys(ctx *gin.Context) {
+	log.SetPrefix("http-server.handler.ListAPIKeys")
+
+	keys, err := h.apiKeySrv.ListAPIKeys(ctx)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+	resp := make([]*response.APIKey, len(keys))
+	for i, v := range keys {
+		resp[i] = v.ToResponse()
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// DeleteApiKeysKeyId revokes api key.
+func (h Handler) DeleteApiKeysKeyId(ctx *gin.Context, keyID uuid.UUID) {
+	log.SetPrefix("http-server.handler.RevokeAPIKey")
+
+	if err := h.apiKeySrv.RevokeAPIKey(ctx, keyID); err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
@@ -0,0 +1,144 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/httpserver/handler"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/httpserver/handler/mocks"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+)
+
+var apiKey = &entity.APIKey{
+	ID:          uuid.New(),
+	Name:        "scanner",
+	Prefix:      "pvz_abcdefgh",
+	Permissions: []entity.Permission{entity.PermReceptionWrite},
+	PvzIDs:      []uuid.UUID{uuid.New()},
+	CreatedAt:   time.Date(2022, 12, 12, 12, 12, 0, 0, time.UTC),
+	Key:         "pvz_abcdefgh-secret",
+}
+
+func TestPostApiKeys(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockAPIKeyService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, nil, nil, nil, service, nil, nil, nil, nil, nil, nil, nil, nil)
+	testCases := []struct {
+		name         string
+		req          interface{}
+		mockBehavior func(req interface{})
+		expCode      int
+	}{
+		{
+			name: "ok",
+			req: &request.CreateAPIKey{
+				Name:        apiKey.Name,
+				Permissions: []string{string(entity.PermReceptionWrite)},
+				PvzIDs:      apiKey.PvzIDs,
+			},
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().CreateAPIKey(gomock.Any(), req).Return(apiKey, nil)
+			},
+			expCode: http.StatusCreated,
+		},
+		{
+			name: "no permissions",
+			req:  &request.CreateAPIKey{Name: apiKey.Name},
+			mockBehavior: func(req interface{}) {
+			},
+			expCode: http.StatusBadRequest,
+		},
+		{
+			name: "unknown permission",
+			req:  &request.CreateAPIKey{Name: apiKey.Name, Permissions: []string{"unknown"}},
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().CreateAPIKey(gomock.Any(), req).Return(nil, apperror.NewBadReq("permission not found"))
+			},
+			expCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			r := newTestRouter()
+
+			tc.mockBehavior(tc.req)
+
+			r.POST("/api-keys", handler.PostApiKeys)
+
+			body, _ := json.Marshal(tc.req)
+			req := httptest.NewRequest(http.MethodPost, "/api-keys", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			r.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expCode == http.StatusCreated {
+				expJSON, err := json.Marshal(apiKey.ToResponse())
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expJSON), rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestDeleteApiKeysKeyId(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockAPIKeyService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, nil, nil, nil, service, nil, nil, nil, nil, nil, nil, nil, nil)
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expCode      int
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				service.EXPECT().RevokeAPIKey(gomock.Any(), apiKey.ID).Return(nil)
+			},
+			expCode: http.StatusNoContent,
+		},
+		{
+			name: "not found",
+			mockBehavior: func() {
+				service.EXPECT().RevokeAPIKey(gomock.Any(), apiKey.ID).Return(apperror.NewBadReq("api key not found"))
+			},
+			expCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(rec)
+			ctx.Request = httptest.NewRequest(http.MethodDelete, "/dummy", nil)
+
+			tc.mockBehavior()
+
+			handler.DeleteApiKeysKeyId(ctx, apiKey.ID)
+			ctx.Writer.WriteHeaderNow()
+
+			require.Equal(t, tc.expCode, rec.Code)
+		})
+	}
+}
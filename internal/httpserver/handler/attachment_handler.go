@@ -0,0 +1,96 @@
+//go:generate mockgen -source=./attachment_handler.go -destination=./mocks/attachment_handler.go -package=mocks
+
+package handler
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+)
+
+type AttachmentService interface {
+	AddAttachment(ctx context.Context, productID uuid.UUID, file io.Reader) (*entity.ProductAttachment, error)
+	ListAttachments(ctx context.Context, productID uuid.UUID) ([]*entity.ProductAttachment, error)
+	OpenAttachment(ctx context.Context, productID, id uuid.UUID) (*entity.ProductAttachment, io.ReadCloser, error)
+	MaxSize() int64
+}
+
+// multipartOverhead is room for multipart boundaries
+// and part headers on top of attachment file.
+const multipartOverhead = 64 << 10
+
+// PostProductsProductIdAttachments uploads photo of product,
+// file is sent as "file" field of multipart form.
+func (h Handler) PostProductsProductIdAttachments(ctx *gin.Context, productID uuid.UUID) {
+	log.SetPrefix("http-server.handler.AddAttachment")
+
+	// form is parsed before service checks file size,
+	// so body is limited here not to spool huge files
+	ctx.Request.Body = http.MaxBytesReader(ctx.Writer, ctx.Request.Body, h.attachmentSrv.MaxSize()+multipartOverhead)
+	header, err := ctx.FormFile("file")
+	if err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			wrapCtxWithError(ctx, apperror.HTTPError{Code: http.StatusRequestEntityTooLarge, Message: "file is too large"})
+			return
+		}
+		wrapCtxWithError(ctx, apperror.NewBadReq("invalid req: "+err.Error()))
+		return
+	}
+	file, err := header.Open()
+	if err != nil {
+		wrapCtxWithError(ctx, apperror.NewBadReq("invalid req: "+err.Error()))
+		return
+	}
+	defer file.Close()
+
+	attachment, err := h.attachmentSrv.AddAttachment(ctx, productID, file)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, attachment.ToResponse())
+}
+
+// GetProductsProductIdAttachments returns product attachments, oldest first.
+func (h Handler) GetProductsProductIdAttachments(ctx *gin.Context, productID uuid.UUID) {
+	log.SetPrefix("http-server.handler.ListAttachments")
+
+	attachments, err := h.attachmentSrv.ListAttachments(ctx, productID)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+	resp := make([]*response.ProductAttachment, len(attachments))
+	for i, v := range attachments {
+		resp[i] = v.ToResponse()
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetProductsProductIdAttachmentsAttachmentId streams attachment file.
+func (h Handler) GetProductsProductIdAttachmentsAttachmentId(ctx *gin.Context, productID, attachmentID uuid.UUID) {
+	log.SetPrefix("http-server.handler.GetAttachment")
+
+	attachment, file, err := h.attachmentSrv.OpenAttachment(ctx, productID, attachmentID)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+	defer file.Close()
+
+	ctx.DataFromReader(http.StatusOK, attachment.Size, attachment.ContentType, file, map[string]string{
+		"X-Content-Type-Options": "nosniff",
+	})
+}
@@ -0,0 +1,177 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/httpserver/handler"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/httpserver/handler/mocks"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+)
+
+var (
+	photo      = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+	attachment = &entity.ProductAttachment{
+		ID:          uuid.New(),
+		ProductID:   product.ID,
+		BlobKey:     "products/" + product.ID.String() + "/photo",
+		ContentType: "image/png",
+		Size:        int64(len(photo)),
+		UploadedBy:  uuid.New(),
+		CreatedAt:   time.Date(2025, 12, 12, 12, 12, 0, 0, time.UTC),
+	}
+)
+
+func TestPostProductsProductIdAttachments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockAttachmentService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, service)
+
+	testCases := []struct {
+		name         string
+		field        string
+		data         []byte
+		mockBehavior func()
+		expCode      int
+	}{
+		{
+			name:  "ok",
+			field: "file",
+			mockBehavior: func() {
+				service.EXPECT().MaxSize().Return(int64(1024))
+				service.EXPECT().AddAttachment(gomock.Any(), product.ID, gomock.Any()).DoAndReturn(
+					func(_ any, _ uuid.UUID, r io.Reader) (*entity.ProductAttachment, error) {
+						data, err := io.ReadAll(r)
+						require.NoError(t, err)
+						require.Equal(t, photo, data)
+						return attachment, nil
+					})
+			},
+			expCode: http.StatusCreated,
+		},
+		{
+			name:  "no file",
+			field: "photo",
+			mockBehavior: func() {
+				service.EXPECT().MaxSize().Return(int64(1024))
+			},
+			expCode: http.StatusBadRequest,
+		},
+		{
+			name:  "unsupported type",
+			field: "file",
+			mockBehavior: func() {
+				service.EXPECT().MaxSize().Return(int64(1024))
+				service.EXPECT().AddAttachment(gomock.Any(), product.ID, gomock.Any()).Return(nil, apperror.NewBadReq("unsupported file type: text/plain"))
+			},
+			expCode: http.StatusBadRequest,
+		},
+		{
+			name:  "too large",
+			field: "file",
+			data:  bytes.Repeat([]byte{0}, 128<<10),
+			mockBehavior: func() {
+				service.EXPECT().MaxSize().Return(int64(1024))
+			},
+			expCode: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(rec)
+
+			var body bytes.Buffer
+			w := multipart.NewWriter(&body)
+			part, err := w.CreateFormFile(tc.field, "photo.png")
+			require.NoError(t, err)
+			data := tc.data
+			if data == nil {
+				data = photo
+			}
+			_, err = part.Write(data)
+			require.NoError(t, err)
+			require.NoError(t, w.Close())
+
+			ctx.Request = httptest.NewRequest(http.MethodPost, "/dummy", &body)
+			ctx.Request.Header.Set("Content-Type", w.FormDataContentType())
+
+			tc.mockBehavior()
+			handler.PostProductsProductIdAttachments(ctx, product.ID)
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expCode == http.StatusCreated {
+				expJSON, err := json.Marshal(attachment.ToResponse())
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expJSON), rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestGetProductsProductIdAttachmentsAttachmentId(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockAttachmentService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, service)
+
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expCode      int
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				service.EXPECT().OpenAttachment(gomock.Any(), product.ID, attachment.ID).Return(attachment, io.NopCloser(bytes.NewReader(photo)), nil)
+			},
+			expCode: http.StatusOK,
+		},
+		{
+			name: "not found",
+			mockBehavior: func() {
+				service.EXPECT().OpenAttachment(gomock.Any(), product.ID, attachment.ID).Return(nil, nil, apperror.NewNotFound("attachment not found"))
+			},
+			expCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(rec)
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/dummy", nil)
+
+			tc.mockBehavior()
+			handler.GetProductsProductIdAttachmentsAttachmentId(ctx, product.ID, attachment.ID)
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expCode == http.StatusOK {
+				require.Equal(t, "image/png", rec.Header().Get("Content-Type"))
+				require.Equal(t, "nosniff", rec.Header().Get("X-Content-Type-Options"))
+				require.Equal(t, photo, rec.Body.Bytes())
+			}
+		})
+	}
+}
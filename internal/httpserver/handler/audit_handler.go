@@ -0,0 +1,82 @@
+//go:generate mockgen -source=./audit_handler.go -destination=./mocks/audit_handler.go -package=mocks
+
+package handler
+
+import (
+	"context"
+	"encoding/base64"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/pkg/openapi"
+)
+
+const defaultAuditLimit = 50
+
+type AuditService interface {
+	ListAudit(context.Context, *request.ListAudit) ([]*entity.AuditEntry, error)
+}
+
+// GetAudit returns audit log page, newest entries first.
+func (h Handler) GetAudit(ctx *gin.Context, params openapi.GetAuditParams) {
+	log.SetPrefix("http-server.handler.ListAudit")
+
+	req := &request.ListAudit{
+		Action:  params.Action,
+		ActorID: params.ActorId,
+		From:    params.From,
+		To:      params.To,
+		Limit:   defaultAuditLimit,
+	}
+	if params.Limit != nil {
+		req.Limit = *params.Limit
+	}
+	if params.Cursor != nil {
+		id, err := decodeAuditCursor(*params.Cursor)
+		if err != nil {
+			wrapCtxWithError(ctx, apperror.NewBadReq("invalid cursor"))
+			return
+		}
+		req.Cursor = &id
+	}
+
+	entries, err := h.auditSrv.ListAudit(ctx, req)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	resp := &response.AuditPage{
+		Items: make([]*response.AuditEntry, len(entries)),
+	}
+	for i, e := range entries {
+		resp.Items[i] = e.ToResponse()
+	}
+	if len(entries) == req.Limit {
+		resp.NextCursor = encodeAuditCursor(entries[len(entries)-1].ID)
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// encodeAuditCursor hides entry ID, so clients
+// don't rely on cursor format.
+func encodeAuditCursor(id int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(id, 10)))
+}
+
+func decodeAuditCursor(cursor string) (int64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseInt(string(raw), 10, 64)
+}
@@ -0,0 +1,127 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/httpserver/handler"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/httpserver/handler/mocks"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/pkg/openapi"
+)
+
+func TestGetAudit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockAuditService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, nil, nil, nil, nil, nil, service, nil, nil, nil, nil, nil, nil)
+
+	limit := 2
+	badCursor := "not a cursor"
+	entries := []*entity.AuditEntry{
+		{ID: 12, Action: entity.AuditLoginSuccess, Payload: json.RawMessage(`{}`)},
+		{ID: 11, Action: entity.AuditLoginFailure, Payload: json.RawMessage(`{}`)},
+	}
+	testCases := []struct {
+		name          string
+		params        openapi.GetAuditParams
+		mockBehavior  func()
+		expCode       int
+		expNextCursor bool
+	}{
+		{
+			name:   "full page",
+			params: openapi.GetAuditParams{Limit: &limit},
+			mockBehavior: func() {
+				service.EXPECT().ListAudit(gomock.Any(), &request.ListAudit{Limit: limit}).Return(entries, nil)
+			},
+			expCode:       http.StatusOK,
+			expNextCursor: true,
+		},
+		{
+			name:   "last page",
+			params: openapi.GetAuditParams{Limit: &limit},
+			mockBehavior: func() {
+				service.EXPECT().ListAudit(gomock.Any(), &request.ListAudit{Limit: limit}).Return(entries[:1], nil)
+			},
+			expCode:       http.StatusOK,
+			expNextCursor: false,
+		},
+		{
+			name:   "invalid cursor",
+			params: openapi.GetAuditParams{Cursor: &badCursor},
+			mockBehavior: func() {
+			},
+			expCode: http.StatusBadRequest,
+		},
+		{
+			name: "service err",
+			mockBehavior: func() {
+				service.EXPECT().ListAudit(gomock.Any(), gomock.Any()).Return(nil, errMock)
+			},
+			expCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(rec)
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/dummy", nil)
+
+			tc.mockBehavior()
+
+			handler.GetAudit(ctx, tc.params)
+			ctx.Writer.WriteHeaderNow()
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expCode == http.StatusOK {
+				var page response.AuditPage
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+				require.Equal(t, tc.expNextCursor, page.NextCursor != "")
+			}
+		})
+	}
+}
+
+func TestGetAuditCursor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockAuditService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, nil, nil, nil, nil, nil, service, nil, nil, nil, nil, nil, nil)
+
+	limit := 1
+	service.EXPECT().ListAudit(gomock.Any(), &request.ListAudit{Limit: limit}).
+		Return([]*entity.AuditEntry{{ID: 42, Payload: json.RawMessage(`{}`)}}, nil)
+
+	rec := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(rec)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/dummy", nil)
+	handler.GetAudit(ctx, openapi.GetAuditParams{Limit: &limit})
+
+	var page response.AuditPage
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &page))
+
+	cursor := int64(42)
+	service.EXPECT().ListAudit(gomock.Any(), &request.ListAudit{Limit: limit, Cursor: &cursor}).
+		Return([]*entity.AuditEntry{}, nil)
+
+	rec = httptest.NewRecorder()
+	ctx, _ = gin.CreateTestContext(rec)
+	ctx.Request = httptest.NewRequest(http.MethodGet, "/dummy", nil)
+	handler.GetAudit(ctx, openapi.GetAuditParams{Limit: &limit, Cursor: &page.NextCursor})
+
+	require.Equal(t, http.StatusOK, rec.Code)
+}
@@ -0,0 +1,79 @@
+//go:generate mockgen -source=./city_handler.go -destination=./mocks/city_handler.go -package=mocks
+
+package handler
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/pkg/openapi"
+)
+
+type CityService interface {
+	ListCities(context.Context, *bool) ([]*entity.CityInfo, error)
+	CreateCity(context.Context, *request.CreateCity) (*entity.CityInfo, error)
+	UpdateCity(context.Context, string, *request.UpdateCity) (*entity.CityInfo, error)
+	IsCityEnabled(context.Context, string) (bool, error)
+}
+
+// GetCities returns city reference.
+func (h Handler) GetCities(ctx *gin.Context, params openapi.GetCitiesParams) {
+	log.SetPrefix("http-server.handler.ListCities")
+
+	cities, err := h.citySrv.ListCities(ctx, params.Enabled)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+	resp := make([]*response.City, len(cities))
+	for i, v := range cities {
+		resp[i] = v.ToResponse()
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// PostCities adds city to reference with moderator auth.
+func (h Handler) PostCities(ctx *gin.Context) {
+	log.SetPrefix("http-server.handler.CreateCity")
+
+	var req request.CreateCity
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		wrapCtxWithError(ctx, apperror.NewBadReq("invalid req: "+err.Error()))
+		return
+	}
+
+	city, err := h.citySrv.CreateCity(ctx, &req)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, city.ToResponse())
+}
+
+// PatchCitiesCode enables or disables city with moderator auth.
+func (h Handler) PatchCitiesCode(ctx *gin.Context, code string) {
+	log.SetPrefix("http-server.handler.UpdateCity")
+
+	var req request.UpdateCity
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		wrapCtxWithError(ctx, apperror.NewBadReq("invalid req: "+err.Error()))
+		return
+	}
+
+	city, err := h.citySrv.UpdateCity(ctx, code, &req)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, city.ToResponse())
+}
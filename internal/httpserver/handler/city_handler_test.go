@@ -0,0 +1,217 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/httpserver/handler"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/httpserver/handler/mocks"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/pkg/openapi"
+)
+
+var city = &entity.CityInfo{
+	Code:      "novosibirsk",
+	Name:      "Новосибирск",
+	NameEn:    "Novosibirsk",
+	Region:    "Новосибирская область",
+	Timezone:  "Asia/Novosibirsk",
+	Enabled:   true,
+	CreatedAt: time.Date(2025, 12, 12, 12, 12, 0, 0, time.UTC),
+}
+
+func TestGetCities(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockCityService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, nil, nil, nil, nil, nil, nil, service, nil, nil, nil, nil, nil)
+
+	enabled := true
+	testCases := []struct {
+		name         string
+		params       openapi.GetCitiesParams
+		mockBehavior func(params openapi.GetCitiesParams)
+		expBody      interface{}
+		expCode      int
+	}{
+		{
+			name:   "ok",
+			params: openapi.GetCitiesParams{Enabled: &enabled},
+			mockBehavior: func(params openapi.GetCitiesParams) {
+				service.EXPECT().ListCities(gomock.Any(), params.Enabled).Return([]*entity.CityInfo{city}, nil)
+			},
+			expBody: []*response.City{city.ToResponse()},
+			expCode: http.StatusOK,
+		},
+		{
+			name:   "service err",
+			params: openapi.GetCitiesParams{},
+			mockBehavior: func(params openapi.GetCitiesParams) {
+				service.EXPECT().ListCities(gomock.Any(), params.Enabled).Return(nil, errMock)
+			},
+			expCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(rec)
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/dummy", nil)
+
+			tc.mockBehavior(tc.params)
+			handler.GetCities(ctx, tc.params)
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expCode == http.StatusOK {
+				expJSON, err := json.Marshal(tc.expBody)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expJSON), rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestPostCities(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockCityService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, nil, nil, nil, nil, nil, nil, service, nil, nil, nil, nil, nil)
+	testCases := []struct {
+		name         string
+		req          interface{}
+		mockBehavior func(req interface{})
+		expCode      int
+	}{
+		{
+			name: "ok",
+			req: &request.CreateCity{
+				Code:     city.Code,
+				Name:     string(city.Name),
+				Timezone: city.Timezone,
+			},
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().CreateCity(gomock.Any(), req).Return(city, nil)
+			},
+			expCode: http.StatusCreated,
+		},
+		{
+			name: "no name",
+			req:  &request.CreateCity{Code: city.Code},
+			mockBehavior: func(req interface{}) {
+			},
+			expCode: http.StatusBadRequest,
+		},
+		{
+			name: "already exists",
+			req: &request.CreateCity{
+				Code: city.Code,
+				Name: string(city.Name),
+			},
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().CreateCity(gomock.Any(), req).Return(nil, apperror.NewBadReq("city already exists"))
+			},
+			expCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			r := newTestRouter()
+
+			tc.mockBehavior(tc.req)
+
+			r.POST("/cities", handler.PostCities)
+
+			body, _ := json.Marshal(tc.req)
+			req := httptest.NewRequest(http.MethodPost, "/cities", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			r.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expCode == http.StatusCreated {
+				expJSON, err := json.Marshal(city.ToResponse())
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expJSON), rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestPatchCitiesCode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockCityService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, nil, nil, nil, nil, nil, nil, service, nil, nil, nil, nil, nil)
+
+	enabled := false
+	testCases := []struct {
+		name         string
+		req          interface{}
+		mockBehavior func(req interface{})
+		expCode      int
+	}{
+		{
+			name: "ok",
+			req:  &request.UpdateCity{Enabled: &enabled},
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().UpdateCity(gomock.Any(), city.Code, req).Return(city, nil)
+			},
+			expCode: http.StatusOK,
+		},
+		{
+			name: "no enabled",
+			req:  &request.UpdateCity{},
+			mockBehavior: func(req interface{}) {
+			},
+			expCode: http.StatusBadRequest,
+		},
+		{
+			name: "not found",
+			req:  &request.UpdateCity{Enabled: &enabled},
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().UpdateCity(gomock.Any(), city.Code, req).Return(nil, apperror.NewNotFound("city not found"))
+			},
+			expCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(rec)
+
+			body, _ := json.Marshal(tc.req)
+			ctx.Request = httptest.NewRequest(http.MethodPatch, "/dummy", bytes.NewReader(body))
+			ctx.Request.Header.Set("Content-Type", "application/json")
+
+			tc.mockBehavior(tc.req)
+			handler.PatchCitiesCode(ctx, city.Code)
+
+			require.Equal(t, tc.expCode, rec.Code)
+		})
+	}
+}
@@ -1,5 +1,3 @@
-//go:generate mockgen -source=./handler.go -destination=./mocks/handler.go -package=mocks
-
 package handler
 
 import (
@@ -7,9 +5,10 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
-	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
 )
 
@@ -18,26 +17,54 @@ const (
 	CtxKeyRetryAfter = "Retry-After"
 )
 
-// RoleCheckerMiddleware is middleware interface
-// for checking account's roles.
-type RoleCheckerMiddleware interface {
-	AuthMiddleware(neededRole ...entity.Role) gin.HandlerFunc
-}
-
 type Handler struct {
-	receptionSrv ReceptionService
-	pvzSrv       PvzService
-	userSrv      UserService
-
-	authSrv RoleCheckerMiddleware
+	receptionSrv   ReceptionService
+	pvzSrv         PvzService
+	userSrv        UserService
+	inviteSrv      InviteService
+	passwordSrv    PasswordService
+	apiKeySrv      APIKeyService
+	mfaSrv         MFAService
+	auditSrv       AuditService
+	citySrv        CityService
+	productTypeSrv ProductTypeService
+	productSrv     ProductService
+	cellSrv        StorageCellService
+	transferSrv    TransferService
+	attachmentSrv  AttachmentService
 }
 
-func NewHandler(receptionSrv ReceptionService, pvzSrv PvzService, usrSrv UserService, autSrv RoleCheckerMiddleware) *Handler {
+func NewHandler(
+	receptionSrv ReceptionService,
+	pvzSrv PvzService,
+	usrSrv UserService,
+	inviteSrv InviteService,
+	passwordSrv PasswordService,
+	apiKeySrv APIKeyService,
+	mfaSrv MFAService,
+	auditSrv AuditService,
+	citySrv CityService,
+	productTypeSrv ProductTypeService,
+	productSrv ProductService,
+	cellSrv StorageCellService,
+	transferSrv TransferService,
+	attachmentSrv AttachmentService,
+) *Handler {
 	return &Handler{
-		receptionSrv: receptionSrv,
-		pvzSrv:       pvzSrv,
-		userSrv:      usrSrv,
-		authSrv:      autSrv,
+		receptionSrv:   receptionSrv,
+		pvzSrv:         pvzSrv,
+		userSrv:        usrSrv,
+		inviteSrv:      inviteSrv,
+		passwordSrv:    passwordSrv,
+		apiKeySrv:      apiKeySrv,
+		mfaSrv:         mfaSrv,
+		auditSrv:       auditSrv,
+		citySrv:        citySrv,
+		productTypeSrv: productTypeSrv,
+		productSrv:     productSrv,
+		cellSrv:        cellSrv,
+		transferSrv:    transferSrv,
+		attachmentSrv:  attachmentSrv,
 	}
 }
 
@@ -61,3 +88,16 @@ func wrapCtxWithError(ctx *gin.Context, err error) {
 	}
 	ctx.Set(CtxKeyRetryAfter, 10)
 }
+
+// checkPvzScope aborts request with 403 if caller
+// is restricted to other PVZs.
+func checkPvzScope(ctx *gin.Context, pvzID uuid.UUID) bool {
+	p, ok := principal.FromContext(ctx)
+	if ok && !p.CanAccessPvz(pvzID) {
+		wrapCtxWithError(ctx, apperror.NewForbidden("no access to pvz"))
+		ctx.Abort()
+		return false
+	}
+
+	return true
+}
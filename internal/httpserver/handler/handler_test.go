@@ -0,0 +1,24 @@
+package handler_test
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
+)
+
+// caller is put into context of the next test request,
+// as auth middleware does before handler runs.
+var caller *principal.Principal
+
+func setCaller(ctx *gin.Context) {
+	if caller != nil {
+		ctx.Set(principal.CtxKey, caller)
+		caller = nil
+	}
+}
+
+func newTestRouter() *gin.Engine {
+	r := gin.New()
+	r.Use(setCaller)
+	return r
+}
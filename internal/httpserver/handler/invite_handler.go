@@ -0,0 +1,58 @@
+//go:generate mockgen -source=./invite_handler.go -destination=./mocks/invite_handler.go -package=mocks
+
+package handler
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+)
+
+type InviteService interface {
+	CreateInvite(context.Context, *request.CreateInvite) (*entity.Invite, error)
+	AcceptInvite(context.Context, *request.AcceptInvite) (*entity.User, error)
+}
+
+// PostInvites creates invite and sends it to email.
+func (h Handler) PostInvites(ctx *gin.Context) {
+	log.SetPrefix("http-server.handler.CreateInvite")
+
+	var req request.CreateInvite
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		wrapCtxWithError(ctx, apperror.NewBadReq("invalid req: "+err.Error()))
+		return
+	}
+
+	invite, err := h.inviteSrv.CreateInvite(ctx, &req)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, invite.ToResponse())
+}
+
+// PostRegisterAcceptInvite creates a new user by invite.
+func (h Handler) PostRegisterAcceptInvite(ctx *gin.Context) {
+	log.SetPrefix("http-server.handler.AcceptInvite")
+
+	var req request.AcceptInvite
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		wrapCtxWithError(ctx, apperror.NewBadReq("invalid req: "+err.Error()))
+		return
+	}
+
+	usr, err := h.inviteSrv.AcceptInvite(ctx, &req)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, usr.ToResponse())
+}
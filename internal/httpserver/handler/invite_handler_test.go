@@ -0,0 +1,167 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/httpserver/handler"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/httpserver/handler/mocks"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+)
+
+var invite = &entity.Invite{
+	ID:        uuid.New(),
+	Email:     "invited@example.com",
+	Role:      entity.RoleModerator,
+	PvzIDs:    []uuid.UUID{uuid.New()},
+	ExpiresAt: time.Date(2022, 12, 12, 12, 12, 0, 0, time.UTC),
+}
+
+func TestPostInvites(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockInviteService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, nil, service, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	testCases := []struct {
+		name         string
+		req          interface{}
+		mockBehavior func(req interface{})
+		expBody      interface{}
+		expCode      int
+	}{
+		{
+			name: "ok",
+			req: &request.CreateInvite{
+				Email:  invite.Email,
+				Role:   string(invite.Role),
+				PvzIDs: invite.PvzIDs,
+			},
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().CreateInvite(gomock.Any(), req).Return(invite, nil)
+			},
+			expBody: invite.ToResponse(),
+			expCode: http.StatusCreated,
+		},
+		{
+			name: "invalid req",
+			req:  &request.CreateInvite{Email: "invalid", Role: string(invite.Role)},
+			mockBehavior: func(req interface{}) {
+			},
+			expCode: http.StatusBadRequest,
+		},
+		{
+			name: "service err",
+			req:  &request.CreateInvite{Email: invite.Email, Role: string(invite.Role)},
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().CreateInvite(gomock.Any(), req).Return(nil, errMock)
+			},
+			expCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			r := newTestRouter()
+
+			tc.mockBehavior(tc.req)
+
+			r.POST("/invites", handler.PostInvites)
+
+			body, _ := json.Marshal(tc.req)
+			req := httptest.NewRequest(http.MethodPost, "/invites", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			r.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expCode == http.StatusCreated {
+				expJSON, err := json.Marshal(tc.expBody)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expJSON), rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestPostRegisterAcceptInvite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockInviteService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, nil, service, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	testCases := []struct {
+		name         string
+		req          interface{}
+		mockBehavior func(req interface{})
+		expBody      interface{}
+		expCode      int
+	}{
+		{
+			name: "ok",
+			req:  &request.AcceptInvite{Token: "token", Password: "password"},
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().AcceptInvite(gomock.Any(), req).Return(mockuser, nil)
+			},
+			expBody: mockuser.ToResponse(),
+			expCode: http.StatusCreated,
+		},
+		{
+			name: "invalid req",
+			req:  &request.AcceptInvite{Token: "token"},
+			mockBehavior: func(req interface{}) {
+			},
+			expCode: http.StatusBadRequest,
+		},
+		{
+			name: "invite not found",
+			req:  &request.AcceptInvite{Token: "token", Password: "password"},
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().AcceptInvite(gomock.Any(), req).Return(nil, apperror.NewBadReq("invite not found or expired"))
+			},
+			expCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			r := newTestRouter()
+
+			tc.mockBehavior(tc.req)
+
+			r.POST("/register/accept-invite", handler.PostRegisterAcceptInvite)
+
+			body, _ := json.Marshal(tc.req)
+			req := httptest.NewRequest(http.MethodPost, "/register/accept-invite", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			r.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expCode == http.StatusCreated {
+				expJSON, err := json.Marshal(tc.expBody)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expJSON), rec.Body.String())
+			}
+		})
+	}
+}
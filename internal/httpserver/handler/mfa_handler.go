@@ -0,0 +1,87 @@
+//go:generate mockgen -source=./mfa_handler.go -destination=./mocks/mfa_handler.go -package=mocks
+
+package handler
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+)
+
+type MFAService interface {
+	Enroll(context.Context, uuid.UUID) (*response.MFAEnroll, error)
+	Verify(context.Context, uuid.UUID, *request.VerifyMFA) (*response.MFARecoveryCodes, error)
+	Login(context.Context, *request.LoginMFA) (*response.Login, error)
+}
+
+// PostMfaEnroll starts TOTP enrollment.
+func (h Handler) PostMfaEnroll(ctx *gin.Context) {
+	log.SetPrefix("http-server.handler.EnrollMFA")
+
+	p, ok := principal.FromContext(ctx)
+	if !ok {
+		wrapCtxWithError(ctx, apperror.NewUnauthorized("unauthorized"))
+		return
+	}
+
+	resp, err := h.mfaSrv.Enroll(ctx, p.UserID)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// PostMfaVerify finishes TOTP enrollment and
+// returns recovery codes.
+func (h Handler) PostMfaVerify(ctx *gin.Context) {
+	log.SetPrefix("http-server.handler.VerifyMFA")
+
+	p, ok := principal.FromContext(ctx)
+	if !ok {
+		wrapCtxWithError(ctx, apperror.NewUnauthorized("unauthorized"))
+		return
+	}
+
+	var req request.VerifyMFA
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		wrapCtxWithError(ctx, apperror.NewBadReq("invalid req: "+err.Error()))
+		return
+	}
+
+	resp, err := h.mfaSrv.Verify(ctx, p.UserID, &req)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// PostLoginMfa exchanges MFA token and code for a token.
+func (h Handler) PostLoginMfa(ctx *gin.Context) {
+	log.SetPrefix("http-server.handler.LoginMFA")
+
+	var req request.LoginMFA
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		wrapCtxWithError(ctx, apperror.NewBadReq("invalid req: "+err.Error()))
+		return
+	}
+
+	resp, err := h.mfaSrv.Login(ctx, &req)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,137 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/httpserver/handler"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/httpserver/handler/mocks"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+)
+
+func TestPostMfaEnroll(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockMFAService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, nil, nil, nil, nil, service, nil, nil, nil, nil, nil, nil, nil)
+
+	userID := uuid.New()
+	enroll := &response.MFAEnroll{Secret: "SECRET", URL: "otpauth://totp/PVZ:mfa?secret=SECRET"}
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expCode      int
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				caller = &principal.Principal{UserID: userID}
+				service.EXPECT().Enroll(gomock.Any(), userID).Return(enroll, nil)
+			},
+			expCode: http.StatusOK,
+		},
+		{
+			name: "already enabled",
+			mockBehavior: func() {
+				caller = &principal.Principal{UserID: userID}
+				service.EXPECT().Enroll(gomock.Any(), userID).Return(nil, apperror.NewBadReq("mfa already enabled"))
+			},
+			expCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			r := newTestRouter()
+
+			tc.mockBehavior()
+
+			r.POST("/mfa/enroll", handler.PostMfaEnroll)
+
+			req := httptest.NewRequest(http.MethodPost, "/mfa/enroll", nil)
+
+			r.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expCode == http.StatusOK {
+				expJSON, err := json.Marshal(enroll)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expJSON), rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestPostLoginMfa(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockMFAService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, nil, nil, nil, nil, service, nil, nil, nil, nil, nil, nil, nil)
+	testCases := []struct {
+		name         string
+		req          interface{}
+		mockBehavior func(req interface{})
+		expCode      int
+	}{
+		{
+			name: "ok",
+			req:  &request.LoginMFA{MFAToken: "mfa-token", Code: "123456"},
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().Login(gomock.Any(), req).Return(&response.Login{Token: "token"}, nil)
+			},
+			expCode: http.StatusOK,
+		},
+		{
+			name: "no code",
+			req:  &request.LoginMFA{MFAToken: "mfa-token"},
+			mockBehavior: func(req interface{}) {
+			},
+			expCode: http.StatusBadRequest,
+		},
+		{
+			name: "invalid code",
+			req:  &request.LoginMFA{MFAToken: "mfa-token", Code: "000000"},
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().Login(gomock.Any(), req).Return(nil, apperror.NewUnauthorized("invalid code"))
+			},
+			expCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			r := newTestRouter()
+
+			tc.mockBehavior(tc.req)
+
+			r.POST("/login/mfa", handler.PostLoginMfa)
+
+			body, _ := json.Marshal(tc.req)
+			req := httptest.NewRequest(http.MethodPost, "/login/mfa", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			r.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.expCode, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./city_handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	request "github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	entity "github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+)
+
+// MockCityService is a mock of CityService interface.
+type MockCityService struct {
+	ctrl     *gomock.Controller
+	recorder *MockCityServiceMockRecorder
+}
+
+// MockCityServiceMockRecorder is the mock recorder for MockCityService.
+type MockCityServiceMockRecorder struct {
+	mock *MockCityService
+}
+
+// NewMockCityService creates a new mock instance.
+func NewMockCityService(ctrl *gomock.Controller) *MockCityService {
+	mock := &MockCityService{ctrl: ctrl}
+	mock.recorder = &MockCityServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCityService) EXPECT() *MockCityServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateCity mocks base method.
+func (m *MockCityService) CreateCity(arg0 context.Context, arg1 *request.CreateCity) (*entity.CityInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCity", arg0, arg1)
+	ret0, _ := ret[0].(*entity.CityInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCity indicates an expected call of CreateCity.
+func (mr *MockCityServiceMockRecorder) CreateCity(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCity", reflect.TypeOf((*MockCityService)(nil).CreateCity), arg0, arg1)
+}
+
+// IsCityEnabled mocks base method.
+func (m *MockCityService) IsCityEnabled(arg0 context.Context, arg1 string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsCityEnabled", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsCityEnabled indicates an expected call of IsCityEnabled.
+func (mr *MockCityServiceMockRecorder) IsCityEnabled(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsCityEnabled", reflect.TypeOf((*MockCityService)(nil).IsCityEnabled), arg0, arg1)
+}
+
+// ListCities mocks base method.
+func (m *MockCityService) ListCities(arg0 context.Context, arg1 *bool) ([]*entity.CityInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCities", arg0, arg1)
+	ret0, _ := ret[0].([]*entity.CityInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCities indicates an expected call of ListCities.
+func (mr *MockCityServiceMockRecorder) ListCities(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCities", reflect.TypeOf((*MockCityService)(nil).ListCities), arg0, arg1)
+}
+
+// UpdateCity mocks base method.
+func (m *MockCityService) UpdateCity(arg0 context.Context, arg1 string, arg2 *request.UpdateCity) (*entity.CityInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCity", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.CityInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCity indicates an expected call of UpdateCity.
+func (mr *MockCityServiceMockRecorder) UpdateCity(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCity", reflect.TypeOf((*MockCityService)(nil).UpdateCity), arg0, arg1, arg2)
+}
@@ -0,0 +1,67 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./invite_handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	request "github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	entity "github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+)
+
+// MockInviteService is a mock of InviteService interface.
+type MockInviteService struct {
+	ctrl     *gomock.Controller
+	recorder *MockInviteServiceMockRecorder
+}
+
+// MockInviteServiceMockRecorder is the mock recorder for MockInviteService.
+type MockInviteServiceMockRecorder struct {
+	mock *MockInviteService
+}
+
+// NewMockInviteService creates a new mock instance.
+func NewMockInviteService(ctrl *gomock.Controller) *MockInviteService {
+	mock := &MockInviteService{ctrl: ctrl}
+	mock.recorder = &MockInviteServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInviteService) EXPECT() *MockInviteServiceMockRecorder {
+	return m.recorder
+}
+
+// AcceptInvite mocks base method.
+func (m *MockInviteService) AcceptInvite(arg0 context.Context, arg1 *request.AcceptInvite) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvite", arg0, arg1)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptInvite indicates an expected call of AcceptInvite.
+func (mr *MockInviteServiceMockRecorder) AcceptInvite(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvite", reflect.TypeOf((*MockInviteService)(nil).AcceptInvite), arg0, arg1)
+}
+
+// CreateInvite mocks base method.
+func (m *MockInviteService) CreateInvite(arg0 context.Context, arg1 *request.CreateInvite) (*entity.Invite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvite", arg0, arg1)
+	ret0, _ := ret[0].(*entity.Invite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInvite indicates an expected call of CreateInvite.
+func (mr *MockInviteServiceMockRecorder) CreateInvite(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvite", reflect.TypeOf((*MockInviteService)(nil).CreateInvite), arg0, arg1)
+}
@@ -0,0 +1,113 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./product_handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	request "github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	entity "github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+)
+
+// MockProductService is a mock of ProductService interface.
+type MockProductService struct {
+	ctrl     *gomock.Controller
+	recorder *MockProductServiceMockRecorder
+}
+
+// MockProductServiceMockRecorder is the mock recorder for MockProductService.
+type MockProductServiceMockRecorder struct {
+	mock *MockProductService
+}
+
+// NewMockProductService creates a new mock instance.
+func NewMockProductService(ctrl *gomock.Controller) *MockProductService {
+	mock := &MockProductService{ctrl: ctrl}
+	mock.recorder = &MockProductServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProductService) EXPECT() *MockProductServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateReturnShipment mocks base method.
+func (m *MockProductService) CreateReturnShipment(ctx context.Context, pvzID uuid.UUID) (*entity.ReturnShipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReturnShipment", ctx, pvzID)
+	ret0, _ := ret[0].(*entity.ReturnShipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReturnShipment indicates an expected call of CreateReturnShipment.
+func (mr *MockProductServiceMockRecorder) CreateReturnShipment(ctx, pvzID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReturnShipment", reflect.TypeOf((*MockProductService)(nil).CreateReturnShipment), ctx, pvzID)
+}
+
+// GetProductEvents mocks base method.
+func (m *MockProductService) GetProductEvents(ctx context.Context, id uuid.UUID) ([]*entity.ProductEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductEvents", ctx, id)
+	ret0, _ := ret[0].([]*entity.ProductEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductEvents indicates an expected call of GetProductEvents.
+func (mr *MockProductServiceMockRecorder) GetProductEvents(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductEvents", reflect.TypeOf((*MockProductService)(nil).GetProductEvents), ctx, id)
+}
+
+// IssueProduct mocks base method.
+func (m *MockProductService) IssueProduct(ctx context.Context, id uuid.UUID, req *request.IssueProduct) (*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueProduct", ctx, id, req)
+	ret0, _ := ret[0].(*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueProduct indicates an expected call of IssueProduct.
+func (mr *MockProductServiceMockRecorder) IssueProduct(ctx, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueProduct", reflect.TypeOf((*MockProductService)(nil).IssueProduct), ctx, id, req)
+}
+
+// ListPvzStock mocks base method.
+func (m *MockProductService) ListPvzStock(ctx context.Context, req *request.ListStock) ([]*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPvzStock", ctx, req)
+	ret0, _ := ret[0].([]*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPvzStock indicates an expected call of ListPvzStock.
+func (mr *MockProductServiceMockRecorder) ListPvzStock(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPvzStock", reflect.TypeOf((*MockProductService)(nil).ListPvzStock), ctx, req)
+}
+
+// SetPickupCode mocks base method.
+func (m *MockProductService) SetPickupCode(ctx context.Context, id uuid.UUID, req *request.SetPickupCode) (*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPickupCode", ctx, id, req)
+	ret0, _ := ret[0].(*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPickupCode indicates an expected call of SetPickupCode.
+func (mr *MockProductServiceMockRecorder) SetPickupCode(ctx, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPickupCode", reflect.TypeOf((*MockProductService)(nil).SetPickupCode), ctx, id, req)
+}
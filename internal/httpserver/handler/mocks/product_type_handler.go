@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./product_type_handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	request "github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	entity "github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+)
+
+// MockProductTypeService is a mock of ProductTypeService interface.
+type MockProductTypeService struct {
+	ctrl     *gomock.Controller
+	recorder *MockProductTypeServiceMockRecorder
+}
+
+// MockProductTypeServiceMockRecorder is the mock recorder for MockProductTypeService.
+type MockProductTypeServiceMockRecorder struct {
+	mock *MockProductTypeService
+}
+
+// NewMockProductTypeService creates a new mock instance.
+func NewMockProductTypeService(ctrl *gomock.Controller) *MockProductTypeService {
+	mock := &MockProductTypeService{ctrl: ctrl}
+	mock.recorder = &MockProductTypeServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProductTypeService) EXPECT() *MockProductTypeServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateProductType mocks base method.
+func (m *MockProductTypeService) CreateProductType(arg0 context.Context, arg1 *request.CreateProductType) (*entity.ProductTypeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProductType", arg0, arg1)
+	ret0, _ := ret[0].(*entity.ProductTypeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProductType indicates an expected call of CreateProductType.
+func (mr *MockProductTypeServiceMockRecorder) CreateProductType(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProductType", reflect.TypeOf((*MockProductTypeService)(nil).CreateProductType), arg0, arg1)
+}
+
+// DeleteProductType mocks base method.
+func (m *MockProductTypeService) DeleteProductType(arg0 context.Context, arg1 string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProductType", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProductType indicates an expected call of DeleteProductType.
+func (mr *MockProductTypeServiceMockRecorder) DeleteProductType(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductType", reflect.TypeOf((*MockProductTypeService)(nil).DeleteProductType), arg0, arg1)
+}
+
+// ListProductTypes mocks base method.
+func (m *MockProductTypeService) ListProductTypes(arg0 context.Context) ([]*entity.ProductTypeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProductTypes", arg0)
+	ret0, _ := ret[0].([]*entity.ProductTypeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProductTypes indicates an expected call of ListProductTypes.
+func (mr *MockProductTypeServiceMockRecorder) ListProductTypes(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProductTypes", reflect.TypeOf((*MockProductTypeService)(nil).ListProductTypes), arg0)
+}
+
+// UpdateProductType mocks base method.
+func (m *MockProductTypeService) UpdateProductType(arg0 context.Context, arg1 string, arg2 *request.UpdateProductType) (*entity.ProductTypeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProductType", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.ProductTypeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProductType indicates an expected call of UpdateProductType.
+func (mr *MockProductTypeServiceMockRecorder) UpdateProductType(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductType", reflect.TypeOf((*MockProductTypeService)(nil).UpdateProductType), arg0, arg1, arg2)
+}
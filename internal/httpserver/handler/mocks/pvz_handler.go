@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
 	request "github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
 	entity "github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
 )
@@ -50,3 +51,33 @@ func (mr *MockPvzServiceMockRecorder) CreatePvz(arg0, arg1 interface{}) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePvz", reflect.TypeOf((*MockPvzService)(nil).CreatePvz), arg0, arg1)
 }
+
+// UpdatePvzCapacity mocks base method.
+func (m *MockPvzService) UpdatePvzCapacity(arg0 context.Context, arg1 uuid.UUID, arg2 *request.UpdatePvzCapacity) (*entity.Pvz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePvzCapacity", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.Pvz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePvzCapacity indicates an expected call of UpdatePvzCapacity.
+func (mr *MockPvzServiceMockRecorder) UpdatePvzCapacity(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePvzCapacity", reflect.TypeOf((*MockPvzService)(nil).UpdatePvzCapacity), arg0, arg1, arg2)
+}
+
+// UpdatePvzStatus mocks base method.
+func (m *MockPvzService) UpdatePvzStatus(arg0 context.Context, arg1 uuid.UUID, arg2 *request.UpdatePvz) (*entity.Pvz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePvzStatus", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.Pvz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePvzStatus indicates an expected call of UpdatePvzStatus.
+func (mr *MockPvzServiceMockRecorder) UpdatePvzStatus(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePvzStatus", reflect.TypeOf((*MockPvzService)(nil).UpdatePvzStatus), arg0, arg1, arg2)
+}
@@ -52,6 +52,36 @@ func (mr *MockReceptionServiceMockRecorder) AddProductToReception(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProductToReception", reflect.TypeOf((*MockReceptionService)(nil).AddProductToReception), arg0, arg1)
 }
 
+// AddProductsBatch mocks base method.
+func (m *MockReceptionService) AddProductsBatch(arg0 context.Context, arg1 *request.AddProductsBatch) (*entity.ProductsBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddProductsBatch", arg0, arg1)
+	ret0, _ := ret[0].(*entity.ProductsBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddProductsBatch indicates an expected call of AddProductsBatch.
+func (mr *MockReceptionServiceMockRecorder) AddProductsBatch(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProductsBatch", reflect.TypeOf((*MockReceptionService)(nil).AddProductsBatch), arg0, arg1)
+}
+
+// CancelReception mocks base method.
+func (m *MockReceptionService) CancelReception(arg0 context.Context, arg1 uuid.UUID, arg2 *request.ChangeReceptionStatus) (*entity.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelReception", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelReception indicates an expected call of CancelReception.
+func (mr *MockReceptionServiceMockRecorder) CancelReception(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelReception", reflect.TypeOf((*MockReceptionService)(nil).CancelReception), arg0, arg1, arg2)
+}
+
 // CreateReception mocks base method.
 func (m *MockReceptionService) CreateReception(arg0 context.Context, arg1 *request.CreateReception) (*entity.Reception, error) {
 	m.ctrl.T.Helper()
@@ -82,10 +112,10 @@ func (mr *MockReceptionServiceMockRecorder) DeleteLastProduct(arg0, arg1 interfa
 }
 
 // FinishReception mocks base method.
-func (m *MockReceptionService) FinishReception(arg0 context.Context, arg1 uuid.UUID) (*entity.Reception, error) {
+func (m *MockReceptionService) FinishReception(arg0 context.Context, arg1 *request.FinishReception) (*entity.ClosedReception, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "FinishReception", arg0, arg1)
-	ret0, _ := ret[0].(*entity.Reception)
+	ret0, _ := ret[0].(*entity.ClosedReception)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -96,6 +126,81 @@ func (mr *MockReceptionServiceMockRecorder) FinishReception(arg0, arg1 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishReception", reflect.TypeOf((*MockReceptionService)(nil).FinishReception), arg0, arg1)
 }
 
+// GetPvzDetails mocks base method.
+func (m *MockReceptionService) GetPvzDetails(arg0 context.Context, arg1 uuid.UUID) (*entity.PvzDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPvzDetails", arg0, arg1)
+	ret0, _ := ret[0].(*entity.PvzDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPvzDetails indicates an expected call of GetPvzDetails.
+func (mr *MockReceptionServiceMockRecorder) GetPvzDetails(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPvzDetails", reflect.TypeOf((*MockReceptionService)(nil).GetPvzDetails), arg0, arg1)
+}
+
+// GetReception mocks base method.
+func (m *MockReceptionService) GetReception(arg0 context.Context, arg1 uuid.UUID) (*entity.ReceptionDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReception", arg0, arg1)
+	ret0, _ := ret[0].(*entity.ReceptionDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReception indicates an expected call of GetReception.
+func (mr *MockReceptionServiceMockRecorder) GetReception(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReception", reflect.TypeOf((*MockReceptionService)(nil).GetReception), arg0, arg1)
+}
+
+// ListPvzReceptions mocks base method.
+func (m *MockReceptionService) ListPvzReceptions(arg0 context.Context, arg1 *request.ListReceptions) ([]*entity.ReceptionSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPvzReceptions", arg0, arg1)
+	ret0, _ := ret[0].([]*entity.ReceptionSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPvzReceptions indicates an expected call of ListPvzReceptions.
+func (mr *MockReceptionServiceMockRecorder) ListPvzReceptions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPvzReceptions", reflect.TypeOf((*MockReceptionService)(nil).ListPvzReceptions), arg0, arg1)
+}
+
+// ReopenReception mocks base method.
+func (m *MockReceptionService) ReopenReception(arg0 context.Context, arg1 uuid.UUID, arg2 *request.ChangeReceptionStatus) (*entity.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReopenReception", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*entity.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReopenReception indicates an expected call of ReopenReception.
+func (mr *MockReceptionServiceMockRecorder) ReopenReception(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReopenReception", reflect.TypeOf((*MockReceptionService)(nil).ReopenReception), arg0, arg1, arg2)
+}
+
+// SearchProductsByBarcode mocks base method.
+func (m *MockReceptionService) SearchProductsByBarcode(arg0 context.Context, arg1 string) ([]*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchProductsByBarcode", arg0, arg1)
+	ret0, _ := ret[0].([]*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchProductsByBarcode indicates an expected call of SearchProductsByBarcode.
+func (mr *MockReceptionServiceMockRecorder) SearchProductsByBarcode(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchProductsByBarcode", reflect.TypeOf((*MockReceptionService)(nil).SearchProductsByBarcode), arg0, arg1)
+}
+
 // SearchReceptions mocks base method.
 func (m *MockReceptionService) SearchReceptions(arg0 context.Context, arg1 *request.SearchPvz) ([]*entity.PvzWithReception, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,83 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./storage_cell_handler.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	request "github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	entity "github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+)
+
+// MockStorageCellService is a mock of StorageCellService interface.
+type MockStorageCellService struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageCellServiceMockRecorder
+}
+
+// MockStorageCellServiceMockRecorder is the mock recorder for MockStorageCellService.
+type MockStorageCellServiceMockRecorder struct {
+	mock *MockStorageCellService
+}
+
+// NewMockStorageCellService creates a new mock instance.
+func NewMockStorageCellService(ctrl *gomock.Controller) *MockStorageCellService {
+	mock := &MockStorageCellService{ctrl: ctrl}
+	mock.recorder = &MockStorageCellServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorageCellService) EXPECT() *MockStorageCellServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateStorageCell mocks base method.
+func (m *MockStorageCellService) CreateStorageCell(ctx context.Context, pvzID uuid.UUID, req *request.CreateStorageCell) (*entity.StorageCell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStorageCell", ctx, pvzID, req)
+	ret0, _ := ret[0].(*entity.StorageCell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStorageCell indicates an expected call of CreateStorageCell.
+func (mr *MockStorageCellServiceMockRecorder) CreateStorageCell(ctx, pvzID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStorageCell", reflect.TypeOf((*MockStorageCellService)(nil).CreateStorageCell), ctx, pvzID, req)
+}
+
+// ListPvzStorageCells mocks base method.
+func (m *MockStorageCellService) ListPvzStorageCells(ctx context.Context, pvzID uuid.UUID) ([]*entity.StorageCell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPvzStorageCells", ctx, pvzID)
+	ret0, _ := ret[0].([]*entity.StorageCell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPvzStorageCells indicates an expected call of ListPvzStorageCells.
+func (mr *MockStorageCellServiceMockRecorder) ListPvzStorageCells(ctx, pvzID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPvzStorageCells", reflect.TypeOf((*MockStorageCellService)(nil).ListPvzStorageCells), ctx, pvzID)
+}
+
+// MoveProduct mocks base method.
+func (m *MockStorageCellService) MoveProduct(ctx context.Context, productID uuid.UUID, req *request.MoveProduct) (*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveProduct", ctx, productID, req)
+	ret0, _ := ret[0].(*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveProduct indicates an expected call of MoveProduct.
+func (mr *MockStorageCellServiceMockRecorder) MoveProduct(ctx, productID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveProduct", reflect.TypeOf((*MockStorageCellService)(nil).MoveProduct), ctx, productID, req)
+}
@@ -0,0 +1,55 @@
+//go:generate mockgen -source=./password_handler.go -destination=./mocks/password_handler.go -package=mocks
+
+package handler
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+)
+
+type PasswordService interface {
+	ForgotPassword(context.Context, *request.ForgotPassword) error
+	ResetPassword(context.Context, *request.ResetPassword) error
+}
+
+// PostPasswordForgot sends password reset code to email.
+func (h Handler) PostPasswordForgot(ctx *gin.Context) {
+	log.SetPrefix("http-server.handler.ForgotPassword")
+
+	var req request.ForgotPassword
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		wrapCtxWithError(ctx, apperror.NewBadReq("invalid req: "+err.Error()))
+		return
+	}
+
+	if err := h.passwordSrv.ForgotPassword(ctx, &req); err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusAccepted)
+}
+
+// PostPasswordReset sets new password by reset code.
+func (h Handler) PostPasswordReset(ctx *gin.Context) {
+	log.SetPrefix("http-server.handler.ResetPasswordByCode")
+
+	var req request.ResetPassword
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		wrapCtxWithError(ctx, apperror.NewBadReq("invalid req: "+err.Error()))
+		return
+	}
+
+	if err := h.passwordSrv.ResetPassword(ctx, &req); err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusOK)
+}
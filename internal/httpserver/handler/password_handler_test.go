@@ -0,0 +1,134 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/httpserver/handler"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/httpserver/handler/mocks"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+)
+
+func TestPostPasswordForgot(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockPasswordService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, nil, nil, service, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	testCases := []struct {
+		name         string
+		req          interface{}
+		mockBehavior func(req interface{})
+		expCode      int
+	}{
+		{
+			name: "ok",
+			req:  &request.ForgotPassword{Email: mockuser.Email},
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().ForgotPassword(gomock.Any(), req).Return(nil)
+			},
+			expCode: http.StatusAccepted,
+		},
+		{
+			name: "invalid email",
+			req:  &request.ForgotPassword{Email: "invalid"},
+			mockBehavior: func(req interface{}) {
+			},
+			expCode: http.StatusBadRequest,
+		},
+		{
+			name: "service err",
+			req:  &request.ForgotPassword{Email: mockuser.Email},
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().ForgotPassword(gomock.Any(), req).Return(errMock)
+			},
+			expCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			r := newTestRouter()
+
+			tc.mockBehavior(tc.req)
+
+			r.POST("/password/forgot", handler.PostPasswordForgot)
+
+			body, _ := json.Marshal(tc.req)
+			req := httptest.NewRequest(http.MethodPost, "/password/forgot", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			r.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.expCode, rec.Code)
+		})
+	}
+}
+
+func TestPostPasswordReset(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockPasswordService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, nil, nil, service, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	testCases := []struct {
+		name         string
+		req          interface{}
+		mockBehavior func(req interface{})
+		expCode      int
+	}{
+		{
+			name: "ok",
+			req:  &request.ResetPassword{Code: "code", Password: "new"},
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().ResetPassword(gomock.Any(), req).Return(nil)
+			},
+			expCode: http.StatusOK,
+		},
+		{
+			name: "invalid req",
+			req:  &request.ResetPassword{Code: "code"},
+			mockBehavior: func(req interface{}) {
+			},
+			expCode: http.StatusBadRequest,
+		},
+		{
+			name: "code not found",
+			req:  &request.ResetPassword{Code: "code", Password: "new"},
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().ResetPassword(gomock.Any(), req).Return(apperror.NewBadReq("reset code not found or expired"))
+			},
+			expCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			r := newTestRouter()
+
+			tc.mockBehavior(tc.req)
+
+			r.POST("/password/reset", handler.PostPasswordReset)
+
+			body, _ := json.Marshal(tc.req)
+			req := httptest.NewRequest(http.MethodPost, "/password/reset", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			r.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.expCode, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,136 @@
+//go:generate mockgen -source=./product_handler.go -destination=./mocks/product_handler.go -package=mocks
+
+package handler
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/pkg/openapi"
+)
+
+const defaultStockLimit = 50
+
+type ProductService interface {
+	IssueProduct(ctx context.Context, id uuid.UUID, req *request.IssueProduct) (*entity.Product, error)
+	SetPickupCode(ctx context.Context, id uuid.UUID, req *request.SetPickupCode) (*entity.Product, error)
+	ListPvzStock(ctx context.Context, req *request.ListStock) ([]*entity.Product, error)
+	GetProductEvents(ctx context.Context, id uuid.UUID) ([]*entity.ProductEvent, error)
+	CreateReturnShipment(ctx context.Context, pvzID uuid.UUID) (*entity.ReturnShipment, error)
+}
+
+// PostProductsProductIdIssue issues stored product
+// to customer by pickup code.
+func (h Handler) PostProductsProductIdIssue(ctx *gin.Context, productID uuid.UUID) {
+	log.SetPrefix("http-server.handler.IssueProduct")
+
+	var req request.IssueProduct
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		wrapCtxWithError(ctx, apperror.NewBadReq("invalid req: "+err.Error()))
+		return
+	}
+
+	product, err := h.productSrv.IssueProduct(ctx, productID, &req)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, product.ToResponse())
+}
+
+// PutProductsProductIdPickupCode sets pickup code
+// of stored product accepted without it.
+func (h Handler) PutProductsProductIdPickupCode(ctx *gin.Context, productID uuid.UUID) {
+	log.SetPrefix("http-server.handler.SetPickupCode")
+
+	var req request.SetPickupCode
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		wrapCtxWithError(ctx, apperror.NewBadReq("invalid req: "+err.Error()))
+		return
+	}
+
+	product, err := h.productSrv.SetPickupCode(ctx, productID, &req)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, product.ToResponse())
+}
+
+// GetProductsProductIdEvents returns product history.
+func (h Handler) GetProductsProductIdEvents(ctx *gin.Context, productID uuid.UUID) {
+	log.SetPrefix("http-server.handler.GetProductEvents")
+
+	events, err := h.productSrv.GetProductEvents(ctx, productID)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+	resp := make([]*response.ProductEvent, len(events))
+	for i, v := range events {
+		resp[i] = v.ToResponse()
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetPvzPvzIdStock returns page of products stored in PVZ.
+func (h Handler) GetPvzPvzIdStock(ctx *gin.Context, pvzID uuid.UUID, params openapi.GetPvzPvzIdStockParams) {
+	log.SetPrefix("http-server.handler.ListPvzStock")
+
+	if !checkPvzScope(ctx, pvzID) {
+		return
+	}
+
+	req := &request.ListStock{
+		PvzID: pvzID,
+		Page:  1,
+		Limit: defaultStockLimit,
+	}
+	if params.Page != nil {
+		req.Page = *params.Page
+	}
+	if params.Limit != nil {
+		req.Limit = *params.Limit
+	}
+
+	products, err := h.productSrv.ListPvzStock(ctx, req)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+	resp := make([]*response.Product, len(products))
+	for i, v := range products {
+		resp[i] = v.ToResponse()
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// PostPvzPvzIdReturnShipments sends products of PVZ, whose
+// storage period is over, back to sender.
+func (h Handler) PostPvzPvzIdReturnShipments(ctx *gin.Context, pvzID uuid.UUID) {
+	log.SetPrefix("http-server.handler.CreateReturnShipment")
+
+	if !checkPvzScope(ctx, pvzID) {
+		return
+	}
+
+	shipment, err := h.productSrv.CreateReturnShipment(ctx, pvzID)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, shipment.ToResponse())
+}
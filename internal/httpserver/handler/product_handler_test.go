@@ -0,0 +1,365 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/httpserver/handler"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/httpserver/handler/mocks"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/pkg/openapi"
+)
+
+func TestPostProductsProductIdIssue(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockProductService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, service, nil, nil, nil)
+
+	issued := *product
+	issued.State = entity.ProductStateIssued
+
+	testCases := []struct {
+		name         string
+		req          interface{}
+		mockBehavior func()
+		expBody      interface{}
+		expCode      int
+	}{
+		{
+			name: "ok",
+			req:  &request.IssueProduct{PickupCode: "1234"},
+			mockBehavior: func() {
+				service.EXPECT().IssueProduct(gomock.Any(), product.ID, &request.IssueProduct{PickupCode: "1234"}).Return(&issued, nil)
+			},
+			expBody: issued.ToResponse(),
+			expCode: http.StatusOK,
+		},
+		{
+			name: "no pickup code",
+			req:  map[string]string{},
+			mockBehavior: func() {
+			},
+			expCode: http.StatusBadRequest,
+		},
+		{
+			name: "invalid pickup code",
+			req:  &request.IssueProduct{PickupCode: "4321"},
+			mockBehavior: func() {
+				service.EXPECT().IssueProduct(gomock.Any(), product.ID, gomock.Any()).Return(nil, apperror.NewBadReq("invalid pickup code"))
+			},
+			expCode: http.StatusBadRequest,
+		},
+		{
+			name: "already issued",
+			req:  &request.IssueProduct{PickupCode: "1234"},
+			mockBehavior: func() {
+				service.EXPECT().IssueProduct(gomock.Any(), product.ID, gomock.Any()).Return(nil, apperror.NewConflict("product is issued"))
+			},
+			expCode: http.StatusConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(rec)
+
+			body, err := json.Marshal(tc.req)
+			require.NoError(t, err)
+			ctx.Request = httptest.NewRequest(http.MethodPost, "/dummy", bytes.NewReader(body))
+			ctx.Request.Header.Set("Content-Type", "application/json")
+
+			tc.mockBehavior()
+			handler.PostProductsProductIdIssue(ctx, product.ID)
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expCode == http.StatusOK {
+				expJSON, err := json.Marshal(tc.expBody)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expJSON), rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestPutProductsProductIdPickupCode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockProductService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, service, nil, nil, nil)
+
+	testCases := []struct {
+		name         string
+		req          interface{}
+		mockBehavior func()
+		expBody      interface{}
+		expCode      int
+	}{
+		{
+			name: "ok",
+			req:  &request.SetPickupCode{PickupCode: "1234"},
+			mockBehavior: func() {
+				service.EXPECT().SetPickupCode(gomock.Any(), product.ID, &request.SetPickupCode{PickupCode: "1234"}).Return(product, nil)
+			},
+			expBody: product.ToResponse(),
+			expCode: http.StatusOK,
+		},
+		{
+			name: "no pickup code",
+			req:  map[string]string{},
+			mockBehavior: func() {
+			},
+			expCode: http.StatusBadRequest,
+		},
+		{
+			name: "already has code",
+			req:  &request.SetPickupCode{PickupCode: "1234"},
+			mockBehavior: func() {
+				service.EXPECT().SetPickupCode(gomock.Any(), product.ID, gomock.Any()).Return(nil, apperror.NewConflict("product already has pickup code"))
+			},
+			expCode: http.StatusConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(rec)
+
+			body, err := json.Marshal(tc.req)
+			require.NoError(t, err)
+			ctx.Request = httptest.NewRequest(http.MethodPut, "/dummy", bytes.NewReader(body))
+			ctx.Request.Header.Set("Content-Type", "application/json")
+
+			tc.mockBehavior()
+			handler.PutProductsProductIdPickupCode(ctx, product.ID)
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expCode == http.StatusOK {
+				expJSON, err := json.Marshal(tc.expBody)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expJSON), rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestGetProductsProductIdEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockProductService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, service, nil, nil, nil)
+
+	event := &entity.ProductEvent{
+		ID:        1,
+		ProductID: product.ID,
+		PvzID:     pvz.ID,
+		Type:      entity.ProductEventReceived,
+		Details:   json.RawMessage(`{}`),
+		CreatedAt: time.Date(2025, 12, 12, 12, 12, 0, 0, time.UTC),
+	}
+
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expBody      interface{}
+		expCode      int
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				service.EXPECT().GetProductEvents(gomock.Any(), product.ID).Return([]*entity.ProductEvent{event}, nil)
+			},
+			expBody: []*response.ProductEvent{event.ToResponse()},
+			expCode: http.StatusOK,
+		},
+		{
+			name: "not found",
+			mockBehavior: func() {
+				service.EXPECT().GetProductEvents(gomock.Any(), product.ID).Return(nil, apperror.NewNotFound("product not found"))
+			},
+			expCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(rec)
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/dummy", nil)
+
+			tc.mockBehavior()
+			handler.GetProductsProductIdEvents(ctx, product.ID)
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expCode == http.StatusOK {
+				expJSON, err := json.Marshal(tc.expBody)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expJSON), rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestGetPvzPvzIdStock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockProductService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, service, nil, nil, nil)
+
+	stored := *product
+	stored.State = entity.ProductStateStored
+	page, limit := 2, 5
+
+	testCases := []struct {
+		name         string
+		params       openapi.GetPvzPvzIdStockParams
+		mockBehavior func()
+		expBody      interface{}
+		expCode      int
+	}{
+		{
+			name: "ok defaults",
+			mockBehavior: func() {
+				service.EXPECT().ListPvzStock(gomock.Any(), &request.ListStock{PvzID: pvz.ID, Page: 1, Limit: 50}).Return([]*entity.Product{&stored}, nil)
+			},
+			expBody: []*response.Product{stored.ToResponse()},
+			expCode: http.StatusOK,
+		},
+		{
+			name:   "ok page",
+			params: openapi.GetPvzPvzIdStockParams{Page: &page, Limit: &limit},
+			mockBehavior: func() {
+				service.EXPECT().ListPvzStock(gomock.Any(), &request.ListStock{PvzID: pvz.ID, Page: page, Limit: limit}).Return([]*entity.Product{}, nil)
+			},
+			expBody: []*response.Product{},
+			expCode: http.StatusOK,
+		},
+		{
+			name: "other pvz",
+			mockBehavior: func() {
+				caller = &principal.Principal{APIKeyID: uuid.New(), PvzIDs: []uuid.UUID{uuid.New()}}
+			},
+			expCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(rec)
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/dummy", nil)
+
+			tc.mockBehavior()
+			setCaller(ctx)
+			handler.GetPvzPvzIdStock(ctx, pvz.ID, tc.params)
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expCode == http.StatusOK {
+				expJSON, err := json.Marshal(tc.expBody)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expJSON), rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestPostPvzPvzIdReturnShipments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockProductService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, service, nil, nil, nil)
+
+	returned := *product
+	returned.State = entity.ProductStateReturnedToSender
+	shipment := &entity.ReturnShipment{
+		ID:        uuid.New(),
+		PvzID:     pvz.ID,
+		CreatedBy: uuid.New(),
+		CreatedAt: time.Date(2025, 12, 12, 12, 12, 0, 0, time.UTC),
+		Products:  []*entity.Product{&returned},
+	}
+
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expBody      interface{}
+		expCode      int
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				service.EXPECT().CreateReturnShipment(gomock.Any(), pvz.ID).Return(shipment, nil)
+			},
+			expBody: shipment.ToResponse(),
+			expCode: http.StatusCreated,
+		},
+		{
+			name: "nothing to return",
+			mockBehavior: func() {
+				service.EXPECT().CreateReturnShipment(gomock.Any(), pvz.ID).Return(nil, apperror.NewConflict("no products to return"))
+			},
+			expCode: http.StatusConflict,
+		},
+		{
+			name: "other pvz",
+			mockBehavior: func() {
+				caller = &principal.Principal{APIKeyID: uuid.New(), PvzIDs: []uuid.UUID{uuid.New()}}
+			},
+			expCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(rec)
+			ctx.Request = httptest.NewRequest(http.MethodPost, "/dummy", nil)
+
+			tc.mockBehavior()
+			setCaller(ctx)
+			handler.PostPvzPvzIdReturnShipments(ctx, pvz.ID)
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expCode == http.StatusCreated {
+				expJSON, err := json.Marshal(tc.expBody)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expJSON), rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+//go:generate mockgen -source=./product_type_handler.go -destination=./mocks/product_type_handler.go -package=mocks
+
+package handler
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+)
+
+type ProductTypeService interface {
+	ListProductTypes(context.Context) ([]*entity.ProductTypeInfo, error)
+	CreateProductType(context.Context, *request.CreateProductType) (*entity.ProductTypeInfo, error)
+	UpdateProductType(context.Context, string, *request.UpdateProductType) (*entity.ProductTypeInfo, error)
+	DeleteProductType(context.Context, string) error
+}
+
+// GetProductTypes returns product type catalog.
+func (h Handler) GetProductTypes(ctx *gin.Context) {
+	log.SetPrefix("http-server.handler.ListProductTypes")
+
+	types, err := h.productTypeSrv.ListProductTypes(ctx)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+	resp := make([]*response.ProductType, len(types))
+	for i, v := range types {
+		resp[i] = v.ToResponse()
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// PostProductTypes adds product type to catalog with moderator auth.
+func (h Handler) PostProductTypes(ctx *gin.Context) {
+	log.SetPrefix("http-server.handler.CreateProductType")
+
+	var req request.CreateProductType
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		wrapCtxWithError(ctx, apperror.NewBadReq("invalid req: "+err.Error()))
+		return
+	}
+
+	productType, err := h.productTypeSrv.CreateProductType(ctx, &req)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, productType.ToResponse())
+}
+
+// PatchProductTypesCode updates product type with moderator auth.
+func (h Handler) PatchProductTypesCode(ctx *gin.Context, code string) {
+	log.SetPrefix("http-server.handler.UpdateProductType")
+
+	var req request.UpdateProductType
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		wrapCtxWithError(ctx, apperror.NewBadReq("invalid req: "+err.Error()))
+		return
+	}
+
+	productType, err := h.productTypeSrv.UpdateProductType(ctx, code, &req)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, productType.ToResponse())
+}
+
+// DeleteProductTypesCode deletes product type with moderator auth.
+func (h Handler) DeleteProductTypesCode(ctx *gin.Context, code string) {
+	log.SetPrefix("http-server.handler.DeleteProductType")
+
+	if err := h.productTypeSrv.DeleteProductType(ctx, code); err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
@@ -0,0 +1,258 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/httpserver/handler"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/httpserver/handler/mocks"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+)
+
+var productType = &entity.ProductTypeInfo{
+	Code:       "electronics",
+	Name:       entity.ProductTypeElectronics,
+	NameEn:     "Electronics",
+	Attributes: []entity.ProductAttribute{{Name: "imei", Required: true, Pattern: `^[0-9]{15}$`}},
+	Fragile:    true,
+	HighValue:  true,
+	CreatedAt:  time.Date(2025, 12, 12, 12, 12, 0, 0, time.UTC),
+}
+
+func TestGetProductTypes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockProductTypeService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, nil, nil, nil, nil, nil, nil, nil, service, nil, nil, nil, nil)
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expBody      interface{}
+		expCode      int
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				service.EXPECT().ListProductTypes(gomock.Any()).Return([]*entity.ProductTypeInfo{productType}, nil)
+			},
+			expBody: []*response.ProductType{productType.ToResponse()},
+			expCode: http.StatusOK,
+		},
+		{
+			name: "service err",
+			mockBehavior: func() {
+				service.EXPECT().ListProductTypes(gomock.Any()).Return(nil, errMock)
+			},
+			expCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(rec)
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/dummy", nil)
+
+			tc.mockBehavior()
+			handler.GetProductTypes(ctx)
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expCode == http.StatusOK {
+				expJSON, err := json.Marshal(tc.expBody)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expJSON), rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestPostProductTypes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockProductTypeService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, nil, nil, nil, nil, nil, nil, nil, service, nil, nil, nil, nil)
+	testCases := []struct {
+		name         string
+		req          interface{}
+		mockBehavior func(req interface{})
+		expCode      int
+	}{
+		{
+			name: "ok",
+			req: &request.CreateProductType{
+				Code:       productType.Code,
+				Name:       string(productType.Name),
+				Attributes: []request.ProductAttribute{{Name: "imei", Required: true, Pattern: `^[0-9]{15}$`}},
+				Fragile:    true,
+				HighValue:  true,
+			},
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().CreateProductType(gomock.Any(), req).Return(productType, nil)
+			},
+			expCode: http.StatusCreated,
+		},
+		{
+			name: "no name",
+			req:  &request.CreateProductType{Code: productType.Code},
+			mockBehavior: func(req interface{}) {
+			},
+			expCode: http.StatusBadRequest,
+		},
+		{
+			name: "attribute without name",
+			req: &request.CreateProductType{
+				Code:       productType.Code,
+				Name:       string(productType.Name),
+				Attributes: []request.ProductAttribute{{Required: true}},
+			},
+			mockBehavior: func(req interface{}) {
+			},
+			expCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			r := newTestRouter()
+
+			tc.mockBehavior(tc.req)
+
+			r.POST("/product-types", handler.PostProductTypes)
+
+			body, _ := json.Marshal(tc.req)
+			req := httptest.NewRequest(http.MethodPost, "/product-types", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			r.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expCode == http.StatusCreated {
+				expJSON, err := json.Marshal(productType.ToResponse())
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expJSON), rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestPatchProductTypesCode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockProductTypeService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, nil, nil, nil, nil, nil, nil, nil, service, nil, nil, nil, nil)
+
+	highValue := false
+	noName := []request.ProductAttribute{{Pattern: ".*"}}
+	testCases := []struct {
+		name         string
+		req          interface{}
+		mockBehavior func(req interface{})
+		expCode      int
+	}{
+		{
+			name: "ok",
+			req:  &request.UpdateProductType{HighValue: &highValue},
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().UpdateProductType(gomock.Any(), productType.Code, req).Return(productType, nil)
+			},
+			expCode: http.StatusOK,
+		},
+		{
+			name: "attribute without name",
+			req:  &request.UpdateProductType{Attributes: &noName},
+			mockBehavior: func(req interface{}) {
+			},
+			expCode: http.StatusBadRequest,
+		},
+		{
+			name: "not found",
+			req:  &request.UpdateProductType{HighValue: &highValue},
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().UpdateProductType(gomock.Any(), productType.Code, req).Return(nil, apperror.NewNotFound("product type not found"))
+			},
+			expCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(rec)
+
+			body, _ := json.Marshal(tc.req)
+			ctx.Request = httptest.NewRequest(http.MethodPatch, "/dummy", bytes.NewReader(body))
+			ctx.Request.Header.Set("Content-Type", "application/json")
+
+			tc.mockBehavior(tc.req)
+			handler.PatchProductTypesCode(ctx, productType.Code)
+
+			require.Equal(t, tc.expCode, rec.Code)
+		})
+	}
+}
+
+func TestDeleteProductTypesCode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockProductTypeService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, nil, nil, nil, nil, nil, nil, nil, service, nil, nil, nil, nil)
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expCode      int
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				service.EXPECT().DeleteProductType(gomock.Any(), productType.Code).Return(nil)
+			},
+			expCode: http.StatusNoContent,
+		},
+		{
+			name: "in use",
+			mockBehavior: func() {
+				service.EXPECT().DeleteProductType(gomock.Any(), productType.Code).Return(apperror.NewBadReq("product type is used by products"))
+			},
+			expCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(rec)
+			ctx.Request = httptest.NewRequest(http.MethodDelete, "/dummy", nil)
+
+			tc.mockBehavior()
+
+			handler.DeleteProductTypesCode(ctx, productType.Code)
+			ctx.Writer.WriteHeaderNow()
+
+			require.Equal(t, tc.expCode, rec.Code)
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
@@ -16,24 +17,26 @@ import (
 
 type PvzService interface {
 	CreatePvz(context.Context, *request.CreatePvz) (*entity.Pvz, error)
+	UpdatePvzStatus(context.Context, uuid.UUID, *request.UpdatePvz) (*entity.Pvz, error)
+	UpdatePvzCapacity(context.Context, uuid.UUID, *request.UpdatePvzCapacity) (*entity.Pvz, error)
 }
 
 // PostPvz creates a new pvz with moderator auth.
 func (h Handler) PostPvz(ctx *gin.Context) {
 	log.SetPrefix("http-server.handler.CreatePvz")
 
-	h.authSrv.AuthMiddleware(entity.RoleModerator)(ctx)
-	if ctx.IsAborted() {
-		return
-	}
-
 	var req request.CreatePvz
 	if err := ctx.ShouldBindJSON(&req); err != nil {
 		wrapCtxWithError(ctx, apperror.NewBadReq("invalid req: "+err.Error()))
 		return
 	}
 
-	if _, ok := entity.Cities[entity.City(req.City)]; !ok {
+	enabled, err := h.citySrv.IsCityEnabled(ctx, req.City)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+	if !enabled {
 		wrapCtxWithError(ctx, apperror.NewBadReq("invalid city: "+req.City))
 		return
 	}
@@ -46,3 +49,54 @@ func (h Handler) PostPvz(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusCreated, pvz.ToResponse())
 }
+
+// PatchPvzPvzId changes PVZ lifecycle status with moderator auth.
+func (h Handler) PatchPvzPvzId(ctx *gin.Context, pvzID uuid.UUID) {
+	log.SetPrefix("http-server.handler.UpdatePvzStatus")
+
+	if !checkPvzScope(ctx, pvzID) {
+		return
+	}
+
+	var req request.UpdatePvz
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		wrapCtxWithError(ctx, apperror.NewBadReq("invalid req: "+err.Error()))
+		return
+	}
+
+	if _, ok := entity.PvzStatuses[entity.PvzStatus(req.Status)]; !ok {
+		wrapCtxWithError(ctx, apperror.NewBadReq("invalid status: "+req.Status))
+		return
+	}
+
+	pvz, err := h.pvzSrv.UpdatePvzStatus(ctx, pvzID, &req)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, pvz.ToResponse())
+}
+
+// PutPvzPvzIdCapacity replaces PVZ capacity limits with moderator auth.
+func (h Handler) PutPvzPvzIdCapacity(ctx *gin.Context, pvzID uuid.UUID) {
+	log.SetPrefix("http-server.handler.UpdatePvzCapacity")
+
+	if !checkPvzScope(ctx, pvzID) {
+		return
+	}
+
+	var req request.UpdatePvzCapacity
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		wrapCtxWithError(ctx, apperror.NewBadReq("invalid req: "+err.Error()))
+		return
+	}
+
+	pvz, err := h.pvzSrv.UpdatePvzCapacity(ctx, pvzID, &req)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, pvz.ToResponse())
+}
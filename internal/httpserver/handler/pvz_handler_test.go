@@ -31,9 +31,9 @@ func TestPostPvz(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	service := mocks.NewMockPvzService(ctrl)
-	authSrv := mocks.NewMockRoleCheckerMiddleware(ctrl)
+	citySrv := mocks.NewMockCityService(ctrl)
 
-	handler := handler.NewHandler(nil, service, nil, authSrv)
+	handler := handler.NewHandler(nil, service, nil, nil, nil, nil, nil, nil, citySrv, nil, nil, nil, nil, nil)
 	testCases := []struct {
 		name         string
 		req          interface{}
@@ -49,7 +49,7 @@ func TestPostPvz(t *testing.T) {
 				City:             string(pvz.City),
 			},
 			mockBehavior: func(req interface{}) {
-				authSrv.EXPECT().AuthMiddleware(entity.RoleModerator).Return(func(ctx *gin.Context) {})
+				citySrv.EXPECT().IsCityEnabled(gomock.Any(), string(pvz.City)).Return(true, nil)
 				service.EXPECT().CreatePvz(gomock.Any(), req).Return(pvz, nil)
 			},
 			expBody: &response.Pvz{
@@ -63,7 +63,6 @@ func TestPostPvz(t *testing.T) {
 			name: "invalid req",
 			req:  "invalid",
 			mockBehavior: func(req interface{}) {
-				authSrv.EXPECT().AuthMiddleware(entity.RoleModerator).Return(func(ctx *gin.Context) {})
 				// service.EXPECT().CreatePvz(gomock.Any(), req).Return(pvz, nil)
 			},
 			expCode: http.StatusBadRequest,
@@ -76,11 +75,23 @@ func TestPostPvz(t *testing.T) {
 				City:             "invalid",
 			},
 			mockBehavior: func(req interface{}) {
-				authSrv.EXPECT().AuthMiddleware(entity.RoleModerator).Return(func(ctx *gin.Context) {})
+				citySrv.EXPECT().IsCityEnabled(gomock.Any(), "invalid").Return(false, nil)
 				// service.EXPECT().CreatePvz(gomock.Any(), req).Return(pvz, nil)
 			},
 			expCode: http.StatusBadRequest,
 		},
+		{
+			name: "city lookup err",
+			req: &request.CreatePvz{
+				ID:               pvz.ID,
+				RegistrationDate: pvz.RegistrationDate,
+				City:             string(pvz.City),
+			},
+			mockBehavior: func(req interface{}) {
+				citySrv.EXPECT().IsCityEnabled(gomock.Any(), string(pvz.City)).Return(false, errMock)
+			},
+			expCode: http.StatusInternalServerError,
+		},
 		{
 			name: "create pvz err",
 			req: &request.CreatePvz{
@@ -89,7 +100,7 @@ func TestPostPvz(t *testing.T) {
 				City:             string(pvz.City),
 			},
 			mockBehavior: func(req interface{}) {
-				authSrv.EXPECT().AuthMiddleware(entity.RoleModerator).Return(func(ctx *gin.Context) {})
+				citySrv.EXPECT().IsCityEnabled(gomock.Any(), string(pvz.City)).Return(true, nil)
 				service.EXPECT().CreatePvz(gomock.Any(), req).Return(nil, errMock)
 			},
 			expCode: http.StatusInternalServerError,
@@ -100,7 +111,7 @@ func TestPostPvz(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			gin.SetMode(gin.TestMode)
 			rec := httptest.NewRecorder()
-			r := gin.New()
+			r := newTestRouter()
 
 			tc.mockBehavior(tc.req)
 
@@ -127,3 +138,138 @@ func TestPostPvz(t *testing.T) {
 		})
 	}
 }
+
+func TestPatchPvzPvzId(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockPvzService(ctrl)
+
+	handler := handler.NewHandler(nil, service, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	closed := *pvz
+	closed.Status = entity.PvzStatusTemporarilyClosed
+	testCases := []struct {
+		name         string
+		req          interface{}
+		mockBehavior func(req interface{})
+		expBody      interface{}
+		expCode      int
+	}{
+		{
+			name: "ok",
+			req:  &request.UpdatePvz{Status: string(entity.PvzStatusTemporarilyClosed), Reason: "renovation"},
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().UpdatePvzStatus(gomock.Any(), pvz.ID, req).Return(&closed, nil)
+			},
+			expBody: closed.ToResponse(),
+			expCode: http.StatusOK,
+		},
+		{
+			name: "invalid status",
+			req:  &request.UpdatePvz{Status: "invalid"},
+			mockBehavior: func(req interface{}) {
+			},
+			expCode: http.StatusBadRequest,
+		},
+		{
+			name: "no status",
+			req:  &request.UpdatePvz{Reason: "renovation"},
+			mockBehavior: func(req interface{}) {
+			},
+			expCode: http.StatusBadRequest,
+		},
+		{
+			name: "service err",
+			req:  &request.UpdatePvz{Status: string(entity.PvzStatusDecommissioned)},
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().UpdatePvzStatus(gomock.Any(), pvz.ID, req).Return(nil, errMock)
+			},
+			expCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(rec)
+
+			body, _ := json.Marshal(tc.req)
+			ctx.Request = httptest.NewRequest(http.MethodPatch, "/dummy", bytes.NewReader(body))
+			ctx.Request.Header.Set("Content-Type", "application/json")
+
+			tc.mockBehavior(tc.req)
+			handler.PatchPvzPvzId(ctx, pvz.ID)
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expCode == http.StatusOK {
+				expJSON, err := json.Marshal(tc.expBody)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expJSON), rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestPutPvzPvzIdCapacity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockPvzService(ctrl)
+
+	handler := handler.NewHandler(nil, service, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	capacity := 100
+	limited := *pvz
+	limited.Capacity = capacity
+	limited.StockCount = 42
+	testCases := []struct {
+		name         string
+		req          interface{}
+		mockBehavior func(req interface{})
+		expBody      interface{}
+		expCode      int
+	}{
+		{
+			name: "ok",
+			req:  &request.UpdatePvzCapacity{Capacity: &capacity},
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().UpdatePvzCapacity(gomock.Any(), pvz.ID, req).Return(&limited, nil)
+			},
+			expBody: limited.ToResponse(),
+			expCode: http.StatusOK,
+		},
+		{
+			name: "zero capacity",
+			req:  map[string]int{"capacity": 0},
+			mockBehavior: func(req interface{}) {
+			},
+			expCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(rec)
+
+			body, _ := json.Marshal(tc.req)
+			ctx.Request = httptest.NewRequest(http.MethodPut, "/dummy", bytes.NewReader(body))
+			ctx.Request.Header.Set("Content-Type", "application/json")
+
+			tc.mockBehavior(tc.req)
+			handler.PutPvzPvzIdCapacity(ctx, pvz.ID)
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expCode == http.StatusOK {
+				expJSON, err := json.Marshal(tc.expBody)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expJSON), rec.Body.String())
+			}
+		})
+	}
+}
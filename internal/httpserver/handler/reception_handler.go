@@ -4,6 +4,7 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"log"
 	"net/http"
 
@@ -13,27 +14,30 @@ import (
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
 	"github.com/myacey/avito-backend-assignment-pvz/pkg/openapi"
 )
 
 type ReceptionService interface {
 	SearchReceptions(context.Context, *request.SearchPvz) ([]*entity.PvzWithReception, error)
-	FinishReception(context.Context, uuid.UUID) (*entity.Reception, error)
+	GetPvzDetails(context.Context, uuid.UUID) (*entity.PvzDetails, error)
+	FinishReception(context.Context, *request.FinishReception) (*entity.ClosedReception, error)
 	DeleteLastProduct(context.Context, uuid.UUID) error
 	CreateReception(context.Context, *request.CreateReception) (*entity.Reception, error)
 	AddProductToReception(context.Context, *request.AddProduct) (*entity.Product, error)
+	SearchProductsByBarcode(context.Context, string) ([]*entity.Product, error)
+	AddProductsBatch(context.Context, *request.AddProductsBatch) (*entity.ProductsBatch, error)
+	GetReception(context.Context, uuid.UUID) (*entity.ReceptionDetails, error)
+	ListPvzReceptions(context.Context, *request.ListReceptions) ([]*entity.ReceptionSummary, error)
+	ReopenReception(context.Context, uuid.UUID, *request.ChangeReceptionStatus) (*entity.Reception, error)
+	CancelReception(context.Context, uuid.UUID, *request.ChangeReceptionStatus) (*entity.Reception, error)
 }
 
 // GetPvz returns PVZ with receptions by page-limit and startDate-endDate.
 func (h Handler) GetPvz(ctx *gin.Context, params openapi.GetPvzParams) {
 	log.SetPrefix("http-server.handler.SearchPvz")
 
-	h.authSrv.AuthMiddleware(entity.RoleEmployee, entity.RoleModerator)(ctx)
-	if ctx.IsAborted() {
-		return
-	}
-
 	startDate := *params.StartDate
 	endDate := *params.EndDate
 
@@ -51,30 +55,130 @@ func (h Handler) GetPvz(ctx *gin.Context, params openapi.GetPvzParams) {
 		Page:      page,
 		Limit:     limit,
 	}
+	if params.Status != nil {
+		status := string(*params.Status)
+		if _, ok := entity.PvzStatuses[entity.PvzStatus(status)]; !ok {
+			wrapCtxWithError(ctx, apperror.NewBadReq("invalid status: "+status))
+			return
+		}
+		req.Status = &status
+	}
+
+	if p, ok := principal.FromContext(ctx); ok {
+		req.PvzIDs = p.PvzScope()
+	}
 
 	pvzWithReceptions, err := h.receptionSrv.SearchReceptions(ctx, req)
 	if err != nil {
 		wrapCtxWithError(ctx, err)
 		return
 	}
-	resp := make([]*response.PvzWithReception, len(pvzWithReceptions))
-	for i, v := range pvzWithReceptions {
-		resp[i] = v.ToResponse()
+
+	resp := make([]*response.PvzWithReception, 0, len(pvzWithReceptions))
+	for _, v := range pvzWithReceptions {
+		resp = append(resp, v.ToResponse())
 	}
 
 	ctx.JSON(http.StatusOK, resp)
 }
 
-// PostPvzPvzIdCloseLastReception ends reception.
-func (h Handler) PostPvzPvzIdCloseLastReception(ctx *gin.Context, pvzID uuid.UUID) {
-	log.SetPrefix("http-server.handler.CloseLastReception")
+const defaultReceptionsLimit = 50
+
+// GetPvzPvzIdReceptions returns page of PVZ receptions, newest first.
+func (h Handler) GetPvzPvzIdReceptions(ctx *gin.Context, pvzID uuid.UUID, params openapi.GetPvzPvzIdReceptionsParams) {
+	log.SetPrefix("http-server.handler.ListPvzReceptions")
+
+	if !checkPvzScope(ctx, pvzID) {
+		return
+	}
+
+	req := &request.ListReceptions{
+		PvzID: pvzID,
+		From:  params.From,
+		To:    params.To,
+		Limit: defaultReceptionsLimit,
+	}
+	if params.Status != nil {
+		status := string(*params.Status)
+		req.Status = &status
+	}
+	if params.Limit != nil {
+		req.Limit = *params.Limit
+	}
+	if params.Cursor != nil {
+		cursor, err := request.DecodeReceptionCursor(*params.Cursor)
+		if err != nil {
+			wrapCtxWithError(ctx, apperror.NewBadReq("invalid cursor"))
+			return
+		}
+		req.Cursor = cursor
+	}
+
+	receptions, err := h.receptionSrv.ListPvzReceptions(ctx, req)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	resp := &response.ReceptionPage{
+		Items: make([]*response.ReceptionSummary, len(receptions)),
+	}
+	for i, r := range receptions {
+		resp.Items[i] = r.ToResponse()
+	}
+	if len(receptions) == req.Limit {
+		last := receptions[len(receptions)-1].Reception
+		resp.NextCursor = (&request.ReceptionCursor{DateTime: last.DateTime, ID: last.ID}).Encode()
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetReceptionsReceptionId returns reception with its products.
+func (h Handler) GetReceptionsReceptionId(ctx *gin.Context, receptionID uuid.UUID) {
+	log.SetPrefix("http-server.handler.GetReception")
 
-	h.authSrv.AuthMiddleware(entity.RoleEmployee)(ctx)
-	if ctx.IsAborted() {
+	details, err := h.receptionSrv.GetReception(ctx, receptionID)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, details.ToResponse())
+}
+
+// PostReceptionsReceptionIdReopen moves closed reception
+// back to in_progress with moderator auth.
+func (h Handler) PostReceptionsReceptionIdReopen(ctx *gin.Context, receptionID uuid.UUID) {
+	log.SetPrefix("http-server.handler.ReopenReception")
+
+	var req request.ChangeReceptionStatus
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		wrapCtxWithError(ctx, apperror.NewBadReq("invalid req: "+err.Error()))
+		return
+	}
+
+	reception, err := h.receptionSrv.ReopenReception(ctx, receptionID, &req)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, reception.ToResponse())
+}
+
+// PostReceptionsReceptionIdCancel cancels reception
+// with moderator auth.
+func (h Handler) PostReceptionsReceptionIdCancel(ctx *gin.Context, receptionID uuid.UUID) {
+	log.SetPrefix("http-server.handler.CancelReception")
+
+	var req request.ChangeReceptionStatus
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		wrapCtxWithError(ctx, apperror.NewBadReq("invalid req: "+err.Error()))
 		return
 	}
 
-	reception, err := h.receptionSrv.FinishReception(ctx, pvzID)
+	reception, err := h.receptionSrv.CancelReception(ctx, receptionID, &req)
 	if err != nil {
 		wrapCtxWithError(ctx, err)
 		return
@@ -83,12 +187,62 @@ func (h Handler) PostPvzPvzIdCloseLastReception(ctx *gin.Context, pvzID uuid.UUI
 	ctx.JSON(http.StatusOK, reception.ToResponse())
 }
 
+// GetPvzPvzId returns PVZ with its current state.
+func (h Handler) GetPvzPvzId(ctx *gin.Context, pvzID uuid.UUID) {
+	log.SetPrefix("http-server.handler.GetPvz")
+
+	if !checkPvzScope(ctx, pvzID) {
+		return
+	}
+
+	details, err := h.receptionSrv.GetPvzDetails(ctx, pvzID)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, details.ToResponse())
+}
+
+// PostPvzPvzIdCloseLastReception ends reception. Only moderator
+// can close reception which doesn't match its manifest.
+func (h Handler) PostPvzPvzIdCloseLastReception(ctx *gin.Context, pvzID uuid.UUID, params openapi.PostPvzPvzIdCloseLastReceptionParams) {
+	log.SetPrefix("http-server.handler.CloseLastReception")
+
+	req := &request.FinishReception{PvzID: pvzID}
+	if params.Override != nil {
+		req.Override = *params.Override
+	}
+
+	if !checkPvzScope(ctx, pvzID) {
+		return
+	}
+
+	closed, err := h.receptionSrv.FinishReception(ctx, req)
+	if err != nil {
+		var recErr *entity.ReconciliationError
+		if errors.As(err, &recErr) {
+			ctx.JSON(http.StatusConflict, response.ReconciliationConflict{
+				Message:        recErr.Error(),
+				RequestID:      ctx.GetHeader(HeaderRequestID),
+				Code:           http.StatusConflict,
+				Reconciliation: recErr.Reconciliation.ToResponse(),
+			})
+			return
+		}
+
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, closed.ToResponse())
+}
+
 // PostPvzPvzIdDeleteLastProduct deletes last product in reception.
 func (h Handler) PostPvzPvzIdDeleteLastProduct(ctx *gin.Context, pvzID uuid.UUID) {
 	log.SetPrefix("http-server.handler.DeleteLastProduct")
 
-	h.authSrv.AuthMiddleware(entity.RoleEmployee)(ctx)
-	if ctx.IsAborted() {
+	if !checkPvzScope(ctx, pvzID) {
 		return
 	}
 
@@ -105,17 +259,16 @@ func (h Handler) PostPvzPvzIdDeleteLastProduct(ctx *gin.Context, pvzID uuid.UUID
 func (h Handler) PostReceptions(ctx *gin.Context) {
 	log.SetPrefix("http-server.handler.CreateReception")
 
-	h.authSrv.AuthMiddleware(entity.RoleEmployee)(ctx)
-	if ctx.IsAborted() {
-		return
-	}
-
 	var req request.CreateReception
 	if err := ctx.ShouldBindJSON(&req); err != nil {
 		wrapCtxWithError(ctx, apperror.NewBadReq("invalid req: "+err.Error()))
 		return
 	}
 
+	if !checkPvzScope(ctx, req.PvzID) {
+		return
+	}
+
 	reception, err := h.receptionSrv.CreateReception(ctx, &req)
 	if err != nil {
 		wrapCtxWithError(ctx, err)
@@ -129,27 +282,94 @@ func (h Handler) PostReceptions(ctx *gin.Context) {
 func (h Handler) PostProducts(ctx *gin.Context) {
 	log.SetPrefix("http-server.handler.PostProducts")
 
-	h.authSrv.AuthMiddleware(entity.RoleEmployee)(ctx)
-	if ctx.IsAborted() {
-		return
-	}
-
 	var req request.AddProduct
 	if err := ctx.ShouldBindJSON(&req); err != nil {
 		wrapCtxWithError(ctx, apperror.NewBadReq("invalid req: "+err.Error()))
 		return
 	}
 
-	if _, ok := entity.ProductTypes[entity.ProductType(req.Type)]; !ok {
-		wrapCtxWithError(ctx, apperror.NewBadReq("invalid product type: "+req.Type))
+	if !checkPvzScope(ctx, req.PvzID) {
 		return
 	}
 
 	product, err := h.receptionSrv.AddProductToReception(ctx, &req)
 	if err != nil {
+		var dup *entity.DuplicateProductError
+		if errors.As(err, &dup) {
+			ctx.JSON(http.StatusConflict, response.DuplicateProduct{
+				Message:   dup.Error(),
+				RequestID: ctx.GetHeader(HeaderRequestID),
+				Code:      http.StatusConflict,
+				Product:   dup.Existing.ToResponse(),
+			})
+			return
+		}
+
 		wrapCtxWithError(ctx, err)
 		return
 	}
 
 	ctx.JSON(http.StatusCreated, product.ToResponse())
 }
+
+// PostProductsBatch adds products to last reception at once.
+// If batch is rejected, response tells what is wrong with each product.
+func (h Handler) PostProductsBatch(ctx *gin.Context) {
+	log.SetPrefix("http-server.handler.PostProductsBatch")
+
+	var req request.AddProductsBatch
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		wrapCtxWithError(ctx, apperror.NewBadReq("invalid req: "+err.Error()))
+		return
+	}
+
+	if !checkPvzScope(ctx, req.PvzID) {
+		return
+	}
+
+	batch, err := h.receptionSrv.AddProductsBatch(ctx, &req)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.JSON(batchStatus(batch), batch.ToResponse())
+}
+
+// batchStatus is 201 for accepted batch, 409 if batch is
+// rejected only because of duplicates and 400 otherwise.
+func batchStatus(batch *entity.ProductsBatch) int {
+	if batch.Accepted() {
+		return http.StatusCreated
+	}
+
+	for _, r := range batch.Results {
+		var dup *entity.DuplicateProductError
+		if r.Err != nil && !errors.As(r.Err, &dup) {
+			return http.StatusBadRequest
+		}
+	}
+	return http.StatusConflict
+}
+
+// GetProducts looks products up by barcode.
+func (h Handler) GetProducts(ctx *gin.Context, params openapi.GetProductsParams) {
+	log.SetPrefix("http-server.handler.SearchProducts")
+
+	if params.Barcode == "" {
+		wrapCtxWithError(ctx, apperror.NewBadReq("barcode is required"))
+		return
+	}
+
+	products, err := h.receptionSrv.SearchProductsByBarcode(ctx, params.Barcode)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+	resp := make([]*response.Product, len(products))
+	for i, v := range products {
+		resp[i] = v.ToResponse()
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
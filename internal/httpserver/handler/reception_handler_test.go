@@ -19,12 +19,14 @@ import (
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
 	"github.com/myacey/avito-backend-assignment-pvz/pkg/openapi"
 )
 
 var (
 	reception = &entity.Reception{ID: uuid.New(), DateTime: time.Date(2022, 12, 12, 12, 12, 0, 0, time.UTC), PvzID: pvz.ID, Status: entity.StatusInProgress}
-	product   = &entity.Product{ID: uuid.New(), DateTime: reception.DateTime, Type: entity.ProductTypeClothes, ReceptionID: reception.ID}
+	product   = &entity.Product{ID: uuid.New(), DateTime: reception.DateTime, Type: entity.ProductTypeClothes, ReceptionID: reception.ID, Barcode: "4601234567890"}
 
 	start = time.Now().AddDate(0, 0, -2)
 	end   = time.Now()
@@ -36,9 +38,8 @@ func TestPostProducts(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	service := mocks.NewMockReceptionService(ctrl)
-	authSrv := mocks.NewMockRoleCheckerMiddleware(ctrl)
 
-	handler := handler.NewHandler(service, nil, nil, authSrv)
+	handler := handler.NewHandler(service, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	testCases := []struct {
 		name         string
 		req          interface{}
@@ -49,11 +50,11 @@ func TestPostProducts(t *testing.T) {
 		{
 			name: "ok",
 			req: &request.AddProduct{
-				Type:  string(product.Type),
-				PvzID: pvz.ID,
+				Type:    string(product.Type),
+				PvzID:   pvz.ID,
+				Barcode: product.Barcode,
 			},
 			mockBehavior: func(req interface{}) {
-				authSrv.EXPECT().AuthMiddleware(entity.RoleEmployee).Return(func(ctx *gin.Context) {})
 				service.EXPECT().AddProductToReception(gomock.Any(), req).Return(product, nil)
 			},
 			expBody: product.ToResponse(),
@@ -63,7 +64,6 @@ func TestPostProducts(t *testing.T) {
 			name: "invalid req",
 			req:  "invalid",
 			mockBehavior: func(req interface{}) {
-				authSrv.EXPECT().AuthMiddleware(entity.RoleEmployee).Return(func(ctx *gin.Context) {})
 				// service.EXPECT().AddProductToReception(gomock.Any(), req).Return(product, nil)
 			},
 			expCode: http.StatusBadRequest,
@@ -71,35 +71,63 @@ func TestPostProducts(t *testing.T) {
 		{
 			name: "invalid product type",
 			req: &request.AddProduct{
-				Type:  "invalid",
-				PvzID: pvz.ID,
+				Type:    "invalid",
+				PvzID:   pvz.ID,
+				Barcode: product.Barcode,
 			},
 			mockBehavior: func(req interface{}) {
-				authSrv.EXPECT().AuthMiddleware(entity.RoleEmployee).Return(func(ctx *gin.Context) {})
-				// service.EXPECT().AddProductToReception(gomock.Any(), req).Return(product, nil)
+				service.EXPECT().AddProductToReception(gomock.Any(), req).Return(nil, apperror.NewBadReq("invalid product type: invalid"))
 			},
-			// expBody: product.ToResponse(),
 			expCode: http.StatusBadRequest,
 		},
 		{
 			name: "service err",
 			req: &request.AddProduct{
-				Type:  string(product.Type),
-				PvzID: pvz.ID,
+				Type:    string(product.Type),
+				PvzID:   pvz.ID,
+				Barcode: product.Barcode,
 			},
 			mockBehavior: func(req interface{}) {
-				authSrv.EXPECT().AuthMiddleware(entity.RoleEmployee).Return(func(ctx *gin.Context) {})
 				service.EXPECT().AddProductToReception(gomock.Any(), req).Return(nil, errMock)
 			},
 			expCode: http.StatusInternalServerError,
 		},
+		{
+			name: "ok no barcode",
+			req: &request.AddProduct{
+				Type:  string(product.Type),
+				PvzID: pvz.ID,
+			},
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().AddProductToReception(gomock.Any(), req).Return(product, nil)
+			},
+			expBody: product.ToResponse(),
+			expCode: http.StatusCreated,
+		},
+		{
+			name: "duplicate barcode",
+			req: &request.AddProduct{
+				Type:    string(product.Type),
+				PvzID:   pvz.ID,
+				Barcode: product.Barcode,
+			},
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().AddProductToReception(gomock.Any(), req).Return(nil, &entity.DuplicateProductError{Existing: product})
+			},
+			expBody: &response.DuplicateProduct{
+				Message: "product with barcode " + product.Barcode + " already accepted",
+				Code:    http.StatusConflict,
+				Product: product.ToResponse(),
+			},
+			expCode: http.StatusConflict,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			gin.SetMode(gin.TestMode)
 			rec := httptest.NewRecorder()
-			r := gin.New()
+			r := newTestRouter()
 
 			tc.mockBehavior(tc.req)
 
@@ -121,6 +149,197 @@ func TestPostProducts(t *testing.T) {
 				exp := tc.expBody.(*response.Product)
 				require.Equal(t, *exp, resp)
 			}
+
+			if tc.expCode == http.StatusConflict {
+				expJSON, err := json.Marshal(tc.expBody)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expJSON), rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestPostProductsBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockReceptionService(ctrl)
+
+	handler := handler.NewHandler(service, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	validReq := &request.AddProductsBatch{
+		PvzID:    pvz.ID,
+		Products: []request.BatchProduct{{Type: string(product.Type), Barcode: product.Barcode}, {Type: "unknown", Barcode: "2"}},
+	}
+	testCases := []struct {
+		name         string
+		req          interface{}
+		mockBehavior func(req interface{})
+		expBody      interface{}
+		expCode      int
+	}{
+		{
+			name: "ok",
+			req:  validReq,
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().AddProductsBatch(gomock.Any(), req).Return(&entity.ProductsBatch{
+					Results: []*entity.BatchProductResult{{Product: product}, {Product: product}},
+				}, nil)
+			},
+			expBody: &response.BatchProducts{
+				Accepted: true,
+				Results: []*response.BatchProductResult{
+					{Status: entity.BatchProductCreated, Product: product.ToResponse()},
+					{Status: entity.BatchProductCreated, Product: product.ToResponse()},
+				},
+			},
+			expCode: http.StatusCreated,
+		},
+		{
+			name: "invalid product",
+			req:  validReq,
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().AddProductsBatch(gomock.Any(), req).Return(&entity.ProductsBatch{
+					Results: []*entity.BatchProductResult{
+						{Err: &entity.DuplicateProductError{Existing: product}},
+						{Err: apperror.NewBadReq("invalid product type: unknown")},
+					},
+				}, nil)
+			},
+			expBody: &response.BatchProducts{
+				Results: []*response.BatchProductResult{
+					{Status: entity.BatchProductDuplicate, Error: "product with barcode 4601234567890 already accepted", Existing: product.ToResponse()},
+					{Status: entity.BatchProductInvalid, Error: "invalid product type: unknown"},
+				},
+			},
+			expCode: http.StatusBadRequest,
+		},
+		{
+			name: "duplicates only",
+			req:  validReq,
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().AddProductsBatch(gomock.Any(), req).Return(&entity.ProductsBatch{
+					Results: []*entity.BatchProductResult{{Err: &entity.DuplicateProductError{Existing: product}}, {}},
+				}, nil)
+			},
+			expBody: &response.BatchProducts{
+				Results: []*response.BatchProductResult{
+					{Status: entity.BatchProductDuplicate, Error: "product with barcode 4601234567890 already accepted", Existing: product.ToResponse()},
+					{Status: entity.BatchProductSkipped},
+				},
+			},
+			expCode: http.StatusConflict,
+		},
+		{
+			name: "no products",
+			req:  &request.AddProductsBatch{PvzID: pvz.ID},
+			mockBehavior: func(req interface{}) {
+			},
+			expCode: http.StatusBadRequest,
+		},
+		{
+			name: "no pvz access",
+			req:  validReq,
+			mockBehavior: func(req interface{}) {
+				caller = &principal.Principal{APIKeyID: uuid.New(), PvzIDs: []uuid.UUID{uuid.New()}}
+			},
+			expCode: http.StatusForbidden,
+		},
+		{
+			name: "service err",
+			req:  validReq,
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().AddProductsBatch(gomock.Any(), req).Return(nil, apperror.NewBadReq("no in-progress reception found"))
+			},
+			expCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			r := newTestRouter()
+
+			tc.mockBehavior(tc.req)
+
+			r.POST("/products/batch", handler.PostProductsBatch)
+
+			body, _ := json.Marshal(tc.req)
+			req := httptest.NewRequest(http.MethodPost, "/products/batch", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+
+			r.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expBody != nil {
+				expJSON, err := json.Marshal(tc.expBody)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expJSON), rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestGetProducts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockReceptionService(ctrl)
+
+	handler := handler.NewHandler(service, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	testCases := []struct {
+		name         string
+		params       openapi.GetProductsParams
+		mockBehavior func(params openapi.GetProductsParams)
+		expBody      interface{}
+		expCode      int
+	}{
+		{
+			name:   "ok",
+			params: openapi.GetProductsParams{Barcode: product.Barcode},
+			mockBehavior: func(params openapi.GetProductsParams) {
+				service.EXPECT().SearchProductsByBarcode(gomock.Any(), params.Barcode).Return([]*entity.Product{product}, nil)
+			},
+			expBody: []*response.Product{product.ToResponse()},
+			expCode: http.StatusOK,
+		},
+		{
+			name:   "empty barcode",
+			params: openapi.GetProductsParams{},
+			mockBehavior: func(params openapi.GetProductsParams) {
+			},
+			expCode: http.StatusBadRequest,
+		},
+		{
+			name:   "service err",
+			params: openapi.GetProductsParams{Barcode: product.Barcode},
+			mockBehavior: func(params openapi.GetProductsParams) {
+				service.EXPECT().SearchProductsByBarcode(gomock.Any(), params.Barcode).Return(nil, errMock)
+			},
+			expCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(rec)
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/dummy", nil)
+
+			tc.mockBehavior(tc.params)
+			handler.GetProducts(ctx, tc.params)
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expCode == http.StatusOK {
+				expJSON, err := json.Marshal(tc.expBody)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expJSON), rec.Body.String())
+			}
 		})
 	}
 }
@@ -129,9 +348,8 @@ func TestPostPvzPvzIdDeleteLastProduct(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	service := mocks.NewMockReceptionService(ctrl)
-	authSrv := mocks.NewMockRoleCheckerMiddleware(ctrl)
 
-	handler := handler.NewHandler(service, nil, nil, authSrv)
+	handler := handler.NewHandler(service, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	testCases := []struct {
 		name         string
 		pvzID        uuid.UUID
@@ -143,7 +361,6 @@ func TestPostPvzPvzIdDeleteLastProduct(t *testing.T) {
 			name:  "ok",
 			pvzID: pvz.ID,
 			mockBehavior: func(pvzID uuid.UUID) {
-				authSrv.EXPECT().AuthMiddleware(entity.RoleEmployee).Return(func(ctx *gin.Context) {})
 				service.EXPECT().DeleteLastProduct(gomock.Any(), pvzID).Return(nil)
 			},
 			expBody: "",
@@ -153,7 +370,6 @@ func TestPostPvzPvzIdDeleteLastProduct(t *testing.T) {
 			name:  "service err",
 			pvzID: pvz.ID,
 			mockBehavior: func(pvzID uuid.UUID) {
-				authSrv.EXPECT().AuthMiddleware(entity.RoleEmployee).Return(func(ctx *gin.Context) {})
 				service.EXPECT().DeleteLastProduct(gomock.Any(), pvzID).Return(errMock)
 			},
 			expCode: http.StatusInternalServerError,
@@ -183,9 +399,94 @@ func TestPostPvzPvzIdCloseLastReception(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	service := mocks.NewMockReceptionService(ctrl)
-	authSrv := mocks.NewMockRoleCheckerMiddleware(ctrl)
 
-	handler := handler.NewHandler(service, nil, nil, authSrv)
+	handler := handler.NewHandler(service, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	override := true
+	closed := &entity.ClosedReception{Reception: reception}
+	rec := &entity.Reconciliation{Discrepancies: []*entity.Discrepancy{{Kind: entity.DiscrepancyMissing, Barcode: "001", Expected: 1}}}
+	testCases := []struct {
+		name         string
+		params       openapi.PostPvzPvzIdCloseLastReceptionParams
+		mockBehavior func()
+		expBody      interface{}
+		expCode      int
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				service.EXPECT().FinishReception(gomock.Any(), &request.FinishReception{PvzID: pvz.ID}).Return(closed, nil)
+			},
+			expBody: closed.ToResponse(),
+			expCode: http.StatusOK,
+		},
+		{
+			name:   "override needs manage permission",
+			params: openapi.PostPvzPvzIdCloseLastReceptionParams{Override: &override},
+			mockBehavior: func() {
+				service.EXPECT().FinishReception(gomock.Any(), &request.FinishReception{PvzID: pvz.ID, Override: true}).
+					Return(&entity.ClosedReception{Reception: reception, Reconciliation: rec}, nil)
+			},
+			expBody: (&entity.ClosedReception{Reception: reception, Reconciliation: rec}).ToResponse(),
+			expCode: http.StatusOK,
+		},
+		{
+			name: "discrepancies",
+			mockBehavior: func() {
+				service.EXPECT().FinishReception(gomock.Any(), gomock.Any()).Return(nil, &entity.ReconciliationError{Reconciliation: rec})
+			},
+			expBody: response.ReconciliationConflict{
+				Message:        "reception has discrepancies with manifest",
+				Code:           http.StatusConflict,
+				Reconciliation: rec.ToResponse(),
+			},
+			expCode: http.StatusConflict,
+		},
+		{
+			name: "service err",
+			mockBehavior: func() {
+				service.EXPECT().FinishReception(gomock.Any(), gomock.Any()).Return(nil, errMock)
+			},
+			expCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(rec)
+			ctx.Request = httptest.NewRequest(http.MethodPost, "/dummy", nil)
+
+			tc.mockBehavior()
+			handler.PostPvzPvzIdCloseLastReception(ctx, pvz.ID, tc.params)
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expBody != nil {
+				expJSON, err := json.Marshal(tc.expBody)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expJSON), rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestGetPvzPvzId(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockReceptionService(ctrl)
+
+	handler := handler.NewHandler(service, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	details := &entity.PvzDetails{
+		Pvz:                   pvz,
+		OpenReception:         reception,
+		OpenReceptionProducts: []*entity.Product{product},
+		ReceptionsToday:       1,
+		ProductsToday:         1,
+	}
 	testCases := []struct {
 		name         string
 		pvzID        uuid.UUID
@@ -197,20 +498,26 @@ func TestPostPvzPvzIdCloseLastReception(t *testing.T) {
 			name:  "ok",
 			pvzID: pvz.ID,
 			mockBehavior: func(pvzID uuid.UUID) {
-				authSrv.EXPECT().AuthMiddleware(entity.RoleEmployee).Return(func(ctx *gin.Context) {})
-				service.EXPECT().FinishReception(gomock.Any(), pvzID).Return(reception, nil)
+				service.EXPECT().GetPvzDetails(gomock.Any(), pvzID).Return(details, nil)
 			},
-			expBody: reception.ToResponse(),
+			expBody: details.ToResponse(),
 			expCode: http.StatusOK,
 		},
 		{
-			name:  "service err",
+			name:  "pvz out of key scope",
 			pvzID: pvz.ID,
 			mockBehavior: func(pvzID uuid.UUID) {
-				authSrv.EXPECT().AuthMiddleware(entity.RoleEmployee).Return(func(ctx *gin.Context) {})
-				service.EXPECT().FinishReception(gomock.Any(), pvzID).Return(nil, errMock)
+				caller = &principal.Principal{APIKeyID: uuid.New(), PvzIDs: []uuid.UUID{uuid.New()}}
 			},
-			expCode: http.StatusInternalServerError,
+			expCode: http.StatusForbidden,
+		},
+		{
+			name:  "not found",
+			pvzID: pvz.ID,
+			mockBehavior: func(pvzID uuid.UUID) {
+				service.EXPECT().GetPvzDetails(gomock.Any(), pvzID).Return(nil, apperror.NewNotFound("pvz not found"))
+			},
+			expCode: http.StatusNotFound,
 		},
 	}
 
@@ -219,10 +526,11 @@ func TestPostPvzPvzIdCloseLastReception(t *testing.T) {
 			gin.SetMode(gin.TestMode)
 			rec := httptest.NewRecorder()
 			ctx, _ := gin.CreateTestContext(rec)
-			ctx.Request = httptest.NewRequest(http.MethodPost, "/dummy", nil)
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/dummy", nil)
 
 			tc.mockBehavior(tc.pvzID)
-			handler.PostPvzPvzIdCloseLastReception(ctx, tc.pvzID)
+			setCaller(ctx)
+			handler.GetPvzPvzId(ctx, tc.pvzID)
 
 			require.Equal(t, tc.expCode, rec.Code)
 
@@ -240,11 +548,12 @@ func TestGetPvz(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	service := mocks.NewMockReceptionService(ctrl)
-	authSrv := mocks.NewMockRoleCheckerMiddleware(ctrl)
 
-	handler := handler.NewHandler(service, nil, nil, authSrv)
+	handler := handler.NewHandler(service, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 	receptionResp := reception.ToResponse()
+	activeStatus := openapi.Active
+	invalidStatus := openapi.PVZStatus("invalid")
 	testCases := []struct {
 		name         string
 		params       openapi.GetPvzParams
@@ -261,7 +570,6 @@ func TestGetPvz(t *testing.T) {
 				Limit:     &limit,
 			},
 			mockBehavior: func(req openapi.GetPvzParams) {
-				authSrv.EXPECT().AuthMiddleware(entity.RoleEmployee).Return(func(ctx *gin.Context) {})
 				service.EXPECT().SearchReceptions(gomock.Any(), &request.SearchPvz{
 					StartDate: *req.StartDate,
 					EndDate:   *req.EndDate,
@@ -276,6 +584,31 @@ func TestGetPvz(t *testing.T) {
 			},
 			expCode: http.StatusOK,
 		},
+		{
+			name: "only assigned pvz",
+			params: openapi.GetPvzParams{
+				StartDate: &start,
+				EndDate:   &end,
+				Page:      &page,
+				Limit:     &limit,
+			},
+			mockBehavior: func(req openapi.GetPvzParams) {
+				caller = &principal.Principal{Role: entity.RoleEmployee, PvzIDs: []uuid.UUID{pvz.ID}}
+				service.EXPECT().SearchReceptions(gomock.Any(), &request.SearchPvz{
+					StartDate: *req.StartDate,
+					EndDate:   *req.EndDate,
+					Page:      *req.Page,
+					Limit:     *req.Limit,
+					PvzIDs:    []uuid.UUID{pvz.ID},
+				}).Return([]*entity.PvzWithReception{
+					{Pvz: pvz, Receptions: []*entity.Reception{reception}},
+				}, nil)
+			},
+			expBody: []*response.PvzWithReception{
+				{Pvz: pvz.ToResponse(), Receptions: []*response.Reception{receptionResp}},
+			},
+			expCode: http.StatusOK,
+		},
 		{
 			name: "service err",
 			params: openapi.GetPvzParams{
@@ -285,7 +618,6 @@ func TestGetPvz(t *testing.T) {
 				Limit:     &limit,
 			},
 			mockBehavior: func(req openapi.GetPvzParams) {
-				authSrv.EXPECT().AuthMiddleware(entity.RoleEmployee).Return(func(ctx *gin.Context) {})
 				service.EXPECT().SearchReceptions(gomock.Any(), &request.SearchPvz{
 					StartDate: *req.StartDate,
 					EndDate:   *req.EndDate,
@@ -295,6 +627,37 @@ func TestGetPvz(t *testing.T) {
 			},
 			expCode: http.StatusInternalServerError,
 		},
+		{
+			name: "filter by status",
+			params: openapi.GetPvzParams{
+				StartDate: &start,
+				EndDate:   &end,
+				Status:    &activeStatus,
+			},
+			mockBehavior: func(req openapi.GetPvzParams) {
+				status := string(*req.Status)
+				service.EXPECT().SearchReceptions(gomock.Any(), &request.SearchPvz{
+					StartDate: *req.StartDate,
+					EndDate:   *req.EndDate,
+					Page:      1,
+					Limit:     10,
+					Status:    &status,
+				}).Return([]*entity.PvzWithReception{}, nil)
+			},
+			expBody: []*response.PvzWithReception{},
+			expCode: http.StatusOK,
+		},
+		{
+			name: "invalid status",
+			params: openapi.GetPvzParams{
+				StartDate: &start,
+				EndDate:   &end,
+				Status:    &invalidStatus,
+			},
+			mockBehavior: func(req openapi.GetPvzParams) {
+			},
+			expCode: http.StatusBadRequest,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -305,6 +668,7 @@ func TestGetPvz(t *testing.T) {
 			ctx.Request = httptest.NewRequest(http.MethodPost, "/dummy", nil)
 
 			tc.mockBehavior(tc.params)
+			setCaller(ctx)
 			handler.GetPvz(ctx, tc.params)
 
 			require.Equal(t, tc.expCode, rec.Code)
@@ -323,9 +687,8 @@ func TestPostReceptions(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	service := mocks.NewMockReceptionService(ctrl)
-	authSrv := mocks.NewMockRoleCheckerMiddleware(ctrl)
 
-	handler := handler.NewHandler(service, nil, nil, authSrv)
+	handler := handler.NewHandler(service, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	testCases := []struct {
 		name         string
 		req          interface{}
@@ -339,7 +702,6 @@ func TestPostReceptions(t *testing.T) {
 				PvzID: pvz.ID,
 			},
 			mockBehavior: func(req interface{}) {
-				authSrv.EXPECT().AuthMiddleware(entity.RoleEmployee).Return(func(ctx *gin.Context) {})
 				service.EXPECT().CreateReception(gomock.Any(), req).Return(reception, nil)
 			},
 			expBody: reception.ToResponse(),
@@ -349,18 +711,26 @@ func TestPostReceptions(t *testing.T) {
 			name: "invalid req",
 			req:  "invalid",
 			mockBehavior: func(req interface{}) {
-				authSrv.EXPECT().AuthMiddleware(entity.RoleEmployee).Return(func(ctx *gin.Context) {})
 				// service.EXPECT().CreateReception(gomock.Any(), req).Return(reception, nil)
 			},
 			expCode: http.StatusBadRequest,
 		},
+		{
+			name: "pvz out of key scope",
+			req: &request.CreateReception{
+				PvzID: pvz.ID,
+			},
+			mockBehavior: func(req interface{}) {
+				caller = &principal.Principal{APIKeyID: uuid.New(), PvzIDs: []uuid.UUID{uuid.New()}}
+			},
+			expCode: http.StatusForbidden,
+		},
 		{
 			name: "service err",
 			req: &request.CreateReception{
 				PvzID: pvz.ID,
 			},
 			mockBehavior: func(req interface{}) {
-				authSrv.EXPECT().AuthMiddleware(entity.RoleEmployee).Return(func(ctx *gin.Context) {})
 				service.EXPECT().CreateReception(gomock.Any(), req).Return(nil, errMock)
 			},
 			expCode: http.StatusInternalServerError,
@@ -379,6 +749,7 @@ func TestPostReceptions(t *testing.T) {
 			ctx.Request.Header.Set("Content-Type", "application/json")
 
 			tc.mockBehavior(tc.req)
+			setCaller(ctx)
 			handler.PostReceptions(ctx)
 
 			require.Equal(t, tc.expCode, rec.Code)
@@ -392,3 +763,286 @@ func TestPostReceptions(t *testing.T) {
 		})
 	}
 }
+
+func TestGetReceptionsReceptionId(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockReceptionService(ctrl)
+
+	handler := handler.NewHandler(service, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	details := &entity.ReceptionDetails{Reception: reception, Products: []*entity.Product{product}}
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expBody      interface{}
+		expCode      int
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				service.EXPECT().GetReception(gomock.Any(), reception.ID).Return(details, nil)
+			},
+			expBody: details.ToResponse(),
+			expCode: http.StatusOK,
+		},
+		{
+			name: "pvz out of key scope",
+			mockBehavior: func() {
+				service.EXPECT().GetReception(gomock.Any(), reception.ID).Return(nil, apperror.NewForbidden("no access to pvz"))
+			},
+			expCode: http.StatusForbidden,
+		},
+		{
+			name: "not found",
+			mockBehavior: func() {
+				service.EXPECT().GetReception(gomock.Any(), reception.ID).Return(nil, apperror.NewNotFound("reception not found"))
+			},
+			expCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(rec)
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/dummy", nil)
+
+			tc.mockBehavior()
+			handler.GetReceptionsReceptionId(ctx, reception.ID)
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expCode == http.StatusOK {
+				expJSON, err := json.Marshal(tc.expBody)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expJSON), rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestGetPvzPvzIdReceptions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockReceptionService(ctrl)
+
+	handler := handler.NewHandler(service, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	summary := &entity.ReceptionSummary{
+		Reception:     reception,
+		ProductCounts: map[entity.ProductType]int64{entity.ProductTypeClothes: 1},
+	}
+	cursor := &request.ReceptionCursor{DateTime: reception.DateTime, ID: reception.ID}
+	encoded := cursor.Encode()
+	invalidCursor := "not a cursor"
+	one := 1
+	status := openapi.GetPvzPvzIdReceptionsParamsStatus("close")
+	closed := "close"
+
+	testCases := []struct {
+		name         string
+		params       openapi.GetPvzPvzIdReceptionsParams
+		mockBehavior func()
+		expBody      interface{}
+		expCode      int
+	}{
+		{
+			name:   "ok last page",
+			params: openapi.GetPvzPvzIdReceptionsParams{Status: &status},
+			mockBehavior: func() {
+				service.EXPECT().ListPvzReceptions(gomock.Any(), &request.ListReceptions{PvzID: pvz.ID, Status: &closed, Limit: 50}).
+					Return([]*entity.ReceptionSummary{summary}, nil)
+			},
+			expBody: &response.ReceptionPage{Items: []*response.ReceptionSummary{summary.ToResponse()}},
+			expCode: http.StatusOK,
+		},
+		{
+			name:   "ok with next cursor",
+			params: openapi.GetPvzPvzIdReceptionsParams{Cursor: &encoded, Limit: &one},
+			mockBehavior: func() {
+				service.EXPECT().ListPvzReceptions(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ interface{}, req *request.ListReceptions) ([]*entity.ReceptionSummary, error) {
+						require.Equal(t, 1, req.Limit)
+						require.True(t, cursor.DateTime.Equal(req.Cursor.DateTime))
+						require.Equal(t, cursor.ID, req.Cursor.ID)
+						return []*entity.ReceptionSummary{summary}, nil
+					})
+			},
+			expBody: &response.ReceptionPage{Items: []*response.ReceptionSummary{summary.ToResponse()}, NextCursor: encoded},
+			expCode: http.StatusOK,
+		},
+		{
+			name:   "invalid cursor",
+			params: openapi.GetPvzPvzIdReceptionsParams{Cursor: &invalidCursor},
+			mockBehavior: func() {
+			},
+			expCode: http.StatusBadRequest,
+		},
+		{
+			name: "pvz out of key scope",
+			mockBehavior: func() {
+				caller = &principal.Principal{APIKeyID: uuid.New(), PvzIDs: []uuid.UUID{uuid.New()}}
+			},
+			expCode: http.StatusForbidden,
+		},
+		{
+			name: "pvz not found",
+			mockBehavior: func() {
+				service.EXPECT().ListPvzReceptions(gomock.Any(), gomock.Any()).Return(nil, apperror.NewNotFound("pvz not found"))
+			},
+			expCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(rec)
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/dummy", nil)
+
+			tc.mockBehavior()
+			setCaller(ctx)
+			handler.GetPvzPvzIdReceptions(ctx, pvz.ID, tc.params)
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expCode == http.StatusOK {
+				expJSON, err := json.Marshal(tc.expBody)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expJSON), rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestPostReceptionsReceptionIdReopen(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockReceptionService(ctrl)
+
+	handler := handler.NewHandler(service, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	reopened := &entity.Reception{ID: reception.ID, DateTime: reception.DateTime, PvzID: reception.PvzID, Status: entity.StatusInProgress}
+	testCases := []struct {
+		name         string
+		req          interface{}
+		mockBehavior func(req interface{})
+		expBody      interface{}
+		expCode      int
+	}{
+		{
+			name: "ok",
+			req:  &request.ChangeReceptionStatus{Reason: "closed by mistake"},
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().ReopenReception(gomock.Any(), reception.ID, req).Return(reopened, nil)
+			},
+			expBody: reopened.ToResponse(),
+			expCode: http.StatusOK,
+		},
+		{
+			name: "no reason",
+			req:  &request.ChangeReceptionStatus{},
+			mockBehavior: func(req interface{}) {
+			},
+			expCode: http.StatusBadRequest,
+		},
+		{
+			name: "newer reception exists",
+			req:  &request.ChangeReceptionStatus{Reason: "closed by mistake"},
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().ReopenReception(gomock.Any(), reception.ID, req).Return(nil, apperror.NewConflict("newer reception exists for pvz"))
+			},
+			expCode: http.StatusConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(rec)
+			jsonBody, err := json.Marshal(tc.req)
+			require.NoError(t, err)
+
+			ctx.Request = httptest.NewRequest(http.MethodPost, "/dummy", bytes.NewBuffer(jsonBody))
+			ctx.Request.Header.Set("Content-Type", "application/json")
+
+			tc.mockBehavior(tc.req)
+			handler.PostReceptionsReceptionIdReopen(ctx, reception.ID)
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expCode == http.StatusOK {
+				expJSON, err := json.Marshal(tc.expBody)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expJSON), rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestPostReceptionsReceptionIdCancel(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockReceptionService(ctrl)
+
+	handler := handler.NewHandler(service, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	cancelled := &entity.Reception{ID: reception.ID, DateTime: reception.DateTime, PvzID: reception.PvzID, Status: entity.StatusCancelled}
+	testCases := []struct {
+		name         string
+		req          interface{}
+		mockBehavior func(req interface{})
+		expBody      interface{}
+		expCode      int
+	}{
+		{
+			name: "ok",
+			req:  &request.ChangeReceptionStatus{Reason: "test reception"},
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().CancelReception(gomock.Any(), reception.ID, req).Return(cancelled, nil)
+			},
+			expBody: cancelled.ToResponse(),
+			expCode: http.StatusOK,
+		},
+		{
+			name: "already cancelled",
+			req:  &request.ChangeReceptionStatus{Reason: "test reception"},
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().CancelReception(gomock.Any(), reception.ID, req).Return(nil, apperror.NewBadReq("reception is already cancelled"))
+			},
+			expCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(rec)
+			jsonBody, err := json.Marshal(tc.req)
+			require.NoError(t, err)
+
+			ctx.Request = httptest.NewRequest(http.MethodPost, "/dummy", bytes.NewBuffer(jsonBody))
+			ctx.Request.Header.Set("Content-Type", "application/json")
+
+			tc.mockBehavior(tc.req)
+			handler.PostReceptionsReceptionIdCancel(ctx, reception.ID)
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expCode == http.StatusOK {
+				expJSON, err := json.Marshal(tc.expBody)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expJSON), rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+//go:generate mockgen -source=./storage_cell_handler.go -destination=./mocks/storage_cell_handler.go -package=mocks
+
+package handler
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+)
+
+type StorageCellService interface {
+	CreateStorageCell(ctx context.Context, pvzID uuid.UUID, req *request.CreateStorageCell) (*entity.StorageCell, error)
+	ListPvzStorageCells(ctx context.Context, pvzID uuid.UUID) ([]*entity.StorageCell, error)
+	MoveProduct(ctx context.Context, productID uuid.UUID, req *request.MoveProduct) (*entity.Product, error)
+}
+
+// PostPvzPvzIdCells adds storage cell to PVZ.
+func (h Handler) PostPvzPvzIdCells(ctx *gin.Context, pvzID uuid.UUID) {
+	log.SetPrefix("http-server.handler.CreateStorageCell")
+
+	if !checkPvzScope(ctx, pvzID) {
+		return
+	}
+
+	var req request.CreateStorageCell
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		wrapCtxWithError(ctx, apperror.NewBadReq("invalid req: "+err.Error()))
+		return
+	}
+
+	cell, err := h.cellSrv.CreateStorageCell(ctx, pvzID, &req)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, cell.ToResponse())
+}
+
+// GetPvzPvzIdCells returns PVZ storage cells with their occupancy.
+func (h Handler) GetPvzPvzIdCells(ctx *gin.Context, pvzID uuid.UUID) {
+	log.SetPrefix("http-server.handler.ListStorageCells")
+
+	if !checkPvzScope(ctx, pvzID) {
+		return
+	}
+
+	cells, err := h.cellSrv.ListPvzStorageCells(ctx, pvzID)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+	resp := make([]*response.StorageCell, len(cells))
+	for i, v := range cells {
+		resp[i] = v.ToResponse()
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// PostProductsProductIdMove moves product into another storage cell.
+func (h Handler) PostProductsProductIdMove(ctx *gin.Context, productID uuid.UUID) {
+	log.SetPrefix("http-server.handler.MoveProduct")
+
+	var req request.MoveProduct
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		wrapCtxWithError(ctx, apperror.NewBadReq("invalid req: "+err.Error()))
+		return
+	}
+
+	product, err := h.cellSrv.MoveProduct(ctx, productID, &req)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, product.ToResponse())
+}
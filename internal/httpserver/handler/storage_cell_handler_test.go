@@ -0,0 +1,227 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/httpserver/handler"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/httpserver/handler/mocks"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+)
+
+var storageCell = &entity.StorageCell{
+	ID:        uuid.New(),
+	PvzID:     pvz.ID,
+	Zone:      "A",
+	Rack:      3,
+	Shelf:     2,
+	SizeClass: entity.SizeClassMedium,
+	Capacity:  10,
+	Occupied:  4,
+	CreatedAt: time.Date(2025, 12, 12, 12, 12, 0, 0, time.UTC),
+}
+
+func TestPostPvzPvzIdCells(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockStorageCellService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, service, nil, nil)
+
+	req := &request.CreateStorageCell{Zone: "A", Rack: 3, Shelf: 2, SizeClass: "medium", Capacity: 10}
+
+	testCases := []struct {
+		name         string
+		req          interface{}
+		mockBehavior func()
+		expBody      interface{}
+		expCode      int
+	}{
+		{
+			name: "ok",
+			req:  req,
+			mockBehavior: func() {
+				service.EXPECT().CreateStorageCell(gomock.Any(), pvz.ID, req).Return(storageCell, nil)
+			},
+			expBody: storageCell.ToResponse(),
+			expCode: http.StatusCreated,
+		},
+		{
+			name: "no capacity",
+			req:  map[string]interface{}{"zone": "A", "rack": 3, "shelf": 2, "size_class": "medium"},
+			mockBehavior: func() {
+			},
+			expCode: http.StatusBadRequest,
+		},
+		{
+			name: "already exists",
+			req:  req,
+			mockBehavior: func() {
+				service.EXPECT().CreateStorageCell(gomock.Any(), pvz.ID, req).Return(nil, apperror.NewConflict("storage cell already exists"))
+			},
+			expCode: http.StatusConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(rec)
+
+			body, err := json.Marshal(tc.req)
+			require.NoError(t, err)
+			ctx.Request = httptest.NewRequest(http.MethodPost, "/dummy", bytes.NewReader(body))
+			ctx.Request.Header.Set("Content-Type", "application/json")
+
+			tc.mockBehavior()
+			handler.PostPvzPvzIdCells(ctx, pvz.ID)
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expCode == http.StatusCreated {
+				expJSON, err := json.Marshal(tc.expBody)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expJSON), rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestGetPvzPvzIdCells(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockStorageCellService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, service, nil, nil)
+
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expBody      interface{}
+		expCode      int
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				service.EXPECT().ListPvzStorageCells(gomock.Any(), pvz.ID).Return([]*entity.StorageCell{storageCell}, nil)
+			},
+			expBody: []*response.StorageCell{storageCell.ToResponse()},
+			expCode: http.StatusOK,
+		},
+		{
+			name: "other pvz",
+			mockBehavior: func() {
+				caller = &principal.Principal{APIKeyID: uuid.New(), PvzIDs: []uuid.UUID{uuid.New()}}
+			},
+			expCode: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(rec)
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/dummy", nil)
+
+			tc.mockBehavior()
+			setCaller(ctx)
+			handler.GetPvzPvzIdCells(ctx, pvz.ID)
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expCode == http.StatusOK {
+				expJSON, err := json.Marshal(tc.expBody)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expJSON), rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestPostProductsProductIdMove(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockStorageCellService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, service, nil, nil)
+
+	moved := *product
+	moved.CellID = storageCell.ID
+	moved.Cell = storageCell
+	req := &request.MoveProduct{CellID: storageCell.ID}
+
+	testCases := []struct {
+		name         string
+		req          interface{}
+		mockBehavior func()
+		expBody      interface{}
+		expCode      int
+	}{
+		{
+			name: "ok",
+			req:  req,
+			mockBehavior: func() {
+				service.EXPECT().MoveProduct(gomock.Any(), product.ID, req).Return(&moved, nil)
+			},
+			expBody: moved.ToResponse(),
+			expCode: http.StatusOK,
+		},
+		{
+			name: "no cell",
+			req:  map[string]string{},
+			mockBehavior: func() {
+			},
+			expCode: http.StatusBadRequest,
+		},
+		{
+			name: "cell full",
+			req:  req,
+			mockBehavior: func() {
+				service.EXPECT().MoveProduct(gomock.Any(), product.ID, req).Return(nil, apperror.NewConflict("storage cell is full"))
+			},
+			expCode: http.StatusConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(rec)
+
+			body, err := json.Marshal(tc.req)
+			require.NoError(t, err)
+			ctx.Request = httptest.NewRequest(http.MethodPost, "/dummy", bytes.NewReader(body))
+			ctx.Request.Header.Set("Content-Type", "application/json")
+
+			tc.mockBehavior()
+			handler.PostProductsProductIdMove(ctx, product.ID)
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expCode == http.StatusOK {
+				expJSON, err := json.Marshal(tc.expBody)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expJSON), rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+//go:generate mockgen -source=./transfer_handler.go -destination=./mocks/transfer_handler.go -package=mocks
+
+package handler
+
+import (
+	"context"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+)
+
+type TransferService interface {
+	CreateTransfer(ctx context.Context, req *request.CreateTransfer) (*entity.Transfer, error)
+	GetTransfer(ctx context.Context, id uuid.UUID) (*entity.Transfer, error)
+	DispatchTransfer(ctx context.Context, id uuid.UUID) (*entity.Transfer, error)
+	ReceiveTransfer(ctx context.Context, id uuid.UUID) (*entity.Transfer, error)
+}
+
+// PostTransfers plans move of stored products to another PVZ.
+func (h Handler) PostTransfers(ctx *gin.Context) {
+	log.SetPrefix("http-server.handler.CreateTransfer")
+
+	var req request.CreateTransfer
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		wrapCtxWithError(ctx, apperror.NewBadReq("invalid req: "+err.Error()))
+		return
+	}
+
+	if !checkPvzScope(ctx, req.FromPvzID) {
+		return
+	}
+
+	transfer, err := h.transferSrv.CreateTransfer(ctx, &req)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, transfer.ToResponse())
+}
+
+// GetTransfersTransferId returns transfer with its products.
+func (h Handler) GetTransfersTransferId(ctx *gin.Context, transferID uuid.UUID) {
+	log.SetPrefix("http-server.handler.GetTransfer")
+
+	transfer, err := h.transferSrv.GetTransfer(ctx, transferID)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, transfer.ToResponse())
+}
+
+// PostTransfersTransferIdDispatch sends transfer from source PVZ.
+func (h Handler) PostTransfersTransferIdDispatch(ctx *gin.Context, transferID uuid.UUID) {
+	log.SetPrefix("http-server.handler.DispatchTransfer")
+
+	transfer, err := h.transferSrv.DispatchTransfer(ctx, transferID)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, transfer.ToResponse())
+}
+
+// PostTransfersTransferIdReceive accepts transfer in destination PVZ.
+func (h Handler) PostTransfersTransferIdReceive(ctx *gin.Context, transferID uuid.UUID) {
+	log.SetPrefix("http-server.handler.ReceiveTransfer")
+
+	transfer, err := h.transferSrv.ReceiveTransfer(ctx, transferID)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, transfer.ToResponse())
+}
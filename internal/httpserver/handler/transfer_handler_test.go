@@ -0,0 +1,178 @@
+package handler_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/httpserver/handler"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/httpserver/handler/mocks"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+)
+
+var transfer = &entity.Transfer{
+	ID:        uuid.New(),
+	FromPvzID: pvz.ID,
+	ToPvzID:   uuid.New(),
+	Status:    entity.TransferStatusCreated,
+	CreatedAt: time.Date(2025, 12, 12, 12, 12, 0, 0, time.UTC),
+	Products:  []*entity.Product{},
+}
+
+func TestPostTransfers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockTransferService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, service, nil)
+
+	req := &request.CreateTransfer{FromPvzID: pvz.ID, ToPvzID: transfer.ToPvzID, ProductIDs: []uuid.UUID{uuid.New()}}
+
+	testCases := []struct {
+		name         string
+		req          interface{}
+		mockBehavior func()
+		expBody      interface{}
+		expCode      int
+	}{
+		{
+			name: "ok",
+			req:  req,
+			mockBehavior: func() {
+				service.EXPECT().CreateTransfer(gomock.Any(), req).Return(transfer, nil)
+			},
+			expBody: transfer.ToResponse(),
+			expCode: http.StatusCreated,
+		},
+		{
+			name: "no products",
+			req:  map[string]interface{}{"from_pvz_id": pvz.ID, "to_pvz_id": transfer.ToPvzID, "product_ids": []uuid.UUID{}},
+			mockBehavior: func() {
+			},
+			expCode: http.StatusBadRequest,
+		},
+		{
+			name: "no access to source pvz",
+			req:  req,
+			mockBehavior: func() {
+				caller = &principal.Principal{APIKeyID: uuid.New(), PvzIDs: []uuid.UUID{transfer.ToPvzID}}
+			},
+			expCode: http.StatusForbidden,
+		},
+		{
+			name: "product not stored",
+			req:  req,
+			mockBehavior: func() {
+				service.EXPECT().CreateTransfer(gomock.Any(), req).Return(nil, apperror.NewConflict("product is issued"))
+			},
+			expCode: http.StatusConflict,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(rec)
+
+			body, err := json.Marshal(tc.req)
+			require.NoError(t, err)
+			ctx.Request = httptest.NewRequest(http.MethodPost, "/dummy", bytes.NewReader(body))
+			ctx.Request.Header.Set("Content-Type", "application/json")
+
+			tc.mockBehavior()
+			setCaller(ctx)
+			handler.PostTransfers(ctx)
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expCode == http.StatusCreated {
+				expJSON, err := json.Marshal(tc.expBody)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expJSON), rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestPostTransfersTransferIdReceive(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockTransferService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, service, nil)
+
+	received := &entity.Transfer{
+		ID:          transfer.ID,
+		FromPvzID:   transfer.FromPvzID,
+		ToPvzID:     transfer.ToPvzID,
+		Status:      entity.TransferStatusReceived,
+		ReceptionID: uuid.New(),
+		CreatedAt:   transfer.CreatedAt,
+		ReceivedAt:  time.Date(2025, 12, 13, 12, 12, 0, 0, time.UTC),
+		Products:    []*entity.Product{},
+	}
+
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expBody      interface{}
+		expCode      int
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				service.EXPECT().ReceiveTransfer(gomock.Any(), transfer.ID).Return(received, nil)
+			},
+			expBody: received.ToResponse(),
+			expCode: http.StatusOK,
+		},
+		{
+			name: "not in transit",
+			mockBehavior: func() {
+				service.EXPECT().ReceiveTransfer(gomock.Any(), transfer.ID).Return(nil, apperror.NewConflict("transfer is created"))
+			},
+			expCode: http.StatusConflict,
+		},
+		{
+			name: "not found",
+			mockBehavior: func() {
+				service.EXPECT().ReceiveTransfer(gomock.Any(), transfer.ID).Return(nil, apperror.NewNotFound("transfer not found"))
+			},
+			expCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(rec)
+			ctx.Request = httptest.NewRequest(http.MethodPost, "/dummy", nil)
+
+			tc.mockBehavior()
+			handler.PostTransfersTransferIdReceive(ctx, transfer.ID)
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expCode == http.StatusOK {
+				expJSON, err := json.Marshal(tc.expBody)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expJSON), rec.Body.String())
+			}
+		})
+	}
+}
@@ -8,17 +8,24 @@ import (
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/pkg/openapi"
 )
 
 type UserService interface {
 	DummyLogin(context.Context, *request.DummyLogin) (*response.Login, error)
 	Register(context.Context, *request.Register) (*entity.User, error)
 	Login(context.Context, *request.Login) (*response.Login, error)
+
+	ListUsers(context.Context, *request.ListUsers) ([]*entity.User, error)
+	GetUser(context.Context, uuid.UUID) (*entity.User, error)
+	UpdateUser(context.Context, uuid.UUID, *request.UpdateUser) (*entity.User, error)
+	ResetPassword(context.Context, uuid.UUID) (*response.ResetPassword, error)
 }
 
 // PostDummyLogin returns token for.
@@ -31,11 +38,6 @@ func (h Handler) PostDummyLogin(ctx *gin.Context) {
 		return
 	}
 
-	if _, ok := entity.Roles[entity.Role(req.Role)]; !ok {
-		wrapCtxWithError(ctx, apperror.NewBadReq("invalid role: "+req.Role))
-		return
-	}
-
 	resp, err := h.userSrv.DummyLogin(ctx, &req)
 	if err != nil {
 		wrapCtxWithError(ctx, err)
@@ -54,11 +56,6 @@ func (h Handler) PostRegister(ctx *gin.Context) {
 		return
 	}
 
-	if _, ok := entity.Roles[entity.Role(req.Role)]; !ok {
-		wrapCtxWithError(ctx, apperror.NewBadReq("invalid rol: "+req.Role))
-		return
-	}
-
 	usr, err := h.userSrv.Register(ctx, &req)
 	if err != nil {
 		wrapCtxWithError(ctx, err)
@@ -86,3 +83,85 @@ func (h Handler) PostLogin(ctx *gin.Context) {
 
 	ctx.JSON(http.StatusOK, resp)
 }
+
+// GetUsers returns users list.
+func (h Handler) GetUsers(ctx *gin.Context, params openapi.GetUsersParams) {
+	log.SetPrefix("http-server.handler.ListUsers")
+
+	page, limit := 1, 10
+	if params.Page != nil {
+		page = *params.Page
+	}
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	req := &request.ListUsers{
+		Role:   params.Role,
+		Active: params.Active,
+		Email:  params.Email,
+		Page:   page,
+		Limit:  limit,
+	}
+
+	users, err := h.userSrv.ListUsers(ctx, req)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+	resp := make([]*response.User, len(users))
+	for i, v := range users {
+		resp[i] = v.ToResponse()
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
+
+// GetUsersUserId returns user by id.
+func (h Handler) GetUsersUserId(ctx *gin.Context, userID uuid.UUID) {
+	log.SetPrefix("http-server.handler.GetUser")
+
+	usr, err := h.userSrv.GetUser(ctx, userID)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, usr.ToResponse())
+}
+
+// PatchUsersUserId changes user role, activity and/or assigned PVZs.
+func (h Handler) PatchUsersUserId(ctx *gin.Context, userID uuid.UUID) {
+	log.SetPrefix("http-server.handler.UpdateUser")
+
+	var req request.UpdateUser
+	if err := ctx.ShouldBindJSON(&req); err != nil {
+		wrapCtxWithError(ctx, apperror.NewBadReq("invalid req: "+err.Error()))
+		return
+	}
+	if req.Role == nil && req.Active == nil && req.PvzIDs == nil {
+		wrapCtxWithError(ctx, apperror.NewBadReq("invalid req: nothing to update"))
+		return
+	}
+
+	usr, err := h.userSrv.UpdateUser(ctx, userID, &req)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, usr.ToResponse())
+}
+
+// PostUsersUserIdResetPassword sets temporary password for user.
+func (h Handler) PostUsersUserIdResetPassword(ctx *gin.Context, userID uuid.UUID) {
+	log.SetPrefix("http-server.handler.ResetPassword")
+
+	resp, err := h.userSrv.ResetPassword(ctx, userID)
+	if err != nil {
+		wrapCtxWithError(ctx, err)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, resp)
+}
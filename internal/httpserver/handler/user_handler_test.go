@@ -18,6 +18,8 @@ import (
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/pkg/openapi"
 )
 
 var mockuser = &entity.User{ID: uuid.New(), Email: "mock@example.com", Password: "mockpassword", Role: entity.RoleEmployee}
@@ -27,7 +29,7 @@ func TestPostDummyLogin(t *testing.T) {
 
 	service := mocks.NewMockUserService(ctrl)
 
-	handler := handler.NewHandler(nil, nil, service, nil)
+	handler := handler.NewHandler(nil, nil, service, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	testCases := []struct {
 		name         string
 		req          interface{}
@@ -59,6 +61,7 @@ func TestPostDummyLogin(t *testing.T) {
 				Role: "invalid",
 			},
 			mockBehavior: func(req interface{}) {
+				service.EXPECT().DummyLogin(gomock.Any(), req).Return(nil, apperror.NewBadReq("invalid role: invalid"))
 			},
 			expCode: http.StatusBadRequest,
 		},
@@ -78,7 +81,7 @@ func TestPostDummyLogin(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			gin.SetMode(gin.TestMode)
 			rec := httptest.NewRecorder()
-			r := gin.New()
+			r := newTestRouter()
 
 			tc.mockBehavior(tc.req)
 
@@ -109,7 +112,7 @@ func TestPostRegister(t *testing.T) {
 
 	service := mocks.NewMockUserService(ctrl)
 
-	handler := handler.NewHandler(nil, nil, service, nil)
+	handler := handler.NewHandler(nil, nil, service, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	testCases := []struct {
 		name         string
 		req          interface{}
@@ -127,7 +130,7 @@ func TestPostRegister(t *testing.T) {
 			mockBehavior: func(req interface{}) {
 				service.EXPECT().Register(gomock.Any(), req).Return(mockuser, nil)
 			},
-			expBody: &response.User{mockuser.ID, mockuser.Email, string(mockuser.Role)},
+			expBody: &response.User{ID: mockuser.ID, Email: mockuser.Email, Role: string(mockuser.Role)},
 			expCode: http.StatusCreated,
 		},
 		{
@@ -145,6 +148,7 @@ func TestPostRegister(t *testing.T) {
 				Role:     "invalid",
 			},
 			mockBehavior: func(req interface{}) {
+				service.EXPECT().Register(gomock.Any(), req).Return(nil, apperror.NewBadReq("invalid role: invalid"))
 			},
 			expCode: http.StatusBadRequest,
 		},
@@ -166,7 +170,7 @@ func TestPostRegister(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			gin.SetMode(gin.TestMode)
 			rec := httptest.NewRecorder()
-			r := gin.New()
+			r := newTestRouter()
 
 			tc.mockBehavior(tc.req)
 
@@ -197,7 +201,7 @@ func TestPostLogin(t *testing.T) {
 
 	service := mocks.NewMockUserService(ctrl)
 
-	handler := handler.NewHandler(nil, nil, service, nil)
+	handler := handler.NewHandler(nil, nil, service, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
 	testCases := []struct {
 		name         string
 		req          interface{}
@@ -214,7 +218,7 @@ func TestPostLogin(t *testing.T) {
 			mockBehavior: func(req interface{}) {
 				service.EXPECT().Login(gomock.Any(), req).Return(&response.Login{Token: "valid"}, nil)
 			},
-			expBody: &response.Login{"valid"},
+			expBody: &response.Login{Token: "valid"},
 			expCode: http.StatusOK,
 		},
 		{
@@ -241,7 +245,7 @@ func TestPostLogin(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			gin.SetMode(gin.TestMode)
 			rec := httptest.NewRecorder()
-			r := gin.New()
+			r := newTestRouter()
 
 			tc.mockBehavior(tc.req)
 
@@ -266,3 +270,230 @@ func TestPostLogin(t *testing.T) {
 		})
 	}
 }
+
+func TestGetUsers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockUserService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, service, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	role := string(entity.RoleEmployee)
+	testCases := []struct {
+		name         string
+		params       openapi.GetUsersParams
+		mockBehavior func(params openapi.GetUsersParams)
+		expBody      interface{}
+		expCode      int
+	}{
+		{
+			name:   "ok",
+			params: openapi.GetUsersParams{Role: &role},
+			mockBehavior: func(params openapi.GetUsersParams) {
+				service.EXPECT().ListUsers(gomock.Any(), &request.ListUsers{Role: params.Role, Page: 1, Limit: 10}).
+					Return([]*entity.User{mockuser}, nil)
+			},
+			expBody: []*response.User{mockuser.ToResponse()},
+			expCode: http.StatusOK,
+		},
+		{
+			name:   "service err",
+			params: openapi.GetUsersParams{Page: &page, Limit: &limit},
+			mockBehavior: func(params openapi.GetUsersParams) {
+				service.EXPECT().ListUsers(gomock.Any(), &request.ListUsers{Page: page, Limit: limit}).Return(nil, errMock)
+			},
+			expCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(rec)
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/dummy", nil)
+
+			tc.mockBehavior(tc.params)
+			handler.GetUsers(ctx, tc.params)
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expCode == http.StatusOK {
+				expJSON, err := json.Marshal(tc.expBody)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expJSON), rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestGetUsersUserId(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockUserService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, service, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	testCases := []struct {
+		name         string
+		userID       uuid.UUID
+		mockBehavior func(userID uuid.UUID)
+		expBody      interface{}
+		expCode      int
+	}{
+		{
+			name:   "ok",
+			userID: mockuser.ID,
+			mockBehavior: func(userID uuid.UUID) {
+				service.EXPECT().GetUser(gomock.Any(), userID).Return(mockuser, nil)
+			},
+			expBody: mockuser.ToResponse(),
+			expCode: http.StatusOK,
+		},
+		{
+			name:   "not found",
+			userID: mockuser.ID,
+			mockBehavior: func(userID uuid.UUID) {
+				service.EXPECT().GetUser(gomock.Any(), userID).Return(nil, apperror.NewNotFound("user not found"))
+			},
+			expCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(rec)
+			ctx.Request = httptest.NewRequest(http.MethodGet, "/dummy", nil)
+
+			tc.mockBehavior(tc.userID)
+			handler.GetUsersUserId(ctx, tc.userID)
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expCode == http.StatusOK {
+				expJSON, err := json.Marshal(tc.expBody)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expJSON), rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestPatchUsersUserId(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockUserService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, service, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+	moderator := string(entity.RoleModerator)
+	testCases := []struct {
+		name         string
+		req          interface{}
+		mockBehavior func(req interface{})
+		expCode      int
+	}{
+		{
+			name: "ok",
+			req:  &request.UpdateUser{Role: &moderator},
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().UpdateUser(gomock.Any(), mockuser.ID, req).Return(mockuser, nil)
+			},
+			expCode: http.StatusOK,
+		},
+		{
+			name: "invalid req",
+			req:  "invalid",
+			mockBehavior: func(req interface{}) {
+			},
+			expCode: http.StatusBadRequest,
+		},
+		{
+			name: "nothing to update",
+			req:  &request.UpdateUser{},
+			mockBehavior: func(req interface{}) {
+			},
+			expCode: http.StatusBadRequest,
+		},
+		{
+			name: "service err",
+			req:  &request.UpdateUser{Role: &moderator},
+			mockBehavior: func(req interface{}) {
+				service.EXPECT().UpdateUser(gomock.Any(), mockuser.ID, req).Return(nil, errMock)
+			},
+			expCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(rec)
+
+			body, _ := json.Marshal(tc.req)
+			ctx.Request = httptest.NewRequest(http.MethodPatch, "/dummy", bytes.NewReader(body))
+			ctx.Request.Header.Set("Content-Type", "application/json")
+
+			tc.mockBehavior(tc.req)
+			handler.PatchUsersUserId(ctx, mockuser.ID)
+
+			require.Equal(t, tc.expCode, rec.Code)
+		})
+	}
+}
+
+func TestPostUsersUserIdResetPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	service := mocks.NewMockUserService(ctrl)
+
+	handler := handler.NewHandler(nil, nil, service, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expBody      interface{}
+		expCode      int
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				service.EXPECT().ResetPassword(gomock.Any(), mockuser.ID).
+					Return(&response.ResetPassword{TemporaryPassword: "temp"}, nil)
+			},
+			expBody: &response.ResetPassword{TemporaryPassword: "temp"},
+			expCode: http.StatusOK,
+		},
+		{
+			name: "service err",
+			mockBehavior: func() {
+				service.EXPECT().ResetPassword(gomock.Any(), mockuser.ID).Return(nil, errMock)
+			},
+			expCode: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			ctx, _ := gin.CreateTestContext(rec)
+			ctx.Request = httptest.NewRequest(http.MethodPost, "/dummy", nil)
+
+			tc.mockBehavior()
+			handler.PostUsersUserIdResetPassword(ctx, mockuser.ID)
+
+			require.Equal(t, tc.expCode, rec.Code)
+
+			if tc.expCode == http.StatusOK {
+				expJSON, err := json.Marshal(tc.expBody)
+				require.NoError(t, err)
+
+				require.JSONEq(t, string(expJSON), rec.Body.String())
+			}
+		})
+	}
+}
@@ -1,6 +1,10 @@
 package request
 
 import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -21,10 +25,121 @@ type Login struct {
 	Password string `json:"password" binding:"required"`
 }
 
+type LoginMFA struct {
+	MFAToken string `json:"mfa_token" binding:"required"`
+	Code     string `json:"code" binding:"required"`
+}
+
+type VerifyMFA struct {
+	Code string `json:"code" binding:"required"`
+}
+
+type ForgotPassword struct {
+	Email string `json:"email" binding:"required,email"`
+}
+
+type ResetPassword struct {
+	Code     string `json:"code" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+type ListUsers struct {
+	Role   *string
+	Active *bool
+	Email  *string
+	Page   int
+	Limit  int
+}
+
+type UpdateUser struct {
+	Role   *string `json:"role"`
+	Active *bool   `json:"active"`
+
+	// PvzIDs replaces PVZs user is assigned to, nil keeps them.
+	PvzIDs *[]uuid.UUID `json:"pvz_ids"`
+}
+
+type CreateInvite struct {
+	Email  string      `json:"email" binding:"required,email"`
+	Role   string      `json:"role" binding:"required"`
+	PvzIDs []uuid.UUID `json:"pvz_ids"`
+}
+
+type AcceptInvite struct {
+	Token    string `json:"token" binding:"required"`
+	Password string `json:"password" binding:"required"`
+}
+
+type CreateAPIKey struct {
+	Name        string      `json:"name" binding:"required"`
+	Permissions []string    `json:"permissions" binding:"required,min=1"`
+	PvzIDs      []uuid.UUID `json:"pvz_ids"`
+	ExpiresAt   *time.Time  `json:"expires_at"`
+}
+
+type ListAudit struct {
+	Action  *string
+	ActorID *uuid.UUID
+	From    *time.Time
+	To      *time.Time
+	Cursor  *int64
+	Limit   int
+}
+
 type CreatePvz struct {
 	ID               uuid.UUID `json:"id" binding:"required,uuid"`
 	RegistrationDate time.Time `json:"registration_date" binding:"required"`
 	City             string    `json:"city"`
+	Capacity         *int      `json:"capacity" binding:"omitempty,min=1"`
+	SoftCapacity     *int      `json:"soft_capacity" binding:"omitempty,min=1"`
+}
+
+type CreateCity struct {
+	Code     string `json:"code" binding:"required"`
+	Name     string `json:"name" binding:"required"`
+	NameEn   string `json:"name_en"`
+	Region   string `json:"region"`
+	Timezone string `json:"timezone"`
+	Enabled  *bool  `json:"enabled"`
+}
+
+type UpdateCity struct {
+	Enabled *bool `json:"enabled" binding:"required"`
+}
+
+type ProductAttribute struct {
+	Name     string `json:"name" binding:"required"`
+	Required bool   `json:"required"`
+	Pattern  string `json:"pattern"`
+}
+
+type CreateProductType struct {
+	Code       string             `json:"code" binding:"required"`
+	Name       string             `json:"name" binding:"required"`
+	NameEn     string             `json:"name_en"`
+	Attributes []ProductAttribute `json:"attributes" binding:"dive"`
+	Fragile    bool               `json:"fragile"`
+	HighValue  bool               `json:"high_value"`
+}
+
+// UpdateProductType changes only fields which are set.
+// Attributes, if set, replace the whole schema.
+type UpdateProductType struct {
+	NameEn     *string             `json:"name_en"`
+	Attributes *[]ProductAttribute `json:"attributes" binding:"omitempty,dive"`
+	Fragile    *bool               `json:"fragile"`
+	HighValue  *bool               `json:"high_value"`
+}
+
+type UpdatePvz struct {
+	Status string `json:"status" binding:"required"`
+	Reason string `json:"reason"`
+}
+
+// UpdatePvzCapacity replaces PVZ limits, nil removes limit.
+type UpdatePvzCapacity struct {
+	Capacity     *int `json:"capacity" binding:"omitempty,min=1"`
+	SoftCapacity *int `json:"soft_capacity" binding:"omitempty,min=1"`
 }
 
 type SearchPvz struct {
@@ -32,13 +147,156 @@ type SearchPvz struct {
 	EndDate   time.Time
 	Page      int
 	Limit     int
+
+	// Status filters PVZ by lifecycle state, nil means any.
+	Status *string
+	// PvzIDs limits search to listed PVZs, nil means any.
+	PvzIDs []uuid.UUID
 }
 
 type CreateReception struct {
-	PvzID uuid.UUID `json:"pvz_id" binding:"required,uuid"`
+	PvzID    uuid.UUID          `json:"pvz_id" binding:"required,uuid"`
+	Manifest *ReceptionManifest `json:"manifest"`
+}
+
+// ReceptionManifest lists expected barcodes,
+// number of products per type or both.
+type ReceptionManifest struct {
+	Barcodes   []string       `json:"barcodes"`
+	TypeCounts map[string]int `json:"type_counts"`
+}
+
+type FinishReception struct {
+	PvzID uuid.UUID
+	// ReceptionID, if set, must be the open
+	// reception of PVZ, otherwise nothing is closed.
+	ReceptionID uuid.UUID
+	// Override closes reception despite
+	// discrepancies with manifest.
+	Override bool
+}
+
+// ChangeReceptionStatus is body of moderator
+// reopen and cancel reception requests.
+type ChangeReceptionStatus struct {
+	Reason string `json:"reason" binding:"required"`
 }
 
 type AddProduct struct {
-	Type  string    `json:"type" binding:"required"`
-	PvzID uuid.UUID `json:"pvz_id" binding:"required,uuid"`
+	Type       string            `json:"type" binding:"required"`
+	PvzID      uuid.UUID         `json:"pvz_id" binding:"required,uuid"`
+	Barcode    string            `json:"barcode"`
+	OrderID    string            `json:"order_id"`
+	Attributes map[string]string `json:"attributes"`
+	// PickupCode is checked when product is issued,
+	// only its hash is stored.
+	PickupCode string `json:"pickup_code"`
+	// PickupCodeHash is set by service from PickupCode.
+	PickupCodeHash string `json:"-"`
+	// SizeClass picks storage cell, medium if empty.
+	SizeClass string `json:"size_class"`
+	// Condition is parcel packaging state, ok if empty.
+	Condition string `json:"condition"`
+	Notes     string `json:"notes" binding:"max=1000"`
+}
+
+type AddProductsBatch struct {
+	PvzID    uuid.UUID      `json:"pvz_id" binding:"required,uuid"`
+	Products []BatchProduct `json:"products" binding:"required,min=1,dive"`
+}
+
+type BatchProduct struct {
+	Type       string            `json:"type" binding:"required"`
+	Barcode    string            `json:"barcode"`
+	OrderID    string            `json:"order_id"`
+	Attributes map[string]string `json:"attributes"`
+	PickupCode string            `json:"pickup_code"`
+	SizeClass  string            `json:"size_class"`
+	Condition  string            `json:"condition"`
+	Notes      string            `json:"notes" binding:"max=1000"`
+	// PickupCodeHash is set by service from PickupCode.
+	PickupCodeHash string `json:"-"`
+}
+
+type ListReceptions struct {
+	PvzID  uuid.UUID
+	Status *string
+	From   *time.Time
+	To     *time.Time
+	Cursor *ReceptionCursor
+	Limit  int
+}
+
+// ReceptionCursor points to the last reception of
+// previous page, receptions are ordered by date desc.
+type ReceptionCursor struct {
+	DateTime time.Time
+	ID       uuid.UUID
+}
+
+// Encode hides cursor fields, so clients
+// don't rely on cursor format.
+func (c *ReceptionCursor) Encode() string {
+	raw := strconv.FormatInt(c.DateTime.UnixNano(), 10) + "|" + c.ID.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func DecodeReceptionCursor(cursor string) (*ReceptionCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	nanos, id, ok := strings.Cut(string(raw), "|")
+	if !ok {
+		return nil, errors.New("malformed cursor")
+	}
+	ns, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	receptionID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ReceptionCursor{
+		DateTime: time.Unix(0, ns),
+		ID:       receptionID,
+	}, nil
+}
+
+type IssueProduct struct {
+	PickupCode string `json:"pickup_code" binding:"required"`
+}
+
+// SetPickupCode sets code of product accepted without it.
+type SetPickupCode struct {
+	PickupCode string `json:"pickup_code" binding:"required"`
+}
+
+type ListStock struct {
+	PvzID uuid.UUID
+	Page  int
+	Limit int
+}
+
+type CreateStorageCell struct {
+	Zone      string `json:"zone" binding:"required"`
+	Rack      int    `json:"rack" binding:"required,min=1"`
+	Shelf     int    `json:"shelf" binding:"required,min=1"`
+	SizeClass string `json:"size_class" binding:"required"`
+	Capacity  int    `json:"capacity" binding:"required,min=1"`
+}
+
+type MoveProduct struct {
+	CellID uuid.UUID `json:"cell_id" binding:"required"`
+}
+
+// CreateTransfer lists stored products to
+// move from one PVZ to another.
+type CreateTransfer struct {
+	FromPvzID  uuid.UUID   `json:"from_pvz_id" binding:"required"`
+	ToPvzID    uuid.UUID   `json:"to_pvz_id" binding:"required"`
+	ProductIDs []uuid.UUID `json:"product_ids" binding:"required,min=1"`
 }
@@ -1,6 +1,7 @@
 package response
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -13,26 +14,184 @@ type Error struct {
 }
 
 type Login struct {
-	Token string `json:"token"`
+	Token string `json:"token,omitempty"`
+
+	// MFAToken is returned instead of Token, when
+	// second factor is required to finish login.
+	MFAToken          string `json:"mfa_token,omitempty"`
+	MFAEnrollRequired bool   `json:"mfa_enroll_required,omitempty"`
+}
+
+type MFAEnroll struct {
+	Secret string `json:"secret"`
+	URL    string `json:"url"`
+}
+
+type MFARecoveryCodes struct {
+	RecoveryCodes []string `json:"recovery_codes"`
 }
 
 type Pvz struct {
 	ID               uuid.UUID `json:"id"`
 	RegistrationDate time.Time `json:"registration_date"`
 	City             string    `json:"city"`
+	Status           string    `json:"status"`
+	Capacity         *int      `json:"capacity,omitempty"`
+	SoftCapacity     *int      `json:"soft_capacity,omitempty"`
+	StockCount       int       `json:"stock_count"`
+}
+
+type City struct {
+	Code      string    `json:"code"`
+	Name      string    `json:"name"`
+	NameEn    string    `json:"name_en"`
+	Region    string    `json:"region"`
+	Timezone  string    `json:"timezone"`
+	Enabled   bool      `json:"enabled"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type Product struct {
-	ID          uuid.UUID `json:"id"`
-	DateTime    time.Time `json:"date_time"`
-	ProductType string    `json:"type"`
-	ReceptionID uuid.UUID `json:"reception_id"`
+	ID          uuid.UUID         `json:"id"`
+	DateTime    time.Time         `json:"date_time"`
+	ProductType string            `json:"type"`
+	ReceptionID uuid.UUID         `json:"reception_id"`
+	Attributes  map[string]string `json:"attributes,omitempty"`
+	Barcode     string            `json:"barcode,omitempty"`
+	OrderID     string            `json:"order_id,omitempty"`
+	State       string            `json:"state,omitempty"`
+	SizeClass   string            `json:"size_class,omitempty"`
+	CellID      *uuid.UUID        `json:"cell_id,omitempty"`
+	Cell        *StorageCell      `json:"cell,omitempty"`
+	Condition   string            `json:"condition,omitempty"`
+	Notes       string            `json:"notes,omitempty"`
+}
+
+type ProductAttachment struct {
+	ID          uuid.UUID  `json:"id"`
+	ProductID   uuid.UUID  `json:"product_id"`
+	ContentType string     `json:"content_type"`
+	Size        int64      `json:"size"`
+	UploadedBy  *uuid.UUID `json:"uploaded_by,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+}
+
+type ProductEvent struct {
+	ID        int64           `json:"id"`
+	ProductID uuid.UUID       `json:"product_id"`
+	PvzID     uuid.UUID       `json:"pvz_id"`
+	Type      string          `json:"type"`
+	ActorID   *uuid.UUID      `json:"actor_id,omitempty"`
+	Details   json.RawMessage `json:"details"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+type ReturnShipment struct {
+	ID        uuid.UUID  `json:"id"`
+	PvzID     uuid.UUID  `json:"pvz_id"`
+	CreatedBy *uuid.UUID `json:"created_by,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	Products  []*Product `json:"products"`
+}
+
+type Transfer struct {
+	ID           uuid.UUID  `json:"id"`
+	FromPvzID    uuid.UUID  `json:"from_pvz_id"`
+	ToPvzID      uuid.UUID  `json:"to_pvz_id"`
+	Status       string     `json:"status"`
+	ReceptionID  *uuid.UUID `json:"reception_id,omitempty"`
+	CreatedBy    *uuid.UUID `json:"created_by,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	DispatchedAt *time.Time `json:"dispatched_at,omitempty"`
+	ReceivedAt   *time.Time `json:"received_at,omitempty"`
+	Products     []*Product `json:"products"`
+}
+
+type StorageCell struct {
+	ID        uuid.UUID `json:"id"`
+	PvzID     uuid.UUID `json:"pvz_id"`
+	Label     string    `json:"label"`
+	Zone      string    `json:"zone"`
+	Rack      int       `json:"rack"`
+	Shelf     int       `json:"shelf"`
+	SizeClass string    `json:"size_class"`
+	Capacity  int       `json:"capacity"`
+	Occupied  int       `json:"occupied"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// DuplicateProduct is an error returned on repeated
+// barcode scan, Product is the one accepted before.
+type DuplicateProduct struct {
+	Message   string   `json:"message"`
+	RequestID string   `json:"request_id"`
+	Code      int      `json:"code"`
+	Product   *Product `json:"product"`
+}
+
+// BatchProducts is a result of batch intake. Results
+// follow order of products in request.
+type BatchProducts struct {
+	Accepted bool                  `json:"accepted"`
+	Results  []*BatchProductResult `json:"results"`
+}
+
+type BatchProductResult struct {
+	// Status is one of: created, invalid, duplicate, skipped.
+	Status   string   `json:"status"`
+	Product  *Product `json:"product,omitempty"`
+	Error    string   `json:"error,omitempty"`
+	Existing *Product `json:"existing,omitempty"`
+}
+
+type ProductAttribute struct {
+	Name     string `json:"name"`
+	Required bool   `json:"required"`
+	Pattern  string `json:"pattern,omitempty"`
+}
+
+type ProductType struct {
+	Code       string             `json:"code"`
+	Name       string             `json:"name"`
+	NameEn     string             `json:"name_en"`
+	Attributes []ProductAttribute `json:"attributes"`
+	Fragile    bool               `json:"fragile"`
+	HighValue  bool               `json:"high_value"`
+	CreatedAt  time.Time          `json:"created_at"`
 }
 
 type User struct {
-	ID    uuid.UUID `json:"id"`
-	Email string    `json:"email"`
-	Role  string    `json:"role"`
+	ID         uuid.UUID `json:"id"`
+	Email      string    `json:"email"`
+	Role       string    `json:"role"`
+	Active     bool      `json:"active"`
+	MFAEnabled bool      `json:"mfa_enabled"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type ResetPassword struct {
+	TemporaryPassword string `json:"temporary_password"`
+}
+
+type Invite struct {
+	ID        uuid.UUID   `json:"id"`
+	Email     string      `json:"email"`
+	Role      string      `json:"role"`
+	PvzIDs    []uuid.UUID `json:"pvz_ids"`
+	ExpiresAt time.Time   `json:"expires_at"`
+}
+
+type APIKey struct {
+	ID          uuid.UUID   `json:"id"`
+	Name        string      `json:"name"`
+	Prefix      string      `json:"prefix"`
+	Key         string      `json:"key,omitempty"`
+	Permissions []string    `json:"permissions"`
+	PvzIDs      []uuid.UUID `json:"pvz_ids"`
+	ExpiresAt   *time.Time  `json:"expires_at,omitempty"`
+	LastUsedAt  *time.Time  `json:"last_used_at,omitempty"`
+	RevokedAt   *time.Time  `json:"revoked_at,omitempty"`
+	CreatedAt   time.Time   `json:"created_at"`
 }
 
 type Reception struct {
@@ -46,3 +205,92 @@ type PvzWithReception struct {
 	Pvz        *Pvz         `json:"pvz"`
 	Receptions []*Reception `json:"receptions"`
 }
+
+type ReceptionWithProducts struct {
+	Reception      *Reception      `json:"reception"`
+	Products       []*Product      `json:"products"`
+	Reconciliation *Reconciliation `json:"reconciliation,omitempty"`
+}
+
+type Discrepancy struct {
+	Kind     string `json:"kind"`
+	Barcode  string `json:"barcode,omitempty"`
+	Type     string `json:"type,omitempty"`
+	Expected int    `json:"expected"`
+	Actual   int    `json:"actual"`
+}
+
+type Reconciliation struct {
+	Discrepancies []*Discrepancy `json:"discrepancies"`
+	Overridden    bool           `json:"overridden"`
+}
+
+// ClosedReception is a reception with reconciliation
+// report, which is omitted if there was no manifest.
+type ClosedReception struct {
+	*Reception
+	Reconciliation *Reconciliation `json:"reconciliation,omitempty"`
+	DamagedCount   int64           `json:"damaged_count"`
+	OpenedCount    int64           `json:"opened_count"`
+}
+
+// ReconciliationConflict is an error returned when
+// reception can't be closed because of discrepancies.
+type ReconciliationConflict struct {
+	Message        string          `json:"message"`
+	RequestID      string          `json:"request_id"`
+	Code           int             `json:"code"`
+	Reconciliation *Reconciliation `json:"reconciliation"`
+}
+
+// ReceptionSummary is a reception with count of
+// its products by product type and by condition.
+type ReceptionSummary struct {
+	ID            uuid.UUID        `json:"id"`
+	DateTime      time.Time        `json:"date_time"`
+	PvzID         uuid.UUID        `json:"pvz_id"`
+	Status        string           `json:"status"`
+	ProductCounts map[string]int64 `json:"product_counts"`
+	DamagedCount  int64            `json:"damaged_count"`
+	OpenedCount   int64            `json:"opened_count"`
+}
+
+type ReceptionPage struct {
+	Items      []*ReceptionSummary `json:"items"`
+	NextCursor string              `json:"next_cursor,omitempty"`
+}
+
+type PvzStats struct {
+	ReceptionsToday  int64 `json:"receptions_today"`
+	ProductsToday    int64 `json:"products_today"`
+	ProductsExpiring int64 `json:"products_expiring"`
+	ProductsToReturn int64 `json:"products_to_return"`
+	// Utilization is nil for PVZ without capacity.
+	Utilization     *float64 `json:"utilization,omitempty"`
+	CapacityWarning bool     `json:"capacity_warning"`
+}
+
+type PvzDetails struct {
+	Pvz                 *Pvz                   `json:"pvz"`
+	OpenReception       *ReceptionWithProducts `json:"open_reception,omitempty"`
+	LastClosedReception *Reception             `json:"last_closed_reception,omitempty"`
+	Stats               PvzStats               `json:"stats"`
+}
+
+type AuditEntry struct {
+	ID        int64           `json:"id"`
+	Action    string          `json:"action"`
+	ActorID   *uuid.UUID      `json:"actor_id,omitempty"`
+	ActorRole string          `json:"actor_role,omitempty"`
+	APIKeyID  *uuid.UUID      `json:"api_key_id,omitempty"`
+	IP        string          `json:"ip"`
+	UserAgent string          `json:"user_agent"`
+	RequestID string          `json:"request_id"`
+	Payload   json.RawMessage `json:"payload"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+type AuditPage struct {
+	Items      []*AuditEntry `json:"items"`
+	NextCursor string        `json:"next_cursor,omitempty"`
+}
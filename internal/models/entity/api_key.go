@@ -0,0 +1,52 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
+)
+
+// APIKey grants machine clients a fixed set of permissions,
+// optionally limited to specific PVZs.
+type APIKey struct {
+	ID          uuid.UUID
+	Name        string
+	Prefix      string
+	Permissions []Permission
+	PvzIDs      []uuid.UUID
+	ExpiresAt   *time.Time
+	LastUsedAt  *time.Time
+	RevokedAt   *time.Time
+	CreatedAt   time.Time
+
+	// Key is a plain key value. It is set only
+	// right after creation and never stored.
+	Key string
+}
+
+func (k *APIKey) ToResponse() *response.APIKey {
+	perms := make([]string, len(k.Permissions))
+	for i, p := range k.Permissions {
+		perms[i] = string(p)
+	}
+
+	return &response.APIKey{
+		ID:          k.ID,
+		Name:        k.Name,
+		Prefix:      k.Prefix,
+		Key:         k.Key,
+		Permissions: perms,
+		PvzIDs:      k.PvzIDs,
+		ExpiresAt:   k.ExpiresAt,
+		LastUsedAt:  k.LastUsedAt,
+		RevokedAt:   k.RevokedAt,
+		CreatedAt:   k.CreatedAt,
+	}
+}
+
+func (k *APIKey) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("entity.APIKey: direct JSON serialization forbidden, use response.APIKey")
+}
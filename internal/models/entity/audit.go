@@ -0,0 +1,84 @@
+package entity
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
+)
+
+// AuditAction is a kind of security relevant event.
+type AuditAction string
+
+const (
+	AuditLoginSuccess       AuditAction = "login.success"
+	AuditLoginFailure       AuditAction = "login.failure"
+	AuditTokenIssued        AuditAction = "token.issued"
+	AuditUserUpdated        AuditAction = "user.updated"
+	AuditPvzCreated         AuditAction = "pvz.created"
+	AuditPvzStatusChanged   AuditAction = "pvz.status_changed"
+	AuditPvzCapacityChanged AuditAction = "pvz.capacity_changed"
+	AuditCityCreated        AuditAction = "city.created"
+	AuditCityUpdated        AuditAction = "city.updated"
+	AuditProductTypeCreated AuditAction = "product_type.created"
+	AuditProductTypeUpdated AuditAction = "product_type.updated"
+	AuditProductTypeDeleted AuditAction = "product_type.deleted"
+	AuditReceptionOpened    AuditAction = "reception.opened"
+	AuditReceptionClosed    AuditAction = "reception.closed"
+	AuditReceptionReopened  AuditAction = "reception.reopened"
+	AuditReceptionCancelled AuditAction = "reception.cancelled"
+	AuditReceptionStale     AuditAction = "reception.stale"
+	AuditProductDeleted     AuditAction = "product.deleted"
+	AuditProductIssued      AuditAction = "product.issued"
+
+	AuditProductPickupCodeSet   AuditAction = "product.pickup_code_set"
+	AuditProductAttachmentAdded AuditAction = "product.attachment_added"
+	AuditReturnShipmentCreated  AuditAction = "return_shipment.created"
+	AuditStorageCellCreated     AuditAction = "storage_cell.created"
+	AuditTransferCreated        AuditAction = "transfer.created"
+	AuditTransferDispatched     AuditAction = "transfer.dispatched"
+	AuditTransferReceived       AuditAction = "transfer.received"
+)
+
+// AuditEntry is a single append-only audit log record.
+// Actor fields are empty for anonymous requests.
+type AuditEntry struct {
+	ID        int64
+	Action    AuditAction
+	ActorID   uuid.UUID
+	ActorRole Role
+	APIKeyID  uuid.UUID
+	IP        string
+	UserAgent string
+	RequestID string
+	Payload   json.RawMessage
+	CreatedAt time.Time
+}
+
+func (e *AuditEntry) ToResponse() *response.AuditEntry {
+	resp := &response.AuditEntry{
+		ID:        e.ID,
+		Action:    string(e.Action),
+		ActorRole: string(e.ActorRole),
+		IP:        e.IP,
+		UserAgent: e.UserAgent,
+		RequestID: e.RequestID,
+		Payload:   e.Payload,
+		CreatedAt: e.CreatedAt,
+	}
+	if e.ActorID != uuid.Nil {
+		resp.ActorID = &e.ActorID
+	}
+	if e.APIKeyID != uuid.Nil {
+		resp.APIKeyID = &e.APIKeyID
+	}
+
+	return resp
+}
+
+func (e *AuditEntry) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("entity.AuditEntry: direct JSON serialization forbidden, use response.AuditEntry")
+}
@@ -0,0 +1,65 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
+)
+
+// City is a city display name, PVZ refers to city by it.
+type City string
+
+// Cities seeded by migrations. The full list is
+// stored in DB and managed by moderators.
+const (
+	CityMoscow          City = "Москва"
+	CitySaintPetersburg City = "Санкт-Петербург"
+	CityKazan           City = "Казань"
+)
+
+func (c *City) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*c = City(s)
+	case string:
+		*c = City(s)
+	default:
+		return fmt.Errorf("unsupported scan type for City: %v", src)
+	}
+	return nil
+}
+
+func (c City) Value() (driver.Value, error) {
+	return string(c), nil
+}
+
+// CityInfo is a city reference record. New PVZ can be
+// created only in enabled cities.
+type CityInfo struct {
+	Code      string
+	Name      City
+	NameEn    string
+	Region    string
+	Timezone  string
+	Enabled   bool
+	CreatedAt time.Time
+}
+
+func (c *CityInfo) ToResponse() *response.City {
+	return &response.City{
+		Code:      c.Code,
+		Name:      string(c.Name),
+		NameEn:    c.NameEn,
+		Region:    c.Region,
+		Timezone:  c.Timezone,
+		Enabled:   c.Enabled,
+		CreatedAt: c.CreatedAt,
+	}
+}
+
+func (c *CityInfo) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("entity.CityInfo: direct JSON serialization forbidden, use response.City")
+}
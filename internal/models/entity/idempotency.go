@@ -0,0 +1,27 @@
+package entity
+
+// IdempotentRequest is a mutating call made with
+// client supplied idempotency key.
+type IdempotentRequest struct {
+	Key string
+	// Operation is HTTP method and path or gRPC method name.
+	Operation string
+	// Caller is authenticated caller's subject, so
+	// keys of different callers don't collide.
+	Caller string
+	Body   []byte
+}
+
+// IdempotentResponse is a stored result of the first
+// request with idempotency key.
+type IdempotentResponse struct {
+	StatusCode int
+	Body       []byte
+}
+
+// IdempotencyRecord is an idempotency key state.
+// Response is nil while first request is in progress.
+type IdempotencyRecord struct {
+	RequestHash string
+	Response    *IdempotentResponse
+}
@@ -0,0 +1,35 @@
+package entity
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
+)
+
+// Invite is a single-use permission to register
+// with given email and role.
+type Invite struct {
+	ID        uuid.UUID
+	Email     string
+	Role      Role
+	PvzIDs    []uuid.UUID
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
+func (i *Invite) ToResponse() *response.Invite {
+	return &response.Invite{
+		ID:        i.ID,
+		Email:     i.Email,
+		Role:      string(i.Role),
+		PvzIDs:    i.PvzIDs,
+		ExpiresAt: i.ExpiresAt,
+	}
+}
+
+func (i *Invite) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("entity.Invite: direct JSON serialization forbidden, use response.Invite")
+}
@@ -0,0 +1,213 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
+)
+
+// ProductState is a product lifecycle state
+// after it is accepted to PVZ.
+type ProductState string
+
+const (
+	ProductStateStored           ProductState = "stored"
+	ProductStateIssued           ProductState = "issued"
+	ProductStateToReturn         ProductState = "to_return"
+	ProductStateReturnedToSender ProductState = "returned_to_sender"
+	ProductStateInTransit        ProductState = "in_transit"
+)
+
+func (s *ProductState) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		*s = ProductState(v)
+	case string:
+		*s = ProductState(v)
+	default:
+		return fmt.Errorf("unsupported scan type for ProductState: %v", src)
+	}
+	return nil
+}
+
+func (s ProductState) Value() (driver.Value, error) {
+	return string(s), nil
+}
+
+// ProductCondition is a state of parcel packaging
+// marked by employee on intake.
+type ProductCondition string
+
+const (
+	ProductConditionOK      ProductCondition = "ok"
+	ProductConditionDamaged ProductCondition = "damaged"
+	ProductConditionOpened  ProductCondition = "opened"
+)
+
+var ProductConditions = map[ProductCondition]bool{
+	ProductConditionOK:      true,
+	ProductConditionDamaged: true,
+	ProductConditionOpened:  true,
+}
+
+func (c *ProductCondition) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		*c = ProductCondition(v)
+	case string:
+		*c = ProductCondition(v)
+	default:
+		return fmt.Errorf("unsupported scan type for ProductCondition: %v", src)
+	}
+	return nil
+}
+
+func (c ProductCondition) Value() (driver.Value, error) {
+	return string(c), nil
+}
+
+// DamageCounts is a number of reception
+// products accepted not in ok condition.
+type DamageCounts struct {
+	Damaged int64
+	Opened  int64
+}
+
+// ProductEventType is a kind of product history record.
+type ProductEventType string
+
+const (
+	ProductEventReceived         ProductEventType = "received"
+	ProductEventIssued           ProductEventType = "issued"
+	ProductEventExpired          ProductEventType = "expired"
+	ProductEventMoved            ProductEventType = "moved"
+	ProductEventReturnedToSender ProductEventType = "returned_to_sender"
+	// ProductEventDeleted is recorded when product
+	// is removed from reception by LIFO deletion.
+	ProductEventDeleted ProductEventType = "deleted"
+
+	ProductEventTransferDispatched ProductEventType = "transfer_dispatched"
+	ProductEventTransferReceived   ProductEventType = "transfer_received"
+)
+
+func (t *ProductEventType) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		*t = ProductEventType(v)
+	case string:
+		*t = ProductEventType(v)
+	default:
+		return fmt.Errorf("unsupported scan type for ProductEventType: %v", src)
+	}
+	return nil
+}
+
+func (t ProductEventType) Value() (driver.Value, error) {
+	return string(t), nil
+}
+
+// ProductEvent is a product history record. ActorID
+// is empty for events recorded by DB or system.
+type ProductEvent struct {
+	ID        int64
+	ProductID uuid.UUID
+	PvzID     uuid.UUID
+	Type      ProductEventType
+	ActorID   uuid.UUID
+	Details   json.RawMessage
+	CreatedAt time.Time
+}
+
+func (e *ProductEvent) ToResponse() *response.ProductEvent {
+	res := &response.ProductEvent{
+		ID:        e.ID,
+		ProductID: e.ProductID,
+		PvzID:     e.PvzID,
+		Type:      string(e.Type),
+		Details:   e.Details,
+		CreatedAt: e.CreatedAt,
+	}
+	if e.ActorID != uuid.Nil {
+		res.ActorID = &e.ActorID
+	}
+	return res
+}
+
+func (e *ProductEvent) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("entity.ProductEvent: direct JSON serialization forbidden, use response.ProductEvent")
+}
+
+// StoredProduct is a product on hand with
+// PVZ and city it is stored in.
+type StoredProduct struct {
+	Product *Product
+	PvzID   uuid.UUID
+	City    City
+}
+
+// ReturnShipment is an outbound shipment of
+// products sent back after storage period.
+type ReturnShipment struct {
+	ID        uuid.UUID
+	PvzID     uuid.UUID
+	CreatedBy uuid.UUID
+	CreatedAt time.Time
+	Products  []*Product
+}
+
+func (s *ReturnShipment) ToResponse() *response.ReturnShipment {
+	res := &response.ReturnShipment{
+		ID:        s.ID,
+		PvzID:     s.PvzID,
+		CreatedAt: s.CreatedAt,
+		Products:  make([]*response.Product, len(s.Products)),
+	}
+	if s.CreatedBy != uuid.Nil {
+		res.CreatedBy = &s.CreatedBy
+	}
+	for i, p := range s.Products {
+		res.Products[i] = p.ToResponse()
+	}
+	return res
+}
+
+func (s *ReturnShipment) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("entity.ReturnShipment: direct JSON serialization forbidden, use response.ReturnShipment")
+}
+
+// ProductAttachment is a file, usually photo of damaged
+// parcel, uploaded for product. File content is kept in
+// blob store by BlobKey.
+type ProductAttachment struct {
+	ID          uuid.UUID
+	ProductID   uuid.UUID
+	BlobKey     string
+	ContentType string
+	Size        int64
+	UploadedBy  uuid.UUID
+	CreatedAt   time.Time
+}
+
+func (a *ProductAttachment) ToResponse() *response.ProductAttachment {
+	res := &response.ProductAttachment{
+		ID:          a.ID,
+		ProductID:   a.ProductID,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		CreatedAt:   a.CreatedAt,
+	}
+	if a.UploadedBy != uuid.Nil {
+		res.UploadedBy = &a.UploadedBy
+	}
+	return res
+}
+
+func (a *ProductAttachment) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("entity.ProductAttachment: direct JSON serialization forbidden, use response.ProductAttachment")
+}
@@ -0,0 +1,121 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
+)
+
+// ProductType is a product type display name,
+// products refer to their type by it.
+type ProductType string
+
+// Product types seeded by migrations. The full list is
+// stored in DB and managed by moderators.
+const (
+	ProductTypeElectronics ProductType = "электроника"
+	ProductTypeClothes     ProductType = "одежда"
+	ProductTypeShoes       ProductType = "обувь"
+)
+
+func (c *ProductType) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*c = ProductType(s)
+	case string:
+		*c = ProductType(s)
+	default:
+		return fmt.Errorf("unsupported scan type for ProductType: %T", src)
+	}
+	return nil
+}
+
+func (c ProductType) Value() (driver.Value, error) {
+	return string(c), nil
+}
+
+// ProductAttribute describes attribute product of
+// some type can have, e.g. IMEI for electronics.
+// If Pattern is set, value must match it.
+type ProductAttribute struct {
+	Name     string
+	Required bool
+	Pattern  string
+}
+
+// ProductTypeInfo is a product type catalog record.
+type ProductTypeInfo struct {
+	Code       string
+	Name       ProductType
+	NameEn     string
+	Attributes []ProductAttribute
+	Fragile    bool
+	HighValue  bool
+	CreatedAt  time.Time
+}
+
+// ValidateAttributes checks product attributes against
+// type schema. Unknown attributes are rejected.
+func (t *ProductTypeInfo) ValidateAttributes(attrs map[string]string) error {
+	known := make(map[string]ProductAttribute, len(t.Attributes))
+	for _, a := range t.Attributes {
+		known[a.Name] = a
+	}
+
+	names := make([]string, 0, len(attrs))
+	for name := range attrs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		a, ok := known[name]
+		if !ok {
+			return fmt.Errorf("unknown attribute %q for product type %s", name, t.Name)
+		}
+		if a.Pattern == "" {
+			continue
+		}
+		if matched, err := regexp.MatchString(a.Pattern, attrs[name]); err != nil || !matched {
+			return fmt.Errorf("invalid value of attribute %q", name)
+		}
+	}
+
+	for _, a := range t.Attributes {
+		if a.Required && attrs[a.Name] == "" {
+			return fmt.Errorf("missing required attribute %q for product type %s", a.Name, t.Name)
+		}
+	}
+
+	return nil
+}
+
+func (t *ProductTypeInfo) ToResponse() *response.ProductType {
+	attrs := make([]response.ProductAttribute, len(t.Attributes))
+	for i, a := range t.Attributes {
+		attrs[i] = response.ProductAttribute{
+			Name:     a.Name,
+			Required: a.Required,
+			Pattern:  a.Pattern,
+		}
+	}
+
+	return &response.ProductType{
+		Code:       t.Code,
+		Name:       string(t.Name),
+		NameEn:     t.NameEn,
+		Attributes: attrs,
+		Fragile:    t.Fragile,
+		HighValue:  t.HighValue,
+		CreatedAt:  t.CreatedAt,
+	}
+}
+
+func (t *ProductTypeInfo) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("entity.ProductTypeInfo: direct JSON serialization forbidden, use response.ProductType")
+}
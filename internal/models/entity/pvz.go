@@ -11,48 +11,85 @@ import (
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
 )
 
-type City string
+// PvzStatus is a PVZ lifecycle state.
+type PvzStatus string
 
 const (
-	CityMoscow          City = "Москва"
-	CitySaintPetersburg City = "Санкт-Петербург"
-	CityKazan           City = "Казань"
+	PvzStatusActive            PvzStatus = "active"
+	PvzStatusTemporarilyClosed PvzStatus = "temporarily_closed"
+	PvzStatusDecommissioned    PvzStatus = "decommissioned"
 )
 
-func (c *City) Scan(src interface{}) error {
-	switch s := src.(type) {
+func (s *PvzStatus) Scan(src interface{}) error {
+	switch v := src.(type) {
 	case []byte:
-		*c = City(s)
+		*s = PvzStatus(v)
 	case string:
-		*c = City(s)
+		*s = PvzStatus(v)
 	default:
-		return fmt.Errorf("unsupported scan type for City: %v", src)
+		return fmt.Errorf("unsupported scan type for PvzStatus: %v", src)
 	}
 	return nil
 }
 
-func (c City) Value() (driver.Value, error) {
-	return string(c), nil
+func (s PvzStatus) Value() (driver.Value, error) {
+	return string(s), nil
 }
 
-var Cities = map[City]bool{
-	CityMoscow:          true,
-	CitySaintPetersburg: true,
-	CityKazan:           true,
+var PvzStatuses = map[PvzStatus]bool{
+	PvzStatusActive:            true,
+	PvzStatusTemporarilyClosed: true,
+	PvzStatusDecommissioned:    true,
 }
 
 type Pvz struct {
 	ID               uuid.UUID
 	RegistrationDate time.Time
 	City             City
+	Status           PvzStatus
+
+	// Capacity is max number of products on hand, 0 is
+	// unlimited. Reaching SoftCapacity only warns.
+	Capacity     int
+	SoftCapacity int
+	StockCount   int
+}
+
+// Utilization is a share of capacity taken by products
+// on hand, 0 for PVZ without capacity.
+func (pvz *Pvz) Utilization() float64 {
+	if pvz.Capacity == 0 {
+		return 0
+	}
+	return float64(pvz.StockCount) / float64(pvz.Capacity)
+}
+
+// CanAccept reports whether n more products fit into PVZ.
+func (pvz *Pvz) CanAccept(n int) bool {
+	return pvz.Capacity == 0 || pvz.StockCount+n <= pvz.Capacity
+}
+
+// OverSoftCapacity reports whether stock reached soft threshold.
+func (pvz *Pvz) OverSoftCapacity() bool {
+	return pvz.SoftCapacity > 0 && pvz.StockCount >= pvz.SoftCapacity
 }
 
 func (pvz *Pvz) ToResponse() *response.Pvz {
-	return &response.Pvz{
+	resp := &response.Pvz{
 		ID:               pvz.ID,
 		RegistrationDate: pvz.RegistrationDate,
 		City:             string(pvz.City),
+		Status:           string(pvz.Status),
+		StockCount:       pvz.StockCount,
+	}
+	if pvz.Capacity > 0 {
+		resp.Capacity = &pvz.Capacity
 	}
+	if pvz.SoftCapacity > 0 {
+		resp.SoftCapacity = &pvz.SoftCapacity
+	}
+
+	return resp
 }
 
 func (pvz *Pvz) MarshalJSON() ([]byte, error) {
@@ -79,3 +116,56 @@ func (pvzwr *PvzWithReception) ToResponse() *response.PvzWithReception {
 func (pvzwr *PvzWithReception) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("entity.PvzWithReception: direct JSON serialization forbidden, use recponse.PvzWithReception")
 }
+
+// PvzDetails is a PVZ with its current state.
+type PvzDetails struct {
+	Pvz *Pvz
+
+	// OpenReception is nil if there is no reception in progress.
+	OpenReception         *Reception
+	OpenReceptionProducts []*Product
+	LastClosedReception   *Reception
+
+	ReceptionsToday int64
+	ProductsToday   int64
+	// ProductsExpiring are stored products whose storage
+	// period ends soon, ProductsToReturn already expired.
+	ProductsExpiring int64
+	ProductsToReturn int64
+}
+
+func (d *PvzDetails) ToResponse() *response.PvzDetails {
+	resp := &response.PvzDetails{
+		Pvz: d.Pvz.ToResponse(),
+		Stats: response.PvzStats{
+			ReceptionsToday:  d.ReceptionsToday,
+			ProductsToday:    d.ProductsToday,
+			ProductsExpiring: d.ProductsExpiring,
+			ProductsToReturn: d.ProductsToReturn,
+			CapacityWarning:  d.Pvz.OverSoftCapacity(),
+		},
+	}
+	if d.Pvz.Capacity > 0 {
+		utilization := d.Pvz.Utilization()
+		resp.Stats.Utilization = &utilization
+	}
+	if d.OpenReception != nil {
+		products := make([]*response.Product, len(d.OpenReceptionProducts))
+		for i, p := range d.OpenReceptionProducts {
+			products[i] = p.ToResponse()
+		}
+		resp.OpenReception = &response.ReceptionWithProducts{
+			Reception: d.OpenReception.ToResponse(),
+			Products:  products,
+		}
+	}
+	if d.LastClosedReception != nil {
+		resp.LastClosedReception = d.LastClosedReception.ToResponse()
+	}
+
+	return resp
+}
+
+func (d *PvzDetails) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("entity.PvzDetails: direct JSON serialization forbidden, use response.PvzDetails")
+}
@@ -3,7 +3,6 @@ package entity
 import (
 	"database/sql/driver"
 	"errors"
-	"fmt"
 	"time"
 
 	"github.com/google/uuid"
@@ -15,12 +14,15 @@ type Status string
 
 const (
 	StatusInProgress Status = "in_progress"
-	StatusFinished   Status = "finished"
+	// StatusFinished matches status_enum value in DB.
+	StatusFinished  Status = "close"
+	StatusCancelled Status = "cancelled"
 )
 
 var Statuses = map[Status]bool{
 	StatusInProgress: true,
 	StatusFinished:   true,
+	StatusCancelled:  true,
 }
 
 func (r Status) Value() (driver.Value, error) {
@@ -32,36 +34,6 @@ func (r *Status) Scan(value interface{}) error {
 	return nil
 }
 
-type ProductType string
-
-const (
-	ProductTypeElectronics ProductType = "электроника"
-	ProductTypeClothes     ProductType = "одежда"
-	ProductTypeShoes       ProductType = "обувь"
-)
-
-func (c *ProductType) Scan(src interface{}) error {
-	switch s := src.(type) {
-	case []byte:
-		*c = ProductType(s)
-	case string:
-		*c = ProductType(s)
-	default:
-		return fmt.Errorf("unsupported scan type for ProductType: %T", src)
-	}
-	return nil
-}
-
-func (c ProductType) Value() (driver.Value, error) {
-	return string(c), nil
-}
-
-var ProductTypes = map[ProductType]bool{
-	ProductTypeElectronics: true,
-	ProductTypeClothes:     true,
-	ProductTypeShoes:       true,
-}
-
 type Reception struct {
 	ID       uuid.UUID
 	DateTime time.Time
@@ -87,17 +59,283 @@ type Product struct {
 	DateTime    time.Time
 	Type        ProductType
 	ReceptionID uuid.UUID
+	Attributes  map[string]string
+	Barcode     string
+	OrderID     string
+	State       ProductState
+	// PickupCodeHash is empty if product was
+	// accepted without pickup code.
+	PickupCodeHash string
+	SizeClass      SizeClass
+	// CellID is empty if product isn't placed into
+	// storage cell. Cell is set only when product
+	// is placed or moved.
+	CellID    uuid.UUID
+	Cell      *StorageCell
+	Condition ProductCondition
+	Notes     string
 }
 
 func (p *Product) ToResponse() *response.Product {
-	return &response.Product{
+	res := &response.Product{
 		ID:          p.ID,
 		DateTime:    p.DateTime,
 		ProductType: string(p.Type),
 		ReceptionID: p.ReceptionID,
+		Attributes:  p.Attributes,
+		Barcode:     p.Barcode,
+		OrderID:     p.OrderID,
+		State:       string(p.State),
+		SizeClass:   string(p.SizeClass),
+		Condition:   string(p.Condition),
+		Notes:       p.Notes,
+	}
+	if p.CellID != uuid.Nil {
+		res.CellID = &p.CellID
+	}
+	if p.Cell != nil {
+		res.Cell = p.Cell.ToResponse()
 	}
+	return res
 }
 
 func (p *Product) MarshalJSON() ([]byte, error) {
 	return nil, errors.New("entity.Product: direct JSON serialization forbidden, use response.Product")
 }
+
+// DuplicateProductError is returned when scanned
+// barcode was already accepted as Existing product.
+type DuplicateProductError struct {
+	Existing *Product
+}
+
+func (e *DuplicateProductError) Error() string {
+	return "product with barcode " + e.Existing.Barcode + " already accepted"
+}
+
+const (
+	BatchProductCreated   = "created"
+	BatchProductInvalid   = "invalid"
+	BatchProductDuplicate = "duplicate"
+	BatchProductSkipped   = "skipped"
+)
+
+// BatchProductResult is a result of one product of batch.
+// Product is set if batch was accepted. Err is set if the
+// product made batch rejected, other products are skipped.
+type BatchProductResult struct {
+	Product *Product
+	Err     error
+}
+
+// ProductsBatch is a result of batch intake, Results
+// follow order of products in request.
+type ProductsBatch struct {
+	Results []*BatchProductResult
+}
+
+// Accepted reports whether all products were valid.
+func (b *ProductsBatch) Accepted() bool {
+	for _, r := range b.Results {
+		if r.Err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+func (b *ProductsBatch) ToResponse() *response.BatchProducts {
+	results := make([]*response.BatchProductResult, len(b.Results))
+	for i, r := range b.Results {
+		results[i] = r.ToResponse()
+	}
+
+	return &response.BatchProducts{
+		Accepted: b.Accepted(),
+		Results:  results,
+	}
+}
+
+func (b *ProductsBatch) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("entity.ProductsBatch: direct JSON serialization forbidden, use response.BatchProducts")
+}
+
+func (r *BatchProductResult) ToResponse() *response.BatchProductResult {
+	var dup *DuplicateProductError
+	switch {
+	case r.Product != nil:
+		return &response.BatchProductResult{Status: BatchProductCreated, Product: r.Product.ToResponse()}
+	case errors.As(r.Err, &dup):
+		return &response.BatchProductResult{
+			Status:   BatchProductDuplicate,
+			Error:    dup.Error(),
+			Existing: dup.Existing.ToResponse(),
+		}
+	case r.Err != nil:
+		return &response.BatchProductResult{Status: BatchProductInvalid, Error: r.Err.Error()}
+	default:
+		return &response.BatchProductResult{Status: BatchProductSkipped}
+	}
+}
+
+// ReceptionDetails is a reception with its products,
+// last added product goes first.
+type ReceptionDetails struct {
+	Reception *Reception
+	Products  []*Product
+	// Reconciliation is set for closed receptions
+	// which had manifest.
+	Reconciliation *Reconciliation
+}
+
+func (d *ReceptionDetails) ToResponse() *response.ReceptionWithProducts {
+	products := make([]*response.Product, len(d.Products))
+	for i, p := range d.Products {
+		products[i] = p.ToResponse()
+	}
+
+	resp := &response.ReceptionWithProducts{
+		Reception: d.Reception.ToResponse(),
+		Products:  products,
+	}
+	if d.Reconciliation != nil {
+		resp.Reconciliation = d.Reconciliation.ToResponse()
+	}
+
+	return resp
+}
+
+func (d *ReceptionDetails) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("entity.ReceptionDetails: direct JSON serialization forbidden, use response.ReceptionWithProducts")
+}
+
+// OpenReception is an in-progress reception
+// along with city of its PVZ.
+type OpenReception struct {
+	Reception *Reception
+	City      City
+	// OpenedAt is when reception was opened or last reopened.
+	OpenedAt time.Time
+	// Alerted is set if stale alert was emitted since OpenedAt.
+	Alerted bool
+}
+
+// ReceptionSummary is a reception with count of
+// its products by product type and by condition.
+type ReceptionSummary struct {
+	Reception     *Reception
+	ProductCounts map[ProductType]int64
+	DamageCounts  DamageCounts
+}
+
+func (s *ReceptionSummary) ToResponse() *response.ReceptionSummary {
+	counts := make(map[string]int64, len(s.ProductCounts))
+	for t, c := range s.ProductCounts {
+		counts[string(t)] = c
+	}
+
+	return &response.ReceptionSummary{
+		ID:            s.Reception.ID,
+		DateTime:      s.Reception.DateTime,
+		PvzID:         s.Reception.PvzID,
+		Status:        string(s.Reception.Status),
+		ProductCounts: counts,
+		DamagedCount:  s.DamageCounts.Damaged,
+		OpenedCount:   s.DamageCounts.Opened,
+	}
+}
+
+func (s *ReceptionSummary) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("entity.ReceptionSummary: direct JSON serialization forbidden, use response.ReceptionSummary")
+}
+
+// ReceptionManifest is what supplier expects to arrive: exact
+// barcodes, number of products per type or both.
+type ReceptionManifest struct {
+	Barcodes   []string
+	TypeCounts map[ProductType]int
+}
+
+const (
+	DiscrepancyMissing    = "missing"
+	DiscrepancyUnexpected = "unexpected"
+	DiscrepancyOverCount  = "over_count"
+)
+
+// Discrepancy is a mismatch between manifest and accepted
+// products. Barcode is set for barcode mismatches, Type
+// for mismatches of per-type counts.
+type Discrepancy struct {
+	Kind     string
+	Barcode  string
+	Type     ProductType
+	Expected int
+	Actual   int
+}
+
+// Reconciliation is a result of comparing reception
+// products with its manifest on close.
+type Reconciliation struct {
+	Discrepancies []*Discrepancy
+	// Overridden is set if reception was closed
+	// by moderator despite discrepancies.
+	Overridden bool
+}
+
+func (r *Reconciliation) ToResponse() *response.Reconciliation {
+	discrepancies := make([]*response.Discrepancy, len(r.Discrepancies))
+	for i, d := range r.Discrepancies {
+		discrepancies[i] = &response.Discrepancy{
+			Kind:     d.Kind,
+			Barcode:  d.Barcode,
+			Type:     string(d.Type),
+			Expected: d.Expected,
+			Actual:   d.Actual,
+		}
+	}
+
+	return &response.Reconciliation{
+		Discrepancies: discrepancies,
+		Overridden:    r.Overridden,
+	}
+}
+
+func (r *Reconciliation) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("entity.Reconciliation: direct JSON serialization forbidden, use response.Reconciliation")
+}
+
+// ReconciliationError is returned when reception can't
+// be closed because of discrepancies with manifest.
+type ReconciliationError struct {
+	Reconciliation *Reconciliation
+}
+
+func (e *ReconciliationError) Error() string {
+	return "reception has discrepancies with manifest"
+}
+
+// ClosedReception is a closed reception with result of
+// reconciliation, which is nil if there was no manifest,
+// and count of products accepted damaged or opened.
+type ClosedReception struct {
+	Reception      *Reception
+	Reconciliation *Reconciliation
+	DamageCounts   DamageCounts
+}
+
+func (c *ClosedReception) ToResponse() *response.ClosedReception {
+	resp := &response.ClosedReception{
+		Reception:    c.Reception.ToResponse(),
+		DamagedCount: c.DamageCounts.Damaged,
+		OpenedCount:  c.DamageCounts.Opened,
+	}
+	if c.Reconciliation != nil {
+		resp.Reconciliation = c.Reconciliation.ToResponse()
+	}
+
+	return resp
+}
+
+func (c *ClosedReception) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("entity.ClosedReception: direct JSON serialization forbidden, use response.ClosedReception")
+}
@@ -0,0 +1,94 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
+)
+
+// SizeClass is a parcel size. Product fits
+// into cell of the same or bigger size class.
+type SizeClass string
+
+const (
+	SizeClassSmall  SizeClass = "small"
+	SizeClassMedium SizeClass = "medium"
+	SizeClassLarge  SizeClass = "large"
+)
+
+var SizeClasses = map[SizeClass]bool{
+	SizeClassSmall:  true,
+	SizeClassMedium: true,
+	SizeClassLarge:  true,
+}
+
+var sizeClassOrder = map[SizeClass]int{
+	SizeClassSmall:  0,
+	SizeClassMedium: 1,
+	SizeClassLarge:  2,
+}
+
+// Fits checks if product of size class c
+// can be placed into cell of size class cell.
+func (c SizeClass) Fits(cell SizeClass) bool {
+	return sizeClassOrder[c] <= sizeClassOrder[cell]
+}
+
+func (c *SizeClass) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		*c = SizeClass(v)
+	case string:
+		*c = SizeClass(v)
+	default:
+		return fmt.Errorf("unsupported scan type for SizeClass: %v", src)
+	}
+	return nil
+}
+
+func (c SizeClass) Value() (driver.Value, error) {
+	return string(c), nil
+}
+
+// StorageCell is a shelf place in PVZ. Occupied is
+// number of products on hand placed into it.
+type StorageCell struct {
+	ID        uuid.UUID
+	PvzID     uuid.UUID
+	Zone      string
+	Rack      int
+	Shelf     int
+	SizeClass SizeClass
+	Capacity  int
+	Occupied  int
+	CreatedAt time.Time
+}
+
+// Label is a cell address printed on shelves.
+func (c *StorageCell) Label() string {
+	return fmt.Sprintf("%s-%d-%d", c.Zone, c.Rack, c.Shelf)
+}
+
+func (c *StorageCell) ToResponse() *response.StorageCell {
+	return &response.StorageCell{
+		ID:        c.ID,
+		PvzID:     c.PvzID,
+		Label:     c.Label(),
+		Zone:      c.Zone,
+		Rack:      c.Rack,
+		Shelf:     c.Shelf,
+		SizeClass: string(c.SizeClass),
+		Capacity:  c.Capacity,
+		Occupied:  c.Occupied,
+		CreatedAt: c.CreatedAt,
+	}
+}
+
+func (c *StorageCell) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("entity.StorageCell: direct JSON serialization forbidden, use response.StorageCell")
+}
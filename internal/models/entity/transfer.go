@@ -0,0 +1,100 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
+)
+
+// TransferStatus is a stage of products
+// moving from one PVZ to another.
+type TransferStatus string
+
+const (
+	TransferStatusCreated   TransferStatus = "created"
+	TransferStatusInTransit TransferStatus = "in_transit"
+	TransferStatusReceived  TransferStatus = "received"
+)
+
+var TransferStatuses = map[TransferStatus]bool{
+	TransferStatusCreated:   true,
+	TransferStatusInTransit: true,
+	TransferStatusReceived:  true,
+}
+
+func (s *TransferStatus) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case []byte:
+		*s = TransferStatus(v)
+	case string:
+		*s = TransferStatus(v)
+	default:
+		return fmt.Errorf("unsupported scan type for TransferStatus: %v", src)
+	}
+	return nil
+}
+
+func (s TransferStatus) Value() (driver.Value, error) {
+	return string(s), nil
+}
+
+// Transfer is a shipment of stored products to neighbour
+// PVZ. ReceptionID is set when destination receives it.
+type Transfer struct {
+	ID           uuid.UUID
+	FromPvzID    uuid.UUID
+	ToPvzID      uuid.UUID
+	Status       TransferStatus
+	ReceptionID  uuid.UUID
+	CreatedBy    uuid.UUID
+	CreatedAt    time.Time
+	DispatchedAt time.Time
+	ReceivedAt   time.Time
+	Products     []*Product
+}
+
+func (t *Transfer) ToResponse() *response.Transfer {
+	res := &response.Transfer{
+		ID:        t.ID,
+		FromPvzID: t.FromPvzID,
+		ToPvzID:   t.ToPvzID,
+		Status:    string(t.Status),
+		CreatedAt: t.CreatedAt,
+		Products:  make([]*response.Product, len(t.Products)),
+	}
+	if t.ReceptionID != uuid.Nil {
+		res.ReceptionID = &t.ReceptionID
+	}
+	if t.CreatedBy != uuid.Nil {
+		res.CreatedBy = &t.CreatedBy
+	}
+	if !t.DispatchedAt.IsZero() {
+		res.DispatchedAt = &t.DispatchedAt
+	}
+	if !t.ReceivedAt.IsZero() {
+		res.ReceivedAt = &t.ReceivedAt
+	}
+	for i, p := range t.Products {
+		res.Products[i] = p.ToResponse()
+	}
+	return res
+}
+
+func (t *Transfer) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("entity.Transfer: direct JSON serialization forbidden, use response.Transfer")
+}
+
+// TransferCandidate is a product state checked
+// before it is added to transfer.
+type TransferCandidate struct {
+	ProductID       uuid.UUID
+	State           ProductState
+	PvzID           uuid.UUID
+	ReceptionStatus Status
+	InTransfer      bool
+}
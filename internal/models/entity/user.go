@@ -3,6 +3,8 @@ package entity
 import (
 	"database/sql/driver"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -14,35 +16,75 @@ type Role string
 const (
 	RoleEmployee  Role = "employee"
 	RoleModerator Role = "moderator"
+	// RoleSystem is role of background jobs,
+	// no user can have it.
+	RoleSystem Role = "system"
 )
 
-// for fast-checking.
-var Roles = map[Role]bool{
-	RoleEmployee:  true,
-	RoleModerator: true,
-}
-
 func (r Role) Value() (driver.Value, error) {
 	return string(r), nil
 }
 
-func (r *Role) Scan(value interface{}) error {
-	*r = Role(string(value.([]byte)))
+func (r *Role) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*r = Role(s)
+	case string:
+		*r = Role(s)
+	default:
+		return fmt.Errorf("unsupported scan type for Role: %T", src)
+	}
 	return nil
 }
 
+// Permission is an action, that can be granted to role.
+// Role -> permissions mapping is stored in DB.
+type Permission string
+
+const (
+	PermPvzCreate         Permission = "pvz:create"
+	PermPvzManage         Permission = "pvz:manage"
+	PermReceptionWrite    Permission = "reception:write"
+	PermReceptionManage   Permission = "reception:manage"
+	PermReportRead        Permission = "report:read"
+	PermUserManage        Permission = "user:manage"
+	PermAPIKeyManage      Permission = "apikey:manage"
+	PermAuditRead         Permission = "audit:read"
+	PermCityManage        Permission = "city:manage"
+	PermProductTypeManage Permission = "product_type:manage"
+	PermProductIssue      Permission = "product:issue"
+	PermProductReturn     Permission = "product:return"
+	PermProductMove       Permission = "product:move"
+	PermProductTransfer   Permission = "product:transfer"
+	PermCellManage        Permission = "cell:manage"
+)
+
 type User struct {
-	ID       uuid.UUID
-	Email    string
-	Password string
-	Role     Role
+	ID        uuid.UUID
+	Email     string
+	Password  string
+	Role      Role
+	Active    bool
+	CreatedAt time.Time
+
+	// TokenVersion is embedded into user's JWT. Bumping it
+	// invalidates all tokens issued before.
+	TokenVersion int32
+
+	// MFASecret is TOTP secret. It is set only
+	// when user is loaded for MFA check.
+	MFASecret  string
+	MFAEnabled bool
 }
 
 func (u *User) ToResponse() *response.User {
 	return &response.User{
-		ID:    u.ID,
-		Email: u.Email,
-		Role:  string(u.Role),
+		ID:         u.ID,
+		Email:      u.Email,
+		Role:       string(u.Role),
+		Active:     u.Active,
+		MFAEnabled: u.MFAEnabled,
+		CreatedAt:  u.CreatedAt,
 	}
 }
 
@@ -1,41 +1,84 @@
+//go:generate mockgen -source=./auth.go -destination=./mocks/auth.go -package=mocks
+
 package auth
 
 import (
+	"context"
 	"errors"
+	"log"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 
 	"github.com/myacey/avito-backend-assignment-pvz/internal/httpserver/handler"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/jwttoken"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
 )
 
 const (
 	HeaderAuthorization = "Authorization"
+	HeaderAPIKey        = "X-Api-Key"
 
 	CtxKeyUserType = "User-Type"
 )
 
 type TokenChecker interface {
-	VerifyToken(token string) (map[string]interface{}, error)
+	VerifyToken(ctx context.Context, token string) (map[string]interface{}, error)
+	VerifyMFAToken(ctx context.Context, token string) (uuid.UUID, error)
+}
+
+type PermissionChecker interface {
+	HasPermissions(ctx context.Context, role entity.Role, needed ...entity.Permission) (bool, error)
+}
+
+type APIKeyChecker interface {
+	Authenticate(ctx context.Context, key string) (*entity.APIKey, error)
+}
+
+// UserPvzGetter returns PVZs user is assigned to.
+type UserPvzGetter interface {
+	ListPvzIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+}
+
+// Access declares how callers of a route are checked.
+// Zero value denies everyone.
+type Access struct {
+	// Public routes are open to unauthenticated callers.
+	Public bool
+	// MFA routes accept MFA challenge tokens, see MFAMiddleware.
+	MFA bool
+	// Permissions are needed to call the route.
+	Permissions []entity.Permission
+	// OverridePermissions are needed in addition to Permissions,
+	// when route is called with override=true.
+	OverridePermissions []entity.Permission
 }
 
 type Service struct {
 	tokenSrv TokenChecker
+	permSrv  PermissionChecker
+	keySrv   APIKeyChecker
+	userPvz  UserPvzGetter
 }
 
-func New(tokenSrv TokenChecker) *Service {
+func New(tokenSrv TokenChecker, permSrv PermissionChecker, keySrv APIKeyChecker, userPvz UserPvzGetter) *Service {
 	return &Service{
 		tokenSrv: tokenSrv,
+		permSrv:  permSrv,
+		keySrv:   keySrv,
+		userPvz:  userPvz,
 	}
 }
 
-func getToken(ctx *gin.Context) (string, error) {
-	bearerToken := ctx.GetHeader(HeaderAuthorization)
-	splitToken := strings.Split(bearerToken, " ")
+func getToken(authHeader string) (string, error) {
+	splitToken := strings.Split(authHeader, " ")
 	if len(splitToken) != 2 {
 		return "", errors.New("invalid token")
 	}
@@ -45,51 +88,175 @@ func getToken(ctx *gin.Context) (string, error) {
 	return tokenStr, nil
 }
 
-func (s *Service) AuthMiddleware(neededRole ...entity.Role) gin.HandlerFunc {
-	return func(ctx *gin.Context) {
-		token, err := getToken(ctx)
+func abortWithError(ctx *gin.Context, code int, msg string) {
+	ctx.AbortWithStatusJSON(code, response.Error{
+		Code:      code,
+		Message:   msg,
+		RequestID: ctx.GetHeader(handler.HeaderRequestID),
+	})
+}
+
+// Authorize authenticates caller by api key or, if it's empty,
+// by bearer token and checks that caller is granted with
+// all of needed permissions. Moderators have access to all
+// PVZs, other users only to PVZs they are assigned to.
+func (s *Service) Authorize(ctx context.Context, apiKey, authHeader string, needed ...entity.Permission) (*principal.Principal, error) {
+	if apiKey != "" {
+		return s.authorizeAPIKey(ctx, apiKey, needed...)
+	}
+
+	token, err := getToken(authHeader)
+	if err != nil {
+		return nil, apperror.NewUnauthorized(err.Error())
+	}
+
+	claims, err := s.tokenSrv.VerifyToken(ctx, token)
+	if err != nil {
+		return nil, apperror.NewUnauthorized(err.Error())
+	}
+
+	r, ok := claims[jwttoken.JwtClaimRole].(string)
+	if !ok {
+		return nil, apperror.NewUnauthorized("invalid role")
+	}
+
+	granted, err := s.permSrv.HasPermissions(ctx, entity.Role(r), needed...)
+	if err != nil {
+		return nil, apperror.NewInternal("failed to check permissions", err)
+	}
+	if !granted {
+		return nil, apperror.NewForbidden("permission denied")
+	}
+
+	p := &principal.Principal{Role: entity.Role(r)}
+	if id, ok := claims[jwttoken.JwtClaimID].(string); ok {
+		p.UserID, _ = uuid.Parse(id)
+	}
+	switch {
+	case p.Role == entity.RoleModerator:
+		p.AllPvz = true
+	case p.UserID == uuid.Nil:
+		// dummy tokens have no user to assign PVZs to
+		p.AllPvz = true
+	default:
+		p.PvzIDs, err = s.userPvz.ListPvzIDs(ctx, p.UserID)
 		if err != nil {
-			ctx.AbortWithStatusJSON(http.StatusUnauthorized, response.Error{
-				Code:      http.StatusUnauthorized,
-				Message:   err.Error(),
-				RequestID: ctx.GetHeader(handler.HeaderRequestID),
-			})
-			return
+			return nil, apperror.NewInternal("failed to get user pvz", err)
+		}
+	}
+
+	return p, nil
+}
+
+// authorizeAPIKey checks that api key is granted with
+// all of needed permissions.
+func (s *Service) authorizeAPIKey(ctx context.Context, apiKey string, needed ...entity.Permission) (*principal.Principal, error) {
+	key, err := s.keySrv.Authenticate(ctx, apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	granted := make(map[entity.Permission]bool, len(key.Permissions))
+	for _, p := range key.Permissions {
+		granted[p] = true
+	}
+	for _, p := range needed {
+		if !granted[p] {
+			return nil, apperror.NewForbidden("permission denied")
 		}
+	}
+
+	return &principal.Principal{
+		APIKeyID: key.ID,
+		AllPvz:   len(key.PvzIDs) == 0,
+		PvzIDs:   key.PvzIDs,
+	}, nil
+}
 
-		claims, err := s.tokenSrv.VerifyToken(token)
+// MFAMiddleware authenticates user for MFA enrollment.
+// Both regular and MFA challenge tokens are accepted,
+// so users with mandatory MFA can enroll before login.
+func (s *Service) MFAMiddleware() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		token, err := getToken(ctx.GetHeader(HeaderAuthorization))
 		if err != nil {
-			ctx.AbortWithStatusJSON(http.StatusUnauthorized, response.Error{
-				Code:      http.StatusUnauthorized,
-				Message:   err.Error(),
-				RequestID: ctx.GetHeader(handler.HeaderRequestID),
-			})
+			abortWithError(ctx, http.StatusUnauthorized, err.Error())
 			return
 		}
 
-		r, ok := claims[jwttoken.JwtClaimRole]
-		if !ok {
-			ctx.AbortWithStatusJSON(http.StatusUnauthorized, response.Error{
-				Code:      http.StatusUnauthorized,
-				Message:   "invalid role",
-				RequestID: ctx.GetHeader(handler.HeaderRequestID),
-			})
+		id, err := s.tokenSrv.VerifyMFAToken(ctx, token)
+		if err != nil {
+			claims, err := s.tokenSrv.VerifyToken(ctx, token)
+			if err != nil {
+				abortWithError(ctx, http.StatusUnauthorized, err.Error())
+				return
+			}
+
+			idStr, _ := claims[jwttoken.JwtClaimID].(string)
+			if id, err = uuid.Parse(idStr); err != nil {
+				abortWithError(ctx, http.StatusForbidden, "mfa is available only for registered users")
+				return
+			}
 		}
 
-		for _, nRole := range neededRole {
-			if r.(string) != string(nRole) {
-				continue
+		ctx.Set(principal.CtxKey, &principal.Principal{UserID: id})
+		ctx.Next()
+	}
+}
+
+// PermissionMiddleware checks that caller's role or api key
+// is granted with all of needed permissions.
+func (s *Service) PermissionMiddleware(needed ...entity.Permission) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		p, err := s.Authorize(ctx, ctx.GetHeader(HeaderAPIKey), ctx.GetHeader(HeaderAuthorization), needed...)
+		if err != nil {
+			code, msg := http.StatusInternalServerError, "failed to authorize"
+			debugErr := err
+
+			var httpErr apperror.HTTPError
+			if errors.As(err, &httpErr) {
+				code, msg, debugErr = httpErr.Code, httpErr.Message, httpErr.DebugError
+			}
+			if code == http.StatusInternalServerError {
+				log.Printf("%s: %v", msg, debugErr)
 			}
 
-			ctx.Set(CtxKeyUserType, claims[jwttoken.JwtClaimRole])
+			abortWithError(ctx, code, msg)
+			return
+		}
+
+		ctx.Set(principal.CtxKey, p)
+		ctx.Set(CtxKeyUserType, string(p.Role))
+		ctx.Next()
+	}
+}
+
+// RouteMiddleware checks caller against access declared for
+// matched route before handler runs. Routes missing from
+// routes are denied, so a new route can't be left unprotected.
+// Requests not matching any route are passed on to get 404.
+func (s *Service) RouteMiddleware(routes map[web.Route]Access) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		path := ctx.FullPath()
+		if path == "" {
 			ctx.Next()
 			return
 		}
 
-		ctx.AbortWithStatusJSON(http.StatusUnauthorized, response.Error{
-			Code:      http.StatusUnauthorized,
-			Message:   "invalid role",
-			RequestID: ctx.GetHeader(handler.HeaderRequestID),
-		})
+		access, ok := routes[web.Route{Method: ctx.Request.Method, Path: path}]
+		switch {
+		case !ok:
+			abortWithError(ctx, http.StatusForbidden, "permission denied")
+		case access.Public:
+			ctx.Next()
+		case access.MFA:
+			s.MFAMiddleware()(ctx)
+		default:
+			needed := access.Permissions
+			if override, _ := strconv.ParseBool(ctx.Query("override")); override {
+				needed = append(needed[:len(needed):len(needed)], access.OverridePermissions...)
+			}
+			s.PermissionMiddleware(needed...)(ctx)
+		}
 	}
 }
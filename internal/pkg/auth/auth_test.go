@@ -0,0 +1,240 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/auth"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/auth/mocks"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/jwttoken"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+)
+
+var errMock = errors.New("mock error")
+
+func TestAuthorize(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	tokenSrv := mocks.NewMockTokenChecker(ctrl)
+	permSrv := mocks.NewMockPermissionChecker(ctrl)
+	keySrv := mocks.NewMockAPIKeyChecker(ctrl)
+	userPvz := mocks.NewMockUserPvzGetter(ctrl)
+
+	srv := auth.New(tokenSrv, permSrv, keySrv, userPvz)
+
+	userID := uuid.New()
+	pvzIDs := []uuid.UUID{uuid.New(), uuid.New()}
+	userClaims := map[string]interface{}{
+		jwttoken.JwtClaimID:   userID.String(),
+		jwttoken.JwtClaimRole: string(entity.RoleEmployee),
+	}
+
+	testCases := []struct {
+		name         string
+		apiKey       string
+		mockBehavior func()
+		expRes       *principal.Principal
+		expErr       error
+	}{
+		{
+			name: "user limited to assigned pvz",
+			mockBehavior: func() {
+				tokenSrv.EXPECT().VerifyToken(gomock.Any(), "token").Return(userClaims, nil)
+				permSrv.EXPECT().HasPermissions(gomock.Any(), entity.RoleEmployee, entity.PermReceptionWrite).Return(true, nil)
+				userPvz.EXPECT().ListPvzIDs(gomock.Any(), userID).Return(pvzIDs, nil)
+			},
+			expRes: &principal.Principal{UserID: userID, Role: entity.RoleEmployee, PvzIDs: pvzIDs},
+			expErr: nil,
+		},
+		{
+			name: "user without assignments",
+			mockBehavior: func() {
+				tokenSrv.EXPECT().VerifyToken(gomock.Any(), "token").Return(userClaims, nil)
+				permSrv.EXPECT().HasPermissions(gomock.Any(), entity.RoleEmployee, entity.PermReceptionWrite).Return(true, nil)
+				userPvz.EXPECT().ListPvzIDs(gomock.Any(), userID).Return([]uuid.UUID{}, nil)
+			},
+			expRes: &principal.Principal{UserID: userID, Role: entity.RoleEmployee, PvzIDs: []uuid.UUID{}},
+			expErr: nil,
+		},
+		{
+			name: "dummy token",
+			mockBehavior: func() {
+				tokenSrv.EXPECT().VerifyToken(gomock.Any(), "token").Return(map[string]interface{}{
+					jwttoken.JwtClaimRole: string(entity.RoleEmployee),
+				}, nil)
+				permSrv.EXPECT().HasPermissions(gomock.Any(), entity.RoleEmployee, entity.PermReceptionWrite).Return(true, nil)
+			},
+			expRes: &principal.Principal{Role: entity.RoleEmployee, AllPvz: true},
+			expErr: nil,
+		},
+		{
+			name: "moderator has access to all pvz",
+			mockBehavior: func() {
+				tokenSrv.EXPECT().VerifyToken(gomock.Any(), "token").Return(map[string]interface{}{
+					jwttoken.JwtClaimID:   userID.String(),
+					jwttoken.JwtClaimRole: string(entity.RoleModerator),
+				}, nil)
+				permSrv.EXPECT().HasPermissions(gomock.Any(), entity.RoleModerator, entity.PermReceptionWrite).Return(true, nil)
+			},
+			expRes: &principal.Principal{UserID: userID, Role: entity.RoleModerator, AllPvz: true},
+			expErr: nil,
+		},
+		{
+			name: "get pvz unk err",
+			mockBehavior: func() {
+				tokenSrv.EXPECT().VerifyToken(gomock.Any(), "token").Return(userClaims, nil)
+				permSrv.EXPECT().HasPermissions(gomock.Any(), entity.RoleEmployee, entity.PermReceptionWrite).Return(true, nil)
+				userPvz.EXPECT().ListPvzIDs(gomock.Any(), userID).Return(nil, errMock)
+			},
+			expRes: nil,
+			expErr: apperror.NewInternal("failed to get user pvz", errMock),
+		},
+		{
+			name: "permission denied",
+			mockBehavior: func() {
+				tokenSrv.EXPECT().VerifyToken(gomock.Any(), "token").Return(userClaims, nil)
+				permSrv.EXPECT().HasPermissions(gomock.Any(), entity.RoleEmployee, entity.PermReceptionWrite).Return(false, nil)
+			},
+			expRes: nil,
+			expErr: apperror.NewForbidden("permission denied"),
+		},
+		{
+			name:   "api key limited to its pvz",
+			apiKey: "key",
+			mockBehavior: func() {
+				keySrv.EXPECT().Authenticate(gomock.Any(), "key").Return(&entity.APIKey{
+					ID:          userID,
+					Permissions: []entity.Permission{entity.PermReceptionWrite},
+					PvzIDs:      pvzIDs,
+				}, nil)
+			},
+			expRes: &principal.Principal{APIKeyID: userID, PvzIDs: pvzIDs},
+			expErr: nil,
+		},
+		{
+			name:   "api key without pvz list",
+			apiKey: "key",
+			mockBehavior: func() {
+				keySrv.EXPECT().Authenticate(gomock.Any(), "key").Return(&entity.APIKey{
+					ID:          userID,
+					Permissions: []entity.Permission{entity.PermReceptionWrite},
+				}, nil)
+			},
+			expRes: &principal.Principal{APIKeyID: userID, AllPvz: true},
+			expErr: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := srv.Authorize(context.Background(), tc.apiKey, "Bearer token", entity.PermReceptionWrite)
+			require.Equal(t, tc.expErr, err)
+			require.Equal(t, tc.expRes, res)
+		})
+	}
+}
+
+func TestRouteMiddleware(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	tokenSrv := mocks.NewMockTokenChecker(ctrl)
+	permSrv := mocks.NewMockPermissionChecker(ctrl)
+
+	srv := auth.New(tokenSrv, permSrv, nil, nil)
+
+	routes := map[web.Route]auth.Access{
+		{Method: http.MethodPost, Path: "/login"}: {Public: true},
+		{Method: http.MethodPost, Path: "/pvz/:pvzId/close_last_reception"}: {
+			Permissions:         []entity.Permission{entity.PermReceptionWrite},
+			OverridePermissions: []entity.Permission{entity.PermReceptionManage},
+		},
+	}
+	moderatorClaims := map[string]interface{}{
+		jwttoken.JwtClaimRole: string(entity.RoleModerator),
+	}
+
+	testCases := []struct {
+		name         string
+		method       string
+		path         string
+		mockBehavior func()
+		expCode      int
+	}{
+		{
+			name:         "public",
+			method:       http.MethodPost,
+			path:         "/login",
+			mockBehavior: func() {},
+			expCode:      http.StatusOK,
+		},
+		{
+			name:   "permitted",
+			method: http.MethodPost,
+			path:   "/pvz/" + uuid.NewString() + "/close_last_reception",
+			mockBehavior: func() {
+				tokenSrv.EXPECT().VerifyToken(gomock.Any(), "token").Return(moderatorClaims, nil)
+				permSrv.EXPECT().HasPermissions(gomock.Any(), entity.RoleModerator, entity.PermReceptionWrite).Return(true, nil)
+			},
+			expCode: http.StatusOK,
+		},
+		{
+			name:   "override needs extra permission",
+			method: http.MethodPost,
+			path:   "/pvz/" + uuid.NewString() + "/close_last_reception?override=true",
+			mockBehavior: func() {
+				tokenSrv.EXPECT().VerifyToken(gomock.Any(), "token").Return(moderatorClaims, nil)
+				permSrv.EXPECT().HasPermissions(gomock.Any(), entity.RoleModerator, entity.PermReceptionWrite, entity.PermReceptionManage).Return(false, nil)
+			},
+			expCode: http.StatusForbidden,
+		},
+		{
+			name:         "route without access denied",
+			method:       http.MethodGet,
+			path:         "/undeclared",
+			mockBehavior: func() {},
+			expCode:      http.StatusForbidden,
+		},
+		{
+			name:         "unknown route",
+			method:       http.MethodGet,
+			path:         "/unknown",
+			mockBehavior: func() {},
+			expCode:      http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gin.SetMode(gin.TestMode)
+			rec := httptest.NewRecorder()
+			r := gin.New()
+			r.Use(srv.RouteMiddleware(routes))
+
+			ok := func(ctx *gin.Context) { ctx.Status(http.StatusOK) }
+			r.POST("/login", ok)
+			r.POST("/pvz/:pvzId/close_last_reception", ok)
+			r.GET("/undeclared", ok)
+
+			tc.mockBehavior()
+
+			req := httptest.NewRequest(tc.method, tc.path, nil)
+			req.Header.Set(auth.HeaderAuthorization, "Bearer token")
+			r.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.expCode, rec.Code)
+		})
+	}
+}
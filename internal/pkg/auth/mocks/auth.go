@@ -0,0 +1,186 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./auth.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	entity "github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+)
+
+// MockTokenChecker is a mock of TokenChecker interface.
+type MockTokenChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockTokenCheckerMockRecorder
+}
+
+// MockTokenCheckerMockRecorder is the mock recorder for MockTokenChecker.
+type MockTokenCheckerMockRecorder struct {
+	mock *MockTokenChecker
+}
+
+// NewMockTokenChecker creates a new mock instance.
+func NewMockTokenChecker(ctrl *gomock.Controller) *MockTokenChecker {
+	mock := &MockTokenChecker{ctrl: ctrl}
+	mock.recorder = &MockTokenCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTokenChecker) EXPECT() *MockTokenCheckerMockRecorder {
+	return m.recorder
+}
+
+// VerifyMFAToken mocks base method.
+func (m *MockTokenChecker) VerifyMFAToken(ctx context.Context, token string) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyMFAToken", ctx, token)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyMFAToken indicates an expected call of VerifyMFAToken.
+func (mr *MockTokenCheckerMockRecorder) VerifyMFAToken(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyMFAToken", reflect.TypeOf((*MockTokenChecker)(nil).VerifyMFAToken), ctx, token)
+}
+
+// VerifyToken mocks base method.
+func (m *MockTokenChecker) VerifyToken(ctx context.Context, token string) (map[string]interface{}, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyToken", ctx, token)
+	ret0, _ := ret[0].(map[string]interface{})
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyToken indicates an expected call of VerifyToken.
+func (mr *MockTokenCheckerMockRecorder) VerifyToken(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyToken", reflect.TypeOf((*MockTokenChecker)(nil).VerifyToken), ctx, token)
+}
+
+// MockPermissionChecker is a mock of PermissionChecker interface.
+type MockPermissionChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockPermissionCheckerMockRecorder
+}
+
+// MockPermissionCheckerMockRecorder is the mock recorder for MockPermissionChecker.
+type MockPermissionCheckerMockRecorder struct {
+	mock *MockPermissionChecker
+}
+
+// NewMockPermissionChecker creates a new mock instance.
+func NewMockPermissionChecker(ctrl *gomock.Controller) *MockPermissionChecker {
+	mock := &MockPermissionChecker{ctrl: ctrl}
+	mock.recorder = &MockPermissionCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPermissionChecker) EXPECT() *MockPermissionCheckerMockRecorder {
+	return m.recorder
+}
+
+// HasPermissions mocks base method.
+func (m *MockPermissionChecker) HasPermissions(ctx context.Context, role entity.Role, needed ...entity.Permission) (bool, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, role}
+	for _, a := range needed {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "HasPermissions", varargs...)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasPermissions indicates an expected call of HasPermissions.
+func (mr *MockPermissionCheckerMockRecorder) HasPermissions(ctx, role interface{}, needed ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, role}, needed...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasPermissions", reflect.TypeOf((*MockPermissionChecker)(nil).HasPermissions), varargs...)
+}
+
+// MockAPIKeyChecker is a mock of APIKeyChecker interface.
+type MockAPIKeyChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIKeyCheckerMockRecorder
+}
+
+// MockAPIKeyCheckerMockRecorder is the mock recorder for MockAPIKeyChecker.
+type MockAPIKeyCheckerMockRecorder struct {
+	mock *MockAPIKeyChecker
+}
+
+// NewMockAPIKeyChecker creates a new mock instance.
+func NewMockAPIKeyChecker(ctrl *gomock.Controller) *MockAPIKeyChecker {
+	mock := &MockAPIKeyChecker{ctrl: ctrl}
+	mock.recorder = &MockAPIKeyCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIKeyChecker) EXPECT() *MockAPIKeyCheckerMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockAPIKeyChecker) Authenticate(ctx context.Context, key string) (*entity.APIKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", ctx, key)
+	ret0, _ := ret[0].(*entity.APIKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockAPIKeyCheckerMockRecorder) Authenticate(ctx, key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockAPIKeyChecker)(nil).Authenticate), ctx, key)
+}
+
+// MockUserPvzGetter is a mock of UserPvzGetter interface.
+type MockUserPvzGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserPvzGetterMockRecorder
+}
+
+// MockUserPvzGetterMockRecorder is the mock recorder for MockUserPvzGetter.
+type MockUserPvzGetterMockRecorder struct {
+	mock *MockUserPvzGetter
+}
+
+// NewMockUserPvzGetter creates a new mock instance.
+func NewMockUserPvzGetter(ctrl *gomock.Controller) *MockUserPvzGetter {
+	mock := &MockUserPvzGetter{ctrl: ctrl}
+	mock.recorder = &MockUserPvzGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserPvzGetter) EXPECT() *MockUserPvzGetterMockRecorder {
+	return m.recorder
+}
+
+// ListPvzIDs mocks base method.
+func (m *MockUserPvzGetter) ListPvzIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPvzIDs", ctx, userID)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPvzIDs indicates an expected call of ListPvzIDs.
+func (mr *MockUserPvzGetterMockRecorder) ListPvzIDs(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPvzIDs", reflect.TypeOf((*MockUserPvzGetter)(nil).ListPvzIDs), ctx, userID)
+}
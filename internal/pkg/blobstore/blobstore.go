@@ -0,0 +1,122 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+const DriverLocal = "local"
+
+var ErrNotFound = errors.New("blob not found")
+
+type Config struct {
+	Driver string `mapstructure:"driver"`
+	Dir    string `mapstructure:"dir"`
+}
+
+// BlobStore keeps file contents by key.
+type BlobStore interface {
+	Put(ctx context.Context, key string, r io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// New returns blob store chosen by config driver.
+// Only local filesystem is supported for now.
+func New(cfg Config) (BlobStore, error) {
+	switch cfg.Driver {
+	case "", DriverLocal:
+		if cfg.Dir == "" {
+			return nil, fmt.Errorf("blobstore: dir is required for %q driver", DriverLocal)
+		}
+		return NewLocalStore(cfg.Dir)
+	default:
+		return nil, fmt.Errorf("blobstore: unknown driver %q", cfg.Driver)
+	}
+}
+
+// LocalStore keeps blobs as files under dir,
+// key is a slash separated path relative to it.
+type LocalStore struct {
+	dir string
+}
+
+func NewLocalStore(dir string) (*LocalStore, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return nil, fmt.Errorf("blobstore: %w", err)
+	}
+	return &LocalStore{dir: dir}, nil
+}
+
+// Put writes blob to temporary file first, so
+// partially written blob is never read by key.
+func (s *LocalStore) Put(_ context.Context, key string, r io.Reader) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return err
+	}
+
+	f, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), path)
+}
+
+func (s *LocalStore) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		switch {
+		case errors.Is(err, os.ErrNotExist):
+			return nil, ErrNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	return f, nil
+}
+
+// Delete removes blob, missing blob is not an error.
+func (s *LocalStore) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// path rejects keys pointing outside of store dir.
+func (s *LocalStore) path(key string) (string, error) {
+	key = filepath.FromSlash(key)
+	if !filepath.IsLocal(key) {
+		return "", fmt.Errorf("blobstore: invalid key %q", key)
+	}
+	return filepath.Join(s.dir, key), nil
+}
@@ -1,6 +1,7 @@
 package jwttoken
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -13,21 +14,59 @@ const (
 	JwtClaimID   = "uuid"
 	JwtClaimRole = "role"
 	JwtClaimExp  = "exp"
+	JwtClaimVer  = "ver"
+	JwtClaimMFA  = "mfa"
+
+	defaultMFATokenTTL = 5 * time.Minute
+)
+
+var (
+	ErrTokenRevoked        = errors.New("token revoked")
+	ErrInvalidToken        = errors.New("invalid token")
+	ErrDummyTokensDisabled = errors.New("dummy tokens are disabled")
 )
 
+// VersionGetter returns current token version of user.
+type VersionGetter interface {
+	GetTokenVersion(ctx context.Context, id uuid.UUID) (int32, error)
+}
+
 type TokenServiceConfig struct {
-	SecretKey string `mapstructure:"jwt_secret_key"`
+	SecretKey   string        `mapstructure:"jwt_secret_key"`
+	MFATokenTTL time.Duration `mapstructure:"mfa_token_ttl"`
+
+	// AllowDummyTokens enables tokens without user id.
+	// It is set from environment mode, not from config file.
+	AllowDummyTokens bool `mapstructure:"-"`
 }
 
 type Service struct {
-	secretKey []byte
+	secretKey   []byte
+	mfaTokenTTL time.Duration
+	allowDummy  bool
+
+	versions VersionGetter
 }
 
-func New(cfg TokenServiceConfig) *Service {
-	return &Service{[]byte(cfg.SecretKey)}
+func New(cfg TokenServiceConfig, versions VersionGetter) *Service {
+	mfaTokenTTL := cfg.MFATokenTTL
+	if mfaTokenTTL <= 0 {
+		mfaTokenTTL = defaultMFATokenTTL
+	}
+
+	return &Service{
+		secretKey:   []byte(cfg.SecretKey),
+		mfaTokenTTL: mfaTokenTTL,
+		allowDummy:  cfg.AllowDummyTokens,
+		versions:    versions,
+	}
 }
 
 func (s *Service) CreateDummyToken(role string) (string, error) {
+	if !s.allowDummy {
+		return "", ErrDummyTokensDisabled
+	}
+
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
 		JwtClaimRole: role,
 		JwtClaimExp:  time.Now().Add(time.Hour * 24).Unix(),
@@ -40,10 +79,11 @@ func (s *Service) CreateDummyToken(role string) (string, error) {
 	return tokenStr, nil
 }
 
-func (s *Service) CreateUserToken(id uuid.UUID, role string) (string, error) {
+func (s *Service) CreateUserToken(id uuid.UUID, role string, version int32) (string, error) {
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
 		JwtClaimID:   id.String(),
 		JwtClaimRole: role,
+		JwtClaimVer:  version,
 		JwtClaimExp:  time.Now().Add(time.Hour * 24).Unix(),
 	})
 	tokenStr, err := token.SignedString(s.secretKey)
@@ -54,7 +94,65 @@ func (s *Service) CreateUserToken(id uuid.UUID, role string) (string, error) {
 	return tokenStr, nil
 }
 
-func (s *Service) VerifyToken(tokenStr string) (map[string]interface{}, error) {
+// CreateMFAToken returns short-lived MFA challenge token.
+// It can't be used as a regular token and is only
+// exchanged for one after second factor check.
+func (s *Service) CreateMFAToken(id uuid.UUID, version int32) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		JwtClaimID:  id.String(),
+		JwtClaimVer: version,
+		JwtClaimMFA: true,
+		JwtClaimExp: time.Now().Add(s.mfaTokenTTL).Unix(),
+	})
+	tokenStr, err := token.SignedString(s.secretKey)
+	if err != nil {
+		return "", err
+	}
+
+	return tokenStr, nil
+}
+
+// VerifyToken checks token signature and expiration.
+// For user tokens it also checks that token version
+// matches the current one, so revoked tokens are rejected.
+// MFA challenge tokens are rejected, as well as dummy
+// tokens, if they are disabled.
+func (s *Service) VerifyToken(ctx context.Context, tokenStr string) (map[string]interface{}, error) {
+	claims, err := s.parse(ctx, tokenStr)
+	if err != nil {
+		return nil, err
+	}
+	if isMFA, _ := claims[JwtClaimMFA].(bool); isMFA {
+		return nil, ErrInvalidToken
+	}
+	if _, ok := claims[JwtClaimID].(string); !ok && !s.allowDummy {
+		return nil, ErrDummyTokensDisabled
+	}
+
+	return claims, nil
+}
+
+// VerifyMFAToken checks MFA challenge token
+// and returns user id it was issued for.
+func (s *Service) VerifyMFAToken(ctx context.Context, tokenStr string) (uuid.UUID, error) {
+	claims, err := s.parse(ctx, tokenStr)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	if isMFA, _ := claims[JwtClaimMFA].(bool); !isMFA {
+		return uuid.Nil, ErrInvalidToken
+	}
+
+	idStr, _ := claims[JwtClaimID].(string)
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return uuid.Nil, ErrInvalidToken
+	}
+
+	return id, nil
+}
+
+func (s *Service) parse(ctx context.Context, tokenStr string) (jwt.MapClaims, error) {
 	token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
 		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
 			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
@@ -67,11 +165,47 @@ func (s *Service) VerifyToken(tokenStr string) (map[string]interface{}, error) {
 	}
 
 	if !token.Valid {
-		return nil, errors.New("invalid token")
+		return nil, ErrInvalidToken
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, ErrInvalidToken
+	}
+
+	if err := s.checkVersion(ctx, claims); err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+// checkVersion compares token version with user's current one.
+// Dummy tokens have no user id and are not checked.
+func (s *Service) checkVersion(ctx context.Context, claims jwt.MapClaims) error {
+	idStr, ok := claims[JwtClaimID].(string)
+	if !ok {
+		return nil
+	}
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return ErrInvalidToken
 	}
 
-	if claims, ok := token.Claims.(jwt.MapClaims); ok {
-		return claims, nil
+	// tokens issued before versioning have no version claim
+	var ver int32
+	if v, ok := claims[JwtClaimVer].(float64); ok {
+		ver = int32(v)
 	}
-	return nil, errors.New("invalid token")
+
+	current, err := s.versions.GetTokenVersion(ctx, id)
+	if err != nil {
+		return ErrTokenRevoked
+	}
+	if current != ver {
+		return ErrTokenRevoked
+	}
+
+	return nil
 }
@@ -0,0 +1,87 @@
+package leader
+
+import (
+	"context"
+	"database/sql"
+	"log"
+	"sync"
+)
+
+// Elector elects single replica with Postgres session
+// advisory lock. Lock is held by dedicated connection
+// and is released by Postgres if connection breaks.
+type Elector struct {
+	db  *sql.DB
+	key int64
+
+	mu   sync.Mutex
+	conn *sql.Conn
+}
+
+func New(db *sql.DB, key int64) *Elector {
+	return &Elector{
+		db:  db,
+		key: key,
+	}
+}
+
+// IsLeader reports whether this replica holds the lock,
+// trying to take it if it doesn't.
+func (e *Elector) IsLeader(ctx context.Context) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	if e.conn != nil {
+		err := e.conn.PingContext(ctx)
+		if err == nil {
+			return true
+		}
+		if ctx.Err() != nil {
+			return false
+		}
+
+		log.Printf("leader: lost lock %d: %v", e.key, err)
+		e.release()
+	}
+
+	conn, err := e.db.Conn(ctx)
+	if err != nil {
+		log.Printf("leader: failed to get connection: %v", err)
+		return false
+	}
+
+	var ok bool
+	if err := conn.QueryRowContext(ctx, "SELECT pg_try_advisory_lock($1)", e.key).Scan(&ok); err != nil || !ok {
+		if err != nil {
+			log.Printf("leader: failed to take lock %d: %v", e.key, err)
+		}
+		conn.Close()
+		return false
+	}
+
+	log.Printf("leader: took lock %d", e.key)
+	e.conn = conn
+	return true
+}
+
+// Release gives up the lock, so other replica can take it.
+func (e *Elector) Release() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.release()
+}
+
+func (e *Elector) release() {
+	if e.conn == nil {
+		return
+	}
+
+	// connection goes back to pool, so lock must
+	// be released explicitly
+	if _, err := e.conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", e.key); err != nil {
+		log.Printf("leader: failed to release lock %d: %v", e.key, err)
+	}
+	e.conn.Close()
+	e.conn = nil
+}
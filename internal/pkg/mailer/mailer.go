@@ -0,0 +1,97 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	DriverStdout = "stdout"
+	DriverFile   = "file"
+)
+
+type Config struct {
+	Driver   string `mapstructure:"driver"`
+	FilePath string `mapstructure:"file_path"`
+	From     string `mapstructure:"from"`
+}
+
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Mailer delivers messages to users.
+type Mailer interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// New returns mailer chosen by config driver.
+// Only local drivers are supported for now.
+func New(cfg Config) (Mailer, error) {
+	switch cfg.Driver {
+	case "", DriverStdout:
+		return NewWriterMailer(os.Stdout, cfg.From), nil
+	case DriverFile:
+		if cfg.FilePath == "" {
+			return nil, fmt.Errorf("mailer: file_path is required for %q driver", DriverFile)
+		}
+		return NewFileMailer(cfg.FilePath, cfg.From), nil
+	default:
+		return nil, fmt.Errorf("mailer: unknown driver %q", cfg.Driver)
+	}
+}
+
+// WriterMailer writes messages to w in plain text.
+type WriterMailer struct {
+	mu   sync.Mutex
+	w    io.Writer
+	from string
+}
+
+func NewWriterMailer(w io.Writer, from string) *WriterMailer {
+	return &WriterMailer{w: w, from: from}
+}
+
+func (m *WriterMailer) Send(_ context.Context, msg Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return writeMessage(m.w, m.from, msg)
+}
+
+// FileMailer appends messages to file,
+// so they can be read in tests and local environment.
+type FileMailer struct {
+	mu   sync.Mutex
+	path string
+	from string
+}
+
+func NewFileMailer(path, from string) *FileMailer {
+	return &FileMailer{path: path, from: from}
+}
+
+func (m *FileMailer) Send(_ context.Context, msg Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	f, err := os.OpenFile(m.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return writeMessage(f, m.from, msg)
+}
+
+func writeMessage(w io.Writer, from string, msg Message) error {
+	_, err := fmt.Fprintf(w, "Date: %s\nFrom: %s\nTo: %s\nSubject: %s\n\n%s\n\n",
+		time.Now().Format(time.RFC1123Z), from, msg.To, msg.Subject, msg.Body)
+	return err
+}
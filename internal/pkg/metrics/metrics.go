@@ -72,6 +72,78 @@ func AddProduct() {
 	addedProductCount.Inc()
 }
 
+// staleReceptionCount - counter of receptions open longer
+// than threshold with Vector2: city, action (alerted, closed).
+var staleReceptionCount = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "stale.reception.total",
+		Help: "Total number of stale receptions by city and taken action",
+	},
+	[]string{"city", "action"},
+)
+
+func StaleReception(city, action string) {
+	staleReceptionCount.WithLabelValues(city, action).Inc()
+}
+
+// expiredProductCount - counter of products marked for
+// return after storage period with Vector1: city.
+var expiredProductCount = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "expired.product.total",
+		Help: "Total number of products expired in storage by city",
+	},
+	[]string{"city"},
+)
+
+func ExpiredProduct(city string) {
+	expiredProductCount.WithLabelValues(city).Inc()
+}
+
+// pvzStock - gauge of products on hand with Vector1: pvz_id.
+var pvzStock = promauto.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "pvz.stock.count",
+		Help: "Number of products on hand by PVZ",
+	},
+	[]string{"pvz_id"},
+)
+
+// pvzUtilization - gauge of capacity share taken by products
+// on hand with Vector1: pvz_id. Only PVZ with capacity are set.
+var pvzUtilization = promauto.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "pvz.utilization.ratio",
+		Help: "Share of PVZ capacity taken by products on hand",
+	},
+	[]string{"pvz_id"},
+)
+
+// capacityWarningCount - counter of intakes over soft
+// capacity with Vector1: pvz_id.
+var capacityWarningCount = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "pvz.capacity.warning.total",
+		Help: "Total number of intakes over PVZ soft capacity",
+	},
+	[]string{"pvz_id"},
+)
+
+// SetPvzStock updates PVZ stock gauges, utilization
+// is dropped for PVZ without capacity.
+func SetPvzStock(pvzID string, stock, capacity int) {
+	pvzStock.WithLabelValues(pvzID).Set(float64(stock))
+	if capacity == 0 {
+		pvzUtilization.DeleteLabelValues(pvzID)
+		return
+	}
+	pvzUtilization.WithLabelValues(pvzID).Set(float64(stock) / float64(capacity))
+}
+
+func CapacityWarning(pvzID string) {
+	capacityWarningCount.WithLabelValues(pvzID).Inc()
+}
+
 func StartMetricsServer() {
 	http.Handle("/metrics", promhttp.Handler())
 	go func() {
@@ -0,0 +1,93 @@
+package principal
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+)
+
+// CtxKey is a key principal is stored under in gin context.
+// gin.Context only resolves string keys.
+const CtxKey = "principal"
+
+type ctxKey struct{}
+
+// Principal is an authenticated caller: a user
+// with bearer token or an API key.
+type Principal struct {
+	UserID   uuid.UUID
+	Role     entity.Role
+	APIKeyID uuid.UUID
+
+	// AllPvz grants access to every PVZ. It is set only for
+	// unscoped callers, otherwise access is limited to PvzIDs,
+	// so caller without assigned PVZs has access to none.
+	AllPvz bool
+	PvzIDs []uuid.UUID
+}
+
+// System is actor of background jobs.
+var System = &Principal{Role: entity.RoleSystem, AllPvz: true}
+
+// IsAPIKey reports whether caller authenticated with API key.
+func (p *Principal) IsAPIKey() bool {
+	return p.APIKeyID != uuid.Nil
+}
+
+// Subject identifies caller across requests: API key or user ID.
+// Callers with dummy tokens have no ID, so they are told by role.
+func (p *Principal) Subject() string {
+	switch {
+	case p.IsAPIKey():
+		return "key:" + p.APIKeyID.String()
+	case p.UserID != uuid.Nil:
+		return "user:" + p.UserID.String()
+	default:
+		return "role:" + string(p.Role)
+	}
+}
+
+// CanAccessPvz checks if caller is allowed to work with PVZ.
+func (p *Principal) CanAccessPvz(pvzID uuid.UUID) bool {
+	if p.AllPvz {
+		return true
+	}
+
+	for _, id := range p.PvzIDs {
+		if id == pvzID {
+			return true
+		}
+	}
+
+	return false
+}
+
+// PvzScope returns PVZs caller is limited to for filtering
+// queries, nil if caller has access to all of them.
+func (p *Principal) PvzScope() []uuid.UUID {
+	if p.AllPvz {
+		return nil
+	}
+	if p.PvzIDs == nil {
+		return []uuid.UUID{}
+	}
+	return p.PvzIDs
+}
+
+// NewContext returns a copy of ctx that carries p.
+func NewContext(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, ctxKey{}, p)
+}
+
+// FromContext returns principal stored with NewContext
+// or set into gin context under CtxKey.
+func FromContext(ctx context.Context) (*Principal, bool) {
+	if p, ok := ctx.Value(ctxKey{}).(*Principal); ok {
+		return p, true
+	}
+
+	p, ok := ctx.Value(CtxKey).(*Principal)
+	return p, ok
+}
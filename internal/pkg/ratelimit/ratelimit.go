@@ -0,0 +1,88 @@
+package ratelimit
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	defaultLimit  = 5
+	defaultWindow = 15 * time.Minute
+)
+
+type Config struct {
+	Limit  int           `mapstructure:"limit"`
+	Window time.Duration `mapstructure:"window"`
+}
+
+type bucket struct {
+	count   int
+	resetAt time.Time
+}
+
+// Limiter is an in-memory fixed window rate limiter.
+// Every key can be used Limit times per Window.
+type Limiter struct {
+	limit  int
+	window time.Duration
+
+	mu       sync.Mutex
+	buckets  map[string]*bucket
+	prunedAt time.Time
+}
+
+func New(cfg Config) *Limiter {
+	limit, window := cfg.Limit, cfg.Window
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	if window <= 0 {
+		window = defaultWindow
+	}
+
+	return &Limiter{
+		limit:    limit,
+		window:   window,
+		buckets:  make(map[string]*bucket),
+		prunedAt: time.Now(),
+	}
+}
+
+// Allow reports whether key can be used now.
+// If not, it also returns time left until window resets.
+func (l *Limiter) Allow(key string) (bool, time.Duration) {
+	now := time.Now()
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.prune(now)
+
+	b, ok := l.buckets[key]
+	if !ok || !now.Before(b.resetAt) {
+		b = &bucket{resetAt: now.Add(l.window)}
+		l.buckets[key] = b
+	}
+
+	if b.count >= l.limit {
+		return false, b.resetAt.Sub(now)
+	}
+
+	b.count++
+	return true, 0
+}
+
+// prune drops expired buckets once per window,
+// so map doesn't grow with every new key.
+func (l *Limiter) prune(now time.Time) {
+	if now.Sub(l.prunedAt) < l.window {
+		return
+	}
+
+	for key, b := range l.buckets {
+		if !now.Before(b.resetAt) {
+			delete(l.buckets, key)
+		}
+	}
+	l.prunedAt = now
+}
@@ -0,0 +1,98 @@
+package rbac
+
+import (
+	"context"
+	"time"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/refcache"
+)
+
+const defaultCacheTTL = time.Minute
+
+type Config struct {
+	CacheTTL time.Duration `mapstructure:"cache_ttl"`
+}
+
+// PermissionLoader loads role -> permissions mapping
+// from persistent storage.
+type PermissionLoader interface {
+	GetRolePermissions(ctx context.Context) (map[entity.Role][]entity.Permission, error)
+}
+
+// Service is a cached role -> permissions lookup.
+// Mapping is reloaded from storage once cache TTL expires,
+// so new roles can be added without restart.
+type Service struct {
+	loader PermissionLoader
+
+	cache *refcache.Cache[entity.Role, map[entity.Permission]bool]
+}
+
+func New(cfg Config, loader PermissionLoader) *Service {
+	ttl := cfg.CacheTTL
+	if ttl <= 0 {
+		ttl = defaultCacheTTL
+	}
+
+	s := &Service{
+		loader: loader,
+	}
+	s.cache = refcache.New(ttl, s.load)
+
+	return s
+}
+
+// HasRole checks if role exists.
+func (s *Service) HasRole(ctx context.Context, role entity.Role) (bool, error) {
+	perms, err := s.cache.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	_, ok := perms[role]
+	return ok, nil
+}
+
+// HasPermissions checks if role is granted with all of needed permissions.
+func (s *Service) HasPermissions(ctx context.Context, role entity.Role, needed ...entity.Permission) (bool, error) {
+	perms, err := s.cache.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	granted, ok := perms[role]
+	if !ok {
+		return false, nil
+	}
+
+	for _, p := range needed {
+		if !granted[p] {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// Invalidate drops cached mapping.
+func (s *Service) Invalidate() {
+	s.cache.Invalidate()
+}
+
+func (s *Service) load(ctx context.Context) (map[entity.Role]map[entity.Permission]bool, error) {
+	res, err := s.loader.GetRolePermissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	perms := make(map[entity.Role]map[entity.Permission]bool, len(res))
+	for role, rolePerms := range res {
+		perms[role] = make(map[entity.Permission]bool, len(rolePerms))
+		for _, p := range rolePerms {
+			perms[role][p] = true
+		}
+	}
+
+	return perms, nil
+}
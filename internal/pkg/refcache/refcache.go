@@ -0,0 +1,64 @@
+package refcache
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Cache keeps a snapshot of reference data (roles, cities,
+// product types) and reloads it once ttl expires, so
+// changes made on other instances are picked up without
+// restart.
+type Cache[K comparable, V any] struct {
+	ttl  time.Duration
+	load func(ctx context.Context) (map[K]V, error)
+
+	mu       sync.RWMutex
+	items    map[K]V
+	loadedAt time.Time
+}
+
+func New[K comparable, V any](ttl time.Duration, load func(ctx context.Context) (map[K]V, error)) *Cache[K, V] {
+	return &Cache[K, V]{
+		ttl:  ttl,
+		load: load,
+	}
+}
+
+// Get returns cached snapshot, reloading it if it is missing
+// or expired.
+func (c *Cache[K, V]) Get(ctx context.Context) (map[K]V, error) {
+	c.mu.RLock()
+	items, loadedAt := c.items, c.loadedAt
+	c.mu.RUnlock()
+
+	if items != nil && time.Since(loadedAt) < c.ttl {
+		return items, nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// other goroutine could reload it while we were waiting
+	if c.items != nil && time.Since(c.loadedAt) < c.ttl {
+		return c.items, nil
+	}
+
+	items, err := c.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	c.items = items
+	c.loadedAt = time.Now()
+
+	return items, nil
+}
+
+// Invalidate drops cached snapshot, so next Get reloads it.
+func (c *Cache[K, V]) Invalidate() {
+	c.mu.Lock()
+	c.items = nil
+	c.mu.Unlock()
+}
@@ -0,0 +1,32 @@
+package reqmeta
+
+import "context"
+
+// CtxKey is a key request metadata is stored under in gin context.
+// gin.Context only resolves string keys.
+const CtxKey = "request-meta"
+
+type ctxKey struct{}
+
+// Meta describes where request came from.
+type Meta struct {
+	IP        string
+	UserAgent string
+	RequestID string
+}
+
+// NewContext returns a copy of ctx that carries m.
+func NewContext(ctx context.Context, m *Meta) context.Context {
+	return context.WithValue(ctx, ctxKey{}, m)
+}
+
+// FromContext returns metadata stored with NewContext
+// or set into gin context under CtxKey.
+func FromContext(ctx context.Context) (*Meta, bool) {
+	if m, ok := ctx.Value(ctxKey{}).(*Meta); ok {
+		return m, true
+	}
+
+	m, ok := ctx.Value(CtxKey).(*Meta)
+	return m, ok
+}
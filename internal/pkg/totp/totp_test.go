@@ -0,0 +1,107 @@
+package totp_test
+
+import (
+	"encoding/base32"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/totp"
+)
+
+// rfcSecret is SHA1 seed of RFC 6238 Appendix B.
+var rfcSecret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+// TestCodeRFC6238 checks codes against RFC 6238 Appendix B
+// SHA1 test vectors. RFC lists 8 digit codes, 6 digit code
+// is the same value modulo 10^6, i.e. its last 6 digits.
+func TestCodeRFC6238(t *testing.T) {
+	testCases := []struct {
+		unix    int64
+		expCode string
+	}{
+		{unix: 59, expCode: "94287082"},
+		{unix: 1111111109, expCode: "07081804"},
+		{unix: 1111111111, expCode: "14050471"},
+		{unix: 1234567890, expCode: "89005924"},
+		{unix: 2000000000, expCode: "69279037"},
+		{unix: 20000000000, expCode: "65353130"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expCode, func(t *testing.T) {
+			code, err := totp.Code(rfcSecret, time.Unix(tc.unix, 0))
+			require.NoError(t, err)
+			require.Equal(t, tc.expCode[2:], code)
+
+			step, ok := totp.Validate(rfcSecret, tc.expCode[2:], time.Unix(tc.unix, 0))
+			require.True(t, ok)
+			require.Equal(t, tc.unix/30, step)
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	step := now.Unix() / 30
+
+	testCases := []struct {
+		name    string
+		code    string
+		secret  string
+		expStep int64
+		expOK   bool
+	}{
+		{name: "current step", code: "050471", secret: rfcSecret, expStep: step, expOK: true},
+		{name: "previous step", code: mustCode(t, now.Add(-30*time.Second)), secret: rfcSecret, expStep: step - 1, expOK: true},
+		{name: "next step", code: mustCode(t, now.Add(30*time.Second)), secret: rfcSecret, expStep: step + 1, expOK: true},
+		{name: "out of skew", code: mustCode(t, now.Add(-90*time.Second)), secret: rfcSecret},
+		{name: "wrong code", code: "000000", secret: rfcSecret},
+		{name: "wrong length", code: "14050471", secret: rfcSecret},
+		{name: "invalid secret", code: "050471", secret: "not base32!"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			step, ok := totp.Validate(tc.secret, tc.code, now)
+			require.Equal(t, tc.expOK, ok)
+			require.Equal(t, tc.expStep, step)
+		})
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	secret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+
+	other, err := totp.GenerateSecret()
+	require.NoError(t, err)
+	require.NotEqual(t, secret, other)
+
+	code, err := totp.Code(secret, time.Now())
+	require.NoError(t, err)
+	require.Len(t, code, 6)
+}
+
+func TestURL(t *testing.T) {
+	u, err := url.Parse(totp.URL("PVZ", "user@example.com", rfcSecret))
+	require.NoError(t, err)
+
+	require.Equal(t, "otpauth", u.Scheme)
+	require.Equal(t, "totp", u.Host)
+	require.Equal(t, "/PVZ:user@example.com", u.Path)
+	require.Equal(t, rfcSecret, u.Query().Get("secret"))
+	require.Equal(t, "PVZ", u.Query().Get("issuer"))
+	require.Equal(t, "6", u.Query().Get("digits"))
+	require.Equal(t, "30", u.Query().Get("period"))
+}
+
+func mustCode(t *testing.T, at time.Time) string {
+	t.Helper()
+
+	code, err := totp.Code(rfcSecret, at)
+	require.NoError(t, err)
+	return code
+}
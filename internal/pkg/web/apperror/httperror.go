@@ -23,3 +23,23 @@ func NewBadReq(msg string) error {
 func NewUnauthorized(msg string) error {
 	return HTTPError{Code: http.StatusUnauthorized, Message: msg}
 }
+
+func NewForbidden(msg string) error {
+	return HTTPError{Code: http.StatusForbidden, Message: msg}
+}
+
+func NewNotFound(msg string) error {
+	return HTTPError{Code: http.StatusNotFound, Message: msg}
+}
+
+func NewConflict(msg string) error {
+	return HTTPError{Code: http.StatusConflict, Message: msg}
+}
+
+func NewUnprocessable(msg string) error {
+	return HTTPError{Code: http.StatusUnprocessableEntity, Message: msg}
+}
+
+func NewTooManyRequests(msg string) error {
+	return HTTPError{Code: http.StatusTooManyRequests, Message: msg}
+}
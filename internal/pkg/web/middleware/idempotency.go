@@ -0,0 +1,152 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/httpserver/handler"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+)
+
+const (
+	HeaderIdempotencyKey      = "Idempotency-Key"
+	HeaderIdempotencyReplayed = "Idempotent-Replayed"
+
+	defaultIdempotencyMaxBodySize = 1 << 20
+)
+
+type IdempotencyStore interface {
+	Begin(ctx context.Context, req *entity.IdempotentRequest) (*entity.IdempotentResponse, error)
+	Complete(ctx context.Context, req *entity.IdempotentRequest, resp *entity.IdempotentResponse) error
+	Release(ctx context.Context, req *entity.IdempotentRequest) error
+}
+
+// IdempotencyMiddleware makes POST requests to given routes with
+// Idempotency-Key header safe to retry. Successful response of the
+// first request is stored and replayed for retries with the same body.
+// Errors are not stored, so such request can be retried with the same key.
+//
+// Responses are stored as is, so routes returning secrets (tokens,
+// api keys, passwords) must not be listed. It must run after auth:
+// requests without principal are passed through, as they have no
+// caller to scope keys to. Body is limited to maxBodySize bytes.
+func IdempotencyMiddleware(store IdempotencyStore, maxBodySize int64, routes ...string) gin.HandlerFunc {
+	if maxBodySize <= 0 {
+		maxBodySize = defaultIdempotencyMaxBodySize
+	}
+
+	idempotent := make(map[string]bool, len(routes))
+	for _, r := range routes {
+		idempotent[r] = true
+	}
+
+	return func(c *gin.Context) {
+		key := c.GetHeader(HeaderIdempotencyKey)
+		p, ok := principal.FromContext(c)
+		if c.Request.Method != http.MethodPost || key == "" || !idempotent[c.FullPath()] || !ok {
+			c.Next()
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, maxBodySize))
+		if err != nil {
+			var maxErr *http.MaxBytesError
+			if errors.As(err, &maxErr) {
+				abortWithError(c, apperror.HTTPError{Code: http.StatusRequestEntityTooLarge, Message: "request body is too large"})
+				return
+			}
+			abortWithError(c, apperror.NewBadReq("failed to read body"))
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		req := &entity.IdempotentRequest{
+			Key:       key,
+			Operation: c.Request.Method + " " + c.Request.URL.Path,
+			Caller:    p.Subject(),
+			Body:      body,
+		}
+
+		stored, err := store.Begin(c.Request.Context(), req)
+		if err != nil {
+			abortWithError(c, err)
+			return
+		}
+		if stored != nil {
+			c.Header(HeaderIdempotencyReplayed, "true")
+			c.Data(stored.StatusCode, gin.MIMEJSON, stored.Body)
+			c.Abort()
+			return
+		}
+
+		// response is stored even if client has gone
+		ctx := context.WithoutCancel(c.Request.Context())
+
+		// key is released if handler fails, panics or response can't
+		// be stored, otherwise retries would get 409 until it expires
+		completed := false
+		defer func() {
+			if completed {
+				return
+			}
+			if err := store.Release(ctx, req); err != nil {
+				log.Printf("idempotency key %q: %v", key, err)
+			}
+		}()
+
+		w := &bodyRecorder{ResponseWriter: c.Writer}
+		c.Writer = w
+
+		c.Next()
+
+		if w.Status() >= http.StatusBadRequest {
+			return
+		}
+		if err := store.Complete(ctx, req, &entity.IdempotentResponse{StatusCode: w.Status(), Body: w.body.Bytes()}); err != nil {
+			log.Printf("idempotency key %q: %v", key, err)
+			return
+		}
+		completed = true
+	}
+}
+
+// bodyRecorder copies written response body.
+type bodyRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *bodyRecorder) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *bodyRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
+
+func abortWithError(c *gin.Context, err error) {
+	var httpErr apperror.HTTPError
+	if !errors.As(err, &httpErr) {
+		httpErr = apperror.HTTPError{Code: http.StatusInternalServerError, Message: "internal error", DebugError: err}
+	}
+	if httpErr.Code == http.StatusInternalServerError {
+		log.Printf("internal error: %v | %v", httpErr.Message, httpErr.DebugError)
+	}
+
+	c.AbortWithStatusJSON(httpErr.Code, response.Error{
+		Code:      httpErr.Code,
+		Message:   httpErr.Message,
+		RequestID: c.GetHeader(handler.HeaderRequestID),
+	})
+}
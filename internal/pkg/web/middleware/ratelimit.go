@@ -0,0 +1,47 @@
+package middleware
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/httpserver/handler"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
+)
+
+type Limiter interface {
+	Allow(key string) (bool, time.Duration)
+}
+
+// RateLimitMiddleware limits requests to given routes by client IP.
+// Other routes are passed through.
+func RateLimitMiddleware(limiter Limiter, routes ...string) gin.HandlerFunc {
+	limited := make(map[string]bool, len(routes))
+	for _, r := range routes {
+		limited[r] = true
+	}
+
+	return func(c *gin.Context) {
+		route := c.FullPath()
+		if !limited[route] {
+			c.Next()
+			return
+		}
+
+		ok, retryAfter := limiter.Allow(route + "|" + c.ClientIP())
+		if !ok {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, response.Error{
+				Code:      http.StatusTooManyRequests,
+				Message:   "too many requests",
+				RequestID: c.GetHeader(handler.HeaderRequestID),
+			})
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,21 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/reqmeta"
+)
+
+// RequestMetaMiddleware stores client IP, user agent and
+// request ID for audit. Must be used after RequestIDMiddleware.
+func RequestMetaMiddleware(requestIDHeader string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Set(reqmeta.CtxKey, &reqmeta.Meta{
+			IP:        c.ClientIP(),
+			UserAgent: c.Request.UserAgent(),
+			RequestID: c.GetHeader(requestIDHeader),
+		})
+
+		c.Next()
+	}
+}
@@ -0,0 +1,70 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Route is a method and path pair.
+type Route struct {
+	Method string
+	Path   string
+}
+
+// routeFilter is a router, that silently skips
+// registration of disabled routes.
+type routeFilter struct {
+	gin.IRouter
+	disabled map[Route]bool
+}
+
+// WithoutRoutes wraps router, so that disabled routes
+// are not registered. It is used to turn off generated
+// handlers, that can't be excluded from registration.
+func WithoutRoutes(router gin.IRouter, disabled ...Route) gin.IRouter {
+	m := make(map[Route]bool, len(disabled))
+	for _, r := range disabled {
+		m[r] = true
+	}
+
+	return &routeFilter{
+		IRouter:  router,
+		disabled: m,
+	}
+}
+
+func (r *routeFilter) Handle(method, path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	if r.disabled[Route{Method: method, Path: path}] {
+		return r
+	}
+	return r.IRouter.Handle(method, path, handlers...)
+}
+
+func (r *routeFilter) GET(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodGet, path, handlers...)
+}
+
+func (r *routeFilter) POST(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodPost, path, handlers...)
+}
+
+func (r *routeFilter) PUT(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodPut, path, handlers...)
+}
+
+func (r *routeFilter) PATCH(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodPatch, path, handlers...)
+}
+
+func (r *routeFilter) DELETE(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodDelete, path, handlers...)
+}
+
+func (r *routeFilter) HEAD(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodHead, path, handlers...)
+}
+
+func (r *routeFilter) OPTIONS(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.Handle(http.MethodOptions, path, handlers...)
+}
@@ -17,7 +17,9 @@ type ServerConfig struct {
 	ReadHeaderTimeout time.Duration `yaml:"readHeaderTimeout"`
 	WriteTimeout      time.Duration `yaml:"writeTimeout"`
 	IdleTimeout       time.Duration `yaml:"idleTimeout"`
-	Env               string        `yaml:"env"`
+	// TrustedProxies are addresses X-Forwarded-For is
+	// honoured from, empty means client IP is peer address.
+	TrustedProxies []string `yaml:"trustedProxies"`
 }
 
 // Server if an interface for web http server.
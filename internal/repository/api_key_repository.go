@@ -0,0 +1,160 @@
+//go:generate mockgen -source=./api_key_repository.go -destination=mocks/api_key_repository.go -package=mocks
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+var (
+	ErrAPIKeyNotFound     = errors.New("api key not found")
+	ErrPermissionNotFound = errors.New("permission not found")
+)
+
+type APIKeyQueries interface {
+	CreateAPIKey(ctx context.Context, arg db.CreateAPIKeyParams) (db.ApiKey, error)
+	ListAPIKeys(ctx context.Context) ([]db.ApiKey, error)
+	RevokeAPIKey(ctx context.Context, id uuid.UUID) (db.ApiKey, error)
+	UseAPIKey(ctx context.Context, keyHash string) (db.ApiKey, error)
+	CountPermissionsByNames(ctx context.Context, names []string) (int64, error)
+	CountPvzByIDs(ctx context.Context, ids []uuid.UUID) (int64, error)
+}
+
+type APIKeyRepository struct {
+	queries APIKeyQueries
+}
+
+func NewAPIKeyRepository(q APIKeyQueries) *APIKeyRepository {
+	return &APIKeyRepository{q}
+}
+
+// CreateAPIKey stores api key with hashed value.
+// All of requested permissions and PVZs must exist.
+func (r *APIKeyRepository) CreateAPIKey(ctx context.Context, req *request.CreateAPIKey, prefix, keyHash string) (*entity.APIKey, error) {
+	pvzIDs := req.PvzIDs
+	if pvzIDs == nil {
+		pvzIDs = []uuid.UUID{}
+	}
+
+	cnt, err := r.queries.CountPermissionsByNames(ctx, req.Permissions)
+	if err != nil {
+		return nil, err
+	}
+	if cnt != int64(len(req.Permissions)) {
+		return nil, ErrPermissionNotFound
+	}
+
+	if len(pvzIDs) > 0 {
+		cnt, err := r.queries.CountPvzByIDs(ctx, pvzIDs)
+		if err != nil {
+			return nil, err
+		}
+		if cnt != int64(len(pvzIDs)) {
+			return nil, ErrPvzNotFound
+		}
+	}
+
+	arg := db.CreateAPIKeyParams{
+		ID:          uuid.New(),
+		Name:        req.Name,
+		Prefix:      prefix,
+		KeyHash:     keyHash,
+		Permissions: req.Permissions,
+		PvzIds:      pvzIDs,
+	}
+	if req.ExpiresAt != nil {
+		arg.ExpiresAt = sql.NullTime{Time: *req.ExpiresAt, Valid: true}
+	}
+
+	res, err := r.queries.CreateAPIKey(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	return toEntityAPIKey(res), nil
+}
+
+func (r *APIKeyRepository) ListAPIKeys(ctx context.Context) ([]*entity.APIKey, error) {
+	res, err := r.queries.ListAPIKeys(ctx)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return []*entity.APIKey{}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	keys := make([]*entity.APIKey, len(res))
+	for i, k := range res {
+		keys[i] = toEntityAPIKey(k)
+	}
+
+	return keys, nil
+}
+
+// RevokeAPIKey revokes active api key.
+func (r *APIKeyRepository) RevokeAPIKey(ctx context.Context, id uuid.UUID) (*entity.APIKey, error) {
+	res, err := r.queries.RevokeAPIKey(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrAPIKeyNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	return toEntityAPIKey(res), nil
+}
+
+// UseAPIKey finds active unexpired api key by hash
+// and updates its last usage time.
+func (r *APIKeyRepository) UseAPIKey(ctx context.Context, keyHash string) (*entity.APIKey, error) {
+	res, err := r.queries.UseAPIKey(ctx, keyHash)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrAPIKeyNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	return toEntityAPIKey(res), nil
+}
+
+func toEntityAPIKey(k db.ApiKey) *entity.APIKey {
+	perms := make([]entity.Permission, len(k.Permissions))
+	for i, p := range k.Permissions {
+		perms[i] = entity.Permission(p)
+	}
+
+	return &entity.APIKey{
+		ID:          k.ID,
+		Name:        k.Name,
+		Prefix:      k.Prefix,
+		Permissions: perms,
+		PvzIDs:      k.PvzIds,
+		ExpiresAt:   nullTimePtr(k.ExpiresAt),
+		LastUsedAt:  nullTimePtr(k.LastUsedAt),
+		RevokedAt:   nullTimePtr(k.RevokedAt),
+		CreatedAt:   k.CreatedAt,
+	}
+}
+
+func nullTimePtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
@@ -0,0 +1,170 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository/mocks"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+func TestCreateAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockAPIKeyQueries(ctrl)
+
+	repo := repository.NewAPIKeyRepository(queries)
+
+	perms := []string{string(entity.PermReceptionWrite)}
+	pvzIDs := []uuid.UUID{uuid.New()}
+	expiresAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	dbKey := db.ApiKey{
+		ID:          uuid.New(),
+		Name:        "scanner",
+		Prefix:      "pvz_abcd",
+		KeyHash:     "hash",
+		Permissions: perms,
+		PvzIds:      pvzIDs,
+		ExpiresAt:   sql.NullTime{Time: expiresAt, Valid: true},
+	}
+	testCases := []struct {
+		name         string
+		req          *request.CreateAPIKey
+		mockBehavior func()
+		expRes       *entity.APIKey
+		expErr       error
+	}{
+		{
+			name: "ok",
+			req:  &request.CreateAPIKey{Name: "scanner", Permissions: perms, PvzIDs: pvzIDs, ExpiresAt: &expiresAt},
+			mockBehavior: func() {
+				queries.EXPECT().CountPermissionsByNames(gomock.Any(), perms).Return(int64(1), nil)
+				queries.EXPECT().CountPvzByIDs(gomock.Any(), pvzIDs).Return(int64(1), nil)
+				queries.EXPECT().CreateAPIKey(gomock.Any(), gomock.Any()).Return(dbKey, nil)
+			},
+			expRes: &entity.APIKey{
+				ID:          dbKey.ID,
+				Name:        "scanner",
+				Prefix:      "pvz_abcd",
+				Permissions: []entity.Permission{entity.PermReceptionWrite},
+				PvzIDs:      pvzIDs,
+				ExpiresAt:   &expiresAt,
+			},
+			expErr: nil,
+		},
+		{
+			name: "permission not found",
+			req:  &request.CreateAPIKey{Name: "scanner", Permissions: perms},
+			mockBehavior: func() {
+				queries.EXPECT().CountPermissionsByNames(gomock.Any(), perms).Return(int64(0), nil)
+			},
+			expRes: nil,
+			expErr: repository.ErrPermissionNotFound,
+		},
+		{
+			name: "pvz not found",
+			req:  &request.CreateAPIKey{Name: "scanner", Permissions: perms, PvzIDs: pvzIDs},
+			mockBehavior: func() {
+				queries.EXPECT().CountPermissionsByNames(gomock.Any(), perms).Return(int64(1), nil)
+				queries.EXPECT().CountPvzByIDs(gomock.Any(), pvzIDs).Return(int64(0), nil)
+			},
+			expRes: nil,
+			expErr: repository.ErrPvzNotFound,
+		},
+		{
+			name: "create err",
+			req:  &request.CreateAPIKey{Name: "scanner", Permissions: perms},
+			mockBehavior: func() {
+				queries.EXPECT().CountPermissionsByNames(gomock.Any(), perms).Return(int64(1), nil)
+				queries.EXPECT().CreateAPIKey(gomock.Any(), gomock.Any()).Return(db.ApiKey{}, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := repo.CreateAPIKey(context.Background(), tc.req, "pvz_abcd", "hash")
+
+			require.Equal(t, tc.expRes, res)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestUseAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockAPIKeyQueries(ctrl)
+
+	repo := repository.NewAPIKeyRepository(queries)
+
+	usedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	dbKey := db.ApiKey{
+		ID:          uuid.New(),
+		Name:        "scanner",
+		Permissions: []string{string(entity.PermReportRead)},
+		PvzIds:      []uuid.UUID{},
+		LastUsedAt:  sql.NullTime{Time: usedAt, Valid: true},
+	}
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       *entity.APIKey
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().UseAPIKey(gomock.Any(), "hash").Return(dbKey, nil)
+			},
+			expRes: &entity.APIKey{
+				ID:          dbKey.ID,
+				Name:        "scanner",
+				Permissions: []entity.Permission{entity.PermReportRead},
+				PvzIDs:      []uuid.UUID{},
+				LastUsedAt:  &usedAt,
+			},
+			expErr: nil,
+		},
+		{
+			name: "not found",
+			mockBehavior: func() {
+				queries.EXPECT().UseAPIKey(gomock.Any(), "hash").Return(db.ApiKey{}, sql.ErrNoRows)
+			},
+			expRes: nil,
+			expErr: repository.ErrAPIKeyNotFound,
+		},
+		{
+			name: "internal err",
+			mockBehavior: func() {
+				queries.EXPECT().UseAPIKey(gomock.Any(), "hash").Return(db.ApiKey{}, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := repo.UseAPIKey(context.Background(), "hash")
+
+			require.Equal(t, tc.expRes, res)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
@@ -0,0 +1,104 @@
+//go:generate mockgen -source=./audit_repository.go -destination=mocks/audit_repository.go -package=mocks
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+type AuditQueries interface {
+	CreateAuditLog(ctx context.Context, arg db.CreateAuditLogParams) error
+	ListAuditLog(ctx context.Context, arg db.ListAuditLogParams) ([]db.AuditLog, error)
+}
+
+type AuditRepository struct {
+	queries AuditQueries
+}
+
+func NewAuditRepository(q AuditQueries) *AuditRepository {
+	return &AuditRepository{q}
+}
+
+// CreateEntry appends entry to audit log. Stored
+// entries can't be updated or deleted.
+func (r *AuditRepository) CreateEntry(ctx context.Context, e *entity.AuditEntry) error {
+	arg := db.CreateAuditLogParams{
+		Action:    string(e.Action),
+		ActorID:   nullUUID(e.ActorID),
+		ActorRole: sql.NullString{String: string(e.ActorRole), Valid: e.ActorRole != ""},
+		ApiKeyID:  nullUUID(e.APIKeyID),
+		Ip:        e.IP,
+		UserAgent: e.UserAgent,
+		RequestID: e.RequestID,
+		Payload:   string(e.Payload),
+	}
+
+	return r.queries.CreateAuditLog(ctx, arg)
+}
+
+// ListEntries returns entries newest first. Cursor is
+// an ID, only entries before it are returned.
+func (r *AuditRepository) ListEntries(ctx context.Context, req *request.ListAudit) ([]*entity.AuditEntry, error) {
+	arg := db.ListAuditLogParams{
+		Limit: int32(req.Limit),
+	}
+	if req.Cursor != nil {
+		arg.BeforeID = sql.NullInt64{Int64: *req.Cursor, Valid: true}
+	}
+	if req.Action != nil {
+		arg.Action = sql.NullString{String: *req.Action, Valid: true}
+	}
+	if req.ActorID != nil {
+		arg.ActorID = nullUUID(*req.ActorID)
+	}
+	if req.From != nil {
+		arg.From = sql.NullTime{Time: *req.From, Valid: true}
+	}
+	if req.To != nil {
+		arg.To = sql.NullTime{Time: *req.To, Valid: true}
+	}
+
+	res, err := r.queries.ListAuditLog(ctx, arg)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return []*entity.AuditEntry{}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	entries := make([]*entity.AuditEntry, len(res))
+	for i, e := range res {
+		entries[i] = toEntityAuditEntry(e)
+	}
+
+	return entries, nil
+}
+
+func toEntityAuditEntry(e db.AuditLog) *entity.AuditEntry {
+	return &entity.AuditEntry{
+		ID:        e.ID,
+		Action:    entity.AuditAction(e.Action),
+		ActorID:   e.ActorID.UUID,
+		ActorRole: entity.Role(e.ActorRole.String),
+		APIKeyID:  e.ApiKeyID.UUID,
+		IP:        e.Ip,
+		UserAgent: e.UserAgent,
+		RequestID: e.RequestID,
+		Payload:   e.Payload,
+		CreatedAt: e.CreatedAt,
+	}
+}
+
+func nullUUID(id uuid.UUID) uuid.NullUUID {
+	return uuid.NullUUID{UUID: id, Valid: id != uuid.Nil}
+}
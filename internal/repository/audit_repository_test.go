@@ -0,0 +1,164 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository/mocks"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+func TestCreateAuditEntry(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockAuditQueries(ctrl)
+
+	repo := repository.NewAuditRepository(queries)
+
+	actorID := uuid.New()
+	payload := json.RawMessage(`{"email":"test@mail.com"}`)
+	testCases := []struct {
+		name         string
+		entry        *entity.AuditEntry
+		mockBehavior func()
+		expErr       error
+	}{
+		{
+			name: "user actor",
+			entry: &entity.AuditEntry{
+				Action:    entity.AuditLoginSuccess,
+				ActorID:   actorID,
+				ActorRole: entity.RoleModerator,
+				IP:        "127.0.0.1",
+				UserAgent: "curl",
+				RequestID: "req",
+				Payload:   payload,
+			},
+			mockBehavior: func() {
+				queries.EXPECT().CreateAuditLog(gomock.Any(), db.CreateAuditLogParams{
+					Action:    string(entity.AuditLoginSuccess),
+					ActorID:   uuid.NullUUID{UUID: actorID, Valid: true},
+					ActorRole: sql.NullString{String: string(entity.RoleModerator), Valid: true},
+					Ip:        "127.0.0.1",
+					UserAgent: "curl",
+					RequestID: "req",
+					Payload:   string(payload),
+				}).Return(nil)
+			},
+			expErr: nil,
+		},
+		{
+			name:  "anonymous",
+			entry: &entity.AuditEntry{Action: entity.AuditLoginFailure, Payload: payload},
+			mockBehavior: func() {
+				queries.EXPECT().CreateAuditLog(gomock.Any(), db.CreateAuditLogParams{
+					Action:  string(entity.AuditLoginFailure),
+					Payload: string(payload),
+				}).Return(nil)
+			},
+			expErr: nil,
+		},
+		{
+			name:  "db err",
+			entry: &entity.AuditEntry{Action: entity.AuditLoginFailure, Payload: payload},
+			mockBehavior: func() {
+				queries.EXPECT().CreateAuditLog(gomock.Any(), gomock.Any()).Return(errMock)
+			},
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			err := repo.CreateEntry(context.Background(), tc.entry)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestListAuditEntries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockAuditQueries(ctrl)
+
+	repo := repository.NewAuditRepository(queries)
+
+	action := string(entity.AuditLoginFailure)
+	cursor := int64(10)
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	dbEntry := db.AuditLog{
+		ID:        9,
+		Action:    action,
+		Ip:        "127.0.0.1",
+		Payload:   json.RawMessage(`{}`),
+		CreatedAt: from,
+	}
+	testCases := []struct {
+		name         string
+		req          *request.ListAudit
+		mockBehavior func()
+		expRes       []*entity.AuditEntry
+		expErr       error
+	}{
+		{
+			name: "ok",
+			req:  &request.ListAudit{Action: &action, From: &from, Cursor: &cursor, Limit: 20},
+			mockBehavior: func() {
+				queries.EXPECT().ListAuditLog(gomock.Any(), db.ListAuditLogParams{
+					BeforeID: sql.NullInt64{Int64: cursor, Valid: true},
+					Action:   sql.NullString{String: action, Valid: true},
+					From:     sql.NullTime{Time: from, Valid: true},
+					Limit:    20,
+				}).Return([]db.AuditLog{dbEntry}, nil)
+			},
+			expRes: []*entity.AuditEntry{{
+				ID:        9,
+				Action:    entity.AuditLoginFailure,
+				IP:        "127.0.0.1",
+				Payload:   json.RawMessage(`{}`),
+				CreatedAt: from,
+			}},
+			expErr: nil,
+		},
+		{
+			name: "no rows",
+			req:  &request.ListAudit{Limit: 20},
+			mockBehavior: func() {
+				queries.EXPECT().ListAuditLog(gomock.Any(), gomock.Any()).Return(nil, sql.ErrNoRows)
+			},
+			expRes: []*entity.AuditEntry{},
+			expErr: nil,
+		},
+		{
+			name: "db err",
+			req:  &request.ListAudit{Limit: 20},
+			mockBehavior: func() {
+				queries.EXPECT().ListAuditLog(gomock.Any(), gomock.Any()).Return(nil, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := repo.ListEntries(context.Background(), tc.req)
+			require.Equal(t, tc.expErr, err)
+			require.Equal(t, tc.expRes, res)
+		})
+	}
+}
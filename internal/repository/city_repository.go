@@ -0,0 +1,112 @@
+//go:generate mockgen -source=./city_repository.go -destination=mocks/city_repository.go -package=mocks
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+var (
+	ErrCityAlreadyExists = errors.New("city already exists")
+	ErrCityNotFound      = errors.New("city not found")
+)
+
+type CityQueries interface {
+	ListCities(ctx context.Context, enabled sql.NullBool) ([]db.City, error)
+	CreateCity(ctx context.Context, arg db.CreateCityParams) (db.City, error)
+	UpdateCity(ctx context.Context, arg db.UpdateCityParams) (db.City, error)
+}
+
+type CityRepository struct {
+	queries CityQueries
+}
+
+func NewCityRepository(q CityQueries) *CityRepository {
+	return &CityRepository{q}
+}
+
+// ListCities returns cities ordered by name.
+// If enabled is nil, all cities are returned.
+func (r *CityRepository) ListCities(ctx context.Context, enabled *bool) ([]*entity.CityInfo, error) {
+	var arg sql.NullBool
+	if enabled != nil {
+		arg = sql.NullBool{Bool: *enabled, Valid: true}
+	}
+
+	res, err := r.queries.ListCities(ctx, arg)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return []*entity.CityInfo{}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	cities := make([]*entity.CityInfo, len(res))
+	for i, c := range res {
+		cities[i] = toEntityCity(c)
+	}
+
+	return cities, nil
+}
+
+func (r *CityRepository) CreateCity(ctx context.Context, req *request.CreateCity) (*entity.CityInfo, error) {
+	arg := db.CreateCityParams{
+		Code:     req.Code,
+		Name:     entity.City(req.Name),
+		NameEn:   req.NameEn,
+		Region:   req.Region,
+		Timezone: req.Timezone,
+		Enabled:  req.Enabled == nil || *req.Enabled,
+	}
+
+	res, err := r.queries.CreateCity(ctx, arg)
+	if err != nil {
+		switch {
+		case isUniqueViolation(err):
+			return nil, ErrCityAlreadyExists
+		default:
+			return nil, err
+		}
+	}
+
+	return toEntityCity(res), nil
+}
+
+func (r *CityRepository) UpdateCity(ctx context.Context, code string, req *request.UpdateCity) (*entity.CityInfo, error) {
+	arg := db.UpdateCityParams{
+		Code:    code,
+		Enabled: *req.Enabled,
+	}
+
+	res, err := r.queries.UpdateCity(ctx, arg)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrCityNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	return toEntityCity(res), nil
+}
+
+func toEntityCity(c db.City) *entity.CityInfo {
+	return &entity.CityInfo{
+		Code:      c.Code,
+		Name:      c.Name,
+		NameEn:    c.NameEn,
+		Region:    c.Region,
+		Timezone:  c.Timezone,
+		Enabled:   c.Enabled,
+		CreatedAt: c.CreatedAt,
+	}
+}
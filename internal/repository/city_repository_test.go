@@ -0,0 +1,176 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository/mocks"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+var dbCity = db.City{
+	Code:      "kazan",
+	Name:      entity.CityKazan,
+	NameEn:    "Kazan",
+	Region:    "Республика Татарстан",
+	Timezone:  "Europe/Moscow",
+	Enabled:   true,
+	CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+}
+
+var entityCity = &entity.CityInfo{
+	Code:      dbCity.Code,
+	Name:      dbCity.Name,
+	NameEn:    dbCity.NameEn,
+	Region:    dbCity.Region,
+	Timezone:  dbCity.Timezone,
+	Enabled:   dbCity.Enabled,
+	CreatedAt: dbCity.CreatedAt,
+}
+
+func TestListCities(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockCityQueries(ctrl)
+
+	repo := repository.NewCityRepository(queries)
+
+	enabled := true
+	testCases := []struct {
+		name         string
+		enabled      *bool
+		mockBehavior func()
+		expRes       []*entity.CityInfo
+		expErr       error
+	}{
+		{
+			name:    "ok only enabled",
+			enabled: &enabled,
+			mockBehavior: func() {
+				queries.EXPECT().ListCities(gomock.Any(), sql.NullBool{Bool: true, Valid: true}).Return([]db.City{dbCity}, nil)
+			},
+			expRes: []*entity.CityInfo{entityCity},
+			expErr: nil,
+		},
+		{
+			name: "ok all",
+			mockBehavior: func() {
+				queries.EXPECT().ListCities(gomock.Any(), sql.NullBool{}).Return(nil, sql.ErrNoRows)
+			},
+			expRes: []*entity.CityInfo{},
+			expErr: nil,
+		},
+		{
+			name: "unk err",
+			mockBehavior: func() {
+				queries.EXPECT().ListCities(gomock.Any(), sql.NullBool{}).Return(nil, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := repo.ListCities(context.Background(), tc.enabled)
+			require.Equal(t, tc.expErr, err)
+			require.Equal(t, tc.expRes, res)
+		})
+	}
+}
+
+func TestCreateCity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockCityQueries(ctrl)
+
+	repo := repository.NewCityRepository(queries)
+
+	req := &request.CreateCity{
+		Code:     dbCity.Code,
+		Name:     string(dbCity.Name),
+		NameEn:   dbCity.NameEn,
+		Region:   dbCity.Region,
+		Timezone: dbCity.Timezone,
+	}
+	arg := db.CreateCityParams{
+		Code:     dbCity.Code,
+		Name:     dbCity.Name,
+		NameEn:   dbCity.NameEn,
+		Region:   dbCity.Region,
+		Timezone: dbCity.Timezone,
+		Enabled:  true,
+	}
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       *entity.CityInfo
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().CreateCity(gomock.Any(), arg).Return(dbCity, nil)
+			},
+			expRes: entityCity,
+			expErr: nil,
+		},
+		{
+			name: "already exists",
+			mockBehavior: func() {
+				queries.EXPECT().CreateCity(gomock.Any(), arg).Return(db.City{}, &pq.Error{Code: "23505"})
+			},
+			expRes: nil,
+			expErr: repository.ErrCityAlreadyExists,
+		},
+		{
+			name: "unk err",
+			mockBehavior: func() {
+				queries.EXPECT().CreateCity(gomock.Any(), arg).Return(db.City{}, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := repo.CreateCity(context.Background(), req)
+			require.Equal(t, tc.expErr, err)
+			require.Equal(t, tc.expRes, res)
+		})
+	}
+}
+
+func TestUpdateCity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockCityQueries(ctrl)
+
+	repo := repository.NewCityRepository(queries)
+
+	enabled := true
+	arg := db.UpdateCityParams{Code: dbCity.Code, Enabled: true}
+
+	queries.EXPECT().UpdateCity(gomock.Any(), arg).Return(dbCity, nil)
+	res, err := repo.UpdateCity(context.Background(), dbCity.Code, &request.UpdateCity{Enabled: &enabled})
+	require.NoError(t, err)
+	require.Equal(t, entityCity, res)
+
+	queries.EXPECT().UpdateCity(gomock.Any(), arg).Return(db.City{}, sql.ErrNoRows)
+	_, err = repo.UpdateCity(context.Background(), dbCity.Code, &request.UpdateCity{Enabled: &enabled})
+	require.Equal(t, repository.ErrCityNotFound, err)
+}
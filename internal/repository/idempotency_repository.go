@@ -0,0 +1,87 @@
+//go:generate mockgen -source=./idempotency_repository.go -destination=mocks/idempotency_repository.go -package=mocks
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+var ErrIdempotencyKeyNotFound = errors.New("idempotency key not found")
+
+type IdempotencyQueries interface {
+	ReserveIdempotencyKey(ctx context.Context, arg db.ReserveIdempotencyKeyParams) (int64, error)
+	GetIdempotencyKey(ctx context.Context, arg db.GetIdempotencyKeyParams) (db.IdempotencyKey, error)
+	SaveIdempotencyResponse(ctx context.Context, arg db.SaveIdempotencyResponseParams) error
+	DeleteIdempotencyKey(ctx context.Context, arg db.DeleteIdempotencyKeyParams) error
+	DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error)
+}
+
+type IdempotencyRepository struct {
+	queries IdempotencyQueries
+}
+
+func NewIdempotencyRepository(q IdempotencyQueries) *IdempotencyRepository {
+	return &IdempotencyRepository{q}
+}
+
+// ReserveKey stores new key for request. Expired key is
+// taken over. Returns false if key is already in use.
+func (r *IdempotencyRepository) ReserveKey(ctx context.Context, scope, key, requestHash string, expiresAt time.Time) (bool, error) {
+	rows, err := r.queries.ReserveIdempotencyKey(ctx, db.ReserveIdempotencyKeyParams{
+		Scope:       scope,
+		Key:         key,
+		RequestHash: requestHash,
+		ExpiresAt:   expiresAt,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return rows > 0, nil
+}
+
+func (r *IdempotencyRepository) GetKey(ctx context.Context, scope, key string) (*entity.IdempotencyRecord, error) {
+	res, err := r.queries.GetIdempotencyKey(ctx, db.GetIdempotencyKeyParams{Scope: scope, Key: key})
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrIdempotencyKeyNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	rec := &entity.IdempotencyRecord{RequestHash: res.RequestHash}
+	if res.StatusCode.Valid {
+		rec.Response = &entity.IdempotentResponse{
+			StatusCode: int(res.StatusCode.Int32),
+			Body:       res.ResponseBody,
+		}
+	}
+
+	return rec, nil
+}
+
+func (r *IdempotencyRepository) SaveResponse(ctx context.Context, scope, key string, resp *entity.IdempotentResponse) error {
+	return r.queries.SaveIdempotencyResponse(ctx, db.SaveIdempotencyResponseParams{
+		Scope:        scope,
+		Key:          key,
+		StatusCode:   sql.NullInt32{Int32: int32(resp.StatusCode), Valid: true},
+		ResponseBody: resp.Body,
+	})
+}
+
+func (r *IdempotencyRepository) DeleteKey(ctx context.Context, scope, key string) error {
+	return r.queries.DeleteIdempotencyKey(ctx, db.DeleteIdempotencyKeyParams{Scope: scope, Key: key})
+}
+
+// DeleteExpired removes expired keys and returns their count.
+func (r *IdempotencyRepository) DeleteExpired(ctx context.Context) (int64, error) {
+	return r.queries.DeleteExpiredIdempotencyKeys(ctx)
+}
@@ -0,0 +1,148 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository/mocks"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+func TestReserveIdempotencyKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockIdempotencyQueries(ctrl)
+
+	repo := repository.NewIdempotencyRepository(queries)
+
+	expiresAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	arg := db.ReserveIdempotencyKeyParams{Scope: "scope", Key: "key", RequestHash: "hash", ExpiresAt: expiresAt}
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       bool
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().ReserveIdempotencyKey(gomock.Any(), arg).Return(int64(1), nil)
+			},
+			expRes: true,
+		},
+		{
+			name: "key in use",
+			mockBehavior: func() {
+				queries.EXPECT().ReserveIdempotencyKey(gomock.Any(), arg).Return(int64(0), nil)
+			},
+			expRes: false,
+		},
+		{
+			name: "unk err",
+			mockBehavior: func() {
+				queries.EXPECT().ReserveIdempotencyKey(gomock.Any(), arg).Return(int64(0), errMock)
+			},
+			expRes: false,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := repo.ReserveKey(context.Background(), "scope", "key", "hash", expiresAt)
+
+			require.Equal(t, tc.expRes, res)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestGetIdempotencyKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockIdempotencyQueries(ctrl)
+
+	repo := repository.NewIdempotencyRepository(queries)
+
+	arg := db.GetIdempotencyKeyParams{Scope: "scope", Key: "key"}
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       *entity.IdempotencyRecord
+		expErr       error
+	}{
+		{
+			name: "completed",
+			mockBehavior: func() {
+				queries.EXPECT().GetIdempotencyKey(gomock.Any(), arg).Return(db.IdempotencyKey{
+					RequestHash:  "hash",
+					StatusCode:   sql.NullInt32{Int32: 201, Valid: true},
+					ResponseBody: []byte(`{}`),
+				}, nil)
+			},
+			expRes: &entity.IdempotencyRecord{
+				RequestHash: "hash",
+				Response:    &entity.IdempotentResponse{StatusCode: 201, Body: []byte(`{}`)},
+			},
+		},
+		{
+			name: "in progress",
+			mockBehavior: func() {
+				queries.EXPECT().GetIdempotencyKey(gomock.Any(), arg).Return(db.IdempotencyKey{RequestHash: "hash"}, nil)
+			},
+			expRes: &entity.IdempotencyRecord{RequestHash: "hash"},
+		},
+		{
+			name: "not found",
+			mockBehavior: func() {
+				queries.EXPECT().GetIdempotencyKey(gomock.Any(), arg).Return(db.IdempotencyKey{}, sql.ErrNoRows)
+			},
+			expErr: repository.ErrIdempotencyKeyNotFound,
+		},
+		{
+			name: "unk err",
+			mockBehavior: func() {
+				queries.EXPECT().GetIdempotencyKey(gomock.Any(), arg).Return(db.IdempotencyKey{}, errMock)
+			},
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := repo.GetKey(context.Background(), "scope", "key")
+
+			require.Equal(t, tc.expRes, res)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestSaveIdempotentResponse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockIdempotencyQueries(ctrl)
+
+	repo := repository.NewIdempotencyRepository(queries)
+
+	queries.EXPECT().SaveIdempotencyResponse(gomock.Any(), db.SaveIdempotencyResponseParams{
+		Scope:        "scope",
+		Key:          "key",
+		StatusCode:   sql.NullInt32{Int32: 400, Valid: true},
+		ResponseBody: []byte(`{}`),
+	}).Return(nil)
+
+	err := repo.SaveResponse(context.Background(), "scope", "key", &entity.IdempotentResponse{StatusCode: 400, Body: []byte(`{}`)})
+	require.NoError(t, err)
+}
@@ -0,0 +1,104 @@
+//go:generate mockgen -source=./invite_repository.go -destination=mocks/invite_repository.go -package=mocks
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+var ErrInviteNotFound = errors.New("invite not found or expired")
+
+type InviteQueries interface {
+	CreateInvite(ctx context.Context, arg db.CreateInviteParams) (db.Invite, error)
+	AcceptInvite(ctx context.Context, arg db.AcceptInviteParams) (db.AcceptInviteRow, error)
+	CountPvzByIDs(ctx context.Context, ids []uuid.UUID) (int64, error)
+}
+
+type InviteRepository struct {
+	queries InviteQueries
+}
+
+func NewInviteRepository(q InviteQueries) *InviteRepository {
+	return &InviteRepository{q}
+}
+
+// CreateInvite stores invite with hashed token.
+// All of requested PVZs must exist.
+func (r *InviteRepository) CreateInvite(ctx context.Context, req *request.CreateInvite, tokenHash string, expiresAt time.Time) (*entity.Invite, error) {
+	pvzIDs := req.PvzIDs
+	if pvzIDs == nil {
+		pvzIDs = []uuid.UUID{}
+	}
+
+	if len(pvzIDs) > 0 {
+		cnt, err := r.queries.CountPvzByIDs(ctx, pvzIDs)
+		if err != nil {
+			return nil, err
+		}
+		if cnt != int64(len(pvzIDs)) {
+			return nil, ErrPvzNotFound
+		}
+	}
+
+	arg := db.CreateInviteParams{
+		ID:        uuid.New(),
+		TokenHash: tokenHash,
+		Email:     req.Email,
+		Role:      req.Role,
+		PvzIds:    pvzIDs,
+		ExpiresAt: expiresAt,
+	}
+
+	res, err := r.queries.CreateInvite(ctx, arg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.Invite{
+		ID:        res.ID,
+		Email:     res.Email,
+		Role:      entity.Role(res.Role),
+		PvzIDs:    res.PvzIds,
+		ExpiresAt: res.ExpiresAt,
+		CreatedAt: res.CreatedAt,
+	}, nil
+}
+
+// AcceptInvite marks invite as used, creates user and
+// assigns the user to invite's PVZs in a single statement.
+func (r *InviteRepository) AcceptInvite(ctx context.Context, tokenHash, password string) (*entity.User, error) {
+	arg := db.AcceptInviteParams{
+		TokenHash: tokenHash,
+		ID:        uuid.New(),
+		Password:  password,
+	}
+
+	res, err := r.queries.AcceptInvite(ctx, arg)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrInviteNotFound
+		case isUniqueViolation(err):
+			return nil, ErrUserAlreadyExists
+		default:
+			return nil, err
+		}
+	}
+
+	return &entity.User{
+		ID:        res.ID,
+		Email:     res.Email,
+		Role:      res.Role,
+		Active:    res.Active,
+		CreatedAt: res.CreatedAt,
+	}, nil
+}
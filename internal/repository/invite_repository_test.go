@@ -0,0 +1,185 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository/mocks"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+func TestCreateInvite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockInviteQueries(ctrl)
+
+	repo := repository.NewInviteRepository(queries)
+
+	pvzIDs := []uuid.UUID{uuid.New(), uuid.New()}
+	expiresAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	dbInvite := db.Invite{
+		ID:        uuid.New(),
+		TokenHash: "hash",
+		Email:     "mock@example.com",
+		Role:      string(entity.RoleModerator),
+		PvzIds:    pvzIDs,
+		ExpiresAt: expiresAt,
+	}
+	testCases := []struct {
+		name         string
+		req          *request.CreateInvite
+		mockBehavior func(req *request.CreateInvite)
+		expRes       *entity.Invite
+		expErr       error
+	}{
+		{
+			name: "ok",
+			req:  &request.CreateInvite{Email: dbInvite.Email, Role: dbInvite.Role, PvzIDs: pvzIDs},
+			mockBehavior: func(req *request.CreateInvite) {
+				queries.EXPECT().CountPvzByIDs(gomock.Any(), pvzIDs).Return(int64(len(pvzIDs)), nil)
+				queries.EXPECT().CreateInvite(gomock.Any(), gomock.Any()).Return(dbInvite, nil)
+			},
+			expRes: &entity.Invite{
+				ID:        dbInvite.ID,
+				Email:     dbInvite.Email,
+				Role:      entity.RoleModerator,
+				PvzIDs:    pvzIDs,
+				ExpiresAt: expiresAt,
+			},
+			expErr: nil,
+		},
+		{
+			name: "ok without pvz",
+			req:  &request.CreateInvite{Email: dbInvite.Email, Role: dbInvite.Role},
+			mockBehavior: func(req *request.CreateInvite) {
+				queries.EXPECT().CreateInvite(gomock.Any(), gomock.Any()).Return(db.Invite{
+					ID:        dbInvite.ID,
+					Email:     dbInvite.Email,
+					Role:      dbInvite.Role,
+					PvzIds:    []uuid.UUID{},
+					ExpiresAt: expiresAt,
+				}, nil)
+			},
+			expRes: &entity.Invite{
+				ID:        dbInvite.ID,
+				Email:     dbInvite.Email,
+				Role:      entity.RoleModerator,
+				PvzIDs:    []uuid.UUID{},
+				ExpiresAt: expiresAt,
+			},
+			expErr: nil,
+		},
+		{
+			name: "pvz not found",
+			req:  &request.CreateInvite{Email: dbInvite.Email, Role: dbInvite.Role, PvzIDs: pvzIDs},
+			mockBehavior: func(req *request.CreateInvite) {
+				queries.EXPECT().CountPvzByIDs(gomock.Any(), pvzIDs).Return(int64(1), nil)
+			},
+			expRes: nil,
+			expErr: repository.ErrPvzNotFound,
+		},
+		{
+			name: "count err",
+			req:  &request.CreateInvite{Email: dbInvite.Email, Role: dbInvite.Role, PvzIDs: pvzIDs},
+			mockBehavior: func(req *request.CreateInvite) {
+				queries.EXPECT().CountPvzByIDs(gomock.Any(), pvzIDs).Return(int64(0), errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+		{
+			name: "create err",
+			req:  &request.CreateInvite{Email: dbInvite.Email, Role: dbInvite.Role},
+			mockBehavior: func(req *request.CreateInvite) {
+				queries.EXPECT().CreateInvite(gomock.Any(), gomock.Any()).Return(db.Invite{}, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(tc.req)
+
+			res, err := repo.CreateInvite(context.Background(), tc.req, "hash", expiresAt)
+
+			require.Equal(t, tc.expRes, res)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestAcceptInvite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockInviteQueries(ctrl)
+
+	repo := repository.NewInviteRepository(queries)
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       *entity.User
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().AcceptInvite(gomock.Any(), gomock.Any()).Return(db.AcceptInviteRow{
+					ID:     mockuser.ID,
+					Email:  mockuser.Email,
+					Role:   mockuser.Role,
+					Active: true,
+				}, nil)
+			},
+			expRes: &entity.User{ID: mockuser.ID, Email: mockuser.Email, Role: mockuser.Role, Active: true},
+			expErr: nil,
+		},
+		{
+			name: "invite not found",
+			mockBehavior: func() {
+				queries.EXPECT().AcceptInvite(gomock.Any(), gomock.Any()).Return(db.AcceptInviteRow{}, sql.ErrNoRows)
+			},
+			expRes: nil,
+			expErr: repository.ErrInviteNotFound,
+		},
+		{
+			name: "user already exists",
+			mockBehavior: func() {
+				queries.EXPECT().AcceptInvite(gomock.Any(), gomock.Any()).
+					Return(db.AcceptInviteRow{}, &pq.Error{Code: repository.ErrUniqueViolationCode})
+			},
+			expRes: nil,
+			expErr: repository.ErrUserAlreadyExists,
+		},
+		{
+			name: "unk err",
+			mockBehavior: func() {
+				queries.EXPECT().AcceptInvite(gomock.Any(), gomock.Any()).Return(db.AcceptInviteRow{}, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := repo.AcceptInvite(context.Background(), "hash", "password")
+
+			require.Equal(t, tc.expRes, res)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
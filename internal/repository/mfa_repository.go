@@ -0,0 +1,123 @@
+//go:generate mockgen -source=./mfa_repository.go -destination=mocks/mfa_repository.go -package=mocks
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+var (
+	ErrMFAAlreadyEnabled    = errors.New("mfa already enabled")
+	ErrMFANotEnrolled       = errors.New("mfa enrollment not started")
+	ErrRecoveryCodeNotFound = errors.New("recovery code not found")
+	ErrTOTPCodeUsed         = errors.New("code already used")
+)
+
+type MFAQueries interface {
+	GetUserByID(ctx context.Context, id uuid.UUID) (db.User, error)
+	SetUserMFASecret(ctx context.Context, arg db.SetUserMFASecretParams) (int64, error)
+	EnableUserMFA(ctx context.Context, arg db.EnableUserMFAParams) (int64, error)
+	UseMFARecoveryCode(ctx context.Context, arg db.UseMFARecoveryCodeParams) (int64, error)
+	UseUserTOTPStep(ctx context.Context, arg db.UseUserTOTPStepParams) (int64, error)
+}
+
+type MFARepository struct {
+	queries MFAQueries
+}
+
+func NewMFARepository(q MFAQueries) *MFARepository {
+	return &MFARepository{q}
+}
+
+// GetUser returns user with MFA secret.
+func (r *MFARepository) GetUser(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	res, err := r.queries.GetUserByID(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrUserNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	usr := toEntityUser(res)
+	usr.MFASecret = res.MfaSecret.String
+
+	return usr, nil
+}
+
+// SetSecret stores pending TOTP secret.
+// Secret can't be changed after MFA is enabled.
+func (r *MFARepository) SetSecret(ctx context.Context, id uuid.UUID, secret string) error {
+	n, err := r.queries.SetUserMFASecret(ctx, db.SetUserMFASecretParams{
+		ID:        id,
+		MfaSecret: sql.NullString{String: secret, Valid: true},
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrMFAAlreadyEnabled
+	}
+
+	return nil
+}
+
+// Enable enables MFA with pending secret and stores
+// recovery codes hashes in a single statement.
+func (r *MFARepository) Enable(ctx context.Context, id uuid.UUID, codeHashes []string) error {
+	n, err := r.queries.EnableUserMFA(ctx, db.EnableUserMFAParams{
+		ID:         id,
+		CodeHashes: codeHashes,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrMFANotEnrolled
+	}
+
+	return nil
+}
+
+// UseRecoveryCode marks unused recovery code as used.
+func (r *MFARepository) UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) error {
+	n, err := r.queries.UseMFARecoveryCode(ctx, db.UseMFARecoveryCodeParams{
+		UserID:   userID,
+		CodeHash: codeHash,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrRecoveryCodeNotFound
+	}
+
+	return nil
+}
+
+// UseTOTPStep records time step of accepted TOTP code.
+// Code of the same or earlier step is rejected, so
+// intercepted code can't be replayed within its period.
+func (r *MFARepository) UseTOTPStep(ctx context.Context, userID uuid.UUID, step int64) error {
+	n, err := r.queries.UseUserTOTPStep(ctx, db.UseUserTOTPStepParams{
+		Step: step,
+		ID:   userID,
+	})
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrTOTPCodeUsed
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository/mocks"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+func TestEnableMFA(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockMFAQueries(ctrl)
+
+	repo := repository.NewMFARepository(queries)
+
+	id := uuid.New()
+	hashes := []string{"hash1", "hash2"}
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().EnableUserMFA(gomock.Any(), db.EnableUserMFAParams{ID: id, CodeHashes: hashes}).Return(int64(2), nil)
+			},
+			expErr: nil,
+		},
+		{
+			name: "not enrolled",
+			mockBehavior: func() {
+				queries.EXPECT().EnableUserMFA(gomock.Any(), db.EnableUserMFAParams{ID: id, CodeHashes: hashes}).Return(int64(0), nil)
+			},
+			expErr: repository.ErrMFANotEnrolled,
+		},
+		{
+			name: "internal err",
+			mockBehavior: func() {
+				queries.EXPECT().EnableUserMFA(gomock.Any(), db.EnableUserMFAParams{ID: id, CodeHashes: hashes}).Return(int64(0), errMock)
+			},
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			err := repo.Enable(context.Background(), id, hashes)
+
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestUseRecoveryCode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockMFAQueries(ctrl)
+
+	repo := repository.NewMFARepository(queries)
+
+	id := uuid.New()
+	arg := db.UseMFARecoveryCodeParams{UserID: id, CodeHash: "hash"}
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().UseMFARecoveryCode(gomock.Any(), arg).Return(int64(1), nil)
+			},
+			expErr: nil,
+		},
+		{
+			name: "already used",
+			mockBehavior: func() {
+				queries.EXPECT().UseMFARecoveryCode(gomock.Any(), arg).Return(int64(0), nil)
+			},
+			expErr: repository.ErrRecoveryCodeNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			err := repo.UseRecoveryCode(context.Background(), id, "hash")
+
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestUseTOTPStep(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockMFAQueries(ctrl)
+
+	repo := repository.NewMFARepository(queries)
+
+	id := uuid.New()
+	arg := db.UseUserTOTPStepParams{Step: 57000000, ID: id}
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().UseUserTOTPStep(gomock.Any(), arg).Return(int64(1), nil)
+			},
+			expErr: nil,
+		},
+		{
+			name: "step already used",
+			mockBehavior: func() {
+				queries.EXPECT().UseUserTOTPStep(gomock.Any(), arg).Return(int64(0), nil)
+			},
+			expErr: repository.ErrTOTPCodeUsed,
+		},
+		{
+			name: "unk err",
+			mockBehavior: func() {
+				queries.EXPECT().UseUserTOTPStep(gomock.Any(), arg).Return(int64(0), errMock)
+			},
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			err := repo.UseTOTPStep(context.Background(), id, 57000000)
+
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestGetMFAUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockMFAQueries(ctrl)
+
+	repo := repository.NewMFARepository(queries)
+
+	id := uuid.New()
+
+	queries.EXPECT().GetUserByID(gomock.Any(), id).Return(db.User{
+		ID:         id,
+		MfaSecret:  sql.NullString{String: "SECRET", Valid: true},
+		MfaEnabled: true,
+	}, nil)
+
+	res, err := repo.GetUser(context.Background(), id)
+
+	require.NoError(t, err)
+	require.Equal(t, "SECRET", res.MFASecret)
+	require.True(t, res.MFAEnabled)
+}
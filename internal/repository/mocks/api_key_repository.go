@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./api_key_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+// MockAPIKeyQueries is a mock of APIKeyQueries interface.
+type MockAPIKeyQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockAPIKeyQueriesMockRecorder
+}
+
+// MockAPIKeyQueriesMockRecorder is the mock recorder for MockAPIKeyQueries.
+type MockAPIKeyQueriesMockRecorder struct {
+	mock *MockAPIKeyQueries
+}
+
+// NewMockAPIKeyQueries creates a new mock instance.
+func NewMockAPIKeyQueries(ctrl *gomock.Controller) *MockAPIKeyQueries {
+	mock := &MockAPIKeyQueries{ctrl: ctrl}
+	mock.recorder = &MockAPIKeyQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAPIKeyQueries) EXPECT() *MockAPIKeyQueriesMockRecorder {
+	return m.recorder
+}
+
+// CountPermissionsByNames mocks base method.
+func (m *MockAPIKeyQueries) CountPermissionsByNames(ctx context.Context, names []string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPermissionsByNames", ctx, names)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPermissionsByNames indicates an expected call of CountPermissionsByNames.
+func (mr *MockAPIKeyQueriesMockRecorder) CountPermissionsByNames(ctx, names interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPermissionsByNames", reflect.TypeOf((*MockAPIKeyQueries)(nil).CountPermissionsByNames), ctx, names)
+}
+
+// CountPvzByIDs mocks base method.
+func (m *MockAPIKeyQueries) CountPvzByIDs(ctx context.Context, ids []uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPvzByIDs", ctx, ids)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPvzByIDs indicates an expected call of CountPvzByIDs.
+func (mr *MockAPIKeyQueriesMockRecorder) CountPvzByIDs(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPvzByIDs", reflect.TypeOf((*MockAPIKeyQueries)(nil).CountPvzByIDs), ctx, ids)
+}
+
+// CreateAPIKey mocks base method.
+func (m *MockAPIKeyQueries) CreateAPIKey(ctx context.Context, arg db.CreateAPIKeyParams) (db.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAPIKey", ctx, arg)
+	ret0, _ := ret[0].(db.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAPIKey indicates an expected call of CreateAPIKey.
+func (mr *MockAPIKeyQueriesMockRecorder) CreateAPIKey(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAPIKey", reflect.TypeOf((*MockAPIKeyQueries)(nil).CreateAPIKey), ctx, arg)
+}
+
+// ListAPIKeys mocks base method.
+func (m *MockAPIKeyQueries) ListAPIKeys(ctx context.Context) ([]db.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAPIKeys", ctx)
+	ret0, _ := ret[0].([]db.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAPIKeys indicates an expected call of ListAPIKeys.
+func (mr *MockAPIKeyQueriesMockRecorder) ListAPIKeys(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAPIKeys", reflect.TypeOf((*MockAPIKeyQueries)(nil).ListAPIKeys), ctx)
+}
+
+// RevokeAPIKey mocks base method.
+func (m *MockAPIKeyQueries) RevokeAPIKey(ctx context.Context, id uuid.UUID) (db.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeAPIKey", ctx, id)
+	ret0, _ := ret[0].(db.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeAPIKey indicates an expected call of RevokeAPIKey.
+func (mr *MockAPIKeyQueriesMockRecorder) RevokeAPIKey(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeAPIKey", reflect.TypeOf((*MockAPIKeyQueries)(nil).RevokeAPIKey), ctx, id)
+}
+
+// UseAPIKey mocks base method.
+func (m *MockAPIKeyQueries) UseAPIKey(ctx context.Context, keyHash string) (db.ApiKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseAPIKey", ctx, keyHash)
+	ret0, _ := ret[0].(db.ApiKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseAPIKey indicates an expected call of UseAPIKey.
+func (mr *MockAPIKeyQueriesMockRecorder) UseAPIKey(ctx, keyHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseAPIKey", reflect.TypeOf((*MockAPIKeyQueries)(nil).UseAPIKey), ctx, keyHash)
+}
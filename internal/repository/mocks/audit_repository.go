@@ -0,0 +1,65 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./audit_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+// MockAuditQueries is a mock of AuditQueries interface.
+type MockAuditQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuditQueriesMockRecorder
+}
+
+// MockAuditQueriesMockRecorder is the mock recorder for MockAuditQueries.
+type MockAuditQueriesMockRecorder struct {
+	mock *MockAuditQueries
+}
+
+// NewMockAuditQueries creates a new mock instance.
+func NewMockAuditQueries(ctrl *gomock.Controller) *MockAuditQueries {
+	mock := &MockAuditQueries{ctrl: ctrl}
+	mock.recorder = &MockAuditQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuditQueries) EXPECT() *MockAuditQueriesMockRecorder {
+	return m.recorder
+}
+
+// CreateAuditLog mocks base method.
+func (m *MockAuditQueries) CreateAuditLog(ctx context.Context, arg db.CreateAuditLogParams) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuditLog", ctx, arg)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAuditLog indicates an expected call of CreateAuditLog.
+func (mr *MockAuditQueriesMockRecorder) CreateAuditLog(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuditLog", reflect.TypeOf((*MockAuditQueries)(nil).CreateAuditLog), ctx, arg)
+}
+
+// ListAuditLog mocks base method.
+func (m *MockAuditQueries) ListAuditLog(ctx context.Context, arg db.ListAuditLogParams) ([]db.AuditLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAuditLog", ctx, arg)
+	ret0, _ := ret[0].([]db.AuditLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAuditLog indicates an expected call of ListAuditLog.
+func (mr *MockAuditQueriesMockRecorder) ListAuditLog(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAuditLog", reflect.TypeOf((*MockAuditQueries)(nil).ListAuditLog), ctx, arg)
+}
@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./city_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	sql "database/sql"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+// MockCityQueries is a mock of CityQueries interface.
+type MockCityQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockCityQueriesMockRecorder
+}
+
+// MockCityQueriesMockRecorder is the mock recorder for MockCityQueries.
+type MockCityQueriesMockRecorder struct {
+	mock *MockCityQueries
+}
+
+// NewMockCityQueries creates a new mock instance.
+func NewMockCityQueries(ctrl *gomock.Controller) *MockCityQueries {
+	mock := &MockCityQueries{ctrl: ctrl}
+	mock.recorder = &MockCityQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCityQueries) EXPECT() *MockCityQueriesMockRecorder {
+	return m.recorder
+}
+
+// CreateCity mocks base method.
+func (m *MockCityQueries) CreateCity(ctx context.Context, arg db.CreateCityParams) (db.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCity", ctx, arg)
+	ret0, _ := ret[0].(db.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCity indicates an expected call of CreateCity.
+func (mr *MockCityQueriesMockRecorder) CreateCity(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCity", reflect.TypeOf((*MockCityQueries)(nil).CreateCity), ctx, arg)
+}
+
+// ListCities mocks base method.
+func (m *MockCityQueries) ListCities(ctx context.Context, enabled sql.NullBool) ([]db.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCities", ctx, enabled)
+	ret0, _ := ret[0].([]db.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCities indicates an expected call of ListCities.
+func (mr *MockCityQueriesMockRecorder) ListCities(ctx, enabled interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCities", reflect.TypeOf((*MockCityQueries)(nil).ListCities), ctx, enabled)
+}
+
+// UpdateCity mocks base method.
+func (m *MockCityQueries) UpdateCity(ctx context.Context, arg db.UpdateCityParams) (db.City, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCity", ctx, arg)
+	ret0, _ := ret[0].(db.City)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCity indicates an expected call of UpdateCity.
+func (mr *MockCityQueriesMockRecorder) UpdateCity(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCity", reflect.TypeOf((*MockCityQueries)(nil).UpdateCity), ctx, arg)
+}
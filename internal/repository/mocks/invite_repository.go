@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./invite_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+// MockInviteQueries is a mock of InviteQueries interface.
+type MockInviteQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockInviteQueriesMockRecorder
+}
+
+// MockInviteQueriesMockRecorder is the mock recorder for MockInviteQueries.
+type MockInviteQueriesMockRecorder struct {
+	mock *MockInviteQueries
+}
+
+// NewMockInviteQueries creates a new mock instance.
+func NewMockInviteQueries(ctrl *gomock.Controller) *MockInviteQueries {
+	mock := &MockInviteQueries{ctrl: ctrl}
+	mock.recorder = &MockInviteQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInviteQueries) EXPECT() *MockInviteQueriesMockRecorder {
+	return m.recorder
+}
+
+// AcceptInvite mocks base method.
+func (m *MockInviteQueries) AcceptInvite(ctx context.Context, arg db.AcceptInviteParams) (db.AcceptInviteRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvite", ctx, arg)
+	ret0, _ := ret[0].(db.AcceptInviteRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptInvite indicates an expected call of AcceptInvite.
+func (mr *MockInviteQueriesMockRecorder) AcceptInvite(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvite", reflect.TypeOf((*MockInviteQueries)(nil).AcceptInvite), ctx, arg)
+}
+
+// CountPvzByIDs mocks base method.
+func (m *MockInviteQueries) CountPvzByIDs(ctx context.Context, ids []uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountPvzByIDs", ctx, ids)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountPvzByIDs indicates an expected call of CountPvzByIDs.
+func (mr *MockInviteQueriesMockRecorder) CountPvzByIDs(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountPvzByIDs", reflect.TypeOf((*MockInviteQueries)(nil).CountPvzByIDs), ctx, ids)
+}
+
+// CreateInvite mocks base method.
+func (m *MockInviteQueries) CreateInvite(ctx context.Context, arg db.CreateInviteParams) (db.Invite, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvite", ctx, arg)
+	ret0, _ := ret[0].(db.Invite)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInvite indicates an expected call of CreateInvite.
+func (mr *MockInviteQueriesMockRecorder) CreateInvite(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvite", reflect.TypeOf((*MockInviteQueries)(nil).CreateInvite), ctx, arg)
+}
@@ -0,0 +1,112 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./mfa_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+// MockMFAQueries is a mock of MFAQueries interface.
+type MockMFAQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockMFAQueriesMockRecorder
+}
+
+// MockMFAQueriesMockRecorder is the mock recorder for MockMFAQueries.
+type MockMFAQueriesMockRecorder struct {
+	mock *MockMFAQueries
+}
+
+// NewMockMFAQueries creates a new mock instance.
+func NewMockMFAQueries(ctrl *gomock.Controller) *MockMFAQueries {
+	mock := &MockMFAQueries{ctrl: ctrl}
+	mock.recorder = &MockMFAQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMFAQueries) EXPECT() *MockMFAQueriesMockRecorder {
+	return m.recorder
+}
+
+// EnableUserMFA mocks base method.
+func (m *MockMFAQueries) EnableUserMFA(ctx context.Context, arg db.EnableUserMFAParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableUserMFA", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableUserMFA indicates an expected call of EnableUserMFA.
+func (mr *MockMFAQueriesMockRecorder) EnableUserMFA(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableUserMFA", reflect.TypeOf((*MockMFAQueries)(nil).EnableUserMFA), ctx, arg)
+}
+
+// GetUserByID mocks base method.
+func (m *MockMFAQueries) GetUserByID(ctx context.Context, id uuid.UUID) (db.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", ctx, id)
+	ret0, _ := ret[0].(db.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByID indicates an expected call of GetUserByID.
+func (mr *MockMFAQueriesMockRecorder) GetUserByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockMFAQueries)(nil).GetUserByID), ctx, id)
+}
+
+// SetUserMFASecret mocks base method.
+func (m *MockMFAQueries) SetUserMFASecret(ctx context.Context, arg db.SetUserMFASecretParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserMFASecret", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetUserMFASecret indicates an expected call of SetUserMFASecret.
+func (mr *MockMFAQueriesMockRecorder) SetUserMFASecret(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserMFASecret", reflect.TypeOf((*MockMFAQueries)(nil).SetUserMFASecret), ctx, arg)
+}
+
+// UseMFARecoveryCode mocks base method.
+func (m *MockMFAQueries) UseMFARecoveryCode(ctx context.Context, arg db.UseMFARecoveryCodeParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseMFARecoveryCode", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseMFARecoveryCode indicates an expected call of UseMFARecoveryCode.
+func (mr *MockMFAQueriesMockRecorder) UseMFARecoveryCode(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseMFARecoveryCode", reflect.TypeOf((*MockMFAQueries)(nil).UseMFARecoveryCode), ctx, arg)
+}
+
+// UseUserTOTPStep mocks base method.
+func (m *MockMFAQueries) UseUserTOTPStep(ctx context.Context, arg db.UseUserTOTPStepParams) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseUserTOTPStep", ctx, arg)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UseUserTOTPStep indicates an expected call of UseUserTOTPStep.
+func (mr *MockMFAQueriesMockRecorder) UseUserTOTPStep(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseUserTOTPStep", reflect.TypeOf((*MockMFAQueries)(nil).UseUserTOTPStep), ctx, arg)
+}
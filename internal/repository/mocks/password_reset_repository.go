@@ -0,0 +1,67 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./password_reset_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+// MockPasswordResetQueries is a mock of PasswordResetQueries interface.
+type MockPasswordResetQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockPasswordResetQueriesMockRecorder
+}
+
+// MockPasswordResetQueriesMockRecorder is the mock recorder for MockPasswordResetQueries.
+type MockPasswordResetQueriesMockRecorder struct {
+	mock *MockPasswordResetQueries
+}
+
+// NewMockPasswordResetQueries creates a new mock instance.
+func NewMockPasswordResetQueries(ctrl *gomock.Controller) *MockPasswordResetQueries {
+	mock := &MockPasswordResetQueries{ctrl: ctrl}
+	mock.recorder = &MockPasswordResetQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPasswordResetQueries) EXPECT() *MockPasswordResetQueriesMockRecorder {
+	return m.recorder
+}
+
+// CreatePasswordResetCode mocks base method.
+func (m *MockPasswordResetQueries) CreatePasswordResetCode(ctx context.Context, arg db.CreatePasswordResetCodeParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePasswordResetCode", ctx, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePasswordResetCode indicates an expected call of CreatePasswordResetCode.
+func (mr *MockPasswordResetQueriesMockRecorder) CreatePasswordResetCode(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePasswordResetCode", reflect.TypeOf((*MockPasswordResetQueries)(nil).CreatePasswordResetCode), ctx, arg)
+}
+
+// ResetPasswordByCode mocks base method.
+func (m *MockPasswordResetQueries) ResetPasswordByCode(ctx context.Context, arg db.ResetPasswordByCodeParams) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPasswordByCode", ctx, arg)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetPasswordByCode indicates an expected call of ResetPasswordByCode.
+func (mr *MockPasswordResetQueriesMockRecorder) ResetPasswordByCode(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPasswordByCode", reflect.TypeOf((*MockPasswordResetQueries)(nil).ResetPasswordByCode), ctx, arg)
+}
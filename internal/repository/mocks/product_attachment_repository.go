@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./product_attachment_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+// MockProductAttachmentQueries is a mock of ProductAttachmentQueries interface.
+type MockProductAttachmentQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockProductAttachmentQueriesMockRecorder
+}
+
+// MockProductAttachmentQueriesMockRecorder is the mock recorder for MockProductAttachmentQueries.
+type MockProductAttachmentQueriesMockRecorder struct {
+	mock *MockProductAttachmentQueries
+}
+
+// NewMockProductAttachmentQueries creates a new mock instance.
+func NewMockProductAttachmentQueries(ctrl *gomock.Controller) *MockProductAttachmentQueries {
+	mock := &MockProductAttachmentQueries{ctrl: ctrl}
+	mock.recorder = &MockProductAttachmentQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProductAttachmentQueries) EXPECT() *MockProductAttachmentQueriesMockRecorder {
+	return m.recorder
+}
+
+// CreateProductAttachment mocks base method.
+func (m *MockProductAttachmentQueries) CreateProductAttachment(ctx context.Context, arg db.CreateProductAttachmentParams) (db.ProductAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProductAttachment", ctx, arg)
+	ret0, _ := ret[0].(db.ProductAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProductAttachment indicates an expected call of CreateProductAttachment.
+func (mr *MockProductAttachmentQueriesMockRecorder) CreateProductAttachment(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProductAttachment", reflect.TypeOf((*MockProductAttachmentQueries)(nil).CreateProductAttachment), ctx, arg)
+}
+
+// GetProductAttachment mocks base method.
+func (m *MockProductAttachmentQueries) GetProductAttachment(ctx context.Context, arg db.GetProductAttachmentParams) (db.ProductAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductAttachment", ctx, arg)
+	ret0, _ := ret[0].(db.ProductAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductAttachment indicates an expected call of GetProductAttachment.
+func (mr *MockProductAttachmentQueriesMockRecorder) GetProductAttachment(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductAttachment", reflect.TypeOf((*MockProductAttachmentQueries)(nil).GetProductAttachment), ctx, arg)
+}
+
+// ListProductAttachments mocks base method.
+func (m *MockProductAttachmentQueries) ListProductAttachments(ctx context.Context, productID uuid.UUID) ([]db.ProductAttachment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProductAttachments", ctx, productID)
+	ret0, _ := ret[0].([]db.ProductAttachment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProductAttachments indicates an expected call of ListProductAttachments.
+func (mr *MockProductAttachmentQueriesMockRecorder) ListProductAttachments(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProductAttachments", reflect.TypeOf((*MockProductAttachmentQueries)(nil).ListProductAttachments), ctx, productID)
+}
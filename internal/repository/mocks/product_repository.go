@@ -0,0 +1,173 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./product_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+// MockProductQueries is a mock of ProductQueries interface.
+type MockProductQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockProductQueriesMockRecorder
+}
+
+// MockProductQueriesMockRecorder is the mock recorder for MockProductQueries.
+type MockProductQueriesMockRecorder struct {
+	mock *MockProductQueries
+}
+
+// NewMockProductQueries creates a new mock instance.
+func NewMockProductQueries(ctrl *gomock.Controller) *MockProductQueries {
+	mock := &MockProductQueries{ctrl: ctrl}
+	mock.recorder = &MockProductQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProductQueries) EXPECT() *MockProductQueriesMockRecorder {
+	return m.recorder
+}
+
+// CreateReturnShipment mocks base method.
+func (m *MockProductQueries) CreateReturnShipment(ctx context.Context, arg db.CreateReturnShipmentParams) (db.ReturnShipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReturnShipment", ctx, arg)
+	ret0, _ := ret[0].(db.ReturnShipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReturnShipment indicates an expected call of CreateReturnShipment.
+func (mr *MockProductQueriesMockRecorder) CreateReturnShipment(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReturnShipment", reflect.TypeOf((*MockProductQueries)(nil).CreateReturnShipment), ctx, arg)
+}
+
+// GetProductByID mocks base method.
+func (m *MockProductQueries) GetProductByID(ctx context.Context, id uuid.UUID) (db.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductByID", ctx, id)
+	ret0, _ := ret[0].(db.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductByID indicates an expected call of GetProductByID.
+func (mr *MockProductQueriesMockRecorder) GetProductByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductByID", reflect.TypeOf((*MockProductQueries)(nil).GetProductByID), ctx, id)
+}
+
+// GetPvzExpiryStats mocks base method.
+func (m *MockProductQueries) GetPvzExpiryStats(ctx context.Context, arg db.GetPvzExpiryStatsParams) (db.GetPvzExpiryStatsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPvzExpiryStats", ctx, arg)
+	ret0, _ := ret[0].(db.GetPvzExpiryStatsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPvzExpiryStats indicates an expected call of GetPvzExpiryStats.
+func (mr *MockProductQueriesMockRecorder) GetPvzExpiryStats(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPvzExpiryStats", reflect.TypeOf((*MockProductQueries)(nil).GetPvzExpiryStats), ctx, arg)
+}
+
+// ListProductEvents mocks base method.
+func (m *MockProductQueries) ListProductEvents(ctx context.Context, productID uuid.UUID) ([]db.ProductEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProductEvents", ctx, productID)
+	ret0, _ := ret[0].([]db.ProductEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProductEvents indicates an expected call of ListProductEvents.
+func (mr *MockProductQueriesMockRecorder) ListProductEvents(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProductEvents", reflect.TypeOf((*MockProductQueries)(nil).ListProductEvents), ctx, productID)
+}
+
+// ListPvzStock mocks base method.
+func (m *MockProductQueries) ListPvzStock(ctx context.Context, arg db.ListPvzStockParams) ([]db.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPvzStock", ctx, arg)
+	ret0, _ := ret[0].([]db.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPvzStock indicates an expected call of ListPvzStock.
+func (mr *MockProductQueriesMockRecorder) ListPvzStock(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPvzStock", reflect.TypeOf((*MockProductQueries)(nil).ListPvzStock), ctx, arg)
+}
+
+// ListReturnShipmentProducts mocks base method.
+func (m *MockProductQueries) ListReturnShipmentProducts(ctx context.Context, shipmentID uuid.UUID) ([]db.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListReturnShipmentProducts", ctx, shipmentID)
+	ret0, _ := ret[0].([]db.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListReturnShipmentProducts indicates an expected call of ListReturnShipmentProducts.
+func (mr *MockProductQueriesMockRecorder) ListReturnShipmentProducts(ctx, shipmentID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListReturnShipmentProducts", reflect.TypeOf((*MockProductQueries)(nil).ListReturnShipmentProducts), ctx, shipmentID)
+}
+
+// ListStoredProductsBefore mocks base method.
+func (m *MockProductQueries) ListStoredProductsBefore(ctx context.Context, before time.Time) ([]db.ListStoredProductsBeforeRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStoredProductsBefore", ctx, before)
+	ret0, _ := ret[0].([]db.ListStoredProductsBeforeRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStoredProductsBefore indicates an expected call of ListStoredProductsBefore.
+func (mr *MockProductQueriesMockRecorder) ListStoredProductsBefore(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoredProductsBefore", reflect.TypeOf((*MockProductQueries)(nil).ListStoredProductsBefore), ctx, before)
+}
+
+// SetProductPickupCode mocks base method.
+func (m *MockProductQueries) SetProductPickupCode(ctx context.Context, arg db.SetProductPickupCodeParams) (db.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProductPickupCode", ctx, arg)
+	ret0, _ := ret[0].(db.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetProductPickupCode indicates an expected call of SetProductPickupCode.
+func (mr *MockProductQueriesMockRecorder) SetProductPickupCode(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProductPickupCode", reflect.TypeOf((*MockProductQueries)(nil).SetProductPickupCode), ctx, arg)
+}
+
+// UpdateProductState mocks base method.
+func (m *MockProductQueries) UpdateProductState(ctx context.Context, arg db.UpdateProductStateParams) (db.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProductState", ctx, arg)
+	ret0, _ := ret[0].(db.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProductState indicates an expected call of UpdateProductState.
+func (mr *MockProductQueriesMockRecorder) UpdateProductState(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductState", reflect.TypeOf((*MockProductQueries)(nil).UpdateProductState), ctx, arg)
+}
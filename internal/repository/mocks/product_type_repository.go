@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./product_type_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+// MockProductTypeQueries is a mock of ProductTypeQueries interface.
+type MockProductTypeQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockProductTypeQueriesMockRecorder
+}
+
+// MockProductTypeQueriesMockRecorder is the mock recorder for MockProductTypeQueries.
+type MockProductTypeQueriesMockRecorder struct {
+	mock *MockProductTypeQueries
+}
+
+// NewMockProductTypeQueries creates a new mock instance.
+func NewMockProductTypeQueries(ctrl *gomock.Controller) *MockProductTypeQueries {
+	mock := &MockProductTypeQueries{ctrl: ctrl}
+	mock.recorder = &MockProductTypeQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProductTypeQueries) EXPECT() *MockProductTypeQueriesMockRecorder {
+	return m.recorder
+}
+
+// CreateProductType mocks base method.
+func (m *MockProductTypeQueries) CreateProductType(ctx context.Context, arg db.CreateProductTypeParams) (db.ProductType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProductType", ctx, arg)
+	ret0, _ := ret[0].(db.ProductType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProductType indicates an expected call of CreateProductType.
+func (mr *MockProductTypeQueriesMockRecorder) CreateProductType(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProductType", reflect.TypeOf((*MockProductTypeQueries)(nil).CreateProductType), ctx, arg)
+}
+
+// DeleteProductType mocks base method.
+func (m *MockProductTypeQueries) DeleteProductType(ctx context.Context, code string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProductType", ctx, code)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProductType indicates an expected call of DeleteProductType.
+func (mr *MockProductTypeQueriesMockRecorder) DeleteProductType(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductType", reflect.TypeOf((*MockProductTypeQueries)(nil).DeleteProductType), ctx, code)
+}
+
+// ListProductTypes mocks base method.
+func (m *MockProductTypeQueries) ListProductTypes(ctx context.Context) ([]db.ProductType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProductTypes", ctx)
+	ret0, _ := ret[0].([]db.ProductType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProductTypes indicates an expected call of ListProductTypes.
+func (mr *MockProductTypeQueriesMockRecorder) ListProductTypes(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProductTypes", reflect.TypeOf((*MockProductTypeQueries)(nil).ListProductTypes), ctx)
+}
+
+// UpdateProductType mocks base method.
+func (m *MockProductTypeQueries) UpdateProductType(ctx context.Context, arg db.UpdateProductTypeParams) (db.ProductType, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProductType", ctx, arg)
+	ret0, _ := ret[0].(db.ProductType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProductType indicates an expected call of UpdateProductType.
+func (mr *MockProductTypeQueriesMockRecorder) UpdateProductType(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductType", reflect.TypeOf((*MockProductTypeQueries)(nil).UpdateProductType), ctx, arg)
+}
@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
 	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
 )
 
@@ -50,6 +51,36 @@ func (mr *MockPvzQueriesMockRecorder) CreatePVZ(ctx, arg interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePVZ", reflect.TypeOf((*MockPvzQueries)(nil).CreatePVZ), ctx, arg)
 }
 
+// GetPVZByID mocks base method.
+func (m *MockPvzQueries) GetPVZByID(ctx context.Context, id uuid.UUID) (db.Pvz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPVZByID", ctx, id)
+	ret0, _ := ret[0].(db.Pvz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPVZByID indicates an expected call of GetPVZByID.
+func (mr *MockPvzQueriesMockRecorder) GetPVZByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPVZByID", reflect.TypeOf((*MockPvzQueries)(nil).GetPVZByID), ctx, id)
+}
+
+// ListPVZStockCounts mocks base method.
+func (m *MockPvzQueries) ListPVZStockCounts(ctx context.Context) ([]db.ListPVZStockCountsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPVZStockCounts", ctx)
+	ret0, _ := ret[0].([]db.ListPVZStockCountsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPVZStockCounts indicates an expected call of ListPVZStockCounts.
+func (mr *MockPvzQueriesMockRecorder) ListPVZStockCounts(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPVZStockCounts", reflect.TypeOf((*MockPvzQueries)(nil).ListPVZStockCounts), ctx)
+}
+
 // SearchPVZ mocks base method.
 func (m *MockPvzQueries) SearchPVZ(ctx context.Context, arg db.SearchPVZParams) ([]db.Pvz, error) {
 	m.ctrl.T.Helper()
@@ -64,3 +95,33 @@ func (mr *MockPvzQueriesMockRecorder) SearchPVZ(ctx, arg interface{}) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPVZ", reflect.TypeOf((*MockPvzQueries)(nil).SearchPVZ), ctx, arg)
 }
+
+// UpdatePVZCapacity mocks base method.
+func (m *MockPvzQueries) UpdatePVZCapacity(ctx context.Context, arg db.UpdatePVZCapacityParams) (db.Pvz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePVZCapacity", ctx, arg)
+	ret0, _ := ret[0].(db.Pvz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePVZCapacity indicates an expected call of UpdatePVZCapacity.
+func (mr *MockPvzQueriesMockRecorder) UpdatePVZCapacity(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePVZCapacity", reflect.TypeOf((*MockPvzQueries)(nil).UpdatePVZCapacity), ctx, arg)
+}
+
+// UpdatePVZStatus mocks base method.
+func (m *MockPvzQueries) UpdatePVZStatus(ctx context.Context, arg db.UpdatePVZStatusParams) (db.UpdatePVZStatusRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePVZStatus", ctx, arg)
+	ret0, _ := ret[0].(db.UpdatePVZStatusRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePVZStatus indicates an expected call of UpdatePVZStatus.
+func (mr *MockPvzQueriesMockRecorder) UpdatePVZStatus(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePVZStatus", reflect.TypeOf((*MockPvzQueries)(nil).UpdatePVZStatus), ctx, arg)
+}
@@ -7,6 +7,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -51,6 +52,51 @@ func (mr *MockReceptionQueriesMockRecorder) AddProductToReception(ctx, arg inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProductToReception", reflect.TypeOf((*MockReceptionQueries)(nil).AddProductToReception), ctx, arg)
 }
 
+// AddProductsToReception mocks base method.
+func (m *MockReceptionQueries) AddProductsToReception(ctx context.Context, arg db.AddProductsToReceptionParams) ([]db.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddProductsToReception", ctx, arg)
+	ret0, _ := ret[0].([]db.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddProductsToReception indicates an expected call of AddProductsToReception.
+func (mr *MockReceptionQueriesMockRecorder) AddProductsToReception(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProductsToReception", reflect.TypeOf((*MockReceptionQueries)(nil).AddProductsToReception), ctx, arg)
+}
+
+// CountProductsByCondition mocks base method.
+func (m *MockReceptionQueries) CountProductsByCondition(ctx context.Context, receptionIds []uuid.UUID) ([]db.CountProductsByConditionRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountProductsByCondition", ctx, receptionIds)
+	ret0, _ := ret[0].([]db.CountProductsByConditionRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountProductsByCondition indicates an expected call of CountProductsByCondition.
+func (mr *MockReceptionQueriesMockRecorder) CountProductsByCondition(ctx, receptionIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountProductsByCondition", reflect.TypeOf((*MockReceptionQueries)(nil).CountProductsByCondition), ctx, receptionIds)
+}
+
+// CountProductsByType mocks base method.
+func (m *MockReceptionQueries) CountProductsByType(ctx context.Context, receptionIds []uuid.UUID) ([]db.CountProductsByTypeRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountProductsByType", ctx, receptionIds)
+	ret0, _ := ret[0].([]db.CountProductsByTypeRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountProductsByType indicates an expected call of CountProductsByType.
+func (mr *MockReceptionQueriesMockRecorder) CountProductsByType(ctx, receptionIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountProductsByType", reflect.TypeOf((*MockReceptionQueries)(nil).CountProductsByType), ctx, receptionIds)
+}
+
 // CreateReception mocks base method.
 func (m *MockReceptionQueries) CreateReception(ctx context.Context, arg db.CreateReceptionParams) (db.Reception, error) {
 	m.ctrl.T.Helper()
@@ -66,12 +112,43 @@ func (mr *MockReceptionQueriesMockRecorder) CreateReception(ctx, arg interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReception", reflect.TypeOf((*MockReceptionQueries)(nil).CreateReception), ctx, arg)
 }
 
+// CreateReceptionWithManifest mocks base method.
+func (m *MockReceptionQueries) CreateReceptionWithManifest(ctx context.Context, arg db.CreateReceptionWithManifestParams) (db.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReceptionWithManifest", ctx, arg)
+	ret0, _ := ret[0].(db.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReceptionWithManifest indicates an expected call of CreateReceptionWithManifest.
+func (mr *MockReceptionQueriesMockRecorder) CreateReceptionWithManifest(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReceptionWithManifest", reflect.TypeOf((*MockReceptionQueries)(nil).CreateReceptionWithManifest), ctx, arg)
+}
+
+// DeleteEmptyReception mocks base method.
+func (m *MockReceptionQueries) DeleteEmptyReception(ctx context.Context, id uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEmptyReception", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEmptyReception indicates an expected call of DeleteEmptyReception.
+func (mr *MockReceptionQueriesMockRecorder) DeleteEmptyReception(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmptyReception", reflect.TypeOf((*MockReceptionQueries)(nil).DeleteEmptyReception), ctx, id)
+}
+
 // DeleteProduct mocks base method.
-func (m *MockReceptionQueries) DeleteProduct(ctx context.Context, id uuid.UUID) error {
+func (m *MockReceptionQueries) DeleteProduct(ctx context.Context, id uuid.UUID) (int64, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "DeleteProduct", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // DeleteProduct indicates an expected call of DeleteProduct.
@@ -80,6 +157,21 @@ func (mr *MockReceptionQueriesMockRecorder) DeleteProduct(ctx, id interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProduct", reflect.TypeOf((*MockReceptionQueries)(nil).DeleteProduct), ctx, id)
 }
 
+// FindProductsByBarcodes mocks base method.
+func (m *MockReceptionQueries) FindProductsByBarcodes(ctx context.Context, arg db.FindProductsByBarcodesParams) ([]db.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindProductsByBarcodes", ctx, arg)
+	ret0, _ := ret[0].([]db.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindProductsByBarcodes indicates an expected call of FindProductsByBarcodes.
+func (mr *MockReceptionQueriesMockRecorder) FindProductsByBarcodes(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProductsByBarcodes", reflect.TypeOf((*MockReceptionQueries)(nil).FindProductsByBarcodes), ctx, arg)
+}
+
 // FinishReception mocks base method.
 func (m *MockReceptionQueries) FinishReception(ctx context.Context, pvzID uuid.UUID) (db.Reception, error) {
 	m.ctrl.T.Helper()
@@ -95,6 +187,36 @@ func (mr *MockReceptionQueriesMockRecorder) FinishReception(ctx, pvzID interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishReception", reflect.TypeOf((*MockReceptionQueries)(nil).FinishReception), ctx, pvzID)
 }
 
+// FinishReceptionWithReconciliation mocks base method.
+func (m *MockReceptionQueries) FinishReceptionWithReconciliation(ctx context.Context, arg db.FinishReceptionWithReconciliationParams) (db.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishReceptionWithReconciliation", ctx, arg)
+	ret0, _ := ret[0].(db.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishReceptionWithReconciliation indicates an expected call of FinishReceptionWithReconciliation.
+func (mr *MockReceptionQueriesMockRecorder) FinishReceptionWithReconciliation(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishReceptionWithReconciliation", reflect.TypeOf((*MockReceptionQueries)(nil).FinishReceptionWithReconciliation), ctx, arg)
+}
+
+// GetLastClosedReceptionByPvzID mocks base method.
+func (m *MockReceptionQueries) GetLastClosedReceptionByPvzID(ctx context.Context, pvzID uuid.UUID) (db.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastClosedReceptionByPvzID", ctx, pvzID)
+	ret0, _ := ret[0].(db.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastClosedReceptionByPvzID indicates an expected call of GetLastClosedReceptionByPvzID.
+func (mr *MockReceptionQueriesMockRecorder) GetLastClosedReceptionByPvzID(ctx, pvzID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastClosedReceptionByPvzID", reflect.TypeOf((*MockReceptionQueries)(nil).GetLastClosedReceptionByPvzID), ctx, pvzID)
+}
+
 // GetLastProductInReception mocks base method.
 func (m *MockReceptionQueries) GetLastProductInReception(ctx context.Context, receptionID uuid.UUID) (db.Product, error) {
 	m.ctrl.T.Helper()
@@ -125,6 +247,185 @@ func (mr *MockReceptionQueriesMockRecorder) GetOpenReceptionByPvzID(ctx, pvzID i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenReceptionByPvzID", reflect.TypeOf((*MockReceptionQueries)(nil).GetOpenReceptionByPvzID), ctx, pvzID)
 }
 
+// GetProductInReceptionByBarcode mocks base method.
+func (m *MockReceptionQueries) GetProductInReceptionByBarcode(ctx context.Context, arg db.GetProductInReceptionByBarcodeParams) (db.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductInReceptionByBarcode", ctx, arg)
+	ret0, _ := ret[0].(db.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductInReceptionByBarcode indicates an expected call of GetProductInReceptionByBarcode.
+func (mr *MockReceptionQueriesMockRecorder) GetProductInReceptionByBarcode(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductInReceptionByBarcode", reflect.TypeOf((*MockReceptionQueries)(nil).GetProductInReceptionByBarcode), ctx, arg)
+}
+
+// GetProductReception mocks base method.
+func (m *MockReceptionQueries) GetProductReception(ctx context.Context, id uuid.UUID) (db.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductReception", ctx, id)
+	ret0, _ := ret[0].(db.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductReception indicates an expected call of GetProductReception.
+func (mr *MockReceptionQueriesMockRecorder) GetProductReception(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductReception", reflect.TypeOf((*MockReceptionQueries)(nil).GetProductReception), ctx, id)
+}
+
+// GetProductsFromReception mocks base method.
+func (m *MockReceptionQueries) GetProductsFromReception(ctx context.Context, receptionID uuid.UUID) ([]db.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductsFromReception", ctx, receptionID)
+	ret0, _ := ret[0].([]db.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductsFromReception indicates an expected call of GetProductsFromReception.
+func (mr *MockReceptionQueriesMockRecorder) GetProductsFromReception(ctx, receptionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductsFromReception", reflect.TypeOf((*MockReceptionQueries)(nil).GetProductsFromReception), ctx, receptionID)
+}
+
+// GetProductsFromReceptionLIFO mocks base method.
+func (m *MockReceptionQueries) GetProductsFromReceptionLIFO(ctx context.Context, receptionID uuid.UUID) ([]db.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductsFromReceptionLIFO", ctx, receptionID)
+	ret0, _ := ret[0].([]db.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductsFromReceptionLIFO indicates an expected call of GetProductsFromReceptionLIFO.
+func (mr *MockReceptionQueriesMockRecorder) GetProductsFromReceptionLIFO(ctx, receptionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductsFromReceptionLIFO", reflect.TypeOf((*MockReceptionQueries)(nil).GetProductsFromReceptionLIFO), ctx, receptionID)
+}
+
+// GetPvzStatsSince mocks base method.
+func (m *MockReceptionQueries) GetPvzStatsSince(ctx context.Context, arg db.GetPvzStatsSinceParams) (db.GetPvzStatsSinceRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPvzStatsSince", ctx, arg)
+	ret0, _ := ret[0].(db.GetPvzStatsSinceRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPvzStatsSince indicates an expected call of GetPvzStatsSince.
+func (mr *MockReceptionQueriesMockRecorder) GetPvzStatsSince(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPvzStatsSince", reflect.TypeOf((*MockReceptionQueries)(nil).GetPvzStatsSince), ctx, arg)
+}
+
+// GetReceptionByID mocks base method.
+func (m *MockReceptionQueries) GetReceptionByID(ctx context.Context, id uuid.UUID) (db.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReceptionByID", ctx, id)
+	ret0, _ := ret[0].(db.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReceptionByID indicates an expected call of GetReceptionByID.
+func (mr *MockReceptionQueriesMockRecorder) GetReceptionByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceptionByID", reflect.TypeOf((*MockReceptionQueries)(nil).GetReceptionByID), ctx, id)
+}
+
+// GetReceptionManifest mocks base method.
+func (m *MockReceptionQueries) GetReceptionManifest(ctx context.Context, receptionID uuid.UUID) (db.ReceptionManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReceptionManifest", ctx, receptionID)
+	ret0, _ := ret[0].(db.ReceptionManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReceptionManifest indicates an expected call of GetReceptionManifest.
+func (mr *MockReceptionQueriesMockRecorder) GetReceptionManifest(ctx, receptionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceptionManifest", reflect.TypeOf((*MockReceptionQueries)(nil).GetReceptionManifest), ctx, receptionID)
+}
+
+// GetReceptionReconciliation mocks base method.
+func (m *MockReceptionQueries) GetReceptionReconciliation(ctx context.Context, receptionID uuid.UUID) (db.ReceptionReconciliation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReceptionReconciliation", ctx, receptionID)
+	ret0, _ := ret[0].(db.ReceptionReconciliation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReceptionReconciliation indicates an expected call of GetReceptionReconciliation.
+func (mr *MockReceptionQueriesMockRecorder) GetReceptionReconciliation(ctx, receptionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceptionReconciliation", reflect.TypeOf((*MockReceptionQueries)(nil).GetReceptionReconciliation), ctx, receptionID)
+}
+
+// ListOpenReceptionsBefore mocks base method.
+func (m *MockReceptionQueries) ListOpenReceptionsBefore(ctx context.Context, before time.Time) ([]db.ListOpenReceptionsBeforeRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOpenReceptionsBefore", ctx, before)
+	ret0, _ := ret[0].([]db.ListOpenReceptionsBeforeRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOpenReceptionsBefore indicates an expected call of ListOpenReceptionsBefore.
+func (mr *MockReceptionQueriesMockRecorder) ListOpenReceptionsBefore(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOpenReceptionsBefore", reflect.TypeOf((*MockReceptionQueries)(nil).ListOpenReceptionsBefore), ctx, before)
+}
+
+// ListPvzReceptions mocks base method.
+func (m *MockReceptionQueries) ListPvzReceptions(ctx context.Context, arg db.ListPvzReceptionsParams) ([]db.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPvzReceptions", ctx, arg)
+	ret0, _ := ret[0].([]db.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPvzReceptions indicates an expected call of ListPvzReceptions.
+func (mr *MockReceptionQueriesMockRecorder) ListPvzReceptions(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPvzReceptions", reflect.TypeOf((*MockReceptionQueries)(nil).ListPvzReceptions), ctx, arg)
+}
+
+// MarkReceptionStaleAlerted mocks base method.
+func (m *MockReceptionQueries) MarkReceptionStaleAlerted(ctx context.Context, receptionID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkReceptionStaleAlerted", ctx, receptionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkReceptionStaleAlerted indicates an expected call of MarkReceptionStaleAlerted.
+func (mr *MockReceptionQueriesMockRecorder) MarkReceptionStaleAlerted(ctx, receptionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkReceptionStaleAlerted", reflect.TypeOf((*MockReceptionQueries)(nil).MarkReceptionStaleAlerted), ctx, receptionID)
+}
+
+// SearchProductsByBarcode mocks base method.
+func (m *MockReceptionQueries) SearchProductsByBarcode(ctx context.Context, arg db.SearchProductsByBarcodeParams) ([]db.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchProductsByBarcode", ctx, arg)
+	ret0, _ := ret[0].([]db.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchProductsByBarcode indicates an expected call of SearchProductsByBarcode.
+func (mr *MockReceptionQueriesMockRecorder) SearchProductsByBarcode(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchProductsByBarcode", reflect.TypeOf((*MockReceptionQueries)(nil).SearchProductsByBarcode), ctx, arg)
+}
+
 // SearchReceptionsByPvzsAndTime mocks base method.
 func (m *MockReceptionQueries) SearchReceptionsByPvzsAndTime(ctx context.Context, arg db.SearchReceptionsByPvzsAndTimeParams) ([]db.Reception, error) {
 	m.ctrl.T.Helper()
@@ -139,3 +440,18 @@ func (mr *MockReceptionQueriesMockRecorder) SearchReceptionsByPvzsAndTime(ctx, a
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchReceptionsByPvzsAndTime", reflect.TypeOf((*MockReceptionQueries)(nil).SearchReceptionsByPvzsAndTime), ctx, arg)
 }
+
+// UpdateReceptionStatus mocks base method.
+func (m *MockReceptionQueries) UpdateReceptionStatus(ctx context.Context, arg db.UpdateReceptionStatusParams) (db.UpdateReceptionStatusRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReceptionStatus", ctx, arg)
+	ret0, _ := ret[0].(db.UpdateReceptionStatusRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReceptionStatus indicates an expected call of UpdateReceptionStatus.
+func (mr *MockReceptionQueriesMockRecorder) UpdateReceptionStatus(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReceptionStatus", reflect.TypeOf((*MockReceptionQueries)(nil).UpdateReceptionStatus), ctx, arg)
+}
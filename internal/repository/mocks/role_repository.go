@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./role_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+// MockRoleQueries is a mock of RoleQueries interface.
+type MockRoleQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoleQueriesMockRecorder
+}
+
+// MockRoleQueriesMockRecorder is the mock recorder for MockRoleQueries.
+type MockRoleQueriesMockRecorder struct {
+	mock *MockRoleQueries
+}
+
+// NewMockRoleQueries creates a new mock instance.
+func NewMockRoleQueries(ctrl *gomock.Controller) *MockRoleQueries {
+	mock := &MockRoleQueries{ctrl: ctrl}
+	mock.recorder = &MockRoleQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoleQueries) EXPECT() *MockRoleQueriesMockRecorder {
+	return m.recorder
+}
+
+// ListRolePermissions mocks base method.
+func (m *MockRoleQueries) ListRolePermissions(ctx context.Context) ([]db.ListRolePermissionsRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRolePermissions", ctx)
+	ret0, _ := ret[0].([]db.ListRolePermissionsRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRolePermissions indicates an expected call of ListRolePermissions.
+func (mr *MockRoleQueriesMockRecorder) ListRolePermissions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRolePermissions", reflect.TypeOf((*MockRoleQueries)(nil).ListRolePermissions), ctx)
+}
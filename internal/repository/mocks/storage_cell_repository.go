@@ -0,0 +1,112 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./storage_cell_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+// MockStorageCellQueries is a mock of StorageCellQueries interface.
+type MockStorageCellQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageCellQueriesMockRecorder
+}
+
+// MockStorageCellQueriesMockRecorder is the mock recorder for MockStorageCellQueries.
+type MockStorageCellQueriesMockRecorder struct {
+	mock *MockStorageCellQueries
+}
+
+// NewMockStorageCellQueries creates a new mock instance.
+func NewMockStorageCellQueries(ctrl *gomock.Controller) *MockStorageCellQueries {
+	mock := &MockStorageCellQueries{ctrl: ctrl}
+	mock.recorder = &MockStorageCellQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorageCellQueries) EXPECT() *MockStorageCellQueriesMockRecorder {
+	return m.recorder
+}
+
+// AssignFreeStorageCell mocks base method.
+func (m *MockStorageCellQueries) AssignFreeStorageCell(ctx context.Context, arg db.AssignFreeStorageCellParams) (db.StorageCell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignFreeStorageCell", ctx, arg)
+	ret0, _ := ret[0].(db.StorageCell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignFreeStorageCell indicates an expected call of AssignFreeStorageCell.
+func (mr *MockStorageCellQueriesMockRecorder) AssignFreeStorageCell(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignFreeStorageCell", reflect.TypeOf((*MockStorageCellQueries)(nil).AssignFreeStorageCell), ctx, arg)
+}
+
+// CreateStorageCell mocks base method.
+func (m *MockStorageCellQueries) CreateStorageCell(ctx context.Context, arg db.CreateStorageCellParams) (db.StorageCell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStorageCell", ctx, arg)
+	ret0, _ := ret[0].(db.StorageCell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStorageCell indicates an expected call of CreateStorageCell.
+func (mr *MockStorageCellQueriesMockRecorder) CreateStorageCell(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStorageCell", reflect.TypeOf((*MockStorageCellQueries)(nil).CreateStorageCell), ctx, arg)
+}
+
+// GetStorageCellByID mocks base method.
+func (m *MockStorageCellQueries) GetStorageCellByID(ctx context.Context, id uuid.UUID) (db.StorageCell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageCellByID", ctx, id)
+	ret0, _ := ret[0].(db.StorageCell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageCellByID indicates an expected call of GetStorageCellByID.
+func (mr *MockStorageCellQueriesMockRecorder) GetStorageCellByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageCellByID", reflect.TypeOf((*MockStorageCellQueries)(nil).GetStorageCellByID), ctx, id)
+}
+
+// ListPvzStorageCells mocks base method.
+func (m *MockStorageCellQueries) ListPvzStorageCells(ctx context.Context, pvzID uuid.UUID) ([]db.StorageCell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPvzStorageCells", ctx, pvzID)
+	ret0, _ := ret[0].([]db.StorageCell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPvzStorageCells indicates an expected call of ListPvzStorageCells.
+func (mr *MockStorageCellQueriesMockRecorder) ListPvzStorageCells(ctx, pvzID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPvzStorageCells", reflect.TypeOf((*MockStorageCellQueries)(nil).ListPvzStorageCells), ctx, pvzID)
+}
+
+// MoveProductToCell mocks base method.
+func (m *MockStorageCellQueries) MoveProductToCell(ctx context.Context, arg db.MoveProductToCellParams) (db.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveProductToCell", ctx, arg)
+	ret0, _ := ret[0].(db.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveProductToCell indicates an expected call of MoveProductToCell.
+func (mr *MockStorageCellQueriesMockRecorder) MoveProductToCell(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveProductToCell", reflect.TypeOf((*MockStorageCellQueries)(nil).MoveProductToCell), ctx, arg)
+}
@@ -0,0 +1,127 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./transfer_repository.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+// MockTransferQueries is a mock of TransferQueries interface.
+type MockTransferQueries struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferQueriesMockRecorder
+}
+
+// MockTransferQueriesMockRecorder is the mock recorder for MockTransferQueries.
+type MockTransferQueriesMockRecorder struct {
+	mock *MockTransferQueries
+}
+
+// NewMockTransferQueries creates a new mock instance.
+func NewMockTransferQueries(ctrl *gomock.Controller) *MockTransferQueries {
+	mock := &MockTransferQueries{ctrl: ctrl}
+	mock.recorder = &MockTransferQueriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferQueries) EXPECT() *MockTransferQueriesMockRecorder {
+	return m.recorder
+}
+
+// CreateTransfer mocks base method.
+func (m *MockTransferQueries) CreateTransfer(ctx context.Context, arg db.CreateTransferParams) (db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransfer", ctx, arg)
+	ret0, _ := ret[0].(db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransfer indicates an expected call of CreateTransfer.
+func (mr *MockTransferQueriesMockRecorder) CreateTransfer(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransfer", reflect.TypeOf((*MockTransferQueries)(nil).CreateTransfer), ctx, arg)
+}
+
+// DispatchTransfer mocks base method.
+func (m *MockTransferQueries) DispatchTransfer(ctx context.Context, arg db.DispatchTransferParams) (db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DispatchTransfer", ctx, arg)
+	ret0, _ := ret[0].(db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DispatchTransfer indicates an expected call of DispatchTransfer.
+func (mr *MockTransferQueriesMockRecorder) DispatchTransfer(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DispatchTransfer", reflect.TypeOf((*MockTransferQueries)(nil).DispatchTransfer), ctx, arg)
+}
+
+// GetTransferByID mocks base method.
+func (m *MockTransferQueries) GetTransferByID(ctx context.Context, id uuid.UUID) (db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransferByID", ctx, id)
+	ret0, _ := ret[0].(db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransferByID indicates an expected call of GetTransferByID.
+func (mr *MockTransferQueriesMockRecorder) GetTransferByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransferByID", reflect.TypeOf((*MockTransferQueries)(nil).GetTransferByID), ctx, id)
+}
+
+// ListTransferCandidates mocks base method.
+func (m *MockTransferQueries) ListTransferCandidates(ctx context.Context, ids []uuid.UUID) ([]db.ListTransferCandidatesRow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransferCandidates", ctx, ids)
+	ret0, _ := ret[0].([]db.ListTransferCandidatesRow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransferCandidates indicates an expected call of ListTransferCandidates.
+func (mr *MockTransferQueriesMockRecorder) ListTransferCandidates(ctx, ids interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransferCandidates", reflect.TypeOf((*MockTransferQueries)(nil).ListTransferCandidates), ctx, ids)
+}
+
+// ListTransferProducts mocks base method.
+func (m *MockTransferQueries) ListTransferProducts(ctx context.Context, transferID uuid.UUID) ([]db.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransferProducts", ctx, transferID)
+	ret0, _ := ret[0].([]db.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransferProducts indicates an expected call of ListTransferProducts.
+func (mr *MockTransferQueriesMockRecorder) ListTransferProducts(ctx, transferID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransferProducts", reflect.TypeOf((*MockTransferQueries)(nil).ListTransferProducts), ctx, transferID)
+}
+
+// ReceiveTransfer mocks base method.
+func (m *MockTransferQueries) ReceiveTransfer(ctx context.Context, arg db.ReceiveTransferParams) (db.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReceiveTransfer", ctx, arg)
+	ret0, _ := ret[0].(db.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReceiveTransfer indicates an expected call of ReceiveTransfer.
+func (mr *MockTransferQueriesMockRecorder) ReceiveTransfer(ctx, arg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiveTransfer", reflect.TypeOf((*MockTransferQueries)(nil).ReceiveTransfer), ctx, arg)
+}
@@ -0,0 +1,72 @@
+//go:generate mockgen -source=./password_reset_repository.go -destination=mocks/password_reset_repository.go -package=mocks
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+var ErrResetCodeNotFound = errors.New("reset code not found or expired")
+
+type PasswordResetQueries interface {
+	CreatePasswordResetCode(ctx context.Context, arg db.CreatePasswordResetCodeParams) (uuid.UUID, error)
+	ResetPasswordByCode(ctx context.Context, arg db.ResetPasswordByCodeParams) (uuid.UUID, error)
+}
+
+type PasswordResetRepository struct {
+	queries PasswordResetQueries
+}
+
+func NewPasswordResetRepository(q PasswordResetQueries) *PasswordResetRepository {
+	return &PasswordResetRepository{q}
+}
+
+// CreateResetCode stores code hash for active user with given email.
+func (r *PasswordResetRepository) CreateResetCode(ctx context.Context, email, codeHash string, expiresAt time.Time) (uuid.UUID, error) {
+	arg := db.CreatePasswordResetCodeParams{
+		ID:        uuid.New(),
+		CodeHash:  codeHash,
+		ExpiresAt: expiresAt,
+		Email:     email,
+	}
+
+	userID, err := r.queries.CreatePasswordResetCode(ctx, arg)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return uuid.Nil, ErrUserNotFound
+		default:
+			return uuid.Nil, err
+		}
+	}
+
+	return userID, nil
+}
+
+// ResetPassword consumes code and sets new password.
+// User's token version is bumped, so old tokens stop working.
+func (r *PasswordResetRepository) ResetPassword(ctx context.Context, codeHash, password string) (uuid.UUID, error) {
+	arg := db.ResetPasswordByCodeParams{
+		CodeHash: codeHash,
+		Password: password,
+	}
+
+	userID, err := r.queries.ResetPasswordByCode(ctx, arg)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return uuid.Nil, ErrResetCodeNotFound
+		default:
+			return uuid.Nil, err
+		}
+	}
+
+	return userID, nil
+}
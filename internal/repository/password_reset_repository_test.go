@@ -0,0 +1,126 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository/mocks"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+func TestCreateResetCode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockPasswordResetQueries(ctrl)
+
+	repo := repository.NewPasswordResetRepository(queries)
+
+	expiresAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       uuid.UUID
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().CreatePasswordResetCode(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, arg db.CreatePasswordResetCodeParams) (uuid.UUID, error) {
+						require.Equal(t, mockuser.Email, arg.Email)
+						require.Equal(t, "hash", arg.CodeHash)
+						require.Equal(t, expiresAt, arg.ExpiresAt)
+						return mockuser.ID, nil
+					})
+			},
+			expRes: mockuser.ID,
+			expErr: nil,
+		},
+		{
+			name: "no user found",
+			mockBehavior: func() {
+				queries.EXPECT().CreatePasswordResetCode(gomock.Any(), gomock.Any()).Return(uuid.Nil, sql.ErrNoRows)
+			},
+			expRes: uuid.Nil,
+			expErr: repository.ErrUserNotFound,
+		},
+		{
+			name: "unk err",
+			mockBehavior: func() {
+				queries.EXPECT().CreatePasswordResetCode(gomock.Any(), gomock.Any()).Return(uuid.Nil, errMock)
+			},
+			expRes: uuid.Nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := repo.CreateResetCode(context.Background(), mockuser.Email, "hash", expiresAt)
+
+			require.Equal(t, tc.expRes, res)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestResetPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockPasswordResetQueries(ctrl)
+
+	repo := repository.NewPasswordResetRepository(queries)
+
+	arg := db.ResetPasswordByCodeParams{CodeHash: "hash", Password: "new"}
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       uuid.UUID
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().ResetPasswordByCode(gomock.Any(), arg).Return(mockuser.ID, nil)
+			},
+			expRes: mockuser.ID,
+			expErr: nil,
+		},
+		{
+			name: "code not found",
+			mockBehavior: func() {
+				queries.EXPECT().ResetPasswordByCode(gomock.Any(), arg).Return(uuid.Nil, sql.ErrNoRows)
+			},
+			expRes: uuid.Nil,
+			expErr: repository.ErrResetCodeNotFound,
+		},
+		{
+			name: "unk err",
+			mockBehavior: func() {
+				queries.EXPECT().ResetPasswordByCode(gomock.Any(), arg).Return(uuid.Nil, errMock)
+			},
+			expRes: uuid.Nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := repo.ResetPassword(context.Background(), "hash", "new")
+
+			require.Equal(t, tc.expRes, res)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
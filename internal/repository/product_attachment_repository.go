@@ -0,0 +1,95 @@
+//go:generate mockgen -source=./product_attachment_repository.go -destination=mocks/product_attachment_repository.go -package=mocks
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+var ErrAttachmentNotFound = errors.New("attachment not found")
+
+type ProductAttachmentQueries interface {
+	CreateProductAttachment(ctx context.Context, arg db.CreateProductAttachmentParams) (db.ProductAttachment, error)
+	ListProductAttachments(ctx context.Context, productID uuid.UUID) ([]db.ProductAttachment, error)
+	GetProductAttachment(ctx context.Context, arg db.GetProductAttachmentParams) (db.ProductAttachment, error)
+}
+
+type ProductAttachmentRepository struct {
+	queries ProductAttachmentQueries
+}
+
+func NewProductAttachmentRepository(q ProductAttachmentQueries) *ProductAttachmentRepository {
+	return &ProductAttachmentRepository{q}
+}
+
+func (r *ProductAttachmentRepository) CreateAttachment(ctx context.Context, a *entity.ProductAttachment) (*entity.ProductAttachment, error) {
+	res, err := r.queries.CreateProductAttachment(ctx, db.CreateProductAttachmentParams{
+		ID:          a.ID,
+		ProductID:   a.ProductID,
+		BlobKey:     a.BlobKey,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		UploadedBy:  nullUUID(a.UploadedBy),
+	})
+	if err != nil {
+		switch {
+		case isForeignKeyViolation(err):
+			return nil, ErrProductNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	return toEntityAttachment(res), nil
+}
+
+// ListAttachments returns product attachments, oldest first.
+func (r *ProductAttachmentRepository) ListAttachments(ctx context.Context, productID uuid.UUID) ([]*entity.ProductAttachment, error) {
+	res, err := r.queries.ListProductAttachments(ctx, productID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	ans := make([]*entity.ProductAttachment, len(res))
+	for i, a := range res {
+		ans[i] = toEntityAttachment(a)
+	}
+
+	return ans, nil
+}
+
+func (r *ProductAttachmentRepository) GetAttachment(ctx context.Context, productID, id uuid.UUID) (*entity.ProductAttachment, error) {
+	res, err := r.queries.GetProductAttachment(ctx, db.GetProductAttachmentParams{
+		ID:        id,
+		ProductID: productID,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrAttachmentNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	return toEntityAttachment(res), nil
+}
+
+func toEntityAttachment(a db.ProductAttachment) *entity.ProductAttachment {
+	return &entity.ProductAttachment{
+		ID:          a.ID,
+		ProductID:   a.ProductID,
+		BlobKey:     a.BlobKey,
+		ContentType: a.ContentType,
+		Size:        a.Size,
+		UploadedBy:  a.UploadedBy.UUID,
+		CreatedAt:   a.CreatedAt,
+	}
+}
@@ -0,0 +1,141 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository/mocks"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+var dbAttachment = db.ProductAttachment{
+	ID:          uuid.New(),
+	ProductID:   product.ID,
+	BlobKey:     "products/" + product.ID.String() + "/photo",
+	ContentType: "image/jpeg",
+	Size:        1024,
+	UploadedBy:  uuid.NullUUID{UUID: uuid.New(), Valid: true},
+	CreatedAt:   time.Now(),
+}
+
+var entityAttachment = &entity.ProductAttachment{
+	ID:          dbAttachment.ID,
+	ProductID:   product.ID,
+	BlobKey:     dbAttachment.BlobKey,
+	ContentType: "image/jpeg",
+	Size:        1024,
+	UploadedBy:  dbAttachment.UploadedBy.UUID,
+	CreatedAt:   dbAttachment.CreatedAt,
+}
+
+func TestCreateAttachment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockProductAttachmentQueries(ctrl)
+
+	repo := repository.NewProductAttachmentRepository(queries)
+
+	arg := db.CreateProductAttachmentParams{
+		ID:          dbAttachment.ID,
+		ProductID:   product.ID,
+		BlobKey:     dbAttachment.BlobKey,
+		ContentType: "image/jpeg",
+		Size:        1024,
+		UploadedBy:  dbAttachment.UploadedBy,
+	}
+
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       *entity.ProductAttachment
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().CreateProductAttachment(gomock.Any(), arg).Return(dbAttachment, nil)
+			},
+			expRes: entityAttachment,
+			expErr: nil,
+		},
+		{
+			name: "product not found",
+			mockBehavior: func() {
+				queries.EXPECT().CreateProductAttachment(gomock.Any(), arg).Return(db.ProductAttachment{}, &pq.Error{Code: "23503"})
+			},
+			expRes: nil,
+			expErr: repository.ErrProductNotFound,
+		},
+		{
+			name: "unk err",
+			mockBehavior: func() {
+				queries.EXPECT().CreateProductAttachment(gomock.Any(), arg).Return(db.ProductAttachment{}, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := repo.CreateAttachment(context.Background(), &entity.ProductAttachment{
+				ID:          dbAttachment.ID,
+				ProductID:   product.ID,
+				BlobKey:     dbAttachment.BlobKey,
+				ContentType: "image/jpeg",
+				Size:        1024,
+				UploadedBy:  dbAttachment.UploadedBy.UUID,
+			})
+			require.Equal(t, tc.expErr, err)
+			require.Equal(t, tc.expRes, res)
+		})
+	}
+}
+
+func TestListAttachments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockProductAttachmentQueries(ctrl)
+
+	repo := repository.NewProductAttachmentRepository(queries)
+
+	queries.EXPECT().ListProductAttachments(gomock.Any(), product.ID).Return([]db.ProductAttachment{dbAttachment}, nil)
+	res, err := repo.ListAttachments(context.Background(), product.ID)
+	require.NoError(t, err)
+	require.Equal(t, []*entity.ProductAttachment{entityAttachment}, res)
+
+	queries.EXPECT().ListProductAttachments(gomock.Any(), product.ID).Return(nil, errMock)
+	_, err = repo.ListAttachments(context.Background(), product.ID)
+	require.Equal(t, errMock, err)
+}
+
+func TestGetAttachment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockProductAttachmentQueries(ctrl)
+
+	repo := repository.NewProductAttachmentRepository(queries)
+
+	arg := db.GetProductAttachmentParams{ID: dbAttachment.ID, ProductID: product.ID}
+
+	queries.EXPECT().GetProductAttachment(gomock.Any(), arg).Return(dbAttachment, nil)
+	res, err := repo.GetAttachment(context.Background(), product.ID, dbAttachment.ID)
+	require.NoError(t, err)
+	require.Equal(t, entityAttachment, res)
+
+	queries.EXPECT().GetProductAttachment(gomock.Any(), arg).Return(db.ProductAttachment{}, sql.ErrNoRows)
+	res, err = repo.GetAttachment(context.Background(), product.ID, dbAttachment.ID)
+	require.Equal(t, repository.ErrAttachmentNotFound, err)
+	require.Nil(t, res)
+}
@@ -0,0 +1,236 @@
+//go:generate mockgen -source=./product_repository.go -destination=mocks/product_repository.go -package=mocks
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+var (
+	ErrProductNotFound   = errors.New("product not found")
+	ErrProductStateStale = errors.New("product state changed")
+	ErrNothingToReturn   = errors.New("no products to return")
+
+	ErrPickupCodeNotSettable = errors.New("product is not stored or already has pickup code")
+)
+
+type ProductQueries interface {
+	GetProductByID(ctx context.Context, id uuid.UUID) (db.Product, error)
+	UpdateProductState(ctx context.Context, arg db.UpdateProductStateParams) (db.Product, error)
+	SetProductPickupCode(ctx context.Context, arg db.SetProductPickupCodeParams) (db.Product, error)
+	ListPvzStock(ctx context.Context, arg db.ListPvzStockParams) ([]db.Product, error)
+	ListProductEvents(ctx context.Context, productID uuid.UUID) ([]db.ProductEvent, error)
+	ListStoredProductsBefore(ctx context.Context, before time.Time) ([]db.ListStoredProductsBeforeRow, error)
+	GetPvzExpiryStats(ctx context.Context, arg db.GetPvzExpiryStatsParams) (db.GetPvzExpiryStatsRow, error)
+	CreateReturnShipment(ctx context.Context, arg db.CreateReturnShipmentParams) (db.ReturnShipment, error)
+	ListReturnShipmentProducts(ctx context.Context, shipmentID uuid.UUID) ([]db.Product, error)
+}
+
+type ProductRepository struct {
+	queries ProductQueries
+}
+
+func NewProductRepository(q ProductQueries) *ProductRepository {
+	return &ProductRepository{q}
+}
+
+func (r *ProductRepository) GetProduct(ctx context.Context, id uuid.UUID) (*entity.Product, error) {
+	res, err := r.queries.GetProductByID(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrProductNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	return toEntityProduct(res), nil
+}
+
+// UpdateProductState moves product of closed reception from one
+// state to another and records the event in product history.
+func (r *ProductRepository) UpdateProductState(ctx context.Context, id uuid.UUID, from, to entity.ProductState, event entity.ProductEventType, actorID uuid.UUID, details map[string]any) (*entity.Product, error) {
+	if details == nil {
+		details = map[string]any{}
+	}
+	rawDetails, err := json.Marshal(details)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.queries.UpdateProductState(ctx, db.UpdateProductStateParams{
+		State:     to,
+		ID:        id,
+		FromState: from,
+		Event:     event,
+		ActorID:   nullUUID(actorID),
+		Details:   string(rawDetails),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrProductStateStale
+		default:
+			return nil, err
+		}
+	}
+
+	return toEntityProduct(res), nil
+}
+
+// SetPickupCode stores pickup code hash of stored product,
+// which was accepted without code.
+func (r *ProductRepository) SetPickupCode(ctx context.Context, id uuid.UUID, codeHash string) (*entity.Product, error) {
+	res, err := r.queries.SetProductPickupCode(ctx, db.SetProductPickupCodeParams{
+		PickupCodeHash: sql.NullString{String: codeHash, Valid: true},
+		ID:             id,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrPickupCodeNotSettable
+		default:
+			return nil, err
+		}
+	}
+
+	return toEntityProduct(res), nil
+}
+
+// ListPvzStock returns page of stored products of PVZ and
+// products waiting for return, oldest first.
+func (r *ProductRepository) ListPvzStock(ctx context.Context, pvzID uuid.UUID, page, limit int) ([]*entity.Product, error) {
+	res, err := r.queries.ListPvzStock(ctx, db.ListPvzStockParams{
+		PvzID:  pvzID,
+		Limit:  int32(limit),
+		Offset: (int32(page) - 1) * int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	products := make([]*entity.Product, len(res))
+	for i, p := range res {
+		products[i] = toEntityProduct(p)
+	}
+
+	return products, nil
+}
+
+// ListProductEvents returns product history, oldest first.
+func (r *ProductRepository) ListProductEvents(ctx context.Context, productID uuid.UUID) ([]*entity.ProductEvent, error) {
+	res, err := r.queries.ListProductEvents(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]*entity.ProductEvent, len(res))
+	for i, e := range res {
+		events[i] = &entity.ProductEvent{
+			ID:        e.ID,
+			ProductID: e.ProductID,
+			PvzID:     e.PvzID,
+			Type:      e.Type,
+			ActorID:   e.ActorID.UUID,
+			Details:   e.Details,
+			CreatedAt: e.CreatedAt,
+		}
+	}
+
+	return events, nil
+}
+
+// ListStoredProductsBefore returns stored products of closed
+// receptions accepted before given time, oldest first.
+func (r *ProductRepository) ListStoredProductsBefore(ctx context.Context, before time.Time) ([]*entity.StoredProduct, error) {
+	res, err := r.queries.ListStoredProductsBefore(ctx, before)
+	if err != nil {
+		return nil, err
+	}
+
+	products := make([]*entity.StoredProduct, len(res))
+	for i, p := range res {
+		products[i] = &entity.StoredProduct{
+			Product: &entity.Product{
+				ID:          p.ID,
+				DateTime:    p.DateTime,
+				Type:        p.Type,
+				ReceptionID: p.ReceptionID,
+				State:       entity.ProductStateStored,
+			},
+			PvzID: p.PvzID,
+			City:  p.City,
+		}
+	}
+
+	return products, nil
+}
+
+// GetPvzExpiryStats counts stored products of PVZ accepted before
+// the time of their type (defaultBefore for types not listed) and
+// products waiting for return.
+func (r *ProductRepository) GetPvzExpiryStats(ctx context.Context, pvzID uuid.UUID, defaultBefore time.Time, typeBefores map[entity.ProductType]time.Time) (expiring, toReturn int64, err error) {
+	arg := db.GetPvzExpiryStatsParams{
+		DefaultBefore: defaultBefore,
+		Types:         make([]string, 0, len(typeBefores)),
+		TypeBefores:   make([]time.Time, 0, len(typeBefores)),
+		PvzID:         pvzID,
+	}
+	for t, before := range typeBefores {
+		arg.Types = append(arg.Types, string(t))
+		arg.TypeBefores = append(arg.TypeBefores, before)
+	}
+
+	res, err := r.queries.GetPvzExpiryStats(ctx, arg)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return res.ExpiringCount, res.ToReturnCount, nil
+}
+
+// CreateReturnShipment groups all products of PVZ waiting for
+// return into new shipment and marks them returned to sender.
+func (r *ProductRepository) CreateReturnShipment(ctx context.Context, pvzID, createdBy uuid.UUID) (*entity.ReturnShipment, error) {
+	res, err := r.queries.CreateReturnShipment(ctx, db.CreateReturnShipmentParams{
+		PvzID:     pvzID,
+		ID:        uuid.New(),
+		CreatedBy: nullUUID(createdBy),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrNothingToReturn
+		default:
+			return nil, err
+		}
+	}
+
+	products, err := r.queries.ListReturnShipmentProducts(ctx, res.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	shipment := &entity.ReturnShipment{
+		ID:        res.ID,
+		PvzID:     res.PvzID,
+		CreatedBy: res.CreatedBy.UUID,
+		CreatedAt: res.CreatedAt,
+		Products:  make([]*entity.Product, len(products)),
+	}
+	for i, p := range products {
+		shipment.Products[i] = toEntityProduct(p)
+	}
+
+	return shipment, nil
+}
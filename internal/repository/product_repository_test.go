@@ -0,0 +1,500 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository/mocks"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+var dbStoredProduct = db.Product{
+	ID:             product.ID,
+	DateTime:       product.DateTime,
+	Type:           product.Type,
+	ReceptionID:    product.ReceptionID,
+	State:          entity.ProductStateStored,
+	PickupCodeHash: sql.NullString{String: "hash", Valid: true},
+}
+
+var entityStoredProduct = &entity.Product{
+	ID:             product.ID,
+	DateTime:       product.DateTime,
+	Type:           product.Type,
+	ReceptionID:    product.ReceptionID,
+	State:          entity.ProductStateStored,
+	PickupCodeHash: "hash",
+}
+
+func TestGetProduct(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockProductQueries(ctrl)
+
+	repo := repository.NewProductRepository(queries)
+
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       *entity.Product
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().GetProductByID(gomock.Any(), product.ID).Return(dbStoredProduct, nil)
+			},
+			expRes: entityStoredProduct,
+			expErr: nil,
+		},
+		{
+			name: "not found",
+			mockBehavior: func() {
+				queries.EXPECT().GetProductByID(gomock.Any(), product.ID).Return(db.Product{}, sql.ErrNoRows)
+			},
+			expRes: nil,
+			expErr: repository.ErrProductNotFound,
+		},
+		{
+			name: "unk err",
+			mockBehavior: func() {
+				queries.EXPECT().GetProductByID(gomock.Any(), product.ID).Return(db.Product{}, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := repo.GetProduct(context.Background(), product.ID)
+			require.Equal(t, tc.expErr, err)
+			require.Equal(t, tc.expRes, res)
+		})
+	}
+}
+
+func TestUpdateProductState(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockProductQueries(ctrl)
+
+	repo := repository.NewProductRepository(queries)
+
+	actorID := uuid.New()
+	arg := db.UpdateProductStateParams{
+		State:     entity.ProductStateIssued,
+		ID:        product.ID,
+		FromState: entity.ProductStateStored,
+		Event:     entity.ProductEventIssued,
+		ActorID:   uuid.NullUUID{UUID: actorID, Valid: true},
+		Details:   "{}",
+	}
+	issued := dbStoredProduct
+	issued.State = entity.ProductStateIssued
+
+	testCases := []struct {
+		name         string
+		actorID      uuid.UUID
+		details      map[string]any
+		mockBehavior func()
+		expRes       *entity.Product
+		expErr       error
+	}{
+		{
+			name:    "ok",
+			actorID: actorID,
+			mockBehavior: func() {
+				queries.EXPECT().UpdateProductState(gomock.Any(), arg).Return(issued, nil)
+			},
+			expRes: &entity.Product{
+				ID:             product.ID,
+				DateTime:       product.DateTime,
+				Type:           product.Type,
+				ReceptionID:    product.ReceptionID,
+				State:          entity.ProductStateIssued,
+				PickupCodeHash: "hash",
+			},
+			expErr: nil,
+		},
+		{
+			name:    "system actor with details",
+			details: map[string]any{"reason": "expired"},
+			mockBehavior: func() {
+				want := arg
+				want.ActorID = uuid.NullUUID{}
+				want.Details = `{"reason":"expired"}`
+				queries.EXPECT().UpdateProductState(gomock.Any(), want).Return(issued, nil)
+			},
+			expRes: &entity.Product{
+				ID:             product.ID,
+				DateTime:       product.DateTime,
+				Type:           product.Type,
+				ReceptionID:    product.ReceptionID,
+				State:          entity.ProductStateIssued,
+				PickupCodeHash: "hash",
+			},
+			expErr: nil,
+		},
+		{
+			name:    "state changed",
+			actorID: actorID,
+			mockBehavior: func() {
+				queries.EXPECT().UpdateProductState(gomock.Any(), arg).Return(db.Product{}, sql.ErrNoRows)
+			},
+			expRes: nil,
+			expErr: repository.ErrProductStateStale,
+		},
+		{
+			name:    "unk err",
+			actorID: actorID,
+			mockBehavior: func() {
+				queries.EXPECT().UpdateProductState(gomock.Any(), arg).Return(db.Product{}, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := repo.UpdateProductState(context.Background(), product.ID, entity.ProductStateStored, entity.ProductStateIssued, entity.ProductEventIssued, tc.actorID, tc.details)
+			require.Equal(t, tc.expErr, err)
+			require.Equal(t, tc.expRes, res)
+		})
+	}
+}
+
+func TestSetPickupCode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockProductQueries(ctrl)
+
+	repo := repository.NewProductRepository(queries)
+
+	arg := db.SetProductPickupCodeParams{
+		PickupCodeHash: sql.NullString{String: "hash", Valid: true},
+		ID:             product.ID,
+	}
+
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       *entity.Product
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().SetProductPickupCode(gomock.Any(), arg).Return(dbStoredProduct, nil)
+			},
+			expRes: entityStoredProduct,
+			expErr: nil,
+		},
+		{
+			name: "not settable",
+			mockBehavior: func() {
+				queries.EXPECT().SetProductPickupCode(gomock.Any(), arg).Return(db.Product{}, sql.ErrNoRows)
+			},
+			expRes: nil,
+			expErr: repository.ErrPickupCodeNotSettable,
+		},
+		{
+			name: "unk err",
+			mockBehavior: func() {
+				queries.EXPECT().SetProductPickupCode(gomock.Any(), arg).Return(db.Product{}, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := repo.SetPickupCode(context.Background(), product.ID, "hash")
+			require.Equal(t, tc.expErr, err)
+			require.Equal(t, tc.expRes, res)
+		})
+	}
+}
+
+func TestListPvzStock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockProductQueries(ctrl)
+
+	repo := repository.NewProductRepository(queries)
+
+	arg := db.ListPvzStockParams{PvzID: pvz.ID, Limit: 10, Offset: 20}
+
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       []*entity.Product
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().ListPvzStock(gomock.Any(), arg).Return([]db.Product{dbStoredProduct}, nil)
+			},
+			expRes: []*entity.Product{entityStoredProduct},
+			expErr: nil,
+		},
+		{
+			name: "unk err",
+			mockBehavior: func() {
+				queries.EXPECT().ListPvzStock(gomock.Any(), arg).Return(nil, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := repo.ListPvzStock(context.Background(), pvz.ID, 3, 10)
+			require.Equal(t, tc.expErr, err)
+			require.Equal(t, tc.expRes, res)
+		})
+	}
+}
+
+func TestListProductEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockProductQueries(ctrl)
+
+	repo := repository.NewProductRepository(queries)
+
+	actorID := uuid.New()
+	createdAt := time.Now()
+	details := json.RawMessage(`{}`)
+
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       []*entity.ProductEvent
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().ListProductEvents(gomock.Any(), product.ID).Return([]db.ProductEvent{
+					{ID: 1, ProductID: product.ID, PvzID: pvz.ID, Type: entity.ProductEventReceived, Details: details, CreatedAt: createdAt},
+					{ID: 2, ProductID: product.ID, PvzID: pvz.ID, Type: entity.ProductEventIssued, ActorID: uuid.NullUUID{UUID: actorID, Valid: true}, Details: details, CreatedAt: createdAt},
+				}, nil)
+			},
+			expRes: []*entity.ProductEvent{
+				{ID: 1, ProductID: product.ID, PvzID: pvz.ID, Type: entity.ProductEventReceived, Details: details, CreatedAt: createdAt},
+				{ID: 2, ProductID: product.ID, PvzID: pvz.ID, Type: entity.ProductEventIssued, ActorID: actorID, Details: details, CreatedAt: createdAt},
+			},
+			expErr: nil,
+		},
+		{
+			name: "unk err",
+			mockBehavior: func() {
+				queries.EXPECT().ListProductEvents(gomock.Any(), product.ID).Return(nil, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := repo.ListProductEvents(context.Background(), product.ID)
+			require.Equal(t, tc.expErr, err)
+			require.Equal(t, tc.expRes, res)
+		})
+	}
+}
+
+func TestListStoredProductsBefore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockProductQueries(ctrl)
+
+	repo := repository.NewProductRepository(queries)
+
+	before := time.Now()
+
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       []*entity.StoredProduct
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().ListStoredProductsBefore(gomock.Any(), before).Return([]db.ListStoredProductsBeforeRow{{
+					ID:          product.ID,
+					DateTime:    product.DateTime,
+					Type:        product.Type,
+					ReceptionID: product.ReceptionID,
+					PvzID:       pvz.ID,
+					City:        pvz.City,
+				}}, nil)
+			},
+			expRes: []*entity.StoredProduct{{
+				Product: &entity.Product{
+					ID:          product.ID,
+					DateTime:    product.DateTime,
+					Type:        product.Type,
+					ReceptionID: product.ReceptionID,
+					State:       entity.ProductStateStored,
+				},
+				PvzID: pvz.ID,
+				City:  pvz.City,
+			}},
+			expErr: nil,
+		},
+		{
+			name: "unk err",
+			mockBehavior: func() {
+				queries.EXPECT().ListStoredProductsBefore(gomock.Any(), before).Return(nil, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := repo.ListStoredProductsBefore(context.Background(), before)
+			require.Equal(t, tc.expErr, err)
+			require.Equal(t, tc.expRes, res)
+		})
+	}
+}
+
+func TestGetPvzExpiryStats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockProductQueries(ctrl)
+
+	repo := repository.NewProductRepository(queries)
+
+	defaultBefore := time.Now()
+	electronicsBefore := defaultBefore.Add(-time.Hour)
+	arg := db.GetPvzExpiryStatsParams{
+		DefaultBefore: defaultBefore,
+		Types:         []string{string(entity.ProductTypeElectronics)},
+		TypeBefores:   []time.Time{electronicsBefore},
+		PvzID:         pvz.ID,
+	}
+
+	queries.EXPECT().GetPvzExpiryStats(gomock.Any(), arg).Return(db.GetPvzExpiryStatsRow{ExpiringCount: 2, ToReturnCount: 3}, nil)
+	expiring, toReturn, err := repo.GetPvzExpiryStats(context.Background(), pvz.ID, defaultBefore, map[entity.ProductType]time.Time{
+		entity.ProductTypeElectronics: electronicsBefore,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), expiring)
+	require.Equal(t, int64(3), toReturn)
+
+	queries.EXPECT().GetPvzExpiryStats(gomock.Any(), gomock.Any()).Return(db.GetPvzExpiryStatsRow{}, errMock)
+	_, _, err = repo.GetPvzExpiryStats(context.Background(), pvz.ID, defaultBefore, nil)
+	require.Equal(t, errMock, err)
+}
+
+func TestCreateReturnShipment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockProductQueries(ctrl)
+
+	repo := repository.NewProductRepository(queries)
+
+	userID := uuid.New()
+	dbShipment := db.ReturnShipment{
+		ID:        uuid.New(),
+		PvzID:     pvz.ID,
+		CreatedBy: uuid.NullUUID{UUID: userID, Valid: true},
+		CreatedAt: time.Now(),
+	}
+	returned := dbStoredProduct
+	returned.State = entity.ProductStateReturnedToSender
+	matchArg := func(_ context.Context, arg db.CreateReturnShipmentParams) {
+		require.Equal(t, pvz.ID, arg.PvzID)
+		require.NotEqual(t, uuid.Nil, arg.ID)
+		require.Equal(t, uuid.NullUUID{UUID: userID, Valid: true}, arg.CreatedBy)
+	}
+
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       *entity.ReturnShipment
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().CreateReturnShipment(gomock.Any(), gomock.Any()).Do(matchArg).Return(dbShipment, nil)
+				queries.EXPECT().ListReturnShipmentProducts(gomock.Any(), dbShipment.ID).Return([]db.Product{returned}, nil)
+			},
+			expRes: &entity.ReturnShipment{
+				ID:        dbShipment.ID,
+				PvzID:     pvz.ID,
+				CreatedBy: userID,
+				CreatedAt: dbShipment.CreatedAt,
+				Products: []*entity.Product{{
+					ID:             product.ID,
+					DateTime:       product.DateTime,
+					Type:           product.Type,
+					ReceptionID:    product.ReceptionID,
+					State:          entity.ProductStateReturnedToSender,
+					PickupCodeHash: "hash",
+				}},
+			},
+			expErr: nil,
+		},
+		{
+			name: "nothing to return",
+			mockBehavior: func() {
+				queries.EXPECT().CreateReturnShipment(gomock.Any(), gomock.Any()).Return(db.ReturnShipment{}, sql.ErrNoRows)
+			},
+			expRes: nil,
+			expErr: repository.ErrNothingToReturn,
+		},
+		{
+			name: "list products err",
+			mockBehavior: func() {
+				queries.EXPECT().CreateReturnShipment(gomock.Any(), gomock.Any()).Return(dbShipment, nil)
+				queries.EXPECT().ListReturnShipmentProducts(gomock.Any(), dbShipment.ID).Return(nil, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := repo.CreateReturnShipment(context.Background(), pvz.ID, userID)
+			require.Equal(t, tc.expErr, err)
+			require.Equal(t, tc.expRes, res)
+		})
+	}
+}
@@ -0,0 +1,182 @@
+//go:generate mockgen -source=./product_type_repository.go -destination=mocks/product_type_repository.go -package=mocks
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+var (
+	ErrProductTypeAlreadyExists = errors.New("product type already exists")
+	ErrProductTypeNotFound      = errors.New("product type not found")
+	ErrProductTypeInUse         = errors.New("product type is used by products")
+)
+
+type ProductTypeQueries interface {
+	ListProductTypes(ctx context.Context) ([]db.ProductType, error)
+	CreateProductType(ctx context.Context, arg db.CreateProductTypeParams) (db.ProductType, error)
+	UpdateProductType(ctx context.Context, arg db.UpdateProductTypeParams) (db.ProductType, error)
+	DeleteProductType(ctx context.Context, code string) (int64, error)
+}
+
+// productAttribute is a stored form of entity.ProductAttribute.
+type productAttribute struct {
+	Name     string `json:"name"`
+	Required bool   `json:"required,omitempty"`
+	Pattern  string `json:"pattern,omitempty"`
+}
+
+type ProductTypeRepository struct {
+	queries ProductTypeQueries
+}
+
+func NewProductTypeRepository(q ProductTypeQueries) *ProductTypeRepository {
+	return &ProductTypeRepository{q}
+}
+
+// ListProductTypes returns product types ordered by name.
+func (r *ProductTypeRepository) ListProductTypes(ctx context.Context) ([]*entity.ProductTypeInfo, error) {
+	res, err := r.queries.ListProductTypes(ctx)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return []*entity.ProductTypeInfo{}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	types := make([]*entity.ProductTypeInfo, len(res))
+	for i, t := range res {
+		types[i], err = toEntityProductType(t)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return types, nil
+}
+
+func (r *ProductTypeRepository) CreateProductType(ctx context.Context, req *request.CreateProductType) (*entity.ProductTypeInfo, error) {
+	attrs, err := marshalProductAttributes(req.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.CreateProductTypeParams{
+		Code:       req.Code,
+		Name:       entity.ProductType(req.Name),
+		NameEn:     req.NameEn,
+		Attributes: attrs,
+		Fragile:    req.Fragile,
+		HighValue:  req.HighValue,
+	}
+
+	res, err := r.queries.CreateProductType(ctx, arg)
+	if err != nil {
+		switch {
+		case isUniqueViolation(err):
+			return nil, ErrProductTypeAlreadyExists
+		default:
+			return nil, err
+		}
+	}
+
+	return toEntityProductType(res)
+}
+
+func (r *ProductTypeRepository) UpdateProductType(ctx context.Context, code string, req *request.UpdateProductType) (*entity.ProductTypeInfo, error) {
+	arg := db.UpdateProductTypeParams{
+		Code: code,
+	}
+	if req.NameEn != nil {
+		arg.NameEn = sql.NullString{String: *req.NameEn, Valid: true}
+	}
+	if req.Attributes != nil {
+		attrs, err := marshalProductAttributes(*req.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		arg.Attributes = sql.NullString{String: attrs, Valid: true}
+	}
+	if req.Fragile != nil {
+		arg.Fragile = sql.NullBool{Bool: *req.Fragile, Valid: true}
+	}
+	if req.HighValue != nil {
+		arg.HighValue = sql.NullBool{Bool: *req.HighValue, Valid: true}
+	}
+
+	res, err := r.queries.UpdateProductType(ctx, arg)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrProductTypeNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	return toEntityProductType(res)
+}
+
+// DeleteProductType deletes product type. Types
+// of already accepted products can't be deleted.
+func (r *ProductTypeRepository) DeleteProductType(ctx context.Context, code string) error {
+	n, err := r.queries.DeleteProductType(ctx, code)
+	if err != nil {
+		switch {
+		case isForeignKeyViolation(err):
+			return ErrProductTypeInUse
+		default:
+			return err
+		}
+	}
+	if n == 0 {
+		return ErrProductTypeNotFound
+	}
+
+	return nil
+}
+
+func marshalProductAttributes(attrs []request.ProductAttribute) (string, error) {
+	stored := make([]productAttribute, len(attrs))
+	for i, a := range attrs {
+		stored[i] = productAttribute(a)
+	}
+
+	raw, err := json.Marshal(stored)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+func toEntityProductType(t db.ProductType) (*entity.ProductTypeInfo, error) {
+	var stored []productAttribute
+	if err := json.Unmarshal(t.Attributes, &stored); err != nil {
+		return nil, err
+	}
+
+	attrs := make([]entity.ProductAttribute, len(stored))
+	for i, a := range stored {
+		attrs[i] = entity.ProductAttribute(a)
+	}
+
+	return &entity.ProductTypeInfo{
+		Code:       t.Code,
+		Name:       t.Name,
+		NameEn:     t.NameEn,
+		Attributes: attrs,
+		Fragile:    t.Fragile,
+		HighValue:  t.HighValue,
+		CreatedAt:  t.CreatedAt,
+	}, nil
+}
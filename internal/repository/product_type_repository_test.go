@@ -0,0 +1,266 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository/mocks"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+var dbProductType = db.ProductType{
+	Code:       "electronics",
+	Name:       entity.ProductTypeElectronics,
+	NameEn:     "Electronics",
+	Attributes: []byte(`[{"name": "imei", "required": true, "pattern": "^[0-9]{15}$"}]`),
+	Fragile:    true,
+	HighValue:  true,
+	CreatedAt:  time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+}
+
+var entityProductType = &entity.ProductTypeInfo{
+	Code:       dbProductType.Code,
+	Name:       dbProductType.Name,
+	NameEn:     dbProductType.NameEn,
+	Attributes: []entity.ProductAttribute{{Name: "imei", Required: true, Pattern: "^[0-9]{15}$"}},
+	Fragile:    true,
+	HighValue:  true,
+	CreatedAt:  dbProductType.CreatedAt,
+}
+
+func TestListProductTypes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockProductTypeQueries(ctrl)
+
+	repo := repository.NewProductTypeRepository(queries)
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       []*entity.ProductTypeInfo
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().ListProductTypes(gomock.Any()).Return([]db.ProductType{dbProductType}, nil)
+			},
+			expRes: []*entity.ProductTypeInfo{entityProductType},
+			expErr: nil,
+		},
+		{
+			name: "no types",
+			mockBehavior: func() {
+				queries.EXPECT().ListProductTypes(gomock.Any()).Return(nil, sql.ErrNoRows)
+			},
+			expRes: []*entity.ProductTypeInfo{},
+			expErr: nil,
+		},
+		{
+			name: "unk err",
+			mockBehavior: func() {
+				queries.EXPECT().ListProductTypes(gomock.Any()).Return(nil, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := repo.ListProductTypes(context.Background())
+			require.Equal(t, tc.expErr, err)
+			require.Equal(t, tc.expRes, res)
+		})
+	}
+}
+
+func TestCreateProductType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockProductTypeQueries(ctrl)
+
+	repo := repository.NewProductTypeRepository(queries)
+
+	req := &request.CreateProductType{
+		Code:       dbProductType.Code,
+		Name:       string(dbProductType.Name),
+		NameEn:     dbProductType.NameEn,
+		Attributes: []request.ProductAttribute{{Name: "imei", Required: true, Pattern: "^[0-9]{15}$"}},
+		Fragile:    true,
+		HighValue:  true,
+	}
+	arg := db.CreateProductTypeParams{
+		Code:       req.Code,
+		Name:       dbProductType.Name,
+		NameEn:     req.NameEn,
+		Attributes: `[{"name":"imei","required":true,"pattern":"^[0-9]{15}$"}]`,
+		Fragile:    true,
+		HighValue:  true,
+	}
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       *entity.ProductTypeInfo
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().CreateProductType(gomock.Any(), arg).Return(dbProductType, nil)
+			},
+			expRes: entityProductType,
+			expErr: nil,
+		},
+		{
+			name: "already exists",
+			mockBehavior: func() {
+				queries.EXPECT().CreateProductType(gomock.Any(), arg).Return(db.ProductType{}, &pq.Error{Code: "23505"})
+			},
+			expRes: nil,
+			expErr: repository.ErrProductTypeAlreadyExists,
+		},
+		{
+			name: "unk err",
+			mockBehavior: func() {
+				queries.EXPECT().CreateProductType(gomock.Any(), arg).Return(db.ProductType{}, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := repo.CreateProductType(context.Background(), req)
+			require.Equal(t, tc.expErr, err)
+			require.Equal(t, tc.expRes, res)
+		})
+	}
+}
+
+func TestUpdateProductType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockProductTypeQueries(ctrl)
+
+	repo := repository.NewProductTypeRepository(queries)
+
+	fragile := false
+	attrs := []request.ProductAttribute{}
+	req := &request.UpdateProductType{Attributes: &attrs, Fragile: &fragile}
+	arg := db.UpdateProductTypeParams{
+		Code:       dbProductType.Code,
+		Attributes: sql.NullString{String: "[]", Valid: true},
+		Fragile:    sql.NullBool{Bool: false, Valid: true},
+	}
+
+	updated := dbProductType
+	updated.Attributes = []byte("[]")
+	updated.Fragile = false
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       *entity.ProductTypeInfo
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().UpdateProductType(gomock.Any(), arg).Return(updated, nil)
+			},
+			expRes: &entity.ProductTypeInfo{
+				Code:       dbProductType.Code,
+				Name:       dbProductType.Name,
+				NameEn:     dbProductType.NameEn,
+				Attributes: []entity.ProductAttribute{},
+				Fragile:    false,
+				HighValue:  true,
+				CreatedAt:  dbProductType.CreatedAt,
+			},
+			expErr: nil,
+		},
+		{
+			name: "not found",
+			mockBehavior: func() {
+				queries.EXPECT().UpdateProductType(gomock.Any(), arg).Return(db.ProductType{}, sql.ErrNoRows)
+			},
+			expRes: nil,
+			expErr: repository.ErrProductTypeNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := repo.UpdateProductType(context.Background(), dbProductType.Code, req)
+			require.Equal(t, tc.expErr, err)
+			require.Equal(t, tc.expRes, res)
+		})
+	}
+}
+
+func TestDeleteProductType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockProductTypeQueries(ctrl)
+
+	repo := repository.NewProductTypeRepository(queries)
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().DeleteProductType(gomock.Any(), dbProductType.Code).Return(int64(1), nil)
+			},
+			expErr: nil,
+		},
+		{
+			name: "not found",
+			mockBehavior: func() {
+				queries.EXPECT().DeleteProductType(gomock.Any(), dbProductType.Code).Return(int64(0), nil)
+			},
+			expErr: repository.ErrProductTypeNotFound,
+		},
+		{
+			name: "in use",
+			mockBehavior: func() {
+				queries.EXPECT().DeleteProductType(gomock.Any(), dbProductType.Code).Return(int64(0), &pq.Error{Code: "23503"})
+			},
+			expErr: repository.ErrProductTypeInUse,
+		},
+		{
+			name: "unk err",
+			mockBehavior: func() {
+				queries.EXPECT().DeleteProductType(gomock.Any(), dbProductType.Code).Return(int64(0), errMock)
+			},
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			err := repo.DeleteProductType(context.Background(), dbProductType.Code)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
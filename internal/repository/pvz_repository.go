@@ -7,16 +7,36 @@ import (
 	"database/sql"
 	"errors"
 
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
 	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
 )
 
-var ErrPvzAlreadyExists = errors.New("pvz already exists")
+var (
+	ErrPvzAlreadyExists = errors.New("pvz already exists")
+	ErrPvzNotFound      = errors.New("pvz not found")
+
+	ErrPvzHasOpenReception = errors.New("pvz has reception in progress")
+	ErrPvzDecommissioned   = errors.New("pvz is decommissioned")
+	ErrPvzCapacityExceeded = errors.New("pvz is at capacity")
+)
+
+const (
+	errPvzHasOpenReceptionCode = "20004"
+	errPvzDecommissionedCode   = "20005"
+	errPvzCapacityExceededCode = "20009"
+)
 
 type PvzQueries interface {
 	SearchPVZ(ctx context.Context, arg db.SearchPVZParams) ([]db.Pvz, error)
 	CreatePVZ(ctx context.Context, arg db.CreatePVZParams) (db.Pvz, error)
+	GetPVZByID(ctx context.Context, id uuid.UUID) (db.Pvz, error)
+	UpdatePVZStatus(ctx context.Context, arg db.UpdatePVZStatusParams) (db.UpdatePVZStatusRow, error)
+	UpdatePVZCapacity(ctx context.Context, arg db.UpdatePVZCapacityParams) (db.Pvz, error)
+	ListPVZStockCounts(ctx context.Context) ([]db.ListPVZStockCountsRow, error)
 }
 
 type PvzRepository struct {
@@ -27,10 +47,15 @@ func NewPvzRepository(q PvzQueries) *PvzRepository {
 	return &PvzRepository{q}
 }
 
+// SearchPvz returns page of PVZs. Zero limit means all of them.
 func (r *PvzRepository) SearchPvz(ctx context.Context, req *request.SearchPvz) ([]*entity.Pvz, error) {
 	arg := db.SearchPVZParams{
+		PvzIds: req.PvzIDs,
 		Offset: (int32(req.Page) - 1) * int32(req.Limit),
-		Limit:  int32(req.Limit),
+		Limit:  sql.NullInt32{Int32: int32(req.Limit), Valid: req.Limit > 0},
+	}
+	if req.Status != nil {
+		arg.Status = sql.NullString{String: *req.Status, Valid: true}
 	}
 
 	res, err := r.queries.SearchPVZ(ctx, arg)
@@ -45,11 +70,7 @@ func (r *PvzRepository) SearchPvz(ctx context.Context, req *request.SearchPvz) (
 
 	pvz := make([]*entity.Pvz, len(res))
 	for i, r := range res {
-		pvz[i] = &entity.Pvz{
-			ID:               r.ID,
-			RegistrationDate: r.RegistrationDate,
-			City:             r.City,
-		}
+		pvz[i] = toEntityPvz(r)
 	}
 
 	return pvz, nil
@@ -60,6 +81,8 @@ func (r *PvzRepository) CreatePvz(ctx context.Context, req *request.CreatePvz) (
 		ID:               req.ID,
 		RegistrationDate: req.RegistrationDate,
 		City:             entity.City(req.City),
+		Capacity:         nullInt32(req.Capacity),
+		SoftCapacity:     nullInt32(req.SoftCapacity),
 	}
 
 	res, err := r.queries.CreatePVZ(ctx, arg)
@@ -67,14 +90,122 @@ func (r *PvzRepository) CreatePvz(ctx context.Context, req *request.CreatePvz) (
 		switch {
 		case isUniqueViolation(err):
 			return nil, ErrPvzAlreadyExists
+		case isForeignKeyViolation(err):
+			return nil, ErrCityNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	return toEntityPvz(res), nil
+}
+
+func (r *PvzRepository) GetPvz(ctx context.Context, id uuid.UUID) (*entity.Pvz, error) {
+	res, err := r.queries.GetPVZByID(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrPvzNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	return toEntityPvz(res), nil
+}
+
+// UpdatePvzStatus changes PVZ status and writes status history
+// record if status actually changed.
+func (r *PvzRepository) UpdatePvzStatus(ctx context.Context, id uuid.UUID, req *request.UpdatePvz, changedBy uuid.UUID) (*entity.Pvz, error) {
+	arg := db.UpdatePVZStatusParams{
+		ID:        id,
+		Status:    entity.PvzStatus(req.Status),
+		ChangedBy: nullUUID(changedBy),
+		Reason:    req.Reason,
+	}
+
+	res, err := r.queries.UpdatePVZStatus(ctx, arg)
+	if err != nil {
+		pqErr, ok := err.(*pq.Error)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrPvzNotFound
+		case ok && pqErr.Code == errPvzHasOpenReceptionCode:
+			return nil, ErrPvzHasOpenReception
+		case ok && pqErr.Code == errPvzDecommissionedCode:
+			return nil, ErrPvzDecommissioned
 		default:
 			return nil, err
 		}
 	}
 
 	return &entity.Pvz{
-		ID:               req.ID,
+		ID:               res.ID,
 		RegistrationDate: res.RegistrationDate,
 		City:             res.City,
+		Status:           res.Status,
+		Capacity:         int(res.Capacity.Int32),
+		SoftCapacity:     int(res.SoftCapacity.Int32),
+		StockCount:       int(res.StockCount),
 	}, nil
 }
+
+// UpdatePvzCapacity replaces PVZ capacity limits. Stock above
+// new capacity is kept, only further intake is refused.
+func (r *PvzRepository) UpdatePvzCapacity(ctx context.Context, id uuid.UUID, req *request.UpdatePvzCapacity) (*entity.Pvz, error) {
+	arg := db.UpdatePVZCapacityParams{
+		Capacity:     nullInt32(req.Capacity),
+		SoftCapacity: nullInt32(req.SoftCapacity),
+		ID:           id,
+	}
+
+	res, err := r.queries.UpdatePVZCapacity(ctx, arg)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrPvzNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	return toEntityPvz(res), nil
+}
+
+// ListPvzStockCounts returns stock and capacity of PVZ in service.
+func (r *PvzRepository) ListPvzStockCounts(ctx context.Context) ([]*entity.Pvz, error) {
+	res, err := r.queries.ListPVZStockCounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pvz := make([]*entity.Pvz, len(res))
+	for i, r := range res {
+		pvz[i] = &entity.Pvz{
+			ID:         r.ID,
+			Capacity:   int(r.Capacity.Int32),
+			StockCount: int(r.StockCount),
+		}
+	}
+
+	return pvz, nil
+}
+
+func toEntityPvz(p db.Pvz) *entity.Pvz {
+	return &entity.Pvz{
+		ID:               p.ID,
+		RegistrationDate: p.RegistrationDate,
+		City:             p.City,
+		Status:           p.Status,
+		Capacity:         int(p.Capacity.Int32),
+		SoftCapacity:     int(p.SoftCapacity.Int32),
+		StockCount:       int(p.StockCount),
+	}
+}
+
+func nullInt32(v *int) sql.NullInt32 {
+	if v == nil {
+		return sql.NullInt32{}
+	}
+	return sql.NullInt32{Int32: int32(*v), Valid: true}
+}
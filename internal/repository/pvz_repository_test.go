@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
 	"github.com/lib/pq"
 	"github.com/stretchr/testify/require"
 
@@ -41,15 +42,35 @@ func TestSearchPvz(t *testing.T) {
 			mockBehavior: func(req *request.SearchPvz) {
 				queries.EXPECT().SearchPVZ(gomock.Any(), db.SearchPVZParams{
 					Offset: (int32(req.Page) - 1) * int32(req.Limit),
-					Limit:  int32(req.Limit),
+					Limit:  sql.NullInt32{Int32: int32(req.Limit), Valid: true},
 				}).Return([]db.Pvz{
-					{pvz1.ID, pvz1.RegistrationDate, pvz1.City},
-					{pvz2.ID, pvz2.RegistrationDate, pvz2.City},
+					{ID: pvz1.ID, RegistrationDate: pvz1.RegistrationDate, City: pvz1.City},
+					{ID: pvz2.ID, RegistrationDate: pvz2.RegistrationDate, City: pvz2.City},
 				}, nil)
 			},
 			expRes: []*entity.Pvz{
-				{pvz1.ID, pvz1.RegistrationDate, pvz1.City},
-				{pvz2.ID, pvz2.RegistrationDate, pvz2.City},
+				{ID: pvz1.ID, RegistrationDate: pvz1.RegistrationDate, City: pvz1.City},
+				{ID: pvz2.ID, RegistrationDate: pvz2.RegistrationDate, City: pvz2.City},
+			},
+			expErr: nil,
+		},
+		{
+			name: "scoped without limit",
+			req: &request.SearchPvz{
+				StartDate: time.Now().AddDate(0, 0, -2),
+				EndDate:   time.Now(),
+				Page:      1,
+				PvzIDs:    []uuid.UUID{pvz1.ID},
+			},
+			mockBehavior: func(req *request.SearchPvz) {
+				queries.EXPECT().SearchPVZ(gomock.Any(), db.SearchPVZParams{
+					PvzIds: []uuid.UUID{pvz1.ID},
+				}).Return([]db.Pvz{
+					{ID: pvz1.ID, RegistrationDate: pvz1.RegistrationDate, City: pvz1.City},
+				}, nil)
+			},
+			expRes: []*entity.Pvz{
+				{ID: pvz1.ID, RegistrationDate: pvz1.RegistrationDate, City: pvz1.City},
 			},
 			expErr: nil,
 		},
@@ -64,7 +85,7 @@ func TestSearchPvz(t *testing.T) {
 			mockBehavior: func(req *request.SearchPvz) {
 				queries.EXPECT().SearchPVZ(gomock.Any(), db.SearchPVZParams{
 					Offset: (int32(req.Page) - 1) * int32(req.Limit),
-					Limit:  int32(req.Limit),
+					Limit:  sql.NullInt32{Int32: int32(req.Limit), Valid: true},
 				}).Return([]db.Pvz{}, sql.ErrNoRows)
 			},
 			expRes: []*entity.Pvz{},
@@ -81,7 +102,7 @@ func TestSearchPvz(t *testing.T) {
 			mockBehavior: func(req *request.SearchPvz) {
 				queries.EXPECT().SearchPVZ(gomock.Any(), db.SearchPVZParams{
 					Offset: (int32(req.Page) - 1) * int32(req.Limit),
-					Limit:  int32(req.Limit),
+					Limit:  sql.NullInt32{Int32: int32(req.Limit), Valid: true},
 				}).Return([]db.Pvz{}, errMock)
 			},
 			expRes: nil,
@@ -197,3 +218,220 @@ func TestCreatePvz(t *testing.T) {
 		require.Equal(t, tc.expErr, err)
 	}
 }
+
+func TestGetPvz(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockPvzQueries(ctrl)
+
+	repo := repository.NewPvzRepository(queries)
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       *entity.Pvz
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().GetPVZByID(gomock.Any(), pvz1.ID).Return(db.Pvz{
+					ID:               pvz1.ID,
+					RegistrationDate: pvz1.RegistrationDate,
+					City:             pvz1.City,
+				}, nil)
+			},
+			expRes: pvz1,
+			expErr: nil,
+		},
+		{
+			name: "not found",
+			mockBehavior: func() {
+				queries.EXPECT().GetPVZByID(gomock.Any(), pvz1.ID).Return(db.Pvz{}, sql.ErrNoRows)
+			},
+			expRes: nil,
+			expErr: repository.ErrPvzNotFound,
+		},
+		{
+			name: "unk err",
+			mockBehavior: func() {
+				queries.EXPECT().GetPVZByID(gomock.Any(), pvz1.ID).Return(db.Pvz{}, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := repo.GetPvz(context.Background(), pvz1.ID)
+			require.Equal(t, tc.expErr, err)
+			require.Equal(t, tc.expRes, res)
+		})
+	}
+}
+
+func TestUpdatePvzStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockPvzQueries(ctrl)
+
+	repo := repository.NewPvzRepository(queries)
+
+	changedBy := uuid.New()
+	req := &request.UpdatePvz{Status: string(entity.PvzStatusDecommissioned), Reason: "moved"}
+	arg := db.UpdatePVZStatusParams{
+		ID:        pvz1.ID,
+		Status:    entity.PvzStatusDecommissioned,
+		ChangedBy: uuid.NullUUID{UUID: changedBy, Valid: true},
+		Reason:    "moved",
+	}
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       *entity.Pvz
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().UpdatePVZStatus(gomock.Any(), arg).Return(db.UpdatePVZStatusRow{
+					ID:               pvz1.ID,
+					RegistrationDate: pvz1.RegistrationDate,
+					City:             pvz1.City,
+					Status:           entity.PvzStatusDecommissioned,
+				}, nil)
+			},
+			expRes: &entity.Pvz{
+				ID:               pvz1.ID,
+				RegistrationDate: pvz1.RegistrationDate,
+				City:             pvz1.City,
+				Status:           entity.PvzStatusDecommissioned,
+			},
+			expErr: nil,
+		},
+		{
+			name: "not found",
+			mockBehavior: func() {
+				queries.EXPECT().UpdatePVZStatus(gomock.Any(), arg).Return(db.UpdatePVZStatusRow{}, sql.ErrNoRows)
+			},
+			expRes: nil,
+			expErr: repository.ErrPvzNotFound,
+		},
+		{
+			name: "reception in progress",
+			mockBehavior: func() {
+				queries.EXPECT().UpdatePVZStatus(gomock.Any(), arg).Return(db.UpdatePVZStatusRow{}, &pq.Error{Code: "20004"})
+			},
+			expRes: nil,
+			expErr: repository.ErrPvzHasOpenReception,
+		},
+		{
+			name: "already decommissioned",
+			mockBehavior: func() {
+				queries.EXPECT().UpdatePVZStatus(gomock.Any(), arg).Return(db.UpdatePVZStatusRow{}, &pq.Error{Code: "20005"})
+			},
+			expRes: nil,
+			expErr: repository.ErrPvzDecommissioned,
+		},
+		{
+			name: "unk err",
+			mockBehavior: func() {
+				queries.EXPECT().UpdatePVZStatus(gomock.Any(), arg).Return(db.UpdatePVZStatusRow{}, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := repo.UpdatePvzStatus(context.Background(), pvz1.ID, req, changedBy)
+			require.Equal(t, tc.expErr, err)
+			require.Equal(t, tc.expRes, res)
+		})
+	}
+}
+
+func TestSearchPvzByStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockPvzQueries(ctrl)
+
+	repo := repository.NewPvzRepository(queries)
+
+	status := string(entity.PvzStatusActive)
+	queries.EXPECT().SearchPVZ(gomock.Any(), db.SearchPVZParams{
+		Status: sql.NullString{String: status, Valid: true},
+		Offset: 0,
+		Limit:  sql.NullInt32{Int32: 10, Valid: true},
+	}).Return([]db.Pvz{}, nil)
+
+	res, err := repo.SearchPvz(context.Background(), &request.SearchPvz{Page: 1, Limit: 10, Status: &status})
+	require.NoError(t, err)
+	require.Empty(t, res)
+}
+
+func TestUpdatePvzCapacity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockPvzQueries(ctrl)
+
+	repo := repository.NewPvzRepository(queries)
+
+	capacity := 100
+	req := &request.UpdatePvzCapacity{Capacity: &capacity}
+	arg := db.UpdatePVZCapacityParams{
+		Capacity: sql.NullInt32{Int32: 100, Valid: true},
+		ID:       pvz1.ID,
+	}
+
+	queries.EXPECT().UpdatePVZCapacity(gomock.Any(), arg).Return(db.Pvz{
+		ID:               pvz1.ID,
+		RegistrationDate: pvz1.RegistrationDate,
+		City:             pvz1.City,
+		Status:           entity.PvzStatusActive,
+		Capacity:         sql.NullInt32{Int32: 100, Valid: true},
+		StockCount:       42,
+	}, nil)
+	res, err := repo.UpdatePvzCapacity(context.Background(), pvz1.ID, req)
+	require.NoError(t, err)
+	require.Equal(t, &entity.Pvz{
+		ID:               pvz1.ID,
+		RegistrationDate: pvz1.RegistrationDate,
+		City:             pvz1.City,
+		Status:           entity.PvzStatusActive,
+		Capacity:         100,
+		StockCount:       42,
+	}, res)
+
+	queries.EXPECT().UpdatePVZCapacity(gomock.Any(), arg).Return(db.Pvz{}, sql.ErrNoRows)
+	_, err = repo.UpdatePvzCapacity(context.Background(), pvz1.ID, req)
+	require.Equal(t, repository.ErrPvzNotFound, err)
+}
+
+func TestListPvzStockCounts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockPvzQueries(ctrl)
+
+	repo := repository.NewPvzRepository(queries)
+
+	queries.EXPECT().ListPVZStockCounts(gomock.Any()).Return([]db.ListPVZStockCountsRow{
+		{ID: pvz1.ID, Capacity: sql.NullInt32{Int32: 10, Valid: true}, StockCount: 7},
+		{ID: pvz.ID, StockCount: 3},
+	}, nil)
+	res, err := repo.ListPvzStockCounts(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []*entity.Pvz{
+		{ID: pvz1.ID, Capacity: 10, StockCount: 7},
+		{ID: pvz.ID, StockCount: 3},
+	}, res)
+
+	queries.EXPECT().ListPVZStockCounts(gomock.Any()).Return(nil, errMock)
+	_, err = repo.ListPvzStockCounts(context.Background())
+	require.Equal(t, errMock, err)
+}
@@ -5,6 +5,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"time"
 
@@ -20,11 +21,23 @@ var (
 	ErrReceptionInProgress  = errors.New("other reception in progress")
 	ErrNoOpenReceptionFound = errors.New("no in-progress reception found")
 	ErrNoProduct            = errors.New("no product in reception")
+	ErrNoClosedReception    = errors.New("no closed reception found")
+	ErrPvzNotActive         = errors.New("pvz is not active")
+	ErrDuplicateBarcode     = errors.New("product with barcode already in reception")
+	ErrReceptionNotFound    = errors.New("reception not found")
+	ErrNoManifest           = errors.New("reception has no manifest")
+	ErrNoReconciliation     = errors.New("reception has no reconciliation")
+	ErrReceptionStatusStale = errors.New("reception status changed")
+	ErrNewerReceptionExists = errors.New("newer reception exists for pvz")
+	ErrReceptionCancelled   = errors.New("reception is cancelled")
 )
 
 const (
 	errReceptionInProgressConflictCode = "20001"
 	errAddReceptionToFinishedReception = "20002"
+	errAddReceptionToNotActivePvz      = "20006"
+	errNewerReceptionExistsCode        = "20007"
+	errReceptionCancelledCode          = "20008"
 )
 
 type ReceptionQueries interface {
@@ -34,7 +47,38 @@ type ReceptionQueries interface {
 	SearchReceptionsByPvzsAndTime(ctx context.Context, arg db.SearchReceptionsByPvzsAndTimeParams) ([]db.Reception, error)
 	FinishReception(ctx context.Context, pvzID uuid.UUID) (db.Reception, error)
 	GetLastProductInReception(ctx context.Context, receptionID uuid.UUID) (db.Product, error)
-	DeleteProduct(ctx context.Context, id uuid.UUID) error
+	DeleteProduct(ctx context.Context, id uuid.UUID) (int64, error)
+	DeleteEmptyReception(ctx context.Context, id uuid.UUID) (int64, error)
+	MarkReceptionStaleAlerted(ctx context.Context, receptionID uuid.UUID) error
+	GetLastClosedReceptionByPvzID(ctx context.Context, pvzID uuid.UUID) (db.Reception, error)
+	GetProductsFromReception(ctx context.Context, receptionID uuid.UUID) ([]db.Product, error)
+	GetPvzStatsSince(ctx context.Context, arg db.GetPvzStatsSinceParams) (db.GetPvzStatsSinceRow, error)
+	GetProductInReceptionByBarcode(ctx context.Context, arg db.GetProductInReceptionByBarcodeParams) (db.Product, error)
+	SearchProductsByBarcode(ctx context.Context, arg db.SearchProductsByBarcodeParams) ([]db.Product, error)
+	AddProductsToReception(ctx context.Context, arg db.AddProductsToReceptionParams) ([]db.Product, error)
+	FindProductsByBarcodes(ctx context.Context, arg db.FindProductsByBarcodesParams) ([]db.Product, error)
+	GetReceptionByID(ctx context.Context, id uuid.UUID) (db.Reception, error)
+	GetProductReception(ctx context.Context, id uuid.UUID) (db.Reception, error)
+	GetProductsFromReceptionLIFO(ctx context.Context, receptionID uuid.UUID) ([]db.Product, error)
+	ListPvzReceptions(ctx context.Context, arg db.ListPvzReceptionsParams) ([]db.Reception, error)
+	CountProductsByType(ctx context.Context, receptionIds []uuid.UUID) ([]db.CountProductsByTypeRow, error)
+	CountProductsByCondition(ctx context.Context, receptionIds []uuid.UUID) ([]db.CountProductsByConditionRow, error)
+	CreateReceptionWithManifest(ctx context.Context, arg db.CreateReceptionWithManifestParams) (db.Reception, error)
+	GetReceptionManifest(ctx context.Context, receptionID uuid.UUID) (db.ReceptionManifest, error)
+	FinishReceptionWithReconciliation(ctx context.Context, arg db.FinishReceptionWithReconciliationParams) (db.Reception, error)
+	GetReceptionReconciliation(ctx context.Context, receptionID uuid.UUID) (db.ReceptionReconciliation, error)
+	UpdateReceptionStatus(ctx context.Context, arg db.UpdateReceptionStatusParams) (db.UpdateReceptionStatusRow, error)
+	ListOpenReceptionsBefore(ctx context.Context, before time.Time) ([]db.ListOpenReceptionsBeforeRow, error)
+}
+
+// discrepancy is how entity.Discrepancy
+// is stored in reconciliation report.
+type discrepancy struct {
+	Kind     string `json:"kind"`
+	Barcode  string `json:"barcode,omitempty"`
+	Type     string `json:"type,omitempty"`
+	Expected int    `json:"expected"`
+	Actual   int    `json:"actual"`
 }
 
 type ReceptionRepository struct {
@@ -71,12 +115,20 @@ func (r *ReceptionRepository) CreateReception(ctx context.Context, req *request.
 		PvzID:    req.PvzID,
 	}
 
-	res, err := r.queries.CreateReception(ctx, arg)
+	var res db.Reception
+	var err error
+	if req.Manifest != nil {
+		res, err = r.createReceptionWithManifest(ctx, arg, req.Manifest)
+	} else {
+		res, err = r.queries.CreateReception(ctx, arg)
+	}
 	if err != nil {
 		pqErr, ok := err.(*pq.Error)
 		switch {
 		case ok && pqErr.Code == errAddReceptionToFinishedReception:
 			return nil, ErrReceptionInProgress
+		case ok && pqErr.Code == errAddReceptionToNotActivePvz:
+			return nil, ErrPvzNotActive
 		default:
 			return nil, err
 		}
@@ -90,11 +142,52 @@ func (r *ReceptionRepository) CreateReception(ctx context.Context, req *request.
 	}, nil
 }
 
+func (r *ReceptionRepository) createReceptionWithManifest(ctx context.Context, arg db.CreateReceptionParams, manifest *request.ReceptionManifest) (db.Reception, error) {
+	counts := manifest.TypeCounts
+	if counts == nil {
+		counts = map[string]int{}
+	}
+	rawCounts, err := json.Marshal(counts)
+	if err != nil {
+		return db.Reception{}, err
+	}
+
+	barcodes := manifest.Barcodes
+	if barcodes == nil {
+		barcodes = []string{}
+	}
+
+	return r.queries.CreateReceptionWithManifest(ctx, db.CreateReceptionWithManifestParams{
+		ID:         arg.ID,
+		DateTime:   arg.DateTime,
+		PvzID:      arg.PvzID,
+		Barcodes:   barcodes,
+		TypeCounts: string(rawCounts),
+	})
+}
+
 func (r *ReceptionRepository) AddProductToReception(ctx context.Context, req *request.AddProduct, receptionID uuid.UUID) (*entity.Product, error) {
+	attrs := req.Attributes
+	if attrs == nil {
+		attrs = map[string]string{}
+	}
+	rawAttrs, err := json.Marshal(attrs)
+	if err != nil {
+		return nil, err
+	}
+
 	arg := db.AddProductToReceptionParams{
 		ID:          uuid.New(),
 		Type:        entity.ProductType(req.Type),
 		ReceptionID: receptionID,
+		Attributes:  string(rawAttrs),
+		Barcode:     sql.NullString{String: req.Barcode, Valid: req.Barcode != ""},
+		OrderID:     sql.NullString{String: req.OrderID, Valid: req.OrderID != ""},
+
+		PickupCodeHash: sql.NullString{String: req.PickupCodeHash, Valid: req.PickupCodeHash != ""},
+		SizeClass:      entity.SizeClass(req.SizeClass),
+		Condition:      entity.ProductCondition(req.Condition),
+		Notes:          sql.NullString{String: req.Notes, Valid: req.Notes != ""},
 	}
 
 	res, err := r.queries.AddProductToReception(ctx, arg)
@@ -103,17 +196,112 @@ func (r *ReceptionRepository) AddProductToReception(ctx context.Context, req *re
 		switch {
 		case ok && pqErr.Code == errReceptionInProgressConflictCode:
 			return nil, ErrReceptionInProgress
+		case ok && pqErr.Code == errPvzCapacityExceededCode:
+			return nil, ErrPvzCapacityExceeded
+		case isForeignKeyViolation(err):
+			return nil, ErrProductTypeNotFound
+		case isUniqueViolation(err):
+			return nil, ErrDuplicateBarcode
 		default:
 			return nil, err
 		}
 	}
 
-	return &entity.Product{
-		ID:          res.ID,
-		DateTime:    res.DateTime,
-		Type:        entity.ProductType(res.Type),
-		ReceptionID: res.ReceptionID,
-	}, nil
+	return toEntityProduct(res), nil
+}
+
+// AddProductsToReception inserts products with one statement,
+// so either all of them are added or none. Result follows
+// order of products.
+func (r *ReceptionRepository) AddProductsToReception(ctx context.Context, receptionID uuid.UUID, products []request.BatchProduct) ([]*entity.Product, error) {
+	arg := db.AddProductsToReceptionParams{
+		ReceptionID: receptionID,
+		Ids:         make([]uuid.UUID, len(products)),
+		Types:       make([]string, len(products)),
+		Attributes:  make([]string, len(products)),
+		Barcodes:    make([]string, len(products)),
+		OrderIds:    make([]string, len(products)),
+
+		PickupCodeHashes: make([]string, len(products)),
+		SizeClasses:      make([]string, len(products)),
+		Conditions:       make([]string, len(products)),
+		Notes:            make([]string, len(products)),
+	}
+	for i, p := range products {
+		attrs := p.Attributes
+		if attrs == nil {
+			attrs = map[string]string{}
+		}
+		rawAttrs, err := json.Marshal(attrs)
+		if err != nil {
+			return nil, err
+		}
+
+		arg.Ids[i] = uuid.New()
+		arg.Types[i] = p.Type
+		arg.Attributes[i] = string(rawAttrs)
+		arg.Barcodes[i] = p.Barcode
+		arg.OrderIds[i] = p.OrderID
+		arg.PickupCodeHashes[i] = p.PickupCodeHash
+		arg.SizeClasses[i] = p.SizeClass
+		arg.Conditions[i] = p.Condition
+		arg.Notes[i] = p.Notes
+	}
+
+	res, err := r.queries.AddProductsToReception(ctx, arg)
+	if err != nil {
+		pqErr, ok := err.(*pq.Error)
+		switch {
+		case ok && pqErr.Code == errReceptionInProgressConflictCode:
+			return nil, ErrReceptionInProgress
+		case ok && pqErr.Code == errPvzCapacityExceededCode:
+			return nil, ErrPvzCapacityExceeded
+		case isForeignKeyViolation(err):
+			return nil, ErrProductTypeNotFound
+		case isUniqueViolation(err):
+			return nil, ErrDuplicateBarcode
+		default:
+			return nil, err
+		}
+	}
+
+	// RETURNING order is not guaranteed
+	byID := make(map[uuid.UUID]db.Product, len(res))
+	for _, p := range res {
+		byID[p.ID] = p
+	}
+	ans := make([]*entity.Product, len(arg.Ids))
+	for i, id := range arg.Ids {
+		p, ok := byID[id]
+		if !ok {
+			return nil, errors.New("inserted product not returned: " + id.String())
+		}
+		ans[i] = toEntityProduct(p)
+	}
+
+	return ans, nil
+}
+
+// FindProductsByBarcodes returns products with given barcodes
+// accepted in reception or stored in PVZ since given time,
+// newest first. Zero since checks reception only.
+func (r *ReceptionRepository) FindProductsByBarcodes(ctx context.Context, barcodes []string, receptionID, pvzID uuid.UUID, since time.Time) ([]*entity.Product, error) {
+	res, err := r.queries.FindProductsByBarcodes(ctx, db.FindProductsByBarcodesParams{
+		Barcodes:    barcodes,
+		ReceptionID: receptionID,
+		PvzID:       pvzID,
+		Since:       sql.NullTime{Time: since, Valid: !since.IsZero()},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	products := make([]*entity.Product, len(res))
+	for i, p := range res {
+		products[i] = toEntityProduct(p)
+	}
+
+	return products, nil
 }
 
 func (r *ReceptionRepository) SearchReceptions(ctx context.Context, req *request.SearchPvz, pvzIDs []uuid.UUID) ([]*entity.Reception, error) {
@@ -165,25 +353,482 @@ func (r *ReceptionRepository) FinishReception(ctx context.Context, pvzID uuid.UU
 	}, nil
 }
 
+// FinishReceptionWithReconciliation closes reception and
+// stores its reconciliation report at once.
+func (r *ReceptionRepository) FinishReceptionWithReconciliation(ctx context.Context, receptionID uuid.UUID, rec *entity.Reconciliation) (*entity.Reception, error) {
+	stored := make([]discrepancy, len(rec.Discrepancies))
+	for i, d := range rec.Discrepancies {
+		stored[i] = discrepancy{
+			Kind:     d.Kind,
+			Barcode:  d.Barcode,
+			Type:     string(d.Type),
+			Expected: d.Expected,
+			Actual:   d.Actual,
+		}
+	}
+	raw, err := json.Marshal(stored)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := r.queries.FinishReceptionWithReconciliation(ctx, db.FinishReceptionWithReconciliationParams{
+		ID:            receptionID,
+		Discrepancies: string(raw),
+		Overridden:    rec.Overridden,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrNoOpenReceptionFound
+		default:
+			return nil, err
+		}
+	}
+
+	return &entity.Reception{
+		ID:       res.ID,
+		DateTime: res.DateTime,
+		PvzID:    res.PvzID,
+		Status:   res.Status,
+	}, nil
+}
+
+func (r *ReceptionRepository) GetReceptionManifest(ctx context.Context, receptionID uuid.UUID) (*entity.ReceptionManifest, error) {
+	res, err := r.queries.GetReceptionManifest(ctx, receptionID)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrNoManifest
+		default:
+			return nil, err
+		}
+	}
+
+	var counts map[entity.ProductType]int
+	if err := json.Unmarshal(res.TypeCounts, &counts); err != nil {
+		return nil, err
+	}
+	if len(counts) == 0 {
+		counts = nil
+	}
+
+	barcodes := res.Barcodes
+	if len(barcodes) == 0 {
+		barcodes = nil
+	}
+
+	return &entity.ReceptionManifest{
+		Barcodes:   barcodes,
+		TypeCounts: counts,
+	}, nil
+}
+
+func (r *ReceptionRepository) GetReconciliation(ctx context.Context, receptionID uuid.UUID) (*entity.Reconciliation, error) {
+	res, err := r.queries.GetReceptionReconciliation(ctx, receptionID)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrNoReconciliation
+		default:
+			return nil, err
+		}
+	}
+
+	var stored []discrepancy
+	if err := json.Unmarshal(res.Discrepancies, &stored); err != nil {
+		return nil, err
+	}
+
+	rec := &entity.Reconciliation{
+		Discrepancies: make([]*entity.Discrepancy, len(stored)),
+		Overridden:    res.Overridden,
+	}
+	for i, d := range stored {
+		rec.Discrepancies[i] = &entity.Discrepancy{
+			Kind:     d.Kind,
+			Barcode:  d.Barcode,
+			Type:     entity.ProductType(d.Type),
+			Expected: d.Expected,
+			Actual:   d.Actual,
+		}
+	}
+
+	return rec, nil
+}
+
 func (r *ReceptionRepository) GetLastProductInReception(ctx context.Context, receptionID uuid.UUID) (*entity.Product, error) {
 	res, err := r.queries.GetLastProductInReception(ctx, receptionID)
 	if errors.Is(err, sql.ErrNoRows) {
 		return nil, ErrNoProduct
 	}
 
-	return &entity.Product{
-		ID:          res.ID,
-		DateTime:    res.DateTime,
-		Type:        entity.ProductType(res.Type),
-		ReceptionID: res.ReceptionID,
-	}, nil
+	return toEntityProduct(res), nil
 }
 
+// DeleteProductInReception deletes product if it is still stored.
 func (r *ReceptionRepository) DeleteProductInReception(ctx context.Context, productID uuid.UUID) error {
-	err := r.queries.DeleteProduct(ctx, productID)
-	if errors.Is(err, sql.ErrNoRows) {
+	n, err := r.queries.DeleteProduct(ctx, productID)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
 		return ErrNoProduct
 	}
 
 	return nil
 }
+
+// DeleteEmptyReception deletes open reception without products.
+// ErrNoOpenReceptionFound is returned if it is closed or not empty.
+func (r *ReceptionRepository) DeleteEmptyReception(ctx context.Context, id uuid.UUID) error {
+	n, err := r.queries.DeleteEmptyReception(ctx, id)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNoOpenReceptionFound
+	}
+
+	return nil
+}
+
+func (r *ReceptionRepository) GetLastClosedReception(ctx context.Context, pvzID uuid.UUID) (*entity.Reception, error) {
+	res, err := r.queries.GetLastClosedReceptionByPvzID(ctx, pvzID)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrNoClosedReception
+		default:
+			return nil, err
+		}
+	}
+
+	return &entity.Reception{
+		ID:       res.ID,
+		DateTime: res.DateTime,
+		PvzID:    res.PvzID,
+		Status:   res.Status,
+	}, nil
+}
+
+// GetProductsInReception returns reception products
+// in order they were added.
+func (r *ReceptionRepository) GetProductsInReception(ctx context.Context, receptionID uuid.UUID) ([]*entity.Product, error) {
+	res, err := r.queries.GetProductsFromReception(ctx, receptionID)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return []*entity.Product{}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	products := make([]*entity.Product, len(res))
+	for i, p := range res {
+		products[i] = toEntityProduct(p)
+	}
+
+	return products, nil
+}
+
+func (r *ReceptionRepository) GetReceptionByID(ctx context.Context, id uuid.UUID) (*entity.Reception, error) {
+	res, err := r.queries.GetReceptionByID(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrReceptionNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	return &entity.Reception{
+		ID:       res.ID,
+		DateTime: res.DateTime,
+		PvzID:    res.PvzID,
+		Status:   res.Status,
+	}, nil
+}
+
+// GetProductReception returns reception holding product now: the one
+// it was received into by its last transfer, or the one it was accepted by.
+func (r *ReceptionRepository) GetProductReception(ctx context.Context, productID uuid.UUID) (*entity.Reception, error) {
+	res, err := r.queries.GetProductReception(ctx, productID)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrReceptionNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	return &entity.Reception{
+		ID:       res.ID,
+		DateTime: res.DateTime,
+		PvzID:    res.PvzID,
+		Status:   res.Status,
+	}, nil
+}
+
+// UpdateReceptionStatus moves reception from one status to another
+// and writes status history record. Reopened reception loses its
+// reconciliation report.
+func (r *ReceptionRepository) UpdateReceptionStatus(ctx context.Context, id uuid.UUID, from, to entity.Status, changedBy uuid.UUID, reason string) (*entity.Reception, error) {
+	arg := db.UpdateReceptionStatusParams{
+		ID:         id,
+		Status:     to,
+		FromStatus: from,
+		ChangedBy:  nullUUID(changedBy),
+		Reason:     reason,
+	}
+
+	res, err := r.queries.UpdateReceptionStatus(ctx, arg)
+	if err != nil {
+		pqErr, ok := err.(*pq.Error)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrReceptionStatusStale
+		case ok && pqErr.Code == errAddReceptionToFinishedReception:
+			return nil, ErrReceptionInProgress
+		case ok && pqErr.Code == errAddReceptionToNotActivePvz:
+			return nil, ErrPvzNotActive
+		case ok && pqErr.Code == errNewerReceptionExistsCode:
+			return nil, ErrNewerReceptionExists
+		case ok && pqErr.Code == errReceptionCancelledCode:
+			return nil, ErrReceptionCancelled
+		default:
+			return nil, err
+		}
+	}
+
+	return &entity.Reception{
+		ID:       res.ID,
+		DateTime: res.DateTime,
+		PvzID:    res.PvzID,
+		Status:   res.Status,
+	}, nil
+}
+
+// ListOpenReceptionsBefore returns in-progress receptions
+// opened or reopened before given time, oldest first.
+func (r *ReceptionRepository) ListOpenReceptionsBefore(ctx context.Context, before time.Time) ([]*entity.OpenReception, error) {
+	res, err := r.queries.ListOpenReceptionsBefore(ctx, before)
+	if err != nil {
+		return nil, err
+	}
+
+	ans := make([]*entity.OpenReception, len(res))
+	for i, r := range res {
+		ans[i] = &entity.OpenReception{
+			Reception: &entity.Reception{
+				ID:       r.ID,
+				DateTime: r.DateTime,
+				PvzID:    r.PvzID,
+				Status:   r.Status,
+			},
+			City:     r.City,
+			OpenedAt: r.OpenedAt,
+			Alerted:  r.AlertedAt.Valid && !r.AlertedAt.Time.Before(r.OpenedAt),
+		}
+	}
+
+	return ans, nil
+}
+
+// MarkStaleAlerted records that stale alert was emitted for reception.
+func (r *ReceptionRepository) MarkStaleAlerted(ctx context.Context, receptionID uuid.UUID) error {
+	return r.queries.MarkReceptionStaleAlerted(ctx, receptionID)
+}
+
+// GetProductsInReceptionLIFO returns reception products,
+// last added first.
+func (r *ReceptionRepository) GetProductsInReceptionLIFO(ctx context.Context, receptionID uuid.UUID) ([]*entity.Product, error) {
+	res, err := r.queries.GetProductsFromReceptionLIFO(ctx, receptionID)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return []*entity.Product{}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	products := make([]*entity.Product, len(res))
+	for i, p := range res {
+		products[i] = toEntityProduct(p)
+	}
+
+	return products, nil
+}
+
+// ListPvzReceptions returns PVZ receptions, newest first,
+// starting after req.Cursor.
+func (r *ReceptionRepository) ListPvzReceptions(ctx context.Context, req *request.ListReceptions) ([]*entity.Reception, error) {
+	arg := db.ListPvzReceptionsParams{
+		PvzID: req.PvzID,
+		Limit: int32(req.Limit),
+	}
+	if req.Status != nil {
+		arg.Status = sql.NullString{String: *req.Status, Valid: true}
+	}
+	if req.From != nil {
+		arg.From = sql.NullTime{Time: *req.From, Valid: true}
+	}
+	if req.To != nil {
+		arg.To = sql.NullTime{Time: *req.To, Valid: true}
+	}
+	if req.Cursor != nil {
+		arg.BeforeDateTime = sql.NullTime{Time: req.Cursor.DateTime, Valid: true}
+		arg.BeforeID = uuid.NullUUID{UUID: req.Cursor.ID, Valid: true}
+	}
+
+	res, err := r.queries.ListPvzReceptions(ctx, arg)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return []*entity.Reception{}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	ans := make([]*entity.Reception, len(res))
+	for i, r := range res {
+		ans[i] = &entity.Reception{
+			ID:       r.ID,
+			DateTime: r.DateTime,
+			PvzID:    r.PvzID,
+			Status:   r.Status,
+		}
+	}
+
+	return ans, nil
+}
+
+// CountProductsByType counts products of each
+// reception by product type.
+func (r *ReceptionRepository) CountProductsByType(ctx context.Context, receptionIDs []uuid.UUID) (map[uuid.UUID]map[entity.ProductType]int64, error) {
+	res, err := r.queries.CountProductsByType(ctx, receptionIDs)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	counts := make(map[uuid.UUID]map[entity.ProductType]int64, len(receptionIDs))
+	for _, row := range res {
+		if counts[row.ReceptionID] == nil {
+			counts[row.ReceptionID] = map[entity.ProductType]int64{}
+		}
+		counts[row.ReceptionID][row.Type] = row.Count
+	}
+
+	return counts, nil
+}
+
+// CountDamagedProducts counts products of each reception
+// accepted damaged or opened. Receptions without such
+// products are missing in result.
+func (r *ReceptionRepository) CountDamagedProducts(ctx context.Context, receptionIDs []uuid.UUID) (map[uuid.UUID]entity.DamageCounts, error) {
+	res, err := r.queries.CountProductsByCondition(ctx, receptionIDs)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, err
+	}
+
+	counts := make(map[uuid.UUID]entity.DamageCounts, len(res))
+	for _, row := range res {
+		counts[row.ReceptionID] = entity.DamageCounts{
+			Damaged: row.Damaged,
+			Opened:  row.Opened,
+		}
+	}
+
+	return counts, nil
+}
+
+// GetProductInReceptionByBarcode returns product
+// with barcode accepted in reception.
+func (r *ReceptionRepository) GetProductInReceptionByBarcode(ctx context.Context, receptionID uuid.UUID, barcode string) (*entity.Product, error) {
+	res, err := r.queries.GetProductInReceptionByBarcode(ctx, db.GetProductInReceptionByBarcodeParams{
+		ReceptionID: receptionID,
+		Barcode:     sql.NullString{String: barcode, Valid: true},
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrNoProduct
+		default:
+			return nil, err
+		}
+	}
+
+	return toEntityProduct(res), nil
+}
+
+// SearchProductsByBarcode returns products with barcode,
+// newest first. Nil pvzIDs means all PVZs, zero since
+// means no lower time bound.
+func (r *ReceptionRepository) SearchProductsByBarcode(ctx context.Context, barcode string, pvzIDs []uuid.UUID, since time.Time) ([]*entity.Product, error) {
+	res, err := r.queries.SearchProductsByBarcode(ctx, db.SearchProductsByBarcodeParams{
+		Barcode: sql.NullString{String: barcode, Valid: true},
+		PvzIds:  pvzIDs,
+		Since:   sql.NullTime{Time: since, Valid: !since.IsZero()},
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return []*entity.Product{}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	products := make([]*entity.Product, len(res))
+	for i, p := range res {
+		products[i] = toEntityProduct(p)
+	}
+
+	return products, nil
+}
+
+// GetPvzStats counts PVZ receptions and products
+// created since given time.
+func (r *ReceptionRepository) GetPvzStats(ctx context.Context, pvzID uuid.UUID, since time.Time) (receptions, products int64, err error) {
+	res, err := r.queries.GetPvzStatsSince(ctx, db.GetPvzStatsSinceParams{
+		PvzID: pvzID,
+		Since: since,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return res.ReceptionsCount, res.ProductsCount, nil
+}
+
+func toEntityProduct(p db.Product) *entity.Product {
+	// attributes are written by AddProductToReception and
+	// AddProductsToReception only, so they are always a
+	// valid JSON object
+	var attrs map[string]string
+	_ = json.Unmarshal(p.Attributes, &attrs)
+	if len(attrs) == 0 {
+		attrs = nil
+	}
+
+	return &entity.Product{
+		ID:          p.ID,
+		DateTime:    p.DateTime,
+		Type:        p.Type,
+		ReceptionID: p.ReceptionID,
+		Attributes:  attrs,
+		Barcode:     p.Barcode.String,
+		OrderID:     p.OrderID.String,
+		State:       p.State,
+		SizeClass:   p.SizeClass,
+		CellID:      p.CellID.UUID,
+		Condition:   p.Condition,
+		Notes:       p.Notes.String,
+
+		PickupCodeHash: p.PickupCodeHash.String,
+	}
+}
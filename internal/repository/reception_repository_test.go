@@ -180,16 +180,55 @@ func TestAddProductToReception(t *testing.T) {
 			},
 			receptionID: reception.ID,
 			mockBehavior: func(req *request.AddProduct) {
-				queries.EXPECT().AddProductToReception(gomock.Any(), gomock.Any()).Return(db.Product{
-					ID:          product.ID,
-					DateTime:    product.DateTime,
-					Type:        product.Type,
-					ReceptionID: product.ReceptionID,
-				}, nil)
+				queries.EXPECT().AddProductToReception(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, arg db.AddProductToReceptionParams) (db.Product, error) {
+						// no barcode is stored as null
+						require.Equal(t, sql.NullString{}, arg.Barcode)
+						return db.Product{
+							ID:          product.ID,
+							DateTime:    product.DateTime,
+							Type:        product.Type,
+							ReceptionID: product.ReceptionID,
+						}, nil
+					})
 			},
 			expRes: product,
 			expErr: nil,
 		},
+		{
+			name: "damaged with notes",
+			req: &request.AddProduct{
+				Type:      string(entity.ProductTypeClothes),
+				PvzID:     pvz.ID,
+				Condition: string(entity.ProductConditionDamaged),
+				Notes:     "torn box",
+			},
+			receptionID: reception.ID,
+			mockBehavior: func(req *request.AddProduct) {
+				queries.EXPECT().AddProductToReception(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, arg db.AddProductToReceptionParams) (db.Product, error) {
+						require.Equal(t, entity.ProductConditionDamaged, arg.Condition)
+						require.Equal(t, sql.NullString{String: "torn box", Valid: true}, arg.Notes)
+						return db.Product{
+							ID:          product.ID,
+							DateTime:    product.DateTime,
+							Type:        product.Type,
+							ReceptionID: product.ReceptionID,
+							Condition:   arg.Condition,
+							Notes:       arg.Notes,
+						}, nil
+					})
+			},
+			expRes: &entity.Product{
+				ID:          product.ID,
+				DateTime:    product.DateTime,
+				Type:        product.Type,
+				ReceptionID: product.ReceptionID,
+				Condition:   entity.ProductConditionDamaged,
+				Notes:       "torn box",
+			},
+			expErr: nil,
+		},
 		{
 			name: "err other reception in progress conflict",
 			req: &request.AddProduct{
@@ -203,6 +242,19 @@ func TestAddProductToReception(t *testing.T) {
 			expRes: nil,
 			expErr: repository.ErrReceptionInProgress,
 		},
+		{
+			name: "err pvz at capacity",
+			req: &request.AddProduct{
+				Type:  string(entity.ProductTypeClothes),
+				PvzID: pvz.ID,
+			},
+			receptionID: reception.ID,
+			mockBehavior: func(req *request.AddProduct) {
+				queries.EXPECT().AddProductToReception(gomock.Any(), gomock.Any()).Return(db.Product{}, &pq.Error{Code: "20009"})
+			},
+			expRes: nil,
+			expErr: repository.ErrPvzCapacityExceeded,
+		},
 		{
 			name: "unk err",
 			req: &request.AddProduct{
@@ -216,6 +268,62 @@ func TestAddProductToReception(t *testing.T) {
 			expRes: nil,
 			expErr: errMock,
 		},
+		{
+			name: "ok with attributes",
+			req: &request.AddProduct{
+				Type:       string(entity.ProductTypeElectronics),
+				PvzID:      pvz.ID,
+				Attributes: map[string]string{"imei": "356938035643809"},
+			},
+			receptionID: reception.ID,
+			mockBehavior: func(req *request.AddProduct) {
+				queries.EXPECT().AddProductToReception(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, arg db.AddProductToReceptionParams) (db.Product, error) {
+						require.JSONEq(t, `{"imei": "356938035643809"}`, arg.Attributes)
+						return db.Product{
+							ID:          product.ID,
+							DateTime:    product.DateTime,
+							Type:        entity.ProductTypeElectronics,
+							ReceptionID: product.ReceptionID,
+							Attributes:  []byte(arg.Attributes),
+						}, nil
+					})
+			},
+			expRes: &entity.Product{
+				ID:          product.ID,
+				DateTime:    product.DateTime,
+				Type:        entity.ProductTypeElectronics,
+				ReceptionID: product.ReceptionID,
+				Attributes:  map[string]string{"imei": "356938035643809"},
+			},
+			expErr: nil,
+		},
+		{
+			name: "err unknown product type",
+			req: &request.AddProduct{
+				Type:  "invalid",
+				PvzID: pvz.ID,
+			},
+			receptionID: reception.ID,
+			mockBehavior: func(req *request.AddProduct) {
+				queries.EXPECT().AddProductToReception(gomock.Any(), gomock.Any()).Return(db.Product{}, &pq.Error{Code: "23503"})
+			},
+			expRes: nil,
+			expErr: repository.ErrProductTypeNotFound,
+		}, {
+			name: "err duplicate barcode",
+			req: &request.AddProduct{
+				Type:    string(entity.ProductTypeClothes),
+				PvzID:   pvz.ID,
+				Barcode: "4601234567890",
+			},
+			receptionID: reception.ID,
+			mockBehavior: func(req *request.AddProduct) {
+				queries.EXPECT().AddProductToReception(gomock.Any(), gomock.Any()).Return(db.Product{}, &pq.Error{Code: "23505"})
+			},
+			expRes: nil,
+			expErr: repository.ErrDuplicateBarcode,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -227,6 +335,7 @@ func TestAddProductToReception(t *testing.T) {
 			require.Equal(t, tc.expRes.ID, res.ID)
 			require.Equal(t, tc.expRes.ReceptionID, res.ReceptionID)
 			require.Equal(t, tc.expRes.Type, res.Type)
+			require.Equal(t, tc.expRes.Attributes, res.Attributes)
 			require.WithinDuration(t, tc.expRes.DateTime, res.DateTime, time.Second)
 		} else {
 			require.Equal(t, tc.expRes, res)
@@ -376,7 +485,7 @@ func TestDeleteProductInReception(t *testing.T) {
 			name: "ok",
 			req:  product.ID,
 			mockBehavior: func(req uuid.UUID) {
-				queries.EXPECT().DeleteProduct(gomock.Any(), req).Return(nil)
+				queries.EXPECT().DeleteProduct(gomock.Any(), req).Return(int64(1), nil)
 			},
 			expErr: nil,
 		},
@@ -384,10 +493,18 @@ func TestDeleteProductInReception(t *testing.T) {
 			name: "err no product",
 			req:  product.ID,
 			mockBehavior: func(req uuid.UUID) {
-				queries.EXPECT().DeleteProduct(gomock.Any(), req).Return(sql.ErrNoRows)
+				queries.EXPECT().DeleteProduct(gomock.Any(), req).Return(int64(0), nil)
 			},
 			expErr: repository.ErrNoProduct,
 		},
+		{
+			name: "unk err",
+			req:  product.ID,
+			mockBehavior: func(req uuid.UUID) {
+				queries.EXPECT().DeleteProduct(gomock.Any(), req).Return(int64(0), errMock)
+			},
+			expErr: errMock,
+		},
 	}
 	for _, tc := range testCases {
 		tc.mockBehavior(tc.req)
@@ -398,6 +515,49 @@ func TestDeleteProductInReception(t *testing.T) {
 	}
 }
 
+func TestDeleteEmptyReception(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockReceptionQueries(ctrl)
+
+	repo := repository.NewReceptionRepository(queries)
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().DeleteEmptyReception(gomock.Any(), reception.ID).Return(int64(1), nil)
+			},
+			expErr: nil,
+		},
+		{
+			name: "not empty",
+			mockBehavior: func() {
+				queries.EXPECT().DeleteEmptyReception(gomock.Any(), reception.ID).Return(int64(0), nil)
+			},
+			expErr: repository.ErrNoOpenReceptionFound,
+		},
+		{
+			name: "unk err",
+			mockBehavior: func() {
+				queries.EXPECT().DeleteEmptyReception(gomock.Any(), reception.ID).Return(int64(0), errMock)
+			},
+			expErr: errMock,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			err := repo.DeleteEmptyReception(context.Background(), reception.ID)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
+
 func TestSearchReceptions(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
@@ -506,3 +666,799 @@ func TestSearchReceptions(t *testing.T) {
 		require.Equal(t, tc.expErr, err)
 	}
 }
+
+func TestGetLastClosedReception(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockReceptionQueries(ctrl)
+
+	repo := repository.NewReceptionRepository(queries)
+	testCases := []struct {
+		name         string
+		req          uuid.UUID
+		mockBehavior func(req uuid.UUID)
+		expRes       *entity.Reception
+		expErr       error
+	}{
+		{
+			name: "ok",
+			req:  pvz1.ID,
+			mockBehavior: func(req uuid.UUID) {
+				queries.EXPECT().GetLastClosedReceptionByPvzID(gomock.Any(), req).Return(db.Reception{
+					ID:       reception1.ID,
+					DateTime: reception1.DateTime,
+					PvzID:    reception1.PvzID,
+					Status:   reception1.Status,
+				}, nil)
+			},
+			expRes: reception1,
+			expErr: nil,
+		},
+		{
+			name: "no reception found",
+			req:  pvz1.ID,
+			mockBehavior: func(req uuid.UUID) {
+				queries.EXPECT().GetLastClosedReceptionByPvzID(gomock.Any(), req).Return(db.Reception{}, sql.ErrNoRows)
+			},
+			expRes: nil,
+			expErr: repository.ErrNoClosedReception,
+		},
+		{
+			name: "unk error",
+			req:  pvz1.ID,
+			mockBehavior: func(req uuid.UUID) {
+				queries.EXPECT().GetLastClosedReceptionByPvzID(gomock.Any(), req).Return(db.Reception{}, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc.mockBehavior(tc.req)
+
+		res, err := repo.GetLastClosedReception(context.Background(), tc.req)
+
+		require.Equal(t, tc.expRes, res)
+		require.Equal(t, tc.expErr, err)
+	}
+}
+
+func TestGetProductsInReception(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockReceptionQueries(ctrl)
+
+	repo := repository.NewReceptionRepository(queries)
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       []*entity.Product
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().GetProductsFromReception(gomock.Any(), reception.ID).Return([]db.Product{{
+					ID:          product.ID,
+					DateTime:    product.DateTime,
+					Type:        product.Type,
+					ReceptionID: product.ReceptionID,
+				}}, nil)
+			},
+			expRes: []*entity.Product{product},
+			expErr: nil,
+		},
+		{
+			name: "no products",
+			mockBehavior: func() {
+				queries.EXPECT().GetProductsFromReception(gomock.Any(), reception.ID).Return(nil, sql.ErrNoRows)
+			},
+			expRes: []*entity.Product{},
+			expErr: nil,
+		},
+		{
+			name: "unk error",
+			mockBehavior: func() {
+				queries.EXPECT().GetProductsFromReception(gomock.Any(), reception.ID).Return(nil, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc.mockBehavior()
+
+		res, err := repo.GetProductsInReception(context.Background(), reception.ID)
+
+		require.Equal(t, tc.expRes, res)
+		require.Equal(t, tc.expErr, err)
+	}
+}
+
+func TestGetPvzStats(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockReceptionQueries(ctrl)
+
+	repo := repository.NewReceptionRepository(queries)
+
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	arg := db.GetPvzStatsSinceParams{PvzID: pvz.ID, Since: since}
+
+	queries.EXPECT().GetPvzStatsSince(gomock.Any(), arg).Return(db.GetPvzStatsSinceRow{ReceptionsCount: 2, ProductsCount: 5}, nil)
+	receptions, products, err := repo.GetPvzStats(context.Background(), pvz.ID, since)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), receptions)
+	require.Equal(t, int64(5), products)
+
+	queries.EXPECT().GetPvzStatsSince(gomock.Any(), arg).Return(db.GetPvzStatsSinceRow{}, errMock)
+	_, _, err = repo.GetPvzStats(context.Background(), pvz.ID, since)
+	require.Equal(t, errMock, err)
+}
+
+func TestGetProductInReceptionByBarcode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockReceptionQueries(ctrl)
+
+	repo := repository.NewReceptionRepository(queries)
+
+	arg := db.GetProductInReceptionByBarcodeParams{
+		ReceptionID: reception.ID,
+		Barcode:     sql.NullString{String: "4601234567890", Valid: true},
+	}
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       *entity.Product
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().GetProductInReceptionByBarcode(gomock.Any(), arg).Return(db.Product{
+					ID:          product.ID,
+					DateTime:    product.DateTime,
+					Type:        product.Type,
+					ReceptionID: product.ReceptionID,
+					Barcode:     arg.Barcode,
+					OrderID:     sql.NullString{String: "order-1", Valid: true},
+				}, nil)
+			},
+			expRes: &entity.Product{
+				ID:          product.ID,
+				DateTime:    product.DateTime,
+				Type:        product.Type,
+				ReceptionID: product.ReceptionID,
+				Barcode:     "4601234567890",
+				OrderID:     "order-1",
+			},
+			expErr: nil,
+		},
+		{
+			name: "not found",
+			mockBehavior: func() {
+				queries.EXPECT().GetProductInReceptionByBarcode(gomock.Any(), arg).Return(db.Product{}, sql.ErrNoRows)
+			},
+			expRes: nil,
+			expErr: repository.ErrNoProduct,
+		},
+		{
+			name: "unk err",
+			mockBehavior: func() {
+				queries.EXPECT().GetProductInReceptionByBarcode(gomock.Any(), arg).Return(db.Product{}, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := repo.GetProductInReceptionByBarcode(context.Background(), reception.ID, "4601234567890")
+			require.Equal(t, tc.expErr, err)
+			require.Equal(t, tc.expRes, res)
+		})
+	}
+}
+
+func TestSearchProductsByBarcode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockReceptionQueries(ctrl)
+
+	repo := repository.NewReceptionRepository(queries)
+
+	barcode := sql.NullString{String: "4601234567890", Valid: true}
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	queries.EXPECT().SearchProductsByBarcode(gomock.Any(), db.SearchProductsByBarcodeParams{
+		Barcode: barcode,
+		PvzIds:  []uuid.UUID{pvz.ID},
+		Since:   sql.NullTime{Time: since, Valid: true},
+	}).Return([]db.Product{{ID: product.ID, DateTime: product.DateTime, Type: product.Type, ReceptionID: product.ReceptionID}}, nil)
+
+	res, err := repo.SearchProductsByBarcode(context.Background(), barcode.String, []uuid.UUID{pvz.ID}, since)
+	require.NoError(t, err)
+	require.Equal(t, []*entity.Product{product}, res)
+
+	// zero since means no time bound
+	queries.EXPECT().SearchProductsByBarcode(gomock.Any(), db.SearchProductsByBarcodeParams{
+		Barcode: barcode,
+	}).Return(nil, sql.ErrNoRows)
+
+	res, err = repo.SearchProductsByBarcode(context.Background(), barcode.String, nil, time.Time{})
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	queries.EXPECT().SearchProductsByBarcode(gomock.Any(), gomock.Any()).Return(nil, errMock)
+
+	res, err = repo.SearchProductsByBarcode(context.Background(), barcode.String, nil, time.Time{})
+	require.Nil(t, res)
+	require.Equal(t, errMock, err)
+}
+
+func TestAddProductsToReception(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockReceptionQueries(ctrl)
+
+	repo := repository.NewReceptionRepository(queries)
+
+	products := []request.BatchProduct{
+		{Type: string(entity.ProductTypeClothes), Barcode: "1", SizeClass: string(entity.SizeClassMedium)},
+		{Type: string(entity.ProductTypeElectronics), Barcode: "2", OrderID: "order", Attributes: map[string]string{"imei": "356938035643809"}, SizeClass: string(entity.SizeClassSmall)},
+	}
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expBarcodes  []string
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().AddProductsToReception(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, arg db.AddProductsToReceptionParams) ([]db.Product, error) {
+						require.Equal(t, reception.ID, arg.ReceptionID)
+						require.Equal(t, []string{products[0].Type, products[1].Type}, arg.Types)
+						require.Equal(t, []string{"1", "2"}, arg.Barcodes)
+						require.Equal(t, []string{"", "order"}, arg.OrderIds)
+						require.Equal(t, []string{`{}`, `{"imei":"356938035643809"}`}, arg.Attributes)
+						require.Equal(t, []string{"medium", "small"}, arg.SizeClasses)
+
+						// returned out of order
+						return []db.Product{
+							{ID: arg.Ids[1], Barcode: sql.NullString{String: "2", Valid: true}},
+							{ID: arg.Ids[0], Barcode: sql.NullString{String: "1", Valid: true}},
+						}, nil
+					})
+			},
+			expBarcodes: []string{"1", "2"},
+		},
+		{
+			name: "reception closed",
+			mockBehavior: func() {
+				queries.EXPECT().AddProductsToReception(gomock.Any(), gomock.Any()).Return(nil, &pq.Error{Code: "20001"})
+			},
+			expErr: repository.ErrReceptionInProgress,
+		},
+		{
+			name: "duplicate barcode",
+			mockBehavior: func() {
+				queries.EXPECT().AddProductsToReception(gomock.Any(), gomock.Any()).Return(nil, &pq.Error{Code: "23505"})
+			},
+			expErr: repository.ErrDuplicateBarcode,
+		},
+		{
+			name: "unk err",
+			mockBehavior: func() {
+				queries.EXPECT().AddProductsToReception(gomock.Any(), gomock.Any()).Return(nil, errMock)
+			},
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := repo.AddProductsToReception(context.Background(), reception.ID, products)
+			require.Equal(t, tc.expErr, err)
+
+			var barcodes []string
+			for _, p := range res {
+				barcodes = append(barcodes, p.Barcode)
+			}
+			require.Equal(t, tc.expBarcodes, barcodes)
+		})
+	}
+}
+
+func TestFindProductsByBarcodes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockReceptionQueries(ctrl)
+
+	repo := repository.NewReceptionRepository(queries)
+
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	queries.EXPECT().FindProductsByBarcodes(gomock.Any(), db.FindProductsByBarcodesParams{
+		Barcodes:    []string{"1"},
+		ReceptionID: reception.ID,
+		PvzID:       pvz.ID,
+		Since:       sql.NullTime{Time: since, Valid: true},
+	}).Return([]db.Product{{ID: product.ID, Barcode: sql.NullString{String: "1", Valid: true}}}, nil)
+
+	res, err := repo.FindProductsByBarcodes(context.Background(), []string{"1"}, reception.ID, pvz.ID, since)
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	require.Equal(t, product.ID, res[0].ID)
+
+	queries.EXPECT().FindProductsByBarcodes(gomock.Any(), db.FindProductsByBarcodesParams{
+		Barcodes:    []string{"1"},
+		ReceptionID: reception.ID,
+		PvzID:       pvz.ID,
+	}).Return(nil, errMock)
+
+	_, err = repo.FindProductsByBarcodes(context.Background(), []string{"1"}, reception.ID, pvz.ID, time.Time{})
+	require.Equal(t, errMock, err)
+}
+
+func TestGetReceptionByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockReceptionQueries(ctrl)
+
+	repo := repository.NewReceptionRepository(queries)
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       *entity.Reception
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().GetReceptionByID(gomock.Any(), reception.ID).Return(db.Reception{
+					ID:       reception.ID,
+					DateTime: reception.DateTime,
+					PvzID:    reception.PvzID,
+					Status:   reception.Status,
+				}, nil)
+			},
+			expRes: reception,
+			expErr: nil,
+		},
+		{
+			name: "not found",
+			mockBehavior: func() {
+				queries.EXPECT().GetReceptionByID(gomock.Any(), reception.ID).Return(db.Reception{}, sql.ErrNoRows)
+			},
+			expRes: nil,
+			expErr: repository.ErrReceptionNotFound,
+		},
+		{
+			name: "unk error",
+			mockBehavior: func() {
+				queries.EXPECT().GetReceptionByID(gomock.Any(), reception.ID).Return(db.Reception{}, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc.mockBehavior()
+
+		res, err := repo.GetReceptionByID(context.Background(), reception.ID)
+
+		require.Equal(t, tc.expRes, res)
+		require.Equal(t, tc.expErr, err)
+	}
+}
+
+func TestGetProductReception(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockReceptionQueries(ctrl)
+
+	repo := repository.NewReceptionRepository(queries)
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       *entity.Reception
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().GetProductReception(gomock.Any(), product.ID).Return(db.Reception{
+					ID:       reception.ID,
+					DateTime: reception.DateTime,
+					PvzID:    reception.PvzID,
+					Status:   reception.Status,
+				}, nil)
+			},
+			expRes: reception,
+			expErr: nil,
+		},
+		{
+			name: "not found",
+			mockBehavior: func() {
+				queries.EXPECT().GetProductReception(gomock.Any(), product.ID).Return(db.Reception{}, sql.ErrNoRows)
+			},
+			expRes: nil,
+			expErr: repository.ErrReceptionNotFound,
+		},
+		{
+			name: "unk error",
+			mockBehavior: func() {
+				queries.EXPECT().GetProductReception(gomock.Any(), product.ID).Return(db.Reception{}, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc.mockBehavior()
+
+		res, err := repo.GetProductReception(context.Background(), product.ID)
+
+		require.Equal(t, tc.expRes, res)
+		require.Equal(t, tc.expErr, err)
+	}
+}
+
+func TestListPvzReceptions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockReceptionQueries(ctrl)
+
+	repo := repository.NewReceptionRepository(queries)
+
+	status := string(entity.StatusFinished)
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	cursor := &request.ReceptionCursor{DateTime: reception11.DateTime, ID: reception11.ID}
+
+	testCases := []struct {
+		name         string
+		req          *request.ListReceptions
+		mockBehavior func()
+		expRes       []*entity.Reception
+		expErr       error
+	}{
+		{
+			name: "ok without filters",
+			req:  &request.ListReceptions{PvzID: pvz1.ID, Limit: 10},
+			mockBehavior: func() {
+				queries.EXPECT().ListPvzReceptions(gomock.Any(), db.ListPvzReceptionsParams{PvzID: pvz1.ID, Limit: 10}).Return([]db.Reception{{
+					ID:       reception11.ID,
+					DateTime: reception11.DateTime,
+					PvzID:    reception11.PvzID,
+					Status:   reception11.Status,
+				}}, nil)
+			},
+			expRes: []*entity.Reception{reception11},
+			expErr: nil,
+		},
+		{
+			name: "ok with filters and cursor",
+			req:  &request.ListReceptions{PvzID: pvz1.ID, Status: &status, From: &from, Cursor: cursor, Limit: 1},
+			mockBehavior: func() {
+				queries.EXPECT().ListPvzReceptions(gomock.Any(), db.ListPvzReceptionsParams{
+					PvzID:          pvz1.ID,
+					Status:         sql.NullString{String: status, Valid: true},
+					From:           sql.NullTime{Time: from, Valid: true},
+					BeforeDateTime: sql.NullTime{Time: cursor.DateTime, Valid: true},
+					BeforeID:       uuid.NullUUID{UUID: cursor.ID, Valid: true},
+					Limit:          1,
+				}).Return([]db.Reception{{
+					ID:       reception1.ID,
+					DateTime: reception1.DateTime,
+					PvzID:    reception1.PvzID,
+					Status:   reception1.Status,
+				}}, nil)
+			},
+			expRes: []*entity.Reception{reception1},
+			expErr: nil,
+		},
+		{
+			name: "unk error",
+			req:  &request.ListReceptions{PvzID: pvz1.ID, Limit: 10},
+			mockBehavior: func() {
+				queries.EXPECT().ListPvzReceptions(gomock.Any(), gomock.Any()).Return(nil, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc.mockBehavior()
+
+		res, err := repo.ListPvzReceptions(context.Background(), tc.req)
+
+		require.Equal(t, tc.expRes, res)
+		require.Equal(t, tc.expErr, err)
+	}
+}
+
+func TestCountProductsByType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockReceptionQueries(ctrl)
+
+	repo := repository.NewReceptionRepository(queries)
+
+	ids := []uuid.UUID{reception1.ID, reception11.ID}
+	queries.EXPECT().CountProductsByType(gomock.Any(), ids).Return([]db.CountProductsByTypeRow{
+		{ReceptionID: reception1.ID, Type: entity.ProductTypeClothes, Count: 3},
+		{ReceptionID: reception1.ID, Type: entity.ProductTypeShoes, Count: 1},
+	}, nil)
+	res, err := repo.CountProductsByType(context.Background(), ids)
+	require.NoError(t, err)
+	require.Equal(t, map[uuid.UUID]map[entity.ProductType]int64{
+		reception1.ID: {entity.ProductTypeClothes: 3, entity.ProductTypeShoes: 1},
+	}, res)
+
+	queries.EXPECT().CountProductsByType(gomock.Any(), ids).Return(nil, errMock)
+	_, err = repo.CountProductsByType(context.Background(), ids)
+	require.Equal(t, errMock, err)
+}
+
+func TestCountDamagedProducts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockReceptionQueries(ctrl)
+
+	repo := repository.NewReceptionRepository(queries)
+
+	ids := []uuid.UUID{reception1.ID, reception11.ID}
+	queries.EXPECT().CountProductsByCondition(gomock.Any(), ids).Return([]db.CountProductsByConditionRow{
+		{ReceptionID: reception1.ID, Damaged: 2, Opened: 1},
+	}, nil)
+	res, err := repo.CountDamagedProducts(context.Background(), ids)
+	require.NoError(t, err)
+	require.Equal(t, map[uuid.UUID]entity.DamageCounts{
+		reception1.ID: {Damaged: 2, Opened: 1},
+	}, res)
+
+	queries.EXPECT().CountProductsByCondition(gomock.Any(), ids).Return(nil, errMock)
+	_, err = repo.CountDamagedProducts(context.Background(), ids)
+	require.Equal(t, errMock, err)
+}
+
+func TestCreateReceptionWithManifest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockReceptionQueries(ctrl)
+
+	repo := repository.NewReceptionRepository(queries)
+
+	req := &request.CreateReception{
+		PvzID:    pvz.ID,
+		Manifest: &request.ReceptionManifest{TypeCounts: map[string]int{"одежда": 2}},
+	}
+	queries.EXPECT().CreateReceptionWithManifest(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, arg db.CreateReceptionWithManifestParams) (db.Reception, error) {
+			require.Equal(t, pvz.ID, arg.PvzID)
+			require.Equal(t, []string{}, arg.Barcodes)
+			require.JSONEq(t, `{"одежда": 2}`, arg.TypeCounts)
+			return db.Reception{ID: reception.ID, DateTime: reception.DateTime, PvzID: pvz.ID, Status: entity.StatusInProgress}, nil
+		})
+	res, err := repo.CreateReception(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, reception, res)
+
+	queries.EXPECT().CreateReceptionWithManifest(gomock.Any(), gomock.Any()).Return(db.Reception{}, &pq.Error{Code: "20002"})
+	_, err = repo.CreateReception(context.Background(), req)
+	require.Equal(t, repository.ErrReceptionInProgress, err)
+}
+
+func TestGetReceptionManifest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockReceptionQueries(ctrl)
+
+	repo := repository.NewReceptionRepository(queries)
+
+	queries.EXPECT().GetReceptionManifest(gomock.Any(), reception.ID).Return(db.ReceptionManifest{
+		ReceptionID: reception.ID,
+		Barcodes:    []string{"001"},
+		TypeCounts:  []byte(`{}`),
+	}, nil)
+	res, err := repo.GetReceptionManifest(context.Background(), reception.ID)
+	require.NoError(t, err)
+	require.Equal(t, &entity.ReceptionManifest{Barcodes: []string{"001"}}, res)
+
+	queries.EXPECT().GetReceptionManifest(gomock.Any(), reception.ID).Return(db.ReceptionManifest{}, sql.ErrNoRows)
+	_, err = repo.GetReceptionManifest(context.Background(), reception.ID)
+	require.Equal(t, repository.ErrNoManifest, err)
+}
+
+func TestFinishReceptionWithReconciliation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockReceptionQueries(ctrl)
+
+	repo := repository.NewReceptionRepository(queries)
+
+	rec := &entity.Reconciliation{
+		Discrepancies: []*entity.Discrepancy{
+			{Kind: entity.DiscrepancyMissing, Barcode: "001", Expected: 1},
+			{Kind: entity.DiscrepancyOverCount, Type: entity.ProductTypeShoes, Expected: 1, Actual: 2},
+		},
+		Overridden: true,
+	}
+	raw := `[{"kind":"missing","barcode":"001","expected":1,"actual":0},{"kind":"over_count","type":"обувь","expected":1,"actual":2}]`
+
+	queries.EXPECT().FinishReceptionWithReconciliation(gomock.Any(), db.FinishReceptionWithReconciliationParams{
+		ID:            reception.ID,
+		Discrepancies: raw,
+		Overridden:    true,
+	}).Return(db.Reception{ID: reception.ID, DateTime: reception.DateTime, PvzID: reception.PvzID, Status: entity.StatusFinished}, nil)
+	res, err := repo.FinishReceptionWithReconciliation(context.Background(), reception.ID, rec)
+	require.NoError(t, err)
+	require.Equal(t, entity.StatusFinished, res.Status)
+
+	queries.EXPECT().FinishReceptionWithReconciliation(gomock.Any(), gomock.Any()).Return(db.Reception{}, sql.ErrNoRows)
+	_, err = repo.FinishReceptionWithReconciliation(context.Background(), reception.ID, rec)
+	require.Equal(t, repository.ErrNoOpenReceptionFound, err)
+
+	queries.EXPECT().GetReceptionReconciliation(gomock.Any(), reception.ID).Return(db.ReceptionReconciliation{
+		ReceptionID:   reception.ID,
+		Discrepancies: []byte(raw),
+		Overridden:    true,
+	}, nil)
+	stored, err := repo.GetReconciliation(context.Background(), reception.ID)
+	require.NoError(t, err)
+	require.Equal(t, rec, stored)
+
+	queries.EXPECT().GetReceptionReconciliation(gomock.Any(), reception.ID).Return(db.ReceptionReconciliation{}, sql.ErrNoRows)
+	_, err = repo.GetReconciliation(context.Background(), reception.ID)
+	require.Equal(t, repository.ErrNoReconciliation, err)
+}
+
+func TestUpdateReceptionStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockReceptionQueries(ctrl)
+
+	repo := repository.NewReceptionRepository(queries)
+
+	changedBy := uuid.New()
+	arg := db.UpdateReceptionStatusParams{
+		ID:         reception.ID,
+		Status:     entity.StatusInProgress,
+		FromStatus: entity.StatusFinished,
+		ChangedBy:  uuid.NullUUID{UUID: changedBy, Valid: true},
+		Reason:     "wrong close",
+	}
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       *entity.Reception
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().UpdateReceptionStatus(gomock.Any(), arg).Return(db.UpdateReceptionStatusRow{
+					ID:       reception.ID,
+					DateTime: reception.DateTime,
+					PvzID:    reception.PvzID,
+					Status:   entity.StatusInProgress,
+				}, nil)
+			},
+			expRes: &entity.Reception{
+				ID:       reception.ID,
+				DateTime: reception.DateTime,
+				PvzID:    reception.PvzID,
+				Status:   entity.StatusInProgress,
+			},
+			expErr: nil,
+		},
+		{
+			name: "status changed",
+			mockBehavior: func() {
+				queries.EXPECT().UpdateReceptionStatus(gomock.Any(), arg).Return(db.UpdateReceptionStatusRow{}, sql.ErrNoRows)
+			},
+			expRes: nil,
+			expErr: repository.ErrReceptionStatusStale,
+		},
+		{
+			name: "other reception in progress",
+			mockBehavior: func() {
+				queries.EXPECT().UpdateReceptionStatus(gomock.Any(), arg).Return(db.UpdateReceptionStatusRow{}, &pq.Error{Code: "20002"})
+			},
+			expRes: nil,
+			expErr: repository.ErrReceptionInProgress,
+		},
+		{
+			name: "pvz not active",
+			mockBehavior: func() {
+				queries.EXPECT().UpdateReceptionStatus(gomock.Any(), arg).Return(db.UpdateReceptionStatusRow{}, &pq.Error{Code: "20006"})
+			},
+			expRes: nil,
+			expErr: repository.ErrPvzNotActive,
+		},
+		{
+			name: "newer reception exists",
+			mockBehavior: func() {
+				queries.EXPECT().UpdateReceptionStatus(gomock.Any(), arg).Return(db.UpdateReceptionStatusRow{}, &pq.Error{Code: "20007"})
+			},
+			expRes: nil,
+			expErr: repository.ErrNewerReceptionExists,
+		},
+		{
+			name: "cancelled",
+			mockBehavior: func() {
+				queries.EXPECT().UpdateReceptionStatus(gomock.Any(), arg).Return(db.UpdateReceptionStatusRow{}, &pq.Error{Code: "20008"})
+			},
+			expRes: nil,
+			expErr: repository.ErrReceptionCancelled,
+		},
+		{
+			name: "unk error",
+			mockBehavior: func() {
+				queries.EXPECT().UpdateReceptionStatus(gomock.Any(), arg).Return(db.UpdateReceptionStatusRow{}, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc.mockBehavior()
+
+		res, err := repo.UpdateReceptionStatus(context.Background(), reception.ID, entity.StatusFinished, entity.StatusInProgress, changedBy, "wrong close")
+
+		require.Equal(t, tc.expRes, res)
+		require.Equal(t, tc.expErr, err)
+	}
+}
+
+func TestListOpenReceptionsBefore(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockReceptionQueries(ctrl)
+
+	repo := repository.NewReceptionRepository(queries)
+
+	before := time.Now().Add(-12 * time.Hour)
+	openedAt := before.Add(-time.Hour)
+	row := db.ListOpenReceptionsBeforeRow{
+		ID:       reception.ID,
+		DateTime: reception.DateTime,
+		PvzID:    reception.PvzID,
+		Status:   reception.Status,
+		OpenedAt: openedAt,
+		City:     entity.CityKazan,
+	}
+	alertedRow := row
+	alertedRow.AlertedAt = sql.NullTime{Time: openedAt.Add(time.Minute), Valid: true}
+	// alerted before reception was reopened
+	reopenedRow := row
+	reopenedRow.AlertedAt = sql.NullTime{Time: openedAt.Add(-time.Minute), Valid: true}
+	queries.EXPECT().ListOpenReceptionsBefore(gomock.Any(), before).Return([]db.ListOpenReceptionsBeforeRow{row, alertedRow, reopenedRow}, nil)
+
+	res, err := repo.ListOpenReceptionsBefore(context.Background(), before)
+	require.NoError(t, err)
+	require.Equal(t, []*entity.OpenReception{
+		{Reception: reception, City: entity.CityKazan, OpenedAt: openedAt},
+		{Reception: reception, City: entity.CityKazan, OpenedAt: openedAt, Alerted: true},
+		{Reception: reception, City: entity.CityKazan, OpenedAt: openedAt},
+	}, res)
+
+	queries.EXPECT().ListOpenReceptionsBefore(gomock.Any(), before).Return(nil, errMock)
+
+	res, err = repo.ListOpenReceptionsBefore(context.Background(), before)
+	require.Nil(t, res)
+	require.Equal(t, errMock, err)
+}
@@ -0,0 +1,44 @@
+//go:generate mockgen -source=./role_repository.go -destination=mocks/role_repository.go -package=mocks
+
+package repository
+
+import (
+	"context"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+type RoleQueries interface {
+	ListRolePermissions(ctx context.Context) ([]db.ListRolePermissionsRow, error)
+}
+
+type RoleRepository struct {
+	queries RoleQueries
+}
+
+func NewRoleRepository(q RoleQueries) *RoleRepository {
+	return &RoleRepository{q}
+}
+
+// GetRolePermissions returns all roles with their permissions.
+// Roles without permissions are returned with empty slice.
+func (r *RoleRepository) GetRolePermissions(ctx context.Context) (map[entity.Role][]entity.Permission, error) {
+	res, err := r.queries.ListRolePermissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	perms := make(map[entity.Role][]entity.Permission)
+	for _, row := range res {
+		role := entity.Role(row.Role)
+		if _, ok := perms[role]; !ok {
+			perms[role] = []entity.Permission{}
+		}
+		if row.Permission.Valid {
+			perms[role] = append(perms[role], entity.Permission(row.Permission.String))
+		}
+	}
+
+	return perms, nil
+}
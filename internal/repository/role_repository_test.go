@@ -0,0 +1,66 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository/mocks"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+func TestGetRolePermissions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockRoleQueries(ctrl)
+
+	repo := repository.NewRoleRepository(queries)
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       map[entity.Role][]entity.Permission
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().ListRolePermissions(gomock.Any()).Return([]db.ListRolePermissionsRow{
+					{Role: "employee", Permission: sql.NullString{String: "reception:write", Valid: true}},
+					{Role: "employee", Permission: sql.NullString{String: "report:read", Valid: true}},
+					{Role: "moderator", Permission: sql.NullString{String: "pvz:create", Valid: true}},
+					{Role: "auditor", Permission: sql.NullString{}},
+				}, nil)
+			},
+			expRes: map[entity.Role][]entity.Permission{
+				entity.RoleEmployee:  {entity.PermReceptionWrite, entity.PermReportRead},
+				entity.RoleModerator: {entity.PermPvzCreate},
+				"auditor":            {},
+			},
+			expErr: nil,
+		},
+		{
+			name: "unk err",
+			mockBehavior: func() {
+				queries.EXPECT().ListRolePermissions(gomock.Any()).Return(nil, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := repo.GetRolePermissions(context.Background())
+
+			require.Equal(t, tc.expRes, res)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
@@ -5,23 +5,136 @@
 package db
 
 import (
+	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
 	entity "github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
 )
 
+type ApiKey struct {
+	ID          uuid.UUID
+	Name        string
+	Prefix      string
+	KeyHash     string
+	Permissions []string
+	PvzIds      []uuid.UUID
+	ExpiresAt   sql.NullTime
+	LastUsedAt  sql.NullTime
+	RevokedAt   sql.NullTime
+	CreatedAt   time.Time
+}
+
+type AuditLog struct {
+	ID        int64
+	Action    string
+	ActorID   uuid.NullUUID
+	ActorRole sql.NullString
+	ApiKeyID  uuid.NullUUID
+	Ip        string
+	UserAgent string
+	RequestID string
+	Payload   json.RawMessage
+	CreatedAt time.Time
+}
+
+type City struct {
+	Code      string
+	Name      entity.City
+	NameEn    string
+	Region    string
+	Timezone  string
+	Enabled   bool
+	CreatedAt time.Time
+}
+
+type IdempotencyKey struct {
+	Scope        string
+	Key          string
+	RequestHash  string
+	StatusCode   sql.NullInt32
+	ResponseBody []byte
+	CreatedAt    time.Time
+	ExpiresAt    time.Time
+}
+
+type Invite struct {
+	ID        uuid.UUID
+	TokenHash string
+	Email     string
+	Role      string
+	PvzIds    []uuid.UUID
+	ExpiresAt time.Time
+	UsedAt    sql.NullTime
+	CreatedAt time.Time
+}
+
 type Product struct {
+	ID             uuid.UUID
+	DateTime       time.Time
+	Type           entity.ProductType
+	ReceptionID    uuid.UUID
+	Attributes     json.RawMessage
+	Barcode        sql.NullString
+	OrderID        sql.NullString
+	Seq            int64
+	State          entity.ProductState
+	PickupCodeHash sql.NullString
+	SizeClass      entity.SizeClass
+	CellID         uuid.NullUUID
+	Condition      entity.ProductCondition
+	Notes          sql.NullString
+}
+
+type ProductAttachment struct {
 	ID          uuid.UUID
-	DateTime    time.Time
-	Type        entity.ProductType
-	ReceptionID uuid.UUID
+	ProductID   uuid.UUID
+	BlobKey     string
+	ContentType string
+	Size        int64
+	UploadedBy  uuid.NullUUID
+	CreatedAt   time.Time
+}
+
+type ProductEvent struct {
+	ID        int64
+	ProductID uuid.UUID
+	PvzID     uuid.UUID
+	Type      entity.ProductEventType
+	ActorID   uuid.NullUUID
+	Details   json.RawMessage
+	CreatedAt time.Time
+}
+
+type ProductType struct {
+	Code       string
+	Name       entity.ProductType
+	NameEn     string
+	Attributes json.RawMessage
+	Fragile    bool
+	HighValue  bool
+	CreatedAt  time.Time
 }
 
 type Pvz struct {
 	ID               uuid.UUID
 	RegistrationDate time.Time
 	City             entity.City
+	Status           entity.PvzStatus
+	Capacity         sql.NullInt32
+	SoftCapacity     sql.NullInt32
+	StockCount       int32
+}
+
+type PvzStatusHistory struct {
+	ID         int64
+	PvzID      uuid.UUID
+	FromStatus entity.PvzStatus
+	ToStatus   entity.PvzStatus
+	ChangedBy  uuid.NullUUID
+	Reason     string
+	ChangedAt  time.Time
 }
 
 type Reception struct {
@@ -29,11 +142,80 @@ type Reception struct {
 	DateTime time.Time
 	PvzID    uuid.UUID
 	Status   entity.Status
+	OpenedAt time.Time
+}
+
+type ReceptionManifest struct {
+	ReceptionID uuid.UUID
+	Barcodes    []string
+	TypeCounts  json.RawMessage
+	CreatedAt   time.Time
+}
+
+type ReceptionReconciliation struct {
+	ReceptionID   uuid.UUID
+	Discrepancies json.RawMessage
+	Overridden    bool
+	CreatedAt     time.Time
+}
+
+type ReceptionStaleAlert struct {
+	ReceptionID uuid.UUID
+	AlertedAt   time.Time
+}
+
+type ReturnShipment struct {
+	ID        uuid.UUID
+	PvzID     uuid.UUID
+	CreatedBy uuid.NullUUID
+	CreatedAt time.Time
+}
+
+type ReturnShipmentProduct struct {
+	ShipmentID uuid.UUID
+	ProductID  uuid.UUID
+}
+
+type StorageCell struct {
+	ID        uuid.UUID
+	PvzID     uuid.UUID
+	Zone      string
+	Rack      int32
+	Shelf     int32
+	SizeClass entity.SizeClass
+	Capacity  int32
+	Occupied  int32
+	CreatedAt time.Time
+}
+
+type Transfer struct {
+	ID           uuid.UUID
+	FromPvzID    uuid.UUID
+	ToPvzID      uuid.UUID
+	Status       entity.TransferStatus
+	ReceptionID  uuid.NullUUID
+	CreatedBy    uuid.NullUUID
+	CreatedAt    time.Time
+	DispatchedBy uuid.NullUUID
+	DispatchedAt sql.NullTime
+	ReceivedBy   uuid.NullUUID
+	ReceivedAt   sql.NullTime
+}
+
+type TransferProduct struct {
+	TransferID uuid.UUID
+	ProductID  uuid.UUID
 }
 
 type User struct {
-	ID       uuid.UUID
-	Email    string
-	Password string
-	Role     entity.Role
+	ID           uuid.UUID
+	Email        string
+	Password     string
+	Role         entity.Role
+	Active       bool
+	CreatedAt    time.Time
+	TokenVersion int32
+	MfaSecret    sql.NullString
+	MfaEnabled   bool
+	MfaLastStep  sql.NullInt64
 }
@@ -7,43 +7,137 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	entity "github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
 )
 
+const countPvzByIDs = `-- name: CountPvzByIDs :one
+SELECT COUNT(*) FROM pvz
+WHERE id = ANY($1::uuid[])
+`
+
+func (q *Queries) CountPvzByIDs(ctx context.Context, ids []uuid.UUID) (int64, error) {
+	row := q.db.QueryRowContext(ctx, countPvzByIDs, pq.Array(ids))
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const createPVZ = `-- name: CreatePVZ :one
-INSERT INTO pvz (id, registration_date, city) VALUES
-($1, $2, $3)
-RETURNING id, registration_date, city
+INSERT INTO pvz (id, registration_date, city, capacity, soft_capacity) VALUES
+($1, $2, $3, $4, $5)
+RETURNING id, registration_date, city, status, capacity, soft_capacity, stock_count
 `
 
 type CreatePVZParams struct {
 	ID               uuid.UUID
 	RegistrationDate time.Time
 	City             entity.City
+	Capacity         sql.NullInt32
+	SoftCapacity     sql.NullInt32
 }
 
 func (q *Queries) CreatePVZ(ctx context.Context, arg CreatePVZParams) (Pvz, error) {
-	row := q.db.QueryRowContext(ctx, createPVZ, arg.ID, arg.RegistrationDate, arg.City)
+	row := q.db.QueryRowContext(ctx, createPVZ,
+		arg.ID,
+		arg.RegistrationDate,
+		arg.City,
+		arg.Capacity,
+		arg.SoftCapacity,
+	)
+	var i Pvz
+	err := row.Scan(
+		&i.ID,
+		&i.RegistrationDate,
+		&i.City,
+		&i.Status,
+		&i.Capacity,
+		&i.SoftCapacity,
+		&i.StockCount,
+	)
+	return i, err
+}
+
+const getPVZByID = `-- name: GetPVZByID :one
+SELECT id, registration_date, city, status, capacity, soft_capacity, stock_count FROM pvz
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetPVZByID(ctx context.Context, id uuid.UUID) (Pvz, error) {
+	row := q.db.QueryRowContext(ctx, getPVZByID, id)
 	var i Pvz
-	err := row.Scan(&i.ID, &i.RegistrationDate, &i.City)
+	err := row.Scan(
+		&i.ID,
+		&i.RegistrationDate,
+		&i.City,
+		&i.Status,
+		&i.Capacity,
+		&i.SoftCapacity,
+		&i.StockCount,
+	)
 	return i, err
 }
 
+const listPVZStockCounts = `-- name: ListPVZStockCounts :many
+SELECT id, capacity, stock_count FROM pvz
+WHERE status != 'decommissioned'
+`
+
+type ListPVZStockCountsRow struct {
+	ID         uuid.UUID
+	Capacity   sql.NullInt32
+	StockCount int32
+}
+
+func (q *Queries) ListPVZStockCounts(ctx context.Context) ([]ListPVZStockCountsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listPVZStockCounts)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListPVZStockCountsRow{}
+	for rows.Next() {
+		var i ListPVZStockCountsRow
+		if err := rows.Scan(&i.ID, &i.Capacity, &i.StockCount); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const searchPVZ = `-- name: SearchPVZ :many
-SELECT id, registration_date, city FROM pvz
-OFFSET $1 LIMIT $2
+SELECT id, registration_date, city, status, capacity, soft_capacity, stock_count FROM pvz
+WHERE ($1::varchar IS NULL OR status = $1::pvz_status_enum)
+    AND ($2::uuid[] IS NULL OR id = ANY($2::uuid[]))
+OFFSET $3 LIMIT $4
 `
 
 type SearchPVZParams struct {
+	Status sql.NullString
+	PvzIds []uuid.UUID
 	Offset int32
-	Limit  int32
+	Limit  sql.NullInt32
 }
 
 func (q *Queries) SearchPVZ(ctx context.Context, arg SearchPVZParams) ([]Pvz, error) {
-	rows, err := q.db.QueryContext(ctx, searchPVZ, arg.Offset, arg.Limit)
+	rows, err := q.db.QueryContext(ctx, searchPVZ,
+		arg.Status,
+		pq.Array(arg.PvzIds),
+		arg.Offset,
+		arg.Limit,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -51,7 +145,15 @@ func (q *Queries) SearchPVZ(ctx context.Context, arg SearchPVZParams) ([]Pvz, er
 	items := []Pvz{}
 	for rows.Next() {
 		var i Pvz
-		if err := rows.Scan(&i.ID, &i.RegistrationDate, &i.City); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.RegistrationDate,
+			&i.City,
+			&i.Status,
+			&i.Capacity,
+			&i.SoftCapacity,
+			&i.StockCount,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -64,3 +166,87 @@ func (q *Queries) SearchPVZ(ctx context.Context, arg SearchPVZParams) ([]Pvz, er
 	}
 	return items, nil
 }
+
+const updatePVZCapacity = `-- name: UpdatePVZCapacity :one
+UPDATE pvz
+SET capacity = $1, soft_capacity = $2
+WHERE id = $3
+RETURNING id, registration_date, city, status, capacity, soft_capacity, stock_count
+`
+
+type UpdatePVZCapacityParams struct {
+	Capacity     sql.NullInt32
+	SoftCapacity sql.NullInt32
+	ID           uuid.UUID
+}
+
+func (q *Queries) UpdatePVZCapacity(ctx context.Context, arg UpdatePVZCapacityParams) (Pvz, error) {
+	row := q.db.QueryRowContext(ctx, updatePVZCapacity, arg.Capacity, arg.SoftCapacity, arg.ID)
+	var i Pvz
+	err := row.Scan(
+		&i.ID,
+		&i.RegistrationDate,
+		&i.City,
+		&i.Status,
+		&i.Capacity,
+		&i.SoftCapacity,
+		&i.StockCount,
+	)
+	return i, err
+}
+
+const updatePVZStatus = `-- name: UpdatePVZStatus :one
+WITH old AS (
+    SELECT id, status FROM pvz
+    WHERE id = $1
+    FOR UPDATE
+), upd AS (
+    UPDATE pvz
+    SET status = $2
+    FROM old
+    WHERE pvz.id = old.id
+    RETURNING pvz.id, pvz.registration_date, pvz.city, pvz.status, pvz.capacity, pvz.soft_capacity, pvz.stock_count, old.status AS old_status
+), history AS (
+    INSERT INTO pvz_status_history (pvz_id, from_status, to_status, changed_by, reason)
+    SELECT upd.id, upd.old_status, upd.status, $3::uuid, $4::varchar FROM upd
+    WHERE upd.old_status != upd.status
+)
+SELECT id, registration_date, city, status, capacity, soft_capacity, stock_count FROM upd
+`
+
+type UpdatePVZStatusParams struct {
+	ID        uuid.UUID
+	Status    entity.PvzStatus
+	ChangedBy uuid.NullUUID
+	Reason    string
+}
+
+type UpdatePVZStatusRow struct {
+	ID               uuid.UUID
+	RegistrationDate time.Time
+	City             entity.City
+	Status           entity.PvzStatus
+	Capacity         sql.NullInt32
+	SoftCapacity     sql.NullInt32
+	StockCount       int32
+}
+
+func (q *Queries) UpdatePVZStatus(ctx context.Context, arg UpdatePVZStatusParams) (UpdatePVZStatusRow, error) {
+	row := q.db.QueryRowContext(ctx, updatePVZStatus,
+		arg.ID,
+		arg.Status,
+		arg.ChangedBy,
+		arg.Reason,
+	)
+	var i UpdatePVZStatusRow
+	err := row.Scan(
+		&i.ID,
+		&i.RegistrationDate,
+		&i.City,
+		&i.Status,
+		&i.Capacity,
+		&i.SoftCapacity,
+		&i.StockCount,
+	)
+	return i, err
+}
@@ -6,24 +6,108 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Querier interface {
+	AcceptInvite(ctx context.Context, arg AcceptInviteParams) (AcceptInviteRow, error)
 	AddProductToReception(ctx context.Context, arg AddProductToReceptionParams) (Product, error)
+	AddProductsToReception(ctx context.Context, arg AddProductsToReceptionParams) ([]Product, error)
+	AssignFreeStorageCell(ctx context.Context, arg AssignFreeStorageCellParams) (StorageCell, error)
+	CountPermissionsByNames(ctx context.Context, names []string) (int64, error)
+	CountProductsByCondition(ctx context.Context, receptionIds []uuid.UUID) ([]CountProductsByConditionRow, error)
+	CountProductsByType(ctx context.Context, receptionIds []uuid.UUID) ([]CountProductsByTypeRow, error)
+	CountPvzByIDs(ctx context.Context, ids []uuid.UUID) (int64, error)
+	CreateAPIKey(ctx context.Context, arg CreateAPIKeyParams) (ApiKey, error)
+	CreateAuditLog(ctx context.Context, arg CreateAuditLogParams) error
+	CreateCity(ctx context.Context, arg CreateCityParams) (City, error)
+	CreateInvite(ctx context.Context, arg CreateInviteParams) (Invite, error)
 	CreatePVZ(ctx context.Context, arg CreatePVZParams) (Pvz, error)
+	CreatePasswordResetCode(ctx context.Context, arg CreatePasswordResetCodeParams) (uuid.UUID, error)
+	CreateProductAttachment(ctx context.Context, arg CreateProductAttachmentParams) (ProductAttachment, error)
+	CreateProductType(ctx context.Context, arg CreateProductTypeParams) (ProductType, error)
 	CreateReception(ctx context.Context, arg CreateReceptionParams) (Reception, error)
+	CreateReceptionWithManifest(ctx context.Context, arg CreateReceptionWithManifestParams) (Reception, error)
+	CreateReturnShipment(ctx context.Context, arg CreateReturnShipmentParams) (ReturnShipment, error)
+	CreateStorageCell(ctx context.Context, arg CreateStorageCellParams) (StorageCell, error)
+	CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
-	DeleteProduct(ctx context.Context, id uuid.UUID) error
+	DeleteEmptyReception(ctx context.Context, id uuid.UUID) (int64, error)
+	DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error)
+	DeleteIdempotencyKey(ctx context.Context, arg DeleteIdempotencyKeyParams) error
+	DeleteProduct(ctx context.Context, id uuid.UUID) (int64, error)
+	DeleteProductType(ctx context.Context, code string) (int64, error)
+	DispatchTransfer(ctx context.Context, arg DispatchTransferParams) (Transfer, error)
+	EnableUserMFA(ctx context.Context, arg EnableUserMFAParams) (int64, error)
+	FindProductsByBarcodes(ctx context.Context, arg FindProductsByBarcodesParams) ([]Product, error)
 	FinishReception(ctx context.Context, pvzID uuid.UUID) (Reception, error)
+	FinishReceptionWithReconciliation(ctx context.Context, arg FinishReceptionWithReconciliationParams) (Reception, error)
+	GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (IdempotencyKey, error)
+	GetLastClosedReceptionByPvzID(ctx context.Context, pvzID uuid.UUID) (Reception, error)
 	GetLastProductInReception(ctx context.Context, receptionID uuid.UUID) (Product, error)
 	GetOpenReceptionByPvzID(ctx context.Context, pvzID uuid.UUID) (Reception, error)
+	GetPVZByID(ctx context.Context, id uuid.UUID) (Pvz, error)
+	GetProductAttachment(ctx context.Context, arg GetProductAttachmentParams) (ProductAttachment, error)
+	GetProductByID(ctx context.Context, id uuid.UUID) (Product, error)
+	GetProductInReceptionByBarcode(ctx context.Context, arg GetProductInReceptionByBarcodeParams) (Product, error)
+	GetProductReception(ctx context.Context, id uuid.UUID) (Reception, error)
 	GetProductsFromReception(ctx context.Context, receptionID uuid.UUID) ([]Product, error)
+	GetProductsFromReceptionLIFO(ctx context.Context, receptionID uuid.UUID) ([]Product, error)
+	GetPvzExpiryStats(ctx context.Context, arg GetPvzExpiryStatsParams) (GetPvzExpiryStatsRow, error)
+	GetPvzStatsSince(ctx context.Context, arg GetPvzStatsSinceParams) (GetPvzStatsSinceRow, error)
+	GetReceptionByID(ctx context.Context, id uuid.UUID) (Reception, error)
+	GetReceptionManifest(ctx context.Context, receptionID uuid.UUID) (ReceptionManifest, error)
+	GetReceptionReconciliation(ctx context.Context, receptionID uuid.UUID) (ReceptionReconciliation, error)
+	GetStorageCellByID(ctx context.Context, id uuid.UUID) (StorageCell, error)
+	GetTransferByID(ctx context.Context, id uuid.UUID) (Transfer, error)
 	GetUserByEmail(ctx context.Context, email string) (User, error)
+	GetUserByID(ctx context.Context, id uuid.UUID) (User, error)
+	GetUserTokenVersion(ctx context.Context, id uuid.UUID) (int32, error)
+	ListAPIKeys(ctx context.Context) ([]ApiKey, error)
+	ListAuditLog(ctx context.Context, arg ListAuditLogParams) ([]AuditLog, error)
+	ListCities(ctx context.Context, enabled sql.NullBool) ([]City, error)
+	ListOpenReceptionsBefore(ctx context.Context, before time.Time) ([]ListOpenReceptionsBeforeRow, error)
+	ListPVZStockCounts(ctx context.Context) ([]ListPVZStockCountsRow, error)
+	ListProductAttachments(ctx context.Context, productID uuid.UUID) ([]ProductAttachment, error)
+	ListProductEvents(ctx context.Context, productID uuid.UUID) ([]ProductEvent, error)
+	ListProductTypes(ctx context.Context) ([]ProductType, error)
+	ListPvzReceptions(ctx context.Context, arg ListPvzReceptionsParams) ([]Reception, error)
+	ListPvzStock(ctx context.Context, arg ListPvzStockParams) ([]Product, error)
+	ListPvzStorageCells(ctx context.Context, pvzID uuid.UUID) ([]StorageCell, error)
+	ListReturnShipmentProducts(ctx context.Context, shipmentID uuid.UUID) ([]Product, error)
+	ListRolePermissions(ctx context.Context) ([]ListRolePermissionsRow, error)
+	ListStoredProductsBefore(ctx context.Context, before time.Time) ([]ListStoredProductsBeforeRow, error)
+	ListTransferCandidates(ctx context.Context, ids []uuid.UUID) ([]ListTransferCandidatesRow, error)
+	ListTransferProducts(ctx context.Context, transferID uuid.UUID) ([]Product, error)
+	ListUserPvzIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+	ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error)
+	MarkReceptionStaleAlerted(ctx context.Context, receptionID uuid.UUID) error
+	MoveProductToCell(ctx context.Context, arg MoveProductToCellParams) (Product, error)
+	ReceiveTransfer(ctx context.Context, arg ReceiveTransferParams) (Transfer, error)
+	ReserveIdempotencyKey(ctx context.Context, arg ReserveIdempotencyKeyParams) (int64, error)
+	ResetPasswordByCode(ctx context.Context, arg ResetPasswordByCodeParams) (uuid.UUID, error)
+	RevokeAPIKey(ctx context.Context, id uuid.UUID) (ApiKey, error)
+	SaveIdempotencyResponse(ctx context.Context, arg SaveIdempotencyResponseParams) error
 	SearchPVZ(ctx context.Context, arg SearchPVZParams) ([]Pvz, error)
+	SearchProductsByBarcode(ctx context.Context, arg SearchProductsByBarcodeParams) ([]Product, error)
 	SearchReceptionsByPvzsAndTime(ctx context.Context, arg SearchReceptionsByPvzsAndTimeParams) ([]Reception, error)
 	SearchReceptionsByTime(ctx context.Context, arg SearchReceptionsByTimeParams) ([]Reception, error)
+	SetProductPickupCode(ctx context.Context, arg SetProductPickupCodeParams) (Product, error)
+	SetUserMFASecret(ctx context.Context, arg SetUserMFASecretParams) (int64, error)
+	UpdateCity(ctx context.Context, arg UpdateCityParams) (City, error)
+	UpdatePVZCapacity(ctx context.Context, arg UpdatePVZCapacityParams) (Pvz, error)
+	UpdatePVZStatus(ctx context.Context, arg UpdatePVZStatusParams) (UpdatePVZStatusRow, error)
+	UpdateProductState(ctx context.Context, arg UpdateProductStateParams) (Product, error)
+	UpdateProductType(ctx context.Context, arg UpdateProductTypeParams) (ProductType, error)
+	UpdateReceptionStatus(ctx context.Context, arg UpdateReceptionStatusParams) (UpdateReceptionStatusRow, error)
+	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
+	UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) (User, error)
+	UseAPIKey(ctx context.Context, keyHash string) (ApiKey, error)
+	UseMFARecoveryCode(ctx context.Context, arg UseMFARecoveryCodeParams) (int64, error)
+	UseUserTOTPStep(ctx context.Context, arg UseUserTOTPStepParams) (int64, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -7,6 +7,7 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
 	"github.com/google/uuid"
@@ -14,34 +15,219 @@ import (
 	entity "github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
 )
 
+const addProductsToReception = `-- name: AddProductsToReception :many
+INSERT INTO products (id, type, reception_id, attributes, barcode, order_id, pickup_code_hash, size_class, condition, notes)
+SELECT u.id, u.type, $1::uuid, u.attributes::jsonb, NULLIF(u.barcode, ''), NULLIF(u.order_id, ''), NULLIF(u.pickup_code_hash, ''),
+    u.size_class::size_class, u.condition::product_condition, NULLIF(u.notes, '')
+FROM unnest(
+    $2::uuid[],
+    $3::varchar[],
+    $4::text[],
+    $5::varchar[],
+    $6::varchar[],
+    $7::varchar[],
+    $8::varchar[],
+    $9::varchar[],
+    $10::varchar[]
+) WITH ORDINALITY AS u(id, type, attributes, barcode, order_id, pickup_code_hash, size_class, condition, notes, n)
+ORDER BY u.n
+RETURNING id, date_time, type, reception_id, attributes, barcode, order_id, seq, state, pickup_code_hash, size_class, cell_id, condition, notes
+`
+
+type AddProductsToReceptionParams struct {
+	ReceptionID      uuid.UUID
+	Ids              []uuid.UUID
+	Types            []string
+	Attributes       []string
+	Barcodes         []string
+	OrderIds         []string
+	PickupCodeHashes []string
+	SizeClasses      []string
+	Conditions       []string
+	Notes            []string
+}
+
+func (q *Queries) AddProductsToReception(ctx context.Context, arg AddProductsToReceptionParams) ([]Product, error) {
+	rows, err := q.db.QueryContext(ctx, addProductsToReception,
+		arg.ReceptionID,
+		pq.Array(arg.Ids),
+		pq.Array(arg.Types),
+		pq.Array(arg.Attributes),
+		pq.Array(arg.Barcodes),
+		pq.Array(arg.OrderIds),
+		pq.Array(arg.PickupCodeHashes),
+		pq.Array(arg.SizeClasses),
+		pq.Array(arg.Conditions),
+		pq.Array(arg.Notes),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Product{}
+	for rows.Next() {
+		var i Product
+		if err := rows.Scan(
+			&i.ID,
+			&i.DateTime,
+			&i.Type,
+			&i.ReceptionID,
+			&i.Attributes,
+			&i.Barcode,
+			&i.OrderID,
+			&i.Seq,
+			&i.State,
+			&i.PickupCodeHash,
+			&i.SizeClass,
+			&i.CellID,
+			&i.Condition,
+			&i.Notes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const addProductToReception = `-- name: AddProductToReception :one
-INSERT INTO products (id, type, reception_id) VALUES
-($1, $2, $3)
-RETURNING id, date_time, type, reception_id
+INSERT INTO products (id, type, reception_id, attributes, barcode, order_id, pickup_code_hash, size_class, condition, notes) VALUES
+($1, $2, $3, $4::text::jsonb, $5, $6, $7, $8, $9, $10)
+RETURNING id, date_time, type, reception_id, attributes, barcode, order_id, seq, state, pickup_code_hash, size_class, cell_id, condition, notes
 `
 
 type AddProductToReceptionParams struct {
-	ID          uuid.UUID
-	Type        entity.ProductType
-	ReceptionID uuid.UUID
+	ID             uuid.UUID
+	Type           entity.ProductType
+	ReceptionID    uuid.UUID
+	Attributes     string
+	Barcode        sql.NullString
+	OrderID        sql.NullString
+	PickupCodeHash sql.NullString
+	SizeClass      entity.SizeClass
+	Condition      entity.ProductCondition
+	Notes          sql.NullString
 }
 
 func (q *Queries) AddProductToReception(ctx context.Context, arg AddProductToReceptionParams) (Product, error) {
-	row := q.db.QueryRowContext(ctx, addProductToReception, arg.ID, arg.Type, arg.ReceptionID)
+	row := q.db.QueryRowContext(ctx, addProductToReception,
+		arg.ID,
+		arg.Type,
+		arg.ReceptionID,
+		arg.Attributes,
+		arg.Barcode,
+		arg.OrderID,
+		arg.PickupCodeHash,
+		arg.SizeClass,
+		arg.Condition,
+		arg.Notes,
+	)
 	var i Product
 	err := row.Scan(
 		&i.ID,
 		&i.DateTime,
 		&i.Type,
 		&i.ReceptionID,
+		&i.Attributes,
+		&i.Barcode,
+		&i.OrderID,
+		&i.Seq,
+		&i.State,
+		&i.PickupCodeHash,
+		&i.SizeClass,
+		&i.CellID,
+		&i.Condition,
+		&i.Notes,
 	)
 	return i, err
 }
 
+const countProductsByCondition = `-- name: CountProductsByCondition :many
+SELECT rp.reception_id,
+    COUNT(*) FILTER (WHERE p.condition = 'damaged') AS damaged,
+    COUNT(*) FILTER (WHERE p.condition = 'opened') AS opened
+FROM reception_products rp
+JOIN products p ON p.id = rp.product_id
+WHERE rp.reception_id = ANY($1::uuid[]) AND p.condition != 'ok'
+GROUP BY rp.reception_id
+`
+
+type CountProductsByConditionRow struct {
+	ReceptionID uuid.UUID
+	Damaged     int64
+	Opened      int64
+}
+
+func (q *Queries) CountProductsByCondition(ctx context.Context, receptionIds []uuid.UUID) ([]CountProductsByConditionRow, error) {
+	rows, err := q.db.QueryContext(ctx, countProductsByCondition, pq.Array(receptionIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CountProductsByConditionRow{}
+	for rows.Next() {
+		var i CountProductsByConditionRow
+		if err := rows.Scan(&i.ReceptionID, &i.Damaged, &i.Opened); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const countProductsByType = `-- name: CountProductsByType :many
+SELECT rp.reception_id, p.type, COUNT(*) AS count
+FROM reception_products rp
+JOIN products p ON p.id = rp.product_id
+WHERE rp.reception_id = ANY($1::uuid[])
+GROUP BY rp.reception_id, p.type
+`
+
+type CountProductsByTypeRow struct {
+	ReceptionID uuid.UUID
+	Type        entity.ProductType
+	Count       int64
+}
+
+func (q *Queries) CountProductsByType(ctx context.Context, receptionIds []uuid.UUID) ([]CountProductsByTypeRow, error) {
+	rows, err := q.db.QueryContext(ctx, countProductsByType, pq.Array(receptionIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []CountProductsByTypeRow{}
+	for rows.Next() {
+		var i CountProductsByTypeRow
+		if err := rows.Scan(&i.ReceptionID, &i.Type, &i.Count); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const createReception = `-- name: CreateReception :one
 INSERT INTO receptions (id, date_time, pvz_id) VALUES
 ($1, $2, $3)
-RETURNING id, date_time, pvz_id, status
+RETURNING id, date_time, pvz_id, status, opened_at
 `
 
 type CreateReceptionParams struct {
@@ -58,25 +244,145 @@ func (q *Queries) CreateReception(ctx context.Context, arg CreateReceptionParams
 		&i.DateTime,
 		&i.PvzID,
 		&i.Status,
+		&i.OpenedAt,
 	)
 	return i, err
 }
 
-const deleteProduct = `-- name: DeleteProduct :exec
+const createReceptionWithManifest = `-- name: CreateReceptionWithManifest :one
+WITH r AS (
+    INSERT INTO receptions (id, date_time, pvz_id) VALUES
+    ($1, $2, $3)
+    RETURNING id, date_time, pvz_id, status, opened_at
+), manifest AS (
+    INSERT INTO reception_manifests (reception_id, barcodes, type_counts)
+    SELECT r.id, $4::text[], $5::text::jsonb FROM r
+)
+SELECT id, date_time, pvz_id, status, opened_at FROM r
+`
+
+type CreateReceptionWithManifestParams struct {
+	ID         uuid.UUID
+	DateTime   time.Time
+	PvzID      uuid.UUID
+	Barcodes   []string
+	TypeCounts string
+}
+
+func (q *Queries) CreateReceptionWithManifest(ctx context.Context, arg CreateReceptionWithManifestParams) (Reception, error) {
+	row := q.db.QueryRowContext(ctx, createReceptionWithManifest,
+		arg.ID,
+		arg.DateTime,
+		arg.PvzID,
+		pq.Array(arg.Barcodes),
+		arg.TypeCounts,
+	)
+	var i Reception
+	err := row.Scan(
+		&i.ID,
+		&i.DateTime,
+		&i.PvzID,
+		&i.Status,
+		&i.OpenedAt,
+	)
+	return i, err
+}
+
+const deleteEmptyReception = `-- name: DeleteEmptyReception :execrows
+-- reception started for transfer which failed to be received
+DELETE FROM receptions
+WHERE id = $1 AND status = 'in_progress'
+    AND NOT EXISTS (SELECT 1 FROM reception_products WHERE reception_id = $1)
+`
+
+func (q *Queries) DeleteEmptyReception(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteEmptyReception, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteProduct = `-- name: DeleteProduct :execrows
 DELETE FROM products
-WHERE id = $1
+WHERE id = $1 AND state = 'stored'
 `
 
-func (q *Queries) DeleteProduct(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.ExecContext(ctx, deleteProduct, id)
-	return err
+func (q *Queries) DeleteProduct(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.ExecContext(ctx, deleteProduct, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const findProductsByBarcodes = `-- name: FindProductsByBarcodes :many
+-- products issued, returned or moved out of PVZ and
+-- products of other PVZs may be legitimately received again
+SELECT p.id, p.date_time, p.type, p.reception_id, p.attributes, p.barcode, p.order_id, p.seq, p.state, p.pickup_code_hash, p.size_class, p.cell_id, p.condition, p.notes FROM products p
+JOIN receptions r ON r.id = product_reception_id(p.id, p.reception_id)
+WHERE p.barcode = ANY($1::varchar[])
+    AND (p.reception_id = $2
+        OR (r.pvz_id = $3 AND p.state = 'stored' AND r.status != 'cancelled'
+            AND $4::timestamptz IS NOT NULL AND p.date_time >= $4::timestamptz))
+ORDER BY p.date_time DESC, p.seq DESC
+`
+
+type FindProductsByBarcodesParams struct {
+	Barcodes    []string
+	ReceptionID uuid.UUID
+	PvzID       uuid.UUID
+	Since       sql.NullTime
+}
+
+func (q *Queries) FindProductsByBarcodes(ctx context.Context, arg FindProductsByBarcodesParams) ([]Product, error) {
+	rows, err := q.db.QueryContext(ctx, findProductsByBarcodes,
+		pq.Array(arg.Barcodes),
+		arg.ReceptionID,
+		arg.PvzID,
+		arg.Since,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Product{}
+	for rows.Next() {
+		var i Product
+		if err := rows.Scan(
+			&i.ID,
+			&i.DateTime,
+			&i.Type,
+			&i.ReceptionID,
+			&i.Attributes,
+			&i.Barcode,
+			&i.OrderID,
+			&i.Seq,
+			&i.State,
+			&i.PickupCodeHash,
+			&i.SizeClass,
+			&i.CellID,
+			&i.Condition,
+			&i.Notes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
 const finishReception = `-- name: FinishReception :one
 UPDATE receptions
 SET status='close'
 WHERE id=(SELECT id FROM receptions R WHERE R.pvz_id=$1 AND R.status='in_progress' LIMIT 1)
-RETURNING id, date_time, pvz_id, status
+RETURNING id, date_time, pvz_id, status, opened_at
 `
 
 func (q *Queries) FinishReception(ctx context.Context, pvzID uuid.UUID) (Reception, error) {
@@ -87,17 +393,80 @@ func (q *Queries) FinishReception(ctx context.Context, pvzID uuid.UUID) (Recepti
 		&i.DateTime,
 		&i.PvzID,
 		&i.Status,
+		&i.OpenedAt,
 	)
 	return i, err
 }
 
-const getLastProductInReception = `-- name: GetLastProductInReception :one
-SELECT id, date_time, type, reception_id FROM products
-WHERE reception_id = $1
+const finishReceptionWithReconciliation = `-- name: FinishReceptionWithReconciliation :one
+WITH r AS (
+    UPDATE receptions
+    SET status = 'close'
+    WHERE id = $1 AND status = 'in_progress'
+    RETURNING id, date_time, pvz_id, status, opened_at
+), reconciliation AS (
+    INSERT INTO reception_reconciliations (reception_id, discrepancies, overridden)
+    SELECT r.id, $2::text::jsonb, $3 FROM r
+    ON CONFLICT (reception_id) DO UPDATE
+    SET discrepancies = EXCLUDED.discrepancies,
+        overridden = EXCLUDED.overridden,
+        created_at = NOW()
+)
+SELECT id, date_time, pvz_id, status, opened_at FROM r
+`
+
+type FinishReceptionWithReconciliationParams struct {
+	ID            uuid.UUID
+	Discrepancies string
+	Overridden    bool
+}
+
+func (q *Queries) FinishReceptionWithReconciliation(ctx context.Context, arg FinishReceptionWithReconciliationParams) (Reception, error) {
+	row := q.db.QueryRowContext(ctx, finishReceptionWithReconciliation, arg.ID, arg.Discrepancies, arg.Overridden)
+	var i Reception
+	err := row.Scan(
+		&i.ID,
+		&i.DateTime,
+		&i.PvzID,
+		&i.Status,
+		&i.OpenedAt,
+	)
+	return i, err
+}
+
+const getLastClosedReceptionByPvzID = `-- name: GetLastClosedReceptionByPvzID :one
+SELECT id, date_time, pvz_id, status, opened_at FROM receptions
+WHERE pvz_id = $1 AND status = 'close'
 ORDER BY date_time DESC
 LIMIT 1
 `
 
+func (q *Queries) GetLastClosedReceptionByPvzID(ctx context.Context, pvzID uuid.UUID) (Reception, error) {
+	row := q.db.QueryRowContext(ctx, getLastClosedReceptionByPvzID, pvzID)
+	var i Reception
+	err := row.Scan(
+		&i.ID,
+		&i.DateTime,
+		&i.PvzID,
+		&i.Status,
+		&i.OpenedAt,
+	)
+	return i, err
+}
+
+const getLastProductInReception = `-- name: GetLastProductInReception :one
+-- only products accepted by intake since reception was
+-- opened are deleted by LIFO, not issued ones or ones
+-- added before reopen
+SELECT p.id, p.date_time, p.type, p.reception_id, p.attributes, p.barcode, p.order_id, p.seq, p.state, p.pickup_code_hash, p.size_class, p.cell_id, p.condition, p.notes FROM products p
+JOIN receptions r ON r.id = p.reception_id
+WHERE p.reception_id = $1
+    AND p.state = 'stored'
+    AND p.date_time >= r.opened_at
+ORDER BY p.date_time DESC, p.seq DESC
+LIMIT 1
+`
+
 func (q *Queries) GetLastProductInReception(ctx context.Context, receptionID uuid.UUID) (Product, error) {
 	row := q.db.QueryRowContext(ctx, getLastProductInReception, receptionID)
 	var i Product
@@ -106,12 +475,22 @@ func (q *Queries) GetLastProductInReception(ctx context.Context, receptionID uui
 		&i.DateTime,
 		&i.Type,
 		&i.ReceptionID,
+		&i.Attributes,
+		&i.Barcode,
+		&i.OrderID,
+		&i.Seq,
+		&i.State,
+		&i.PickupCodeHash,
+		&i.SizeClass,
+		&i.CellID,
+		&i.Condition,
+		&i.Notes,
 	)
 	return i, err
 }
 
 const getOpenReceptionByPvzID = `-- name: GetOpenReceptionByPvzID :one
-SELECT id, date_time, pvz_id, status FROM receptions
+SELECT id, date_time, pvz_id, status, opened_at FROM receptions
 WHERE pvz_id = $1 AND status = 'in_progress'
 LIMIT 1
 `
@@ -124,13 +503,69 @@ func (q *Queries) GetOpenReceptionByPvzID(ctx context.Context, pvzID uuid.UUID)
 		&i.DateTime,
 		&i.PvzID,
 		&i.Status,
+		&i.OpenedAt,
+	)
+	return i, err
+}
+
+const getProductInReceptionByBarcode = `-- name: GetProductInReceptionByBarcode :one
+SELECT id, date_time, type, reception_id, attributes, barcode, order_id, seq, state, pickup_code_hash, size_class, cell_id, condition, notes FROM products
+WHERE reception_id = $1 AND barcode = $2
+`
+
+type GetProductInReceptionByBarcodeParams struct {
+	ReceptionID uuid.UUID
+	Barcode     sql.NullString
+}
+
+func (q *Queries) GetProductInReceptionByBarcode(ctx context.Context, arg GetProductInReceptionByBarcodeParams) (Product, error) {
+	row := q.db.QueryRowContext(ctx, getProductInReceptionByBarcode, arg.ReceptionID, arg.Barcode)
+	var i Product
+	err := row.Scan(
+		&i.ID,
+		&i.DateTime,
+		&i.Type,
+		&i.ReceptionID,
+		&i.Attributes,
+		&i.Barcode,
+		&i.OrderID,
+		&i.Seq,
+		&i.State,
+		&i.PickupCodeHash,
+		&i.SizeClass,
+		&i.CellID,
+		&i.Condition,
+		&i.Notes,
+	)
+	return i, err
+}
+
+const getProductReception = `-- name: GetProductReception :one
+-- reception holding product now
+SELECT r.id, r.date_time, r.pvz_id, r.status, r.opened_at FROM receptions r
+JOIN products p ON r.id = product_reception_id(p.id, p.reception_id)
+WHERE p.id = $1
+`
+
+func (q *Queries) GetProductReception(ctx context.Context, id uuid.UUID) (Reception, error) {
+	row := q.db.QueryRowContext(ctx, getProductReception, id)
+	var i Reception
+	err := row.Scan(
+		&i.ID,
+		&i.DateTime,
+		&i.PvzID,
+		&i.Status,
+		&i.OpenedAt,
 	)
 	return i, err
 }
 
 const getProductsFromReception = `-- name: GetProductsFromReception :many
-SELECT id, date_time, type, reception_id FROM products
-WHERE reception_id IN ($1)
+-- products accepted by reception or received into it by transfer
+SELECT p.id, p.date_time, p.type, p.reception_id, p.attributes, p.barcode, p.order_id, p.seq, p.state, p.pickup_code_hash, p.size_class, p.cell_id, p.condition, p.notes FROM products p
+JOIN reception_products rp ON rp.product_id = p.id
+WHERE rp.reception_id IN ($1)
+ORDER BY p.date_time, p.seq
 `
 
 func (q *Queries) GetProductsFromReception(ctx context.Context, receptionID uuid.UUID) ([]Product, error) {
@@ -147,6 +582,310 @@ func (q *Queries) GetProductsFromReception(ctx context.Context, receptionID uuid
 			&i.DateTime,
 			&i.Type,
 			&i.ReceptionID,
+			&i.Attributes,
+			&i.Barcode,
+			&i.OrderID,
+			&i.Seq,
+			&i.State,
+			&i.PickupCodeHash,
+			&i.SizeClass,
+			&i.CellID,
+			&i.Condition,
+			&i.Notes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getProductsFromReceptionLIFO = `-- name: GetProductsFromReceptionLIFO :many
+SELECT p.id, p.date_time, p.type, p.reception_id, p.attributes, p.barcode, p.order_id, p.seq, p.state, p.pickup_code_hash, p.size_class, p.cell_id, p.condition, p.notes FROM products p
+JOIN reception_products rp ON rp.product_id = p.id
+WHERE rp.reception_id = $1
+ORDER BY p.date_time DESC, p.seq DESC
+`
+
+func (q *Queries) GetProductsFromReceptionLIFO(ctx context.Context, receptionID uuid.UUID) ([]Product, error) {
+	rows, err := q.db.QueryContext(ctx, getProductsFromReceptionLIFO, receptionID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Product{}
+	for rows.Next() {
+		var i Product
+		if err := rows.Scan(
+			&i.ID,
+			&i.DateTime,
+			&i.Type,
+			&i.ReceptionID,
+			&i.Attributes,
+			&i.Barcode,
+			&i.OrderID,
+			&i.Seq,
+			&i.State,
+			&i.PickupCodeHash,
+			&i.SizeClass,
+			&i.CellID,
+			&i.Condition,
+			&i.Notes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getPvzStatsSince = `-- name: GetPvzStatsSince :one
+SELECT
+    (SELECT COUNT(*) FROM receptions r
+        WHERE r.pvz_id = $1 AND r.date_time >= $2 AND r.status != 'cancelled') AS receptions_count,
+    (SELECT COUNT(*) FROM products p
+        JOIN receptions r ON r.id = p.reception_id
+        WHERE r.pvz_id = $1 AND p.date_time >= $2 AND r.status != 'cancelled') AS products_count
+`
+
+type GetPvzStatsSinceParams struct {
+	PvzID uuid.UUID
+	Since time.Time
+}
+
+type GetPvzStatsSinceRow struct {
+	ReceptionsCount int64
+	ProductsCount   int64
+}
+
+func (q *Queries) GetPvzStatsSince(ctx context.Context, arg GetPvzStatsSinceParams) (GetPvzStatsSinceRow, error) {
+	row := q.db.QueryRowContext(ctx, getPvzStatsSince, arg.PvzID, arg.Since)
+	var i GetPvzStatsSinceRow
+	err := row.Scan(&i.ReceptionsCount, &i.ProductsCount)
+	return i, err
+}
+
+const getReceptionByID = `-- name: GetReceptionByID :one
+SELECT id, date_time, pvz_id, status, opened_at FROM receptions
+WHERE id = $1
+`
+
+func (q *Queries) GetReceptionByID(ctx context.Context, id uuid.UUID) (Reception, error) {
+	row := q.db.QueryRowContext(ctx, getReceptionByID, id)
+	var i Reception
+	err := row.Scan(
+		&i.ID,
+		&i.DateTime,
+		&i.PvzID,
+		&i.Status,
+		&i.OpenedAt,
+	)
+	return i, err
+}
+
+const getReceptionManifest = `-- name: GetReceptionManifest :one
+SELECT reception_id, barcodes, type_counts, created_at FROM reception_manifests
+WHERE reception_id = $1
+`
+
+func (q *Queries) GetReceptionManifest(ctx context.Context, receptionID uuid.UUID) (ReceptionManifest, error) {
+	row := q.db.QueryRowContext(ctx, getReceptionManifest, receptionID)
+	var i ReceptionManifest
+	err := row.Scan(
+		&i.ReceptionID,
+		pq.Array(&i.Barcodes),
+		&i.TypeCounts,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getReceptionReconciliation = `-- name: GetReceptionReconciliation :one
+SELECT reception_id, discrepancies, overridden, created_at FROM reception_reconciliations
+WHERE reception_id = $1
+`
+
+func (q *Queries) GetReceptionReconciliation(ctx context.Context, receptionID uuid.UUID) (ReceptionReconciliation, error) {
+	row := q.db.QueryRowContext(ctx, getReceptionReconciliation, receptionID)
+	var i ReceptionReconciliation
+	err := row.Scan(
+		&i.ReceptionID,
+		&i.Discrepancies,
+		&i.Overridden,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listOpenReceptionsBefore = `-- name: ListOpenReceptionsBefore :many
+SELECT r.id, r.date_time, r.pvz_id, r.status, r.opened_at, p.city, a.alerted_at FROM receptions r
+JOIN pvz p ON p.id = r.pvz_id
+LEFT JOIN reception_stale_alerts a ON a.reception_id = r.id
+WHERE r.status = 'in_progress' AND r.opened_at < $1
+ORDER BY r.opened_at
+`
+
+type ListOpenReceptionsBeforeRow struct {
+	ID        uuid.UUID
+	DateTime  time.Time
+	PvzID     uuid.UUID
+	Status    entity.Status
+	OpenedAt  time.Time
+	City      entity.City
+	AlertedAt sql.NullTime
+}
+
+func (q *Queries) ListOpenReceptionsBefore(ctx context.Context, before time.Time) ([]ListOpenReceptionsBeforeRow, error) {
+	rows, err := q.db.QueryContext(ctx, listOpenReceptionsBefore, before)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListOpenReceptionsBeforeRow{}
+	for rows.Next() {
+		var i ListOpenReceptionsBeforeRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.DateTime,
+			&i.PvzID,
+			&i.Status,
+			&i.OpenedAt,
+			&i.City,
+			&i.AlertedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listPvzReceptions = `-- name: ListPvzReceptions :many
+SELECT id, date_time, pvz_id, status, opened_at FROM receptions
+WHERE pvz_id = $1
+    AND ($2::status_enum IS NULL OR status = $2::status_enum)
+    AND ($3::timestamptz IS NULL OR date_time >= $3::timestamptz)
+    AND ($4::timestamptz IS NULL OR date_time < $4::timestamptz)
+    AND ($5::timestamptz IS NULL
+        OR (date_time, id) < ($5::timestamptz, $6::uuid))
+ORDER BY date_time DESC, id DESC
+LIMIT $7
+`
+
+type ListPvzReceptionsParams struct {
+	PvzID          uuid.UUID
+	Status         sql.NullString
+	From           sql.NullTime
+	To             sql.NullTime
+	BeforeDateTime sql.NullTime
+	BeforeID       uuid.NullUUID
+	Limit          int32
+}
+
+func (q *Queries) ListPvzReceptions(ctx context.Context, arg ListPvzReceptionsParams) ([]Reception, error) {
+	rows, err := q.db.QueryContext(ctx, listPvzReceptions,
+		arg.PvzID,
+		arg.Status,
+		arg.From,
+		arg.To,
+		arg.BeforeDateTime,
+		arg.BeforeID,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Reception{}
+	for rows.Next() {
+		var i Reception
+		if err := rows.Scan(
+			&i.ID,
+			&i.DateTime,
+			&i.PvzID,
+			&i.Status,
+			&i.OpenedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markReceptionStaleAlerted = `-- name: MarkReceptionStaleAlerted :exec
+INSERT INTO reception_stale_alerts (reception_id) VALUES ($1)
+ON CONFLICT (reception_id) DO UPDATE SET alerted_at = NOW()
+`
+
+func (q *Queries) MarkReceptionStaleAlerted(ctx context.Context, receptionID uuid.UUID) error {
+	_, err := q.db.ExecContext(ctx, markReceptionStaleAlerted, receptionID)
+	return err
+}
+
+const searchProductsByBarcode = `-- name: SearchProductsByBarcode :many
+SELECT p.id, p.date_time, p.type, p.reception_id, p.attributes, p.barcode, p.order_id, p.seq, p.state, p.pickup_code_hash, p.size_class, p.cell_id, p.condition, p.notes FROM products p
+JOIN receptions r ON r.id = product_reception_id(p.id, p.reception_id)
+WHERE p.barcode = $1
+    AND ($2::uuid[] IS NULL OR r.pvz_id = ANY($2::uuid[]))
+    AND ($3::timestamptz IS NULL OR p.date_time >= $3::timestamptz)
+ORDER BY p.date_time DESC
+`
+
+type SearchProductsByBarcodeParams struct {
+	Barcode sql.NullString
+	PvzIds  []uuid.UUID
+	Since   sql.NullTime
+}
+
+func (q *Queries) SearchProductsByBarcode(ctx context.Context, arg SearchProductsByBarcodeParams) ([]Product, error) {
+	rows, err := q.db.QueryContext(ctx, searchProductsByBarcode, arg.Barcode, pq.Array(arg.PvzIds), arg.Since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Product{}
+	for rows.Next() {
+		var i Product
+		if err := rows.Scan(
+			&i.ID,
+			&i.DateTime,
+			&i.Type,
+			&i.ReceptionID,
+			&i.Attributes,
+			&i.Barcode,
+			&i.OrderID,
+			&i.Seq,
+			&i.State,
+			&i.PickupCodeHash,
+			&i.SizeClass,
+			&i.CellID,
+			&i.Condition,
+			&i.Notes,
 		); err != nil {
 			return nil, err
 		}
@@ -162,8 +901,9 @@ func (q *Queries) GetProductsFromReception(ctx context.Context, receptionID uuid
 }
 
 const searchReceptionsByPvzsAndTime = `-- name: SearchReceptionsByPvzsAndTime :many
-SELECT id, date_time, pvz_id, status FROM receptions
+SELECT id, date_time, pvz_id, status, opened_at FROM receptions
 WHERE pvz_id = ANY($1::uuid[]) AND date_time BETWEEN $2 AND $3
+    AND status != 'cancelled'
 `
 
 type SearchReceptionsByPvzsAndTimeParams struct {
@@ -186,6 +926,7 @@ func (q *Queries) SearchReceptionsByPvzsAndTime(ctx context.Context, arg SearchR
 			&i.DateTime,
 			&i.PvzID,
 			&i.Status,
+			&i.OpenedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -201,7 +942,7 @@ func (q *Queries) SearchReceptionsByPvzsAndTime(ctx context.Context, arg SearchR
 }
 
 const searchReceptionsByTime = `-- name: SearchReceptionsByTime :many
-SELECT id, date_time, pvz_id, status FROM receptions
+SELECT id, date_time, pvz_id, status, opened_at FROM receptions
 WHERE date_time BETWEEN $1 AND $2
 `
 
@@ -224,6 +965,7 @@ func (q *Queries) SearchReceptionsByTime(ctx context.Context, arg SearchReceptio
 			&i.DateTime,
 			&i.PvzID,
 			&i.Status,
+			&i.OpenedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -237,3 +979,61 @@ func (q *Queries) SearchReceptionsByTime(ctx context.Context, arg SearchReceptio
 	}
 	return items, nil
 }
+
+const updateReceptionStatus = `-- name: UpdateReceptionStatus :one
+WITH old AS (
+    SELECT id, status FROM receptions
+    WHERE id = $1
+    FOR UPDATE
+), upd AS (
+    UPDATE receptions
+    SET status = $2,
+        opened_at = CASE WHEN $2 = 'in_progress' THEN NOW() ELSE receptions.opened_at END
+    FROM old
+    WHERE receptions.id = old.id AND old.status = $3
+    RETURNING receptions.id, receptions.date_time, receptions.pvz_id, receptions.status, receptions.opened_at, old.status AS old_status
+), history AS (
+    INSERT INTO reception_status_history (reception_id, from_status, to_status, changed_by, reason)
+    SELECT upd.id, upd.old_status, upd.status, $4::uuid, $5::varchar FROM upd
+), stale AS (
+    -- reopened reception will be reconciled again on close
+    DELETE FROM reception_reconciliations
+    WHERE reception_id IN (SELECT upd.id FROM upd WHERE upd.status = 'in_progress')
+)
+SELECT id, date_time, pvz_id, status, opened_at FROM upd
+`
+
+type UpdateReceptionStatusParams struct {
+	ID         uuid.UUID
+	Status     entity.Status
+	FromStatus entity.Status
+	ChangedBy  uuid.NullUUID
+	Reason     string
+}
+
+type UpdateReceptionStatusRow struct {
+	ID       uuid.UUID
+	DateTime time.Time
+	PvzID    uuid.UUID
+	Status   entity.Status
+	OpenedAt time.Time
+}
+
+func (q *Queries) UpdateReceptionStatus(ctx context.Context, arg UpdateReceptionStatusParams) (UpdateReceptionStatusRow, error) {
+	row := q.db.QueryRowContext(ctx, updateReceptionStatus,
+		arg.ID,
+		arg.Status,
+		arg.FromStatus,
+		arg.ChangedBy,
+		arg.Reason,
+	)
+	var i UpdateReceptionStatusRow
+	err := row.Scan(
+		&i.ID,
+		&i.DateTime,
+		&i.PvzID,
+		&i.Status,
+		&i.OpenedAt,
+	)
+	return i, err
+}
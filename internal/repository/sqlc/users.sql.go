@@ -7,15 +7,17 @@ package db
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	entity "github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
 )
 
 const createUser = `-- name: CreateUser :one
 INSERT INTO users (id, email, password, role) VALUES
 ($1, $2, $3, $4)
-RETURNING id, email, password, role
+RETURNING id, email, password, role, active, created_at, token_version, mfa_secret, mfa_enabled, mfa_last_step
 `
 
 type CreateUserParams struct {
@@ -38,12 +40,18 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.Email,
 		&i.Password,
 		&i.Role,
+		&i.Active,
+		&i.CreatedAt,
+		&i.TokenVersion,
+		&i.MfaSecret,
+		&i.MfaEnabled,
+		&i.MfaLastStep,
 	)
 	return i, err
 }
 
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT id, email, password, role FROM users
+SELECT id, email, password, role, active, created_at, token_version, mfa_secret, mfa_enabled, mfa_last_step FROM users
 WHERE email = $1
 LIMIT 1
 `
@@ -56,6 +64,216 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (User, error
 		&i.Email,
 		&i.Password,
 		&i.Role,
+		&i.Active,
+		&i.CreatedAt,
+		&i.TokenVersion,
+		&i.MfaSecret,
+		&i.MfaEnabled,
+		&i.MfaLastStep,
+	)
+	return i, err
+}
+
+const getUserByID = `-- name: GetUserByID :one
+SELECT id, email, password, role, active, created_at, token_version, mfa_secret, mfa_enabled, mfa_last_step FROM users
+WHERE id = $1
+LIMIT 1
+`
+
+func (q *Queries) GetUserByID(ctx context.Context, id uuid.UUID) (User, error) {
+	row := q.db.QueryRowContext(ctx, getUserByID, id)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Password,
+		&i.Role,
+		&i.Active,
+		&i.CreatedAt,
+		&i.TokenVersion,
+		&i.MfaSecret,
+		&i.MfaEnabled,
+		&i.MfaLastStep,
+	)
+	return i, err
+}
+
+const getUserTokenVersion = `-- name: GetUserTokenVersion :one
+SELECT token_version FROM users
+WHERE id = $1 AND active
+LIMIT 1
+`
+
+func (q *Queries) GetUserTokenVersion(ctx context.Context, id uuid.UUID) (int32, error) {
+	row := q.db.QueryRowContext(ctx, getUserTokenVersion, id)
+	var token_version int32
+	err := row.Scan(&token_version)
+	return token_version, err
+}
+
+const listUserPvzIDs = `-- name: ListUserPvzIDs :many
+SELECT pvz_id FROM user_pvz
+WHERE user_id = $1
+ORDER BY pvz_id
+`
+
+func (q *Queries) ListUserPvzIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	rows, err := q.db.QueryContext(ctx, listUserPvzIDs, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []uuid.UUID{}
+	for rows.Next() {
+		var pvz_id uuid.UUID
+		if err := rows.Scan(&pvz_id); err != nil {
+			return nil, err
+		}
+		items = append(items, pvz_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUsers = `-- name: ListUsers :many
+SELECT id, email, password, role, active, created_at, token_version, mfa_secret, mfa_enabled, mfa_last_step FROM users
+WHERE ($1::varchar IS NULL OR role = $1::varchar)
+    AND ($2::boolean IS NULL OR active = $2::boolean)
+    AND ($3::varchar IS NULL OR email ILIKE '%' || $3::varchar || '%')
+ORDER BY created_at DESC, id
+OFFSET $4 LIMIT $5
+`
+
+type ListUsersParams struct {
+	Role   sql.NullString
+	Active sql.NullBool
+	Email  sql.NullString
+	Offset int32
+	Limit  int32
+}
+
+func (q *Queries) ListUsers(ctx context.Context, arg ListUsersParams) ([]User, error) {
+	rows, err := q.db.QueryContext(ctx, listUsers,
+		arg.Role,
+		arg.Active,
+		arg.Email,
+		arg.Offset,
+		arg.Limit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []User{}
+	for rows.Next() {
+		var i User
+		if err := rows.Scan(
+			&i.ID,
+			&i.Email,
+			&i.Password,
+			&i.Role,
+			&i.Active,
+			&i.CreatedAt,
+			&i.TokenVersion,
+			&i.MfaSecret,
+			&i.MfaEnabled,
+			&i.MfaLastStep,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const updateUser = `-- name: UpdateUser :one
+-- pvz_ids replaces PVZs user is assigned to, NULL keeps them
+WITH unassigned AS (
+    DELETE FROM user_pvz
+    WHERE user_id = $1 AND $2::uuid[] IS NOT NULL
+        AND NOT (pvz_id = ANY($2::uuid[]))
+), assigned AS (
+    INSERT INTO user_pvz (user_id, pvz_id)
+    SELECT u.id, unnest($2::uuid[]) FROM users u
+    WHERE u.id = $1
+    ON CONFLICT DO NOTHING
+)
+UPDATE users
+SET role = COALESCE($3::varchar, role),
+    active = COALESCE($4::boolean, active),
+    token_version = token_version + 1
+WHERE id = $1
+RETURNING id, email, password, role, active, created_at, token_version, mfa_secret, mfa_enabled, mfa_last_step
+`
+
+type UpdateUserParams struct {
+	ID     uuid.UUID
+	PvzIds []uuid.UUID
+	Role   sql.NullString
+	Active sql.NullBool
+}
+
+func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, updateUser,
+		arg.ID,
+		pq.Array(arg.PvzIds),
+		arg.Role,
+		arg.Active,
+	)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Password,
+		&i.Role,
+		&i.Active,
+		&i.CreatedAt,
+		&i.TokenVersion,
+		&i.MfaSecret,
+		&i.MfaEnabled,
+		&i.MfaLastStep,
+	)
+	return i, err
+}
+
+const updateUserPassword = `-- name: UpdateUserPassword :one
+UPDATE users
+SET password = $2,
+    token_version = token_version + 1
+WHERE id = $1
+RETURNING id, email, password, role, active, created_at, token_version, mfa_secret, mfa_enabled, mfa_last_step
+`
+
+type UpdateUserPasswordParams struct {
+	ID       uuid.UUID
+	Password string
+}
+
+func (q *Queries) UpdateUserPassword(ctx context.Context, arg UpdateUserPasswordParams) (User, error) {
+	row := q.db.QueryRowContext(ctx, updateUserPassword, arg.ID, arg.Password)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.Email,
+		&i.Password,
+		&i.Role,
+		&i.Active,
+		&i.CreatedAt,
+		&i.TokenVersion,
+		&i.MfaSecret,
+		&i.MfaEnabled,
+		&i.MfaLastStep,
 	)
 	return i, err
 }
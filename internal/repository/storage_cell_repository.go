@@ -0,0 +1,161 @@
+//go:generate mockgen -source=./storage_cell_repository.go -destination=mocks/storage_cell_repository.go -package=mocks
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+// storageCellCapacityConstraint guards cell occupancy,
+// placement into full cell violates it.
+const storageCellCapacityConstraint = "storage_cells_capacity_check"
+
+var (
+	ErrStorageCellNotFound      = errors.New("storage cell not found")
+	ErrStorageCellAlreadyExists = errors.New("storage cell already exists")
+	ErrStorageCellFull          = errors.New("storage cell is full")
+	ErrNoFreeStorageCell        = errors.New("no free storage cell")
+	ErrProductNotMovable        = errors.New("product can't be moved to storage cell")
+)
+
+type StorageCellQueries interface {
+	CreateStorageCell(ctx context.Context, arg db.CreateStorageCellParams) (db.StorageCell, error)
+	GetStorageCellByID(ctx context.Context, id uuid.UUID) (db.StorageCell, error)
+	ListPvzStorageCells(ctx context.Context, pvzID uuid.UUID) ([]db.StorageCell, error)
+	AssignFreeStorageCell(ctx context.Context, arg db.AssignFreeStorageCellParams) (db.StorageCell, error)
+	MoveProductToCell(ctx context.Context, arg db.MoveProductToCellParams) (db.Product, error)
+}
+
+type StorageCellRepository struct {
+	queries StorageCellQueries
+}
+
+func NewStorageCellRepository(q StorageCellQueries) *StorageCellRepository {
+	return &StorageCellRepository{q}
+}
+
+func (r *StorageCellRepository) CreateStorageCell(ctx context.Context, pvzID uuid.UUID, req *request.CreateStorageCell) (*entity.StorageCell, error) {
+	arg := db.CreateStorageCellParams{
+		ID:        uuid.New(),
+		PvzID:     pvzID,
+		Zone:      req.Zone,
+		Rack:      int32(req.Rack),
+		Shelf:     int32(req.Shelf),
+		SizeClass: entity.SizeClass(req.SizeClass),
+		Capacity:  int32(req.Capacity),
+	}
+
+	res, err := r.queries.CreateStorageCell(ctx, arg)
+	if err != nil {
+		switch {
+		case isUniqueViolation(err):
+			return nil, ErrStorageCellAlreadyExists
+		default:
+			return nil, err
+		}
+	}
+
+	return toEntityStorageCell(res), nil
+}
+
+func (r *StorageCellRepository) GetStorageCell(ctx context.Context, id uuid.UUID) (*entity.StorageCell, error) {
+	res, err := r.queries.GetStorageCellByID(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrStorageCellNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	return toEntityStorageCell(res), nil
+}
+
+// ListPvzStorageCells returns cells of PVZ with
+// their occupancy, ordered by address.
+func (r *StorageCellRepository) ListPvzStorageCells(ctx context.Context, pvzID uuid.UUID) ([]*entity.StorageCell, error) {
+	res, err := r.queries.ListPvzStorageCells(ctx, pvzID)
+	if err != nil {
+		return nil, err
+	}
+
+	cells := make([]*entity.StorageCell, len(res))
+	for i, c := range res {
+		cells[i] = toEntityStorageCell(c)
+	}
+
+	return cells, nil
+}
+
+// AssignFreeStorageCell places product into free cell of PVZ
+// which fits its size class. Smallest fitting cells are taken
+// first, cells being filled concurrently are skipped.
+func (r *StorageCellRepository) AssignFreeStorageCell(ctx context.Context, pvzID, productID uuid.UUID, sizeClass entity.SizeClass) (*entity.StorageCell, error) {
+	arg := db.AssignFreeStorageCellParams{
+		PvzID:     pvzID,
+		SizeClass: sizeClass,
+		ProductID: productID,
+	}
+
+	res, err := r.queries.AssignFreeStorageCell(ctx, arg)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrNoFreeStorageCell
+		default:
+			return nil, err
+		}
+	}
+
+	return toEntityStorageCell(res), nil
+}
+
+// MoveProductToCell moves product on hand into cell of the
+// same PVZ and records it in product history. Product which
+// was issued meanwhile or doesn't fit into cell isn't moved.
+func (r *StorageCellRepository) MoveProductToCell(ctx context.Context, productID, cellID, actorID uuid.UUID) (*entity.Product, error) {
+	arg := db.MoveProductToCellParams{
+		ID:      productID,
+		CellID:  cellID,
+		ActorID: nullUUID(actorID),
+	}
+
+	res, err := r.queries.MoveProductToCell(ctx, arg)
+	if err != nil {
+		pqErr, ok := err.(*pq.Error)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrProductNotMovable
+		case ok && pqErr.Constraint == storageCellCapacityConstraint:
+			return nil, ErrStorageCellFull
+		default:
+			return nil, err
+		}
+	}
+
+	return toEntityProduct(res), nil
+}
+
+func toEntityStorageCell(c db.StorageCell) *entity.StorageCell {
+	return &entity.StorageCell{
+		ID:        c.ID,
+		PvzID:     c.PvzID,
+		Zone:      c.Zone,
+		Rack:      int(c.Rack),
+		Shelf:     int(c.Shelf),
+		SizeClass: c.SizeClass,
+		Capacity:  int(c.Capacity),
+		Occupied:  int(c.Occupied),
+		CreatedAt: c.CreatedAt,
+	}
+}
@@ -0,0 +1,237 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository/mocks"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+var dbStorageCell = db.StorageCell{
+	ID:        uuid.New(),
+	PvzID:     pvz.ID,
+	Zone:      "A",
+	Rack:      3,
+	Shelf:     2,
+	SizeClass: entity.SizeClassMedium,
+	Capacity:  10,
+	Occupied:  4,
+	CreatedAt: time.Now(),
+}
+
+var entityStorageCell = &entity.StorageCell{
+	ID:        dbStorageCell.ID,
+	PvzID:     pvz.ID,
+	Zone:      "A",
+	Rack:      3,
+	Shelf:     2,
+	SizeClass: entity.SizeClassMedium,
+	Capacity:  10,
+	Occupied:  4,
+	CreatedAt: dbStorageCell.CreatedAt,
+}
+
+func TestCreateStorageCell(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockStorageCellQueries(ctrl)
+
+	repo := repository.NewStorageCellRepository(queries)
+
+	req := &request.CreateStorageCell{Zone: "A", Rack: 3, Shelf: 2, SizeClass: "medium", Capacity: 10}
+	matchArg := func(_ context.Context, arg db.CreateStorageCellParams) {
+		require.NotEqual(t, uuid.Nil, arg.ID)
+		arg.ID = uuid.Nil
+		require.Equal(t, db.CreateStorageCellParams{
+			PvzID:     pvz.ID,
+			Zone:      "A",
+			Rack:      3,
+			Shelf:     2,
+			SizeClass: entity.SizeClassMedium,
+			Capacity:  10,
+		}, arg)
+	}
+
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       *entity.StorageCell
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().CreateStorageCell(gomock.Any(), gomock.Any()).Do(matchArg).Return(dbStorageCell, nil)
+			},
+			expRes: entityStorageCell,
+			expErr: nil,
+		},
+		{
+			name: "already exists",
+			mockBehavior: func() {
+				queries.EXPECT().CreateStorageCell(gomock.Any(), gomock.Any()).Return(db.StorageCell{}, &pq.Error{Code: "23505"})
+			},
+			expRes: nil,
+			expErr: repository.ErrStorageCellAlreadyExists,
+		},
+		{
+			name: "unk err",
+			mockBehavior: func() {
+				queries.EXPECT().CreateStorageCell(gomock.Any(), gomock.Any()).Return(db.StorageCell{}, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := repo.CreateStorageCell(context.Background(), pvz.ID, req)
+			require.Equal(t, tc.expErr, err)
+			require.Equal(t, tc.expRes, res)
+		})
+	}
+}
+
+func TestGetStorageCell(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockStorageCellQueries(ctrl)
+
+	repo := repository.NewStorageCellRepository(queries)
+
+	queries.EXPECT().GetStorageCellByID(gomock.Any(), dbStorageCell.ID).Return(dbStorageCell, nil)
+	res, err := repo.GetStorageCell(context.Background(), dbStorageCell.ID)
+	require.NoError(t, err)
+	require.Equal(t, entityStorageCell, res)
+
+	queries.EXPECT().GetStorageCellByID(gomock.Any(), dbStorageCell.ID).Return(db.StorageCell{}, sql.ErrNoRows)
+	_, err = repo.GetStorageCell(context.Background(), dbStorageCell.ID)
+	require.Equal(t, repository.ErrStorageCellNotFound, err)
+}
+
+func TestListPvzStorageCells(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockStorageCellQueries(ctrl)
+
+	repo := repository.NewStorageCellRepository(queries)
+
+	queries.EXPECT().ListPvzStorageCells(gomock.Any(), pvz.ID).Return([]db.StorageCell{dbStorageCell}, nil)
+	res, err := repo.ListPvzStorageCells(context.Background(), pvz.ID)
+	require.NoError(t, err)
+	require.Equal(t, []*entity.StorageCell{entityStorageCell}, res)
+
+	queries.EXPECT().ListPvzStorageCells(gomock.Any(), pvz.ID).Return(nil, errMock)
+	_, err = repo.ListPvzStorageCells(context.Background(), pvz.ID)
+	require.Equal(t, errMock, err)
+}
+
+func TestAssignFreeStorageCell(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockStorageCellQueries(ctrl)
+
+	repo := repository.NewStorageCellRepository(queries)
+
+	arg := db.AssignFreeStorageCellParams{PvzID: pvz.ID, SizeClass: entity.SizeClassSmall, ProductID: product.ID}
+
+	queries.EXPECT().AssignFreeStorageCell(gomock.Any(), arg).Return(dbStorageCell, nil)
+	res, err := repo.AssignFreeStorageCell(context.Background(), pvz.ID, product.ID, entity.SizeClassSmall)
+	require.NoError(t, err)
+	require.Equal(t, entityStorageCell, res)
+
+	queries.EXPECT().AssignFreeStorageCell(gomock.Any(), arg).Return(db.StorageCell{}, sql.ErrNoRows)
+	_, err = repo.AssignFreeStorageCell(context.Background(), pvz.ID, product.ID, entity.SizeClassSmall)
+	require.Equal(t, repository.ErrNoFreeStorageCell, err)
+}
+
+func TestMoveProductToCell(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockStorageCellQueries(ctrl)
+
+	repo := repository.NewStorageCellRepository(queries)
+
+	actorID := uuid.New()
+	arg := db.MoveProductToCellParams{
+		ID:      product.ID,
+		CellID:  dbStorageCell.ID,
+		ActorID: uuid.NullUUID{UUID: actorID, Valid: true},
+	}
+	moved := dbStoredProduct
+	moved.SizeClass = entity.SizeClassSmall
+	moved.CellID = uuid.NullUUID{UUID: dbStorageCell.ID, Valid: true}
+
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       *entity.Product
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().MoveProductToCell(gomock.Any(), arg).Return(moved, nil)
+			},
+			expRes: &entity.Product{
+				ID:             product.ID,
+				DateTime:       product.DateTime,
+				Type:           product.Type,
+				ReceptionID:    product.ReceptionID,
+				State:          entity.ProductStateStored,
+				PickupCodeHash: "hash",
+				SizeClass:      entity.SizeClassSmall,
+				CellID:         dbStorageCell.ID,
+			},
+			expErr: nil,
+		},
+		{
+			name: "not movable",
+			mockBehavior: func() {
+				queries.EXPECT().MoveProductToCell(gomock.Any(), arg).Return(db.Product{}, sql.ErrNoRows)
+			},
+			expRes: nil,
+			expErr: repository.ErrProductNotMovable,
+		},
+		{
+			name: "cell full",
+			mockBehavior: func() {
+				queries.EXPECT().MoveProductToCell(gomock.Any(), arg).Return(db.Product{}, &pq.Error{Code: "23514", Constraint: "storage_cells_capacity_check"})
+			},
+			expRes: nil,
+			expErr: repository.ErrStorageCellFull,
+		},
+		{
+			name: "unk err",
+			mockBehavior: func() {
+				queries.EXPECT().MoveProductToCell(gomock.Any(), arg).Return(db.Product{}, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := repo.MoveProductToCell(context.Background(), product.ID, dbStorageCell.ID, actorID)
+			require.Equal(t, tc.expErr, err)
+			require.Equal(t, tc.expRes, res)
+		})
+	}
+}
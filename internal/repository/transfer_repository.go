@@ -0,0 +1,162 @@
+//go:generate mockgen -source=./transfer_repository.go -destination=mocks/transfer_repository.go -package=mocks
+
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+var (
+	ErrTransferNotFound            = errors.New("transfer not found")
+	ErrTransferProductsUnavailable = errors.New("transfer products are no longer stored in source pvz")
+	ErrTransferNotInTransit        = errors.New("transfer is not in transit")
+	ErrTransferReceptionNotOpen    = errors.New("destination reception is not in progress")
+)
+
+type TransferQueries interface {
+	CreateTransfer(ctx context.Context, arg db.CreateTransferParams) (db.Transfer, error)
+	GetTransferByID(ctx context.Context, id uuid.UUID) (db.Transfer, error)
+	ListTransferProducts(ctx context.Context, transferID uuid.UUID) ([]db.Product, error)
+	ListTransferCandidates(ctx context.Context, ids []uuid.UUID) ([]db.ListTransferCandidatesRow, error)
+	DispatchTransfer(ctx context.Context, arg db.DispatchTransferParams) (db.Transfer, error)
+	ReceiveTransfer(ctx context.Context, arg db.ReceiveTransferParams) (db.Transfer, error)
+}
+
+type TransferRepository struct {
+	queries TransferQueries
+}
+
+func NewTransferRepository(q TransferQueries) *TransferRepository {
+	return &TransferRepository{q}
+}
+
+func (r *TransferRepository) CreateTransfer(ctx context.Context, fromPvzID, toPvzID uuid.UUID, productIDs []uuid.UUID, createdBy uuid.UUID) (*entity.Transfer, error) {
+	res, err := r.queries.CreateTransfer(ctx, db.CreateTransferParams{
+		ID:         uuid.New(),
+		FromPvzID:  fromPvzID,
+		ToPvzID:    toPvzID,
+		CreatedBy:  nullUUID(createdBy),
+		ProductIds: productIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return r.withProducts(ctx, res)
+}
+
+func (r *TransferRepository) GetTransfer(ctx context.Context, id uuid.UUID) (*entity.Transfer, error) {
+	res, err := r.queries.GetTransferByID(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrTransferNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	return r.withProducts(ctx, res)
+}
+
+// ListTransferCandidates returns state of products, which
+// are going to be transferred. Unknown products are skipped.
+func (r *TransferRepository) ListTransferCandidates(ctx context.Context, productIDs []uuid.UUID) ([]*entity.TransferCandidate, error) {
+	res, err := r.queries.ListTransferCandidates(ctx, productIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates := make([]*entity.TransferCandidate, len(res))
+	for i, c := range res {
+		candidates[i] = &entity.TransferCandidate{
+			ProductID:       c.ID,
+			State:           c.State,
+			PvzID:           c.PvzID,
+			ReceptionStatus: c.ReceptionStatus,
+			InTransfer:      c.InTransfer,
+		}
+	}
+
+	return candidates, nil
+}
+
+// DispatchTransfer sends created transfer on its way. Products
+// leave their cells and dispatch is recorded in their history.
+// If any product was issued, expired or moved meanwhile,
+// transfer is not dispatched.
+func (r *TransferRepository) DispatchTransfer(ctx context.Context, id, actorID uuid.UUID) (*entity.Transfer, error) {
+	res, err := r.queries.DispatchTransfer(ctx, db.DispatchTransferParams{
+		ID:      id,
+		ActorID: nullUUID(actorID),
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrTransferProductsUnavailable
+		default:
+			return nil, err
+		}
+	}
+
+	return r.withProducts(ctx, res)
+}
+
+// ReceiveTransfer stores transfer products in reception of
+// destination PVZ. Reception must be in progress and PVZ
+// must have room for them, as for regular intake.
+func (r *TransferRepository) ReceiveTransfer(ctx context.Context, id, receptionID, actorID uuid.UUID) (*entity.Transfer, error) {
+	res, err := r.queries.ReceiveTransfer(ctx, db.ReceiveTransferParams{
+		ReceptionID: nullUUID(receptionID),
+		ActorID:     nullUUID(actorID),
+		ID:          id,
+	})
+	if err != nil {
+		pqErr, ok := err.(*pq.Error)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrTransferNotInTransit
+		case ok && pqErr.Code == errReceptionInProgressConflictCode:
+			return nil, ErrTransferReceptionNotOpen
+		case ok && pqErr.Code == errPvzCapacityExceededCode:
+			return nil, ErrPvzCapacityExceeded
+		default:
+			return nil, err
+		}
+	}
+
+	return r.withProducts(ctx, res)
+}
+
+func (r *TransferRepository) withProducts(ctx context.Context, t db.Transfer) (*entity.Transfer, error) {
+	products, err := r.queries.ListTransferProducts(ctx, t.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer := &entity.Transfer{
+		ID:           t.ID,
+		FromPvzID:    t.FromPvzID,
+		ToPvzID:      t.ToPvzID,
+		Status:       t.Status,
+		ReceptionID:  t.ReceptionID.UUID,
+		CreatedBy:    t.CreatedBy.UUID,
+		CreatedAt:    t.CreatedAt,
+		DispatchedAt: t.DispatchedAt.Time,
+		ReceivedAt:   t.ReceivedAt.Time,
+		Products:     make([]*entity.Product, len(products)),
+	}
+	for i, p := range products {
+		transfer.Products[i] = toEntityProduct(p)
+	}
+
+	return transfer, nil
+}
@@ -0,0 +1,317 @@
+package repository_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository/mocks"
+	db "github.com/myacey/avito-backend-assignment-pvz/internal/repository/sqlc"
+)
+
+var dbTransfer = db.Transfer{
+	ID:        uuid.New(),
+	FromPvzID: pvz.ID,
+	ToPvzID:   pvz1.ID,
+	Status:    entity.TransferStatusCreated,
+	CreatedBy: uuid.NullUUID{UUID: uuid.New(), Valid: true},
+	CreatedAt: time.Now(),
+}
+
+var entityTransfer = &entity.Transfer{
+	ID:        dbTransfer.ID,
+	FromPvzID: pvz.ID,
+	ToPvzID:   pvz1.ID,
+	Status:    entity.TransferStatusCreated,
+	CreatedBy: dbTransfer.CreatedBy.UUID,
+	CreatedAt: dbTransfer.CreatedAt,
+	Products:  []*entity.Product{entityStoredProduct},
+}
+
+func TestCreateTransfer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockTransferQueries(ctrl)
+
+	repo := repository.NewTransferRepository(queries)
+
+	productIDs := []uuid.UUID{product.ID}
+	matchArg := func(_ context.Context, arg db.CreateTransferParams) {
+		require.NotEqual(t, uuid.Nil, arg.ID)
+		arg.ID = uuid.Nil
+		require.Equal(t, db.CreateTransferParams{
+			FromPvzID:  pvz.ID,
+			ToPvzID:    pvz1.ID,
+			CreatedBy:  dbTransfer.CreatedBy,
+			ProductIds: productIDs,
+		}, arg)
+	}
+
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       *entity.Transfer
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().CreateTransfer(gomock.Any(), gomock.Any()).Do(matchArg).Return(dbTransfer, nil)
+				queries.EXPECT().ListTransferProducts(gomock.Any(), dbTransfer.ID).Return([]db.Product{dbStoredProduct}, nil)
+			},
+			expRes: entityTransfer,
+			expErr: nil,
+		},
+		{
+			name: "unk err",
+			mockBehavior: func() {
+				queries.EXPECT().CreateTransfer(gomock.Any(), gomock.Any()).Return(db.Transfer{}, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := repo.CreateTransfer(context.Background(), pvz.ID, pvz1.ID, productIDs, dbTransfer.CreatedBy.UUID)
+			require.Equal(t, tc.expErr, err)
+			require.Equal(t, tc.expRes, res)
+		})
+	}
+}
+
+func TestGetTransfer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockTransferQueries(ctrl)
+
+	repo := repository.NewTransferRepository(queries)
+
+	queries.EXPECT().GetTransferByID(gomock.Any(), dbTransfer.ID).Return(dbTransfer, nil)
+	queries.EXPECT().ListTransferProducts(gomock.Any(), dbTransfer.ID).Return([]db.Product{dbStoredProduct}, nil)
+	res, err := repo.GetTransfer(context.Background(), dbTransfer.ID)
+	require.NoError(t, err)
+	require.Equal(t, entityTransfer, res)
+
+	queries.EXPECT().GetTransferByID(gomock.Any(), dbTransfer.ID).Return(db.Transfer{}, sql.ErrNoRows)
+	res, err = repo.GetTransfer(context.Background(), dbTransfer.ID)
+	require.Equal(t, repository.ErrTransferNotFound, err)
+	require.Nil(t, res)
+}
+
+func TestListTransferCandidates(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockTransferQueries(ctrl)
+
+	repo := repository.NewTransferRepository(queries)
+
+	productIDs := []uuid.UUID{product.ID}
+	queries.EXPECT().ListTransferCandidates(gomock.Any(), productIDs).Return([]db.ListTransferCandidatesRow{{
+		ID:              product.ID,
+		State:           entity.ProductStateStored,
+		PvzID:           pvz.ID,
+		ReceptionStatus: entity.StatusFinished,
+		InTransfer:      true,
+	}}, nil)
+
+	res, err := repo.ListTransferCandidates(context.Background(), productIDs)
+	require.NoError(t, err)
+	require.Equal(t, []*entity.TransferCandidate{{
+		ProductID:       product.ID,
+		State:           entity.ProductStateStored,
+		PvzID:           pvz.ID,
+		ReceptionStatus: entity.StatusFinished,
+		InTransfer:      true,
+	}}, res)
+}
+
+func TestDispatchTransfer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockTransferQueries(ctrl)
+
+	repo := repository.NewTransferRepository(queries)
+
+	actorID := uuid.New()
+	dispatchedAt := time.Now()
+	dispatched := dbTransfer
+	dispatched.Status = entity.TransferStatusInTransit
+	dispatched.DispatchedBy = uuid.NullUUID{UUID: actorID, Valid: true}
+	dispatched.DispatchedAt = sql.NullTime{Time: dispatchedAt, Valid: true}
+	inTransit := dbStoredProduct
+	inTransit.State = entity.ProductStateInTransit
+	arg := db.DispatchTransferParams{
+		ID:      dbTransfer.ID,
+		ActorID: uuid.NullUUID{UUID: actorID, Valid: true},
+	}
+
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       *entity.Transfer
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().DispatchTransfer(gomock.Any(), arg).Return(dispatched, nil)
+				queries.EXPECT().ListTransferProducts(gomock.Any(), dbTransfer.ID).Return([]db.Product{inTransit}, nil)
+			},
+			expRes: &entity.Transfer{
+				ID:           dbTransfer.ID,
+				FromPvzID:    pvz.ID,
+				ToPvzID:      pvz1.ID,
+				Status:       entity.TransferStatusInTransit,
+				CreatedBy:    dbTransfer.CreatedBy.UUID,
+				CreatedAt:    dbTransfer.CreatedAt,
+				DispatchedAt: dispatchedAt,
+				Products: []*entity.Product{{
+					ID:             product.ID,
+					DateTime:       product.DateTime,
+					Type:           product.Type,
+					ReceptionID:    product.ReceptionID,
+					State:          entity.ProductStateInTransit,
+					PickupCodeHash: "hash",
+				}},
+			},
+			expErr: nil,
+		},
+		{
+			name: "products unavailable",
+			mockBehavior: func() {
+				queries.EXPECT().DispatchTransfer(gomock.Any(), arg).Return(db.Transfer{}, sql.ErrNoRows)
+			},
+			expRes: nil,
+			expErr: repository.ErrTransferProductsUnavailable,
+		},
+		{
+			name: "unk err",
+			mockBehavior: func() {
+				queries.EXPECT().DispatchTransfer(gomock.Any(), arg).Return(db.Transfer{}, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := repo.DispatchTransfer(context.Background(), dbTransfer.ID, actorID)
+			require.Equal(t, tc.expErr, err)
+			require.Equal(t, tc.expRes, res)
+		})
+	}
+}
+
+func TestReceiveTransfer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockTransferQueries(ctrl)
+
+	repo := repository.NewTransferRepository(queries)
+
+	actorID := uuid.New()
+	receivedAt := time.Now()
+	received := dbTransfer
+	received.Status = entity.TransferStatusReceived
+	received.ReceptionID = uuid.NullUUID{UUID: reception11.ID, Valid: true}
+	received.ReceivedBy = uuid.NullUUID{UUID: actorID, Valid: true}
+	received.ReceivedAt = sql.NullTime{Time: receivedAt, Valid: true}
+	stored := dbStoredProduct
+	stored.ReceptionID = reception11.ID
+	arg := db.ReceiveTransferParams{
+		ReceptionID: uuid.NullUUID{UUID: reception11.ID, Valid: true},
+		ActorID:     uuid.NullUUID{UUID: actorID, Valid: true},
+		ID:          dbTransfer.ID,
+	}
+
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       *entity.Transfer
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().ReceiveTransfer(gomock.Any(), arg).Return(received, nil)
+				queries.EXPECT().ListTransferProducts(gomock.Any(), dbTransfer.ID).Return([]db.Product{stored}, nil)
+			},
+			expRes: &entity.Transfer{
+				ID:          dbTransfer.ID,
+				FromPvzID:   pvz.ID,
+				ToPvzID:     pvz1.ID,
+				Status:      entity.TransferStatusReceived,
+				ReceptionID: reception11.ID,
+				CreatedBy:   dbTransfer.CreatedBy.UUID,
+				CreatedAt:   dbTransfer.CreatedAt,
+				ReceivedAt:  receivedAt,
+				Products: []*entity.Product{{
+					ID:             product.ID,
+					DateTime:       product.DateTime,
+					Type:           product.Type,
+					ReceptionID:    reception11.ID,
+					State:          entity.ProductStateStored,
+					PickupCodeHash: "hash",
+				}},
+			},
+			expErr: nil,
+		},
+		{
+			name: "not in transit",
+			mockBehavior: func() {
+				queries.EXPECT().ReceiveTransfer(gomock.Any(), arg).Return(db.Transfer{}, sql.ErrNoRows)
+			},
+			expRes: nil,
+			expErr: repository.ErrTransferNotInTransit,
+		},
+		{
+			name: "reception closed",
+			mockBehavior: func() {
+				queries.EXPECT().ReceiveTransfer(gomock.Any(), arg).Return(db.Transfer{}, &pq.Error{Code: "20001"})
+			},
+			expRes: nil,
+			expErr: repository.ErrTransferReceptionNotOpen,
+		},
+		{
+			name: "pvz at capacity",
+			mockBehavior: func() {
+				queries.EXPECT().ReceiveTransfer(gomock.Any(), arg).Return(db.Transfer{}, &pq.Error{Code: "20009"})
+			},
+			expRes: nil,
+			expErr: repository.ErrPvzCapacityExceeded,
+		},
+		{
+			name: "unk err",
+			mockBehavior: func() {
+				queries.EXPECT().ReceiveTransfer(gomock.Any(), arg).Return(db.Transfer{}, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := repo.ReceiveTransfer(context.Background(), dbTransfer.ID, reception11.ID, actorID)
+			require.Equal(t, tc.expErr, err)
+			require.Equal(t, tc.expRes, res)
+		})
+	}
+}
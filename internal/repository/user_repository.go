@@ -22,6 +22,13 @@ var (
 type UserQueries interface {
 	CreateUser(ctx context.Context, arg db.CreateUserParams) (db.User, error)
 	GetUserByEmail(ctx context.Context, email string) (db.User, error)
+	GetUserByID(ctx context.Context, id uuid.UUID) (db.User, error)
+	ListUsers(ctx context.Context, arg db.ListUsersParams) ([]db.User, error)
+	UpdateUser(ctx context.Context, arg db.UpdateUserParams) (db.User, error)
+	UpdateUserPassword(ctx context.Context, arg db.UpdateUserPasswordParams) (db.User, error)
+	GetUserTokenVersion(ctx context.Context, id uuid.UUID) (int32, error)
+	ListUserPvzIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error)
+	CountPvzByIDs(ctx context.Context, ids []uuid.UUID) (int64, error)
 }
 
 type UserRepository struct {
@@ -50,11 +57,7 @@ func (r *UserRepository) CreateUser(ctx context.Context, req *request.Register)
 		}
 	}
 
-	return &entity.User{
-		ID:    res.ID,
-		Email: res.Email,
-		Role:  res.Role,
-	}, nil
+	return toEntityUser(res), nil
 }
 
 func (r *UserRepository) GetUser(ctx context.Context, req *request.Login) (*entity.User, error) {
@@ -68,10 +71,150 @@ func (r *UserRepository) GetUser(ctx context.Context, req *request.Login) (*enti
 		}
 	}
 
+	usr := toEntityUser(res)
+	usr.Password = res.Password
+
+	return usr, nil
+}
+
+func (r *UserRepository) GetUserByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	res, err := r.queries.GetUserByID(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrUserNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	return toEntityUser(res), nil
+}
+
+func (r *UserRepository) ListUsers(ctx context.Context, req *request.ListUsers) ([]*entity.User, error) {
+	arg := db.ListUsersParams{
+		Offset: (int32(req.Page) - 1) * int32(req.Limit),
+		Limit:  int32(req.Limit),
+	}
+	if req.Role != nil {
+		arg.Role = sql.NullString{String: *req.Role, Valid: true}
+	}
+	if req.Active != nil {
+		arg.Active = sql.NullBool{Bool: *req.Active, Valid: true}
+	}
+	if req.Email != nil {
+		arg.Email = sql.NullString{String: *req.Email, Valid: true}
+	}
+
+	res, err := r.queries.ListUsers(ctx, arg)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return []*entity.User{}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	users := make([]*entity.User, len(res))
+	for i, u := range res {
+		users[i] = toEntityUser(u)
+	}
+
+	return users, nil
+}
+
+// UpdateUser changes user's role, activity and assigned PVZs.
+// All of requested PVZs must exist.
+func (r *UserRepository) UpdateUser(ctx context.Context, id uuid.UUID, req *request.UpdateUser) (*entity.User, error) {
+	arg := db.UpdateUserParams{
+		ID: id,
+	}
+	if req.PvzIDs != nil {
+		arg.PvzIds = *req.PvzIDs
+		if arg.PvzIds == nil {
+			arg.PvzIds = []uuid.UUID{}
+		}
+
+		if len(arg.PvzIds) > 0 {
+			cnt, err := r.queries.CountPvzByIDs(ctx, arg.PvzIds)
+			if err != nil {
+				return nil, err
+			}
+			if cnt != int64(len(arg.PvzIds)) {
+				return nil, ErrPvzNotFound
+			}
+		}
+	}
+	if req.Role != nil {
+		arg.Role = sql.NullString{String: *req.Role, Valid: true}
+	}
+	if req.Active != nil {
+		arg.Active = sql.NullBool{Bool: *req.Active, Valid: true}
+	}
+
+	res, err := r.queries.UpdateUser(ctx, arg)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return nil, ErrUserNotFound
+		default:
+			return nil, err
+		}
+	}
+
+	return toEntityUser(res), nil
+}
+
+func (r *UserRepository) UpdatePassword(ctx context.Context, id uuid.UUID, password string) error {
+	_, err := r.queries.UpdateUserPassword(ctx, db.UpdateUserPasswordParams{
+		ID:       id,
+		Password: password,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return ErrUserNotFound
+		default:
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetTokenVersion returns current token version of active user.
+func (r *UserRepository) GetTokenVersion(ctx context.Context, id uuid.UUID) (int32, error) {
+	res, err := r.queries.GetUserTokenVersion(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			return 0, ErrUserNotFound
+		default:
+			return 0, err
+		}
+	}
+
+	return res, nil
+}
+
+// ListPvzIDs returns PVZs user is assigned to.
+// Empty list means user is not limited to any PVZ.
+func (r *UserRepository) ListPvzIDs(ctx context.Context, userID uuid.UUID) ([]uuid.UUID, error) {
+	return r.queries.ListUserPvzIDs(ctx, userID)
+}
+
+// toEntityUser converts db user to entity
+// without password.
+func toEntityUser(u db.User) *entity.User {
 	return &entity.User{
-		ID:       res.ID,
-		Email:    res.Email,
-		Role:     res.Role,
-		Password: res.Password,
-	}, nil
+		ID:        u.ID,
+		Email:     u.Email,
+		Role:      u.Role,
+		Active:    u.Active,
+		CreatedAt: u.CreatedAt,
+
+		TokenVersion: u.TokenVersion,
+		MFAEnabled:   u.MfaEnabled,
+	}
 }
@@ -159,3 +159,344 @@ func TestGetUser(t *testing.T) {
 		require.Equal(t, tc.expErr, err)
 	}
 }
+
+func TestGetUserByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockUserQueries(ctrl)
+
+	repo := repository.NewUserRepository(queries)
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       *entity.User
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().GetUserByID(gomock.Any(), mockuser.ID).Return(db.User{
+					ID:       mockuser.ID,
+					Email:    mockuser.Email,
+					Password: mockuser.Password,
+					Role:     mockuser.Role,
+				}, nil)
+			},
+			expRes: mockuserWithoutPassword,
+			expErr: nil,
+		},
+		{
+			name: "no user found",
+			mockBehavior: func() {
+				queries.EXPECT().GetUserByID(gomock.Any(), mockuser.ID).Return(db.User{}, sql.ErrNoRows)
+			},
+			expRes: nil,
+			expErr: repository.ErrUserNotFound,
+		},
+		{
+			name: "unk error",
+			mockBehavior: func() {
+				queries.EXPECT().GetUserByID(gomock.Any(), mockuser.ID).Return(db.User{}, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc.mockBehavior()
+
+		res, err := repo.GetUserByID(context.Background(), mockuser.ID)
+
+		require.Equal(t, tc.expRes, res)
+		require.Equal(t, tc.expErr, err)
+	}
+}
+
+func TestListUsers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockUserQueries(ctrl)
+
+	repo := repository.NewUserRepository(queries)
+
+	role := string(entity.RoleEmployee)
+	active := true
+	email := "mock"
+	testCases := []struct {
+		name         string
+		req          *request.ListUsers
+		mockBehavior func()
+		expRes       []*entity.User
+		expErr       error
+	}{
+		{
+			name: "ok",
+			req:  &request.ListUsers{Role: &role, Active: &active, Email: &email, Page: 2, Limit: 10},
+			mockBehavior: func() {
+				queries.EXPECT().ListUsers(gomock.Any(), db.ListUsersParams{
+					Role:   sql.NullString{String: role, Valid: true},
+					Active: sql.NullBool{Bool: active, Valid: true},
+					Email:  sql.NullString{String: email, Valid: true},
+					Offset: 10,
+					Limit:  10,
+				}).Return([]db.User{{
+					ID:       mockuser.ID,
+					Email:    mockuser.Email,
+					Password: mockuser.Password,
+					Role:     mockuser.Role,
+				}}, nil)
+			},
+			expRes: []*entity.User{mockuserWithoutPassword},
+			expErr: nil,
+		},
+		{
+			name: "no rows",
+			req:  &request.ListUsers{Page: 1, Limit: 10},
+			mockBehavior: func() {
+				queries.EXPECT().ListUsers(gomock.Any(), db.ListUsersParams{Offset: 0, Limit: 10}).Return(nil, sql.ErrNoRows)
+			},
+			expRes: []*entity.User{},
+			expErr: nil,
+		},
+		{
+			name: "unk error",
+			req:  &request.ListUsers{Page: 1, Limit: 10},
+			mockBehavior: func() {
+				queries.EXPECT().ListUsers(gomock.Any(), gomock.Any()).Return(nil, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc.mockBehavior()
+
+		res, err := repo.ListUsers(context.Background(), tc.req)
+
+		require.Equal(t, tc.expRes, res)
+		require.Equal(t, tc.expErr, err)
+	}
+}
+
+func TestUpdateUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockUserQueries(ctrl)
+
+	repo := repository.NewUserRepository(queries)
+
+	active := false
+	pvzIDs := []uuid.UUID{uuid.New()}
+	var noPvzIDs []uuid.UUID
+	testCases := []struct {
+		name         string
+		req          *request.UpdateUser
+		mockBehavior func()
+		expRes       *entity.User
+		expErr       error
+	}{
+		{
+			name: "ok",
+			req:  &request.UpdateUser{Active: &active},
+			mockBehavior: func() {
+				queries.EXPECT().UpdateUser(gomock.Any(), db.UpdateUserParams{
+					Active: sql.NullBool{Bool: active, Valid: true},
+					ID:     mockuser.ID,
+				}).Return(db.User{
+					ID:       mockuser.ID,
+					Email:    mockuser.Email,
+					Password: mockuser.Password,
+					Role:     mockuser.Role,
+				}, nil)
+			},
+			expRes: mockuserWithoutPassword,
+			expErr: nil,
+		},
+		{
+			name: "assign pvz",
+			req:  &request.UpdateUser{PvzIDs: &pvzIDs},
+			mockBehavior: func() {
+				queries.EXPECT().CountPvzByIDs(gomock.Any(), pvzIDs).Return(int64(1), nil)
+				queries.EXPECT().UpdateUser(gomock.Any(), db.UpdateUserParams{
+					ID:     mockuser.ID,
+					PvzIds: pvzIDs,
+				}).Return(db.User{
+					ID:       mockuser.ID,
+					Email:    mockuser.Email,
+					Password: mockuser.Password,
+					Role:     mockuser.Role,
+				}, nil)
+			},
+			expRes: mockuserWithoutPassword,
+			expErr: nil,
+		},
+		{
+			name: "unassign all pvz",
+			req:  &request.UpdateUser{PvzIDs: &noPvzIDs},
+			mockBehavior: func() {
+				queries.EXPECT().UpdateUser(gomock.Any(), db.UpdateUserParams{
+					ID:     mockuser.ID,
+					PvzIds: []uuid.UUID{},
+				}).Return(db.User{
+					ID:       mockuser.ID,
+					Email:    mockuser.Email,
+					Password: mockuser.Password,
+					Role:     mockuser.Role,
+				}, nil)
+			},
+			expRes: mockuserWithoutPassword,
+			expErr: nil,
+		},
+		{
+			name: "pvz not found",
+			req:  &request.UpdateUser{PvzIDs: &pvzIDs},
+			mockBehavior: func() {
+				queries.EXPECT().CountPvzByIDs(gomock.Any(), pvzIDs).Return(int64(0), nil)
+			},
+			expRes: nil,
+			expErr: repository.ErrPvzNotFound,
+		},
+		{
+			name: "no user found",
+			req:  &request.UpdateUser{Active: &active},
+			mockBehavior: func() {
+				queries.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Return(db.User{}, sql.ErrNoRows)
+			},
+			expRes: nil,
+			expErr: repository.ErrUserNotFound,
+		},
+		{
+			name: "unk error",
+			req:  &request.UpdateUser{Active: &active},
+			mockBehavior: func() {
+				queries.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).Return(db.User{}, errMock)
+			},
+			expRes: nil,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc.mockBehavior()
+
+		res, err := repo.UpdateUser(context.Background(), mockuser.ID, tc.req)
+
+		require.Equal(t, tc.expRes, res)
+		require.Equal(t, tc.expErr, err)
+	}
+}
+
+func TestUpdatePassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockUserQueries(ctrl)
+
+	repo := repository.NewUserRepository(queries)
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().UpdateUserPassword(gomock.Any(), db.UpdateUserPasswordParams{
+					ID:       mockuser.ID,
+					Password: "new",
+				}).Return(db.User{}, nil)
+			},
+			expErr: nil,
+		},
+		{
+			name: "no user found",
+			mockBehavior: func() {
+				queries.EXPECT().UpdateUserPassword(gomock.Any(), gomock.Any()).Return(db.User{}, sql.ErrNoRows)
+			},
+			expErr: repository.ErrUserNotFound,
+		},
+		{
+			name: "unk error",
+			mockBehavior: func() {
+				queries.EXPECT().UpdateUserPassword(gomock.Any(), gomock.Any()).Return(db.User{}, errMock)
+			},
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc.mockBehavior()
+
+		err := repo.UpdatePassword(context.Background(), mockuser.ID, "new")
+
+		require.Equal(t, tc.expErr, err)
+	}
+}
+
+func TestGetTokenVersion(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockUserQueries(ctrl)
+
+	repo := repository.NewUserRepository(queries)
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       int32
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				queries.EXPECT().GetUserTokenVersion(gomock.Any(), mockuser.ID).Return(int32(3), nil)
+			},
+			expRes: 3,
+			expErr: nil,
+		},
+		{
+			name: "no active user found",
+			mockBehavior: func() {
+				queries.EXPECT().GetUserTokenVersion(gomock.Any(), mockuser.ID).Return(int32(0), sql.ErrNoRows)
+			},
+			expRes: 0,
+			expErr: repository.ErrUserNotFound,
+		},
+		{
+			name: "unk error",
+			mockBehavior: func() {
+				queries.EXPECT().GetUserTokenVersion(gomock.Any(), mockuser.ID).Return(int32(0), errMock)
+			},
+			expRes: 0,
+			expErr: errMock,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc.mockBehavior()
+
+		res, err := repo.GetTokenVersion(context.Background(), mockuser.ID)
+
+		require.Equal(t, tc.expRes, res)
+		require.Equal(t, tc.expErr, err)
+	}
+}
+
+func TestListPvzIDs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	queries := mocks.NewMockUserQueries(ctrl)
+
+	repo := repository.NewUserRepository(queries)
+
+	pvzIDs := []uuid.UUID{uuid.New(), uuid.New()}
+
+	queries.EXPECT().ListUserPvzIDs(gomock.Any(), mockuser.ID).Return(pvzIDs, nil)
+	res, err := repo.ListPvzIDs(context.Background(), mockuser.ID)
+	require.NoError(t, err)
+	require.Equal(t, pvzIDs, res)
+
+	queries.EXPECT().ListUserPvzIDs(gomock.Any(), mockuser.ID).Return(nil, errMock)
+	_, err = repo.ListPvzIDs(context.Background(), mockuser.ID)
+	require.Equal(t, errMock, err)
+}
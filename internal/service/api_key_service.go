@@ -0,0 +1,160 @@
+//go:generate mockgen -source=./api_key_service.go -destination=./mocks/api_key_service.go -package=mocks
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/secret"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+)
+
+const (
+	apiKeyLen       = 32
+	apiKeyPrefix    = "pvz_"
+	apiKeyPrefixLen = len(apiKeyPrefix) + 8
+)
+
+type APIKeyRepo interface {
+	CreateAPIKey(ctx context.Context, req *request.CreateAPIKey, prefix, keyHash string) (*entity.APIKey, error)
+	ListAPIKeys(ctx context.Context) ([]*entity.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id uuid.UUID) (*entity.APIKey, error)
+	UseAPIKey(ctx context.Context, keyHash string) (*entity.APIKey, error)
+}
+
+type PermissionChecker interface {
+	HasPermissions(ctx context.Context, role entity.Role, needed ...entity.Permission) (bool, error)
+}
+
+type APIKeyServiceImpl struct {
+	repo APIKeyRepo
+
+	permChecker PermissionChecker
+	auditor     Auditor
+}
+
+func NewAPIKeyService(repo APIKeyRepo, permChecker PermissionChecker, auditor Auditor) *APIKeyServiceImpl {
+	return &APIKeyServiceImpl{
+		repo:        repo,
+		permChecker: permChecker,
+		auditor:     auditor,
+	}
+}
+
+// CreateAPIKey creates new api key. Plain key is returned
+// only here, storage keeps its hash and prefix.
+func (s *APIKeyServiceImpl) CreateAPIKey(ctx context.Context, req *request.CreateAPIKey) (*entity.APIKey, error) {
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return nil, apperror.NewBadReq("invalid req: expires_at must be in the future")
+	}
+
+	if p, ok := principal.FromContext(ctx); ok {
+		if err := s.checkGrantable(ctx, p, req); err != nil {
+			return nil, err
+		}
+	}
+
+	rnd, err := secret.Generate(apiKeyLen)
+	if err != nil {
+		return nil, apperror.NewInternal("failed to generate api key", err)
+	}
+	key := apiKeyPrefix + rnd
+
+	res, err := s.repo.CreateAPIKey(ctx, req, key[:apiKeyPrefixLen], secret.Hash(key))
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrPermissionNotFound),
+			errors.Is(err, repository.ErrPvzNotFound):
+			return nil, apperror.NewBadReq(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to create api key", err)
+		}
+	}
+	res.Key = key
+
+	s.auditor.Record(ctx, entity.AuditTokenIssued, map[string]any{
+		"type":        "api_key",
+		"api_key_id":  res.ID,
+		"name":        res.Name,
+		"permissions": req.Permissions,
+	})
+	return res, nil
+}
+
+// checkGrantable checks that creator holds every permission and
+// PVZ granted to new key, so key can't be used to escalate access.
+// API keys have no role and can't grant permissions at all.
+func (s *APIKeyServiceImpl) checkGrantable(ctx context.Context, p *principal.Principal, req *request.CreateAPIKey) error {
+	for _, perm := range req.Permissions {
+		granted := false
+		if !p.IsAPIKey() {
+			var err error
+			granted, err = s.permChecker.HasPermissions(ctx, p.Role, entity.Permission(perm))
+			if err != nil {
+				return apperror.NewInternal("failed to check permissions", err)
+			}
+		}
+		if !granted {
+			return apperror.NewForbidden("cannot grant permission: " + perm)
+		}
+	}
+
+	if !p.AllPvz {
+		if len(req.PvzIDs) == 0 {
+			return apperror.NewForbidden("api key must be limited to your pvz")
+		}
+		for _, id := range req.PvzIDs {
+			if !p.CanAccessPvz(id) {
+				return apperror.NewForbidden("no access to pvz")
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *APIKeyServiceImpl) ListAPIKeys(ctx context.Context) ([]*entity.APIKey, error) {
+	res, err := s.repo.ListAPIKeys(ctx)
+	if err != nil {
+		return nil, apperror.NewInternal("failed to list api keys", err)
+	}
+
+	return res, nil
+}
+
+func (s *APIKeyServiceImpl) RevokeAPIKey(ctx context.Context, id uuid.UUID) error {
+	_, err := s.repo.RevokeAPIKey(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrAPIKeyNotFound):
+			return apperror.NewBadReq(err.Error())
+		default:
+			return apperror.NewInternal("failed to revoke api key", err)
+		}
+	}
+
+	return nil
+}
+
+// Authenticate returns active api key by its plain value.
+func (s *APIKeyServiceImpl) Authenticate(ctx context.Context, key string) (*entity.APIKey, error) {
+	res, err := s.repo.UseAPIKey(ctx, secret.Hash(key))
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrAPIKeyNotFound):
+			return nil, apperror.NewUnauthorized("invalid api key")
+		default:
+			return nil, apperror.NewInternal("failed to check api key", err)
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,165 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/secret"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/service"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/service/mocks"
+)
+
+func TestCreateAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockAPIKeyRepo(ctrl)
+	permChecker := mocks.NewMockPermissionChecker(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	srv := service.NewAPIKeyService(repo, permChecker, auditor)
+
+	req := &request.CreateAPIKey{
+		Name:        "scanner",
+		Permissions: []string{string(entity.PermReceptionWrite)},
+	}
+	moderatorCtx := principal.NewContext(context.Background(), &principal.Principal{UserID: uuid.New(), Role: entity.RoleModerator, AllPvz: true})
+
+	t.Run("OK", func(t *testing.T) {
+		permChecker.EXPECT().HasPermissions(gomock.Any(), entity.RoleModerator, entity.PermReceptionWrite).Return(true, nil)
+		var prefix, keyHash string
+		repo.EXPECT().CreateAPIKey(gomock.Any(), req, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ *request.CreateAPIKey, p, h string) (*entity.APIKey, error) {
+				prefix, keyHash = p, h
+				return &entity.APIKey{ID: uuid.New(), Name: req.Name, Prefix: p}, nil
+			})
+
+		res, err := srv.CreateAPIKey(moderatorCtx, req)
+
+		require.NoError(t, err)
+		require.True(t, strings.HasPrefix(res.Key, prefix))
+		require.Equal(t, keyHash, secret.Hash(res.Key))
+	})
+
+	t.Run("expired", func(t *testing.T) {
+		expiresAt := time.Now().Add(-time.Hour)
+
+		res, err := srv.CreateAPIKey(moderatorCtx, &request.CreateAPIKey{
+			Name:        req.Name,
+			Permissions: req.Permissions,
+			ExpiresAt:   &expiresAt,
+		})
+
+		require.Nil(t, res)
+		require.Equal(t, apperror.NewBadReq("invalid req: expires_at must be in the future"), err)
+	})
+
+	t.Run("permission creator lacks", func(t *testing.T) {
+		permChecker.EXPECT().HasPermissions(gomock.Any(), entity.RoleModerator, entity.PermReceptionWrite).Return(false, nil)
+
+		res, err := srv.CreateAPIKey(moderatorCtx, req)
+
+		require.Nil(t, res)
+		require.Equal(t, apperror.NewForbidden("cannot grant permission: reception:write"), err)
+	})
+
+	t.Run("created by api key", func(t *testing.T) {
+		ctx := principal.NewContext(context.Background(), &principal.Principal{APIKeyID: uuid.New(), AllPvz: true})
+
+		res, err := srv.CreateAPIKey(ctx, req)
+
+		require.Nil(t, res)
+		require.Equal(t, apperror.NewForbidden("cannot grant permission: reception:write"), err)
+	})
+
+	t.Run("pvz creator lacks", func(t *testing.T) {
+		scopedCtx := principal.NewContext(context.Background(), &principal.Principal{
+			UserID: uuid.New(),
+			Role:   entity.RoleModerator,
+			PvzIDs: []uuid.UUID{uuid.New()},
+		})
+		permChecker.EXPECT().HasPermissions(gomock.Any(), entity.RoleModerator, entity.PermReceptionWrite).Return(true, nil).Times(2)
+
+		res, err := srv.CreateAPIKey(scopedCtx, req)
+		require.Nil(t, res)
+		require.Equal(t, apperror.NewForbidden("api key must be limited to your pvz"), err)
+
+		res, err = srv.CreateAPIKey(scopedCtx, &request.CreateAPIKey{
+			Name:        req.Name,
+			Permissions: req.Permissions,
+			PvzIDs:      []uuid.UUID{uuid.New()},
+		})
+		require.Nil(t, res)
+		require.Equal(t, apperror.NewForbidden("no access to pvz"), err)
+	})
+
+	t.Run("check permissions err", func(t *testing.T) {
+		permChecker.EXPECT().HasPermissions(gomock.Any(), entity.RoleModerator, entity.PermReceptionWrite).Return(false, errMock)
+
+		res, err := srv.CreateAPIKey(moderatorCtx, req)
+
+		require.Nil(t, res)
+		require.Equal(t, apperror.NewInternal("failed to check permissions", errMock), err)
+	})
+
+	t.Run("unknown permission", func(t *testing.T) {
+		permChecker.EXPECT().HasPermissions(gomock.Any(), entity.RoleModerator, entity.PermReceptionWrite).Return(true, nil)
+		repo.EXPECT().CreateAPIKey(gomock.Any(), req, gomock.Any(), gomock.Any()).Return(nil, repository.ErrPermissionNotFound)
+
+		res, err := srv.CreateAPIKey(moderatorCtx, req)
+
+		require.Nil(t, res)
+		require.Equal(t, apperror.NewBadReq(repository.ErrPermissionNotFound.Error()), err)
+	})
+
+	t.Run("repo err", func(t *testing.T) {
+		permChecker.EXPECT().HasPermissions(gomock.Any(), entity.RoleModerator, entity.PermReceptionWrite).Return(true, nil)
+		repo.EXPECT().CreateAPIKey(gomock.Any(), req, gomock.Any(), gomock.Any()).Return(nil, errMock)
+
+		res, err := srv.CreateAPIKey(moderatorCtx, req)
+
+		require.Nil(t, res)
+		require.Equal(t, apperror.NewInternal("failed to create api key", errMock), err)
+	})
+}
+
+func TestAuthenticateAPIKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockAPIKeyRepo(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	srv := service.NewAPIKeyService(repo, nil, auditor)
+
+	key := &entity.APIKey{ID: uuid.New(), Permissions: []entity.Permission{entity.PermReportRead}}
+
+	t.Run("OK", func(t *testing.T) {
+		repo.EXPECT().UseAPIKey(gomock.Any(), secret.Hash("pvz_key")).Return(key, nil)
+
+		res, err := srv.Authenticate(context.Background(), "pvz_key")
+
+		require.NoError(t, err)
+		require.Equal(t, key, res)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		repo.EXPECT().UseAPIKey(gomock.Any(), secret.Hash("pvz_key")).Return(nil, repository.ErrAPIKeyNotFound)
+
+		res, err := srv.Authenticate(context.Background(), "pvz_key")
+
+		require.Nil(t, res)
+		require.Equal(t, apperror.NewUnauthorized("invalid api key"), err)
+	})
+}
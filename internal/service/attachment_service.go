@@ -0,0 +1,214 @@
+//go:generate mockgen -source=./attachment_service.go -destination=./mocks/attachment_service.go -package=mocks
+
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+)
+
+type AttachmentRepo interface {
+	CreateAttachment(ctx context.Context, a *entity.ProductAttachment) (*entity.ProductAttachment, error)
+	ListAttachments(ctx context.Context, productID uuid.UUID) ([]*entity.ProductAttachment, error)
+	GetAttachment(ctx context.Context, productID, id uuid.UUID) (*entity.ProductAttachment, error)
+}
+
+// BlobStore keeps attachment files, database
+// stores only their keys and metadata.
+type BlobStore interface {
+	Put(ctx context.Context, key string, r io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+const defaultAttachmentMaxSize = 10 << 20
+
+var defaultAttachmentTypes = []string{"image/jpeg", "image/png", "image/webp"}
+
+type AttachmentServiceImpl struct {
+	repo          AttachmentRepo
+	productRepo   ProductGetter
+	receptionRepo ReceptionGetter
+	store         BlobStore
+	auditor       Auditor
+
+	maxSize      int64
+	allowedTypes map[string]bool
+}
+
+// NewAttachmentService creates attachment service, unset max size
+// and allowed types default to 10 MiB and common photo formats.
+func NewAttachmentService(repo AttachmentRepo, productRepo ProductGetter, receptionRepo ReceptionGetter, store BlobStore, auditor Auditor, maxSize int64, allowedTypes []string) *AttachmentServiceImpl {
+	if maxSize <= 0 {
+		maxSize = defaultAttachmentMaxSize
+	}
+	if len(allowedTypes) == 0 {
+		allowedTypes = defaultAttachmentTypes
+	}
+
+	allowed := make(map[string]bool, len(allowedTypes))
+	for _, t := range allowedTypes {
+		allowed[t] = true
+	}
+
+	return &AttachmentServiceImpl{
+		repo:          repo,
+		productRepo:   productRepo,
+		receptionRepo: receptionRepo,
+		store:         store,
+		auditor:       auditor,
+		maxSize:       maxSize,
+		allowedTypes:  allowed,
+	}
+}
+
+// MaxSize returns max attachment file size in bytes.
+func (s *AttachmentServiceImpl) MaxSize() int64 {
+	return s.maxSize
+}
+
+// AddAttachment uploads file, usually photo of damaged parcel,
+// for product. Content type is detected from file content rather
+// than trusted from client, file must be of one of allowed types.
+func (s *AttachmentServiceImpl) AddAttachment(ctx context.Context, productID uuid.UUID, file io.Reader) (*entity.ProductAttachment, error) {
+	if _, err := s.getProductInScope(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	data, err := io.ReadAll(io.LimitReader(file, s.maxSize+1))
+	if err != nil {
+		return nil, apperror.NewBadReq("failed to read file: " + err.Error())
+	}
+	if len(data) == 0 {
+		return nil, apperror.NewBadReq("file is empty")
+	}
+	if int64(len(data)) > s.maxSize {
+		return nil, apperror.NewBadReq(fmt.Sprintf("file is too large, max %d bytes", s.maxSize))
+	}
+
+	contentType, _, err := mime.ParseMediaType(http.DetectContentType(data))
+	if err != nil || !s.allowedTypes[contentType] {
+		return nil, apperror.NewBadReq("unsupported file type: " + contentType)
+	}
+
+	var actorID uuid.UUID
+	if p, ok := principal.FromContext(ctx); ok {
+		actorID = p.UserID
+	}
+
+	id := uuid.New()
+	attachment := &entity.ProductAttachment{
+		ID:          id,
+		ProductID:   productID,
+		BlobKey:     "products/" + productID.String() + "/" + id.String(),
+		ContentType: contentType,
+		Size:        int64(len(data)),
+		UploadedBy:  actorID,
+	}
+
+	if err := s.store.Put(ctx, attachment.BlobKey, bytes.NewReader(data)); err != nil {
+		return nil, apperror.NewInternal("failed to store attachment", err)
+	}
+
+	res, err := s.repo.CreateAttachment(ctx, attachment)
+	if err != nil {
+		// file without record is never read, remove it
+		// so failed uploads don't pile up in store
+		if err := s.store.Delete(ctx, attachment.BlobKey); err != nil {
+			log.Printf("failed to delete orphan attachment %s: %v", attachment.BlobKey, err)
+		}
+		switch {
+		case errors.Is(err, repository.ErrProductNotFound):
+			return nil, apperror.NewNotFound(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to save attachment", err)
+		}
+	}
+
+	s.auditor.Record(ctx, entity.AuditProductAttachmentAdded, map[string]any{
+		"product_id":    productID,
+		"attachment_id": res.ID,
+		"content_type":  res.ContentType,
+		"size":          res.Size,
+	})
+	return res, nil
+}
+
+// ListAttachments returns product attachments, oldest first.
+func (s *AttachmentServiceImpl) ListAttachments(ctx context.Context, productID uuid.UUID) ([]*entity.ProductAttachment, error) {
+	if _, err := s.getProductInScope(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	res, err := s.repo.ListAttachments(ctx, productID)
+	if err != nil {
+		return nil, apperror.NewInternal("failed to list attachments", err)
+	}
+
+	return res, nil
+}
+
+// OpenAttachment returns attachment with its file content,
+// caller must close it.
+func (s *AttachmentServiceImpl) OpenAttachment(ctx context.Context, productID, id uuid.UUID) (*entity.ProductAttachment, io.ReadCloser, error) {
+	if _, err := s.getProductInScope(ctx, productID); err != nil {
+		return nil, nil, err
+	}
+
+	attachment, err := s.repo.GetAttachment(ctx, productID, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrAttachmentNotFound):
+			return nil, nil, apperror.NewNotFound(err.Error())
+		default:
+			return nil, nil, apperror.NewInternal("failed to get attachment", err)
+		}
+	}
+
+	file, err := s.store.Get(ctx, attachment.BlobKey)
+	if err != nil {
+		// record without file means store was
+		// cleaned up by hand, it is not a client error
+		return nil, nil, apperror.NewInternal("failed to read attachment", err)
+	}
+
+	return attachment, file, nil
+}
+
+// getProductInScope returns product, caller
+// must have access to its reception PVZ.
+func (s *AttachmentServiceImpl) getProductInScope(ctx context.Context, id uuid.UUID) (*entity.Product, error) {
+	product, err := s.productRepo.GetProduct(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrProductNotFound):
+			return nil, apperror.NewNotFound(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to get product", err)
+		}
+	}
+
+	reception, err := s.receptionRepo.GetProductReception(ctx, product.ID)
+	if err != nil {
+		return nil, apperror.NewInternal("failed to get product reception", err)
+	}
+
+	if p, ok := principal.FromContext(ctx); ok && !p.CanAccessPvz(reception.PvzID) {
+		return nil, apperror.NewForbidden("no access to pvz")
+	}
+
+	return product, nil
+}
@@ -0,0 +1,277 @@
+package service_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/service"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/service/mocks"
+)
+
+var pngFile = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func TestAddAttachment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockAttachmentRepo(ctrl)
+	productRepo := mocks.NewMockProductGetter(ctrl)
+	receptionRepo := mocks.NewMockReceptionGetter(ctrl)
+	store := mocks.NewMockBlobStore(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	srv := service.NewAttachmentService(repo, productRepo, receptionRepo, store, auditor, 32, []string{"image/png", "image/jpeg"})
+
+	userID := uuid.New()
+	userCtx := principal.NewContext(context.Background(), &principal.Principal{UserID: userID, AllPvz: true})
+	keyPrefix := "products/" + product.ID.String() + "/"
+
+	productInScope := func() {
+		productRepo.EXPECT().GetProduct(gomock.Any(), product.ID).Return(product, nil)
+		receptionRepo.EXPECT().GetProductReception(gomock.Any(), product.ID).Return(reception3, nil)
+	}
+
+	testCases := []struct {
+		name         string
+		ctx          context.Context
+		file         []byte
+		mockBehavior func()
+		expErr       error
+	}{
+		{
+			name: "ok",
+			ctx:  userCtx,
+			file: pngFile,
+			mockBehavior: func() {
+				productInScope()
+				store.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, key string, r io.Reader) error {
+						require.True(t, strings.HasPrefix(key, keyPrefix))
+						data, err := io.ReadAll(r)
+						require.NoError(t, err)
+						require.Equal(t, pngFile, data)
+						return nil
+					})
+				repo.EXPECT().CreateAttachment(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, a *entity.ProductAttachment) (*entity.ProductAttachment, error) {
+						require.Equal(t, product.ID, a.ProductID)
+						require.Equal(t, keyPrefix+a.ID.String(), a.BlobKey)
+						require.Equal(t, "image/png", a.ContentType)
+						require.Equal(t, int64(len(pngFile)), a.Size)
+						require.Equal(t, userID, a.UploadedBy)
+						a.CreatedAt = time.Now()
+						return a, nil
+					})
+				auditor.EXPECT().Record(gomock.Any(), entity.AuditProductAttachmentAdded, gomock.Any())
+			},
+			expErr: nil,
+		},
+		{
+			name: "product not found",
+			ctx:  userCtx,
+			file: pngFile,
+			mockBehavior: func() {
+				productRepo.EXPECT().GetProduct(gomock.Any(), product.ID).Return(nil, repository.ErrProductNotFound)
+			},
+			expErr: apperror.NewNotFound(repository.ErrProductNotFound.Error()),
+		},
+		{
+			name: "other pvz",
+			ctx:  principal.NewContext(context.Background(), &principal.Principal{PvzIDs: []uuid.UUID{pvz2.ID}}),
+			file: pngFile,
+			mockBehavior: func() {
+				productInScope()
+			},
+			expErr: apperror.NewForbidden("no access to pvz"),
+		},
+		{
+			name: "empty file",
+			ctx:  userCtx,
+			file: nil,
+			mockBehavior: func() {
+				productInScope()
+			},
+			expErr: apperror.NewBadReq("file is empty"),
+		},
+		{
+			name: "too large",
+			ctx:  userCtx,
+			file: append(append([]byte{}, pngFile...), make([]byte, 32)...),
+			mockBehavior: func() {
+				productInScope()
+			},
+			expErr: apperror.NewBadReq("file is too large, max 32 bytes"),
+		},
+		{
+			name: "unsupported type",
+			ctx:  userCtx,
+			file: []byte("just some text"),
+			mockBehavior: func() {
+				productInScope()
+			},
+			expErr: apperror.NewBadReq("unsupported file type: text/plain"),
+		},
+		{
+			name: "store err",
+			ctx:  userCtx,
+			file: pngFile,
+			mockBehavior: func() {
+				productInScope()
+				store.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(errMock)
+			},
+			expErr: apperror.NewInternal("failed to store attachment", errMock),
+		},
+		{
+			name: "save err removes file",
+			ctx:  userCtx,
+			file: pngFile,
+			mockBehavior: func() {
+				productInScope()
+				var key string
+				store.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, k string, _ io.Reader) error {
+						key = k
+						return nil
+					})
+				repo.EXPECT().CreateAttachment(gomock.Any(), gomock.Any()).Return(nil, errMock)
+				store.EXPECT().Delete(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, k string) error {
+						require.Equal(t, key, k)
+						return nil
+					})
+			},
+			expErr: apperror.NewInternal("failed to save attachment", errMock),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := srv.AddAttachment(tc.ctx, product.ID, bytes.NewReader(tc.file))
+			require.Equal(t, tc.expErr, err)
+			if tc.expErr == nil {
+				require.NotNil(t, res)
+			} else {
+				require.Nil(t, res)
+			}
+		})
+	}
+}
+
+func TestAttachmentServiceDefaults(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockAttachmentRepo(ctrl)
+	productRepo := mocks.NewMockProductGetter(ctrl)
+	receptionRepo := mocks.NewMockReceptionGetter(ctrl)
+	store := mocks.NewMockBlobStore(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	srv := service.NewAttachmentService(repo, productRepo, receptionRepo, store, auditor, 0, nil)
+
+	require.Equal(t, int64(10<<20), srv.MaxSize())
+
+	webpFile := []byte("RIFF\x00\x00\x00\x00WEBPVP8 ")
+	productRepo.EXPECT().GetProduct(gomock.Any(), product.ID).Return(product, nil)
+	receptionRepo.EXPECT().GetProductReception(gomock.Any(), product.ID).Return(reception3, nil)
+	store.EXPECT().Put(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+	repo.EXPECT().CreateAttachment(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, a *entity.ProductAttachment) (*entity.ProductAttachment, error) {
+			require.Equal(t, "image/webp", a.ContentType)
+			return a, nil
+		})
+	auditor.EXPECT().Record(gomock.Any(), entity.AuditProductAttachmentAdded, gomock.Any())
+
+	_, err := srv.AddAttachment(context.Background(), product.ID, bytes.NewReader(webpFile))
+	require.NoError(t, err)
+}
+
+func TestOpenAttachment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockAttachmentRepo(ctrl)
+	productRepo := mocks.NewMockProductGetter(ctrl)
+	receptionRepo := mocks.NewMockReceptionGetter(ctrl)
+	store := mocks.NewMockBlobStore(ctrl)
+
+	srv := service.NewAttachmentService(repo, productRepo, receptionRepo, store, mocks.NewMockAuditor(ctrl), 32, []string{"image/png"})
+
+	attachment := &entity.ProductAttachment{
+		ID:          uuid.New(),
+		ProductID:   product.ID,
+		BlobKey:     "products/" + product.ID.String() + "/photo",
+		ContentType: "image/png",
+		Size:        int64(len(pngFile)),
+		CreatedAt:   time.Now(),
+	}
+
+	productInScope := func() {
+		productRepo.EXPECT().GetProduct(gomock.Any(), product.ID).Return(product, nil)
+		receptionRepo.EXPECT().GetProductReception(gomock.Any(), product.ID).Return(reception3, nil)
+	}
+
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expResp      *entity.ProductAttachment
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				productInScope()
+				repo.EXPECT().GetAttachment(gomock.Any(), product.ID, attachment.ID).Return(attachment, nil)
+				store.EXPECT().Get(gomock.Any(), attachment.BlobKey).Return(io.NopCloser(bytes.NewReader(pngFile)), nil)
+			},
+			expResp: attachment,
+			expErr:  nil,
+		},
+		{
+			name: "not found",
+			mockBehavior: func() {
+				productInScope()
+				repo.EXPECT().GetAttachment(gomock.Any(), product.ID, attachment.ID).Return(nil, repository.ErrAttachmentNotFound)
+			},
+			expResp: nil,
+			expErr:  apperror.NewNotFound(repository.ErrAttachmentNotFound.Error()),
+		},
+		{
+			name: "file missing in store",
+			mockBehavior: func() {
+				productInScope()
+				repo.EXPECT().GetAttachment(gomock.Any(), product.ID, attachment.ID).Return(attachment, nil)
+				store.EXPECT().Get(gomock.Any(), attachment.BlobKey).Return(nil, errMock)
+			},
+			expResp: nil,
+			expErr:  apperror.NewInternal("failed to read attachment", errMock),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, file, err := srv.OpenAttachment(context.Background(), product.ID, attachment.ID)
+			require.Equal(t, tc.expResp, res)
+			require.Equal(t, tc.expErr, err)
+			if err == nil {
+				data, err := io.ReadAll(file)
+				require.NoError(t, err)
+				require.Equal(t, pngFile, data)
+				require.NoError(t, file.Close())
+			}
+		})
+	}
+}
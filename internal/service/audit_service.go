@@ -0,0 +1,74 @@
+//go:generate mockgen -source=./audit_service.go -destination=./mocks/audit_service.go -package=mocks
+
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"log"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/reqmeta"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+)
+
+// Auditor records security relevant actions.
+type Auditor interface {
+	Record(ctx context.Context, action entity.AuditAction, payload map[string]any)
+}
+
+type AuditRepo interface {
+	CreateEntry(ctx context.Context, e *entity.AuditEntry) error
+	ListEntries(ctx context.Context, req *request.ListAudit) ([]*entity.AuditEntry, error)
+}
+
+type AuditServiceImpl struct {
+	repo AuditRepo
+}
+
+func NewAuditService(repo AuditRepo) *AuditServiceImpl {
+	return &AuditServiceImpl{
+		repo: repo,
+	}
+}
+
+// Record stores action with caller and request metadata
+// taken from ctx. Audit is best-effort: failure is logged
+// and doesn't fail the audited action.
+func (s *AuditServiceImpl) Record(ctx context.Context, action entity.AuditAction, payload map[string]any) {
+	if payload == nil {
+		payload = map[string]any{}
+	}
+
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		log.Printf("audit: failed to marshal %s payload: %v", action, err)
+		return
+	}
+
+	e := &entity.AuditEntry{
+		Action:  action,
+		Payload: raw,
+	}
+	if p, ok := principal.FromContext(ctx); ok {
+		e.ActorID, e.ActorRole, e.APIKeyID = p.UserID, p.Role, p.APIKeyID
+	}
+	if m, ok := reqmeta.FromContext(ctx); ok {
+		e.IP, e.UserAgent, e.RequestID = m.IP, m.UserAgent, m.RequestID
+	}
+
+	if err := s.repo.CreateEntry(ctx, e); err != nil {
+		log.Printf("audit: failed to record %s: %v", action, err)
+	}
+}
+
+func (s *AuditServiceImpl) ListAudit(ctx context.Context, req *request.ListAudit) ([]*entity.AuditEntry, error) {
+	res, err := s.repo.ListEntries(ctx, req)
+	if err != nil {
+		return nil, apperror.NewInternal("failed to list audit log", err)
+	}
+
+	return res, nil
+}
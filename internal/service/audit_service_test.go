@@ -0,0 +1,126 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/reqmeta"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/service"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/service/mocks"
+)
+
+func TestRecordAudit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockAuditRepo(ctrl)
+
+	srv := service.NewAuditService(repo)
+
+	userID := uuid.New()
+	userCtx := principal.NewContext(context.Background(), &principal.Principal{UserID: userID, Role: entity.RoleModerator})
+	userCtx = reqmeta.NewContext(userCtx, &reqmeta.Meta{IP: "127.0.0.1", UserAgent: "curl", RequestID: "req"})
+
+	testCases := []struct {
+		name         string
+		ctx          context.Context
+		payload      map[string]any
+		mockBehavior func()
+	}{
+		{
+			name:    "with actor and meta",
+			ctx:     userCtx,
+			payload: map[string]any{"pvz_id": "id"},
+			mockBehavior: func() {
+				repo.EXPECT().CreateEntry(gomock.Any(), &entity.AuditEntry{
+					Action:    entity.AuditPvzCreated,
+					ActorID:   userID,
+					ActorRole: entity.RoleModerator,
+					IP:        "127.0.0.1",
+					UserAgent: "curl",
+					RequestID: "req",
+					Payload:   json.RawMessage(`{"pvz_id":"id"}`),
+				}).Return(nil)
+			},
+		},
+		{
+			name:    "anonymous without payload",
+			ctx:     context.Background(),
+			payload: nil,
+			mockBehavior: func() {
+				repo.EXPECT().CreateEntry(gomock.Any(), &entity.AuditEntry{
+					Action:  entity.AuditPvzCreated,
+					Payload: json.RawMessage(`{}`),
+				}).Return(nil)
+			},
+		},
+		{
+			name:    "repo err is not propagated",
+			ctx:     context.Background(),
+			payload: nil,
+			mockBehavior: func() {
+				repo.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Return(errMock)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			srv.Record(tc.ctx, entity.AuditPvzCreated, tc.payload)
+		})
+	}
+}
+
+func TestListAudit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockAuditRepo(ctrl)
+
+	srv := service.NewAuditService(repo)
+
+	req := &request.ListAudit{Limit: 10}
+	entries := []*entity.AuditEntry{{ID: 1, Action: entity.AuditLoginSuccess}}
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expRes       []*entity.AuditEntry
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				repo.EXPECT().ListEntries(gomock.Any(), req).Return(entries, nil)
+			},
+			expRes: entries,
+			expErr: nil,
+		},
+		{
+			name: "repo err",
+			mockBehavior: func() {
+				repo.EXPECT().ListEntries(gomock.Any(), req).Return(nil, errMock)
+			},
+			expRes: nil,
+			expErr: apperror.NewInternal("failed to list audit log", errMock),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := srv.ListAudit(context.Background(), req)
+			require.Equal(t, tc.expErr, err)
+			require.Equal(t, tc.expRes, res)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+//go:generate mockgen -source=./city_service.go -destination=./mocks/city_service.go -package=mocks
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+	// runtime image has no tzdata, city timezones
+	// are validated against embedded database.
+	_ "time/tzdata"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/refcache"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+)
+
+const (
+	defaultCityCacheTTL = time.Minute
+	defaultCityTimezone = "Europe/Moscow"
+)
+
+type CityRepo interface {
+	ListCities(ctx context.Context, enabled *bool) ([]*entity.CityInfo, error)
+	CreateCity(ctx context.Context, req *request.CreateCity) (*entity.CityInfo, error)
+	UpdateCity(ctx context.Context, code string, req *request.UpdateCity) (*entity.CityInfo, error)
+}
+
+// CityServiceImpl manages city reference. Enabled cities
+// are cached and reloaded once cache TTL expires, so cities
+// enabled on other instances are picked up without restart.
+type CityServiceImpl struct {
+	repo    CityRepo
+	auditor Auditor
+
+	cache *refcache.Cache[entity.City, bool]
+}
+
+func NewCityService(repo CityRepo, auditor Auditor, ttl time.Duration) *CityServiceImpl {
+	if ttl <= 0 {
+		ttl = defaultCityCacheTTL
+	}
+
+	s := &CityServiceImpl{
+		repo:    repo,
+		auditor: auditor,
+	}
+	s.cache = refcache.New(ttl, s.loadEnabled)
+
+	return s
+}
+
+func (s *CityServiceImpl) ListCities(ctx context.Context, enabled *bool) ([]*entity.CityInfo, error) {
+	res, err := s.repo.ListCities(ctx, enabled)
+	if err != nil {
+		return nil, apperror.NewInternal("failed to list cities", err)
+	}
+
+	return res, nil
+}
+
+func (s *CityServiceImpl) CreateCity(ctx context.Context, req *request.CreateCity) (*entity.CityInfo, error) {
+	if req.Timezone == "" {
+		req.Timezone = defaultCityTimezone
+	}
+	if _, err := time.LoadLocation(req.Timezone); err != nil {
+		return nil, apperror.NewBadReq("invalid timezone: " + req.Timezone)
+	}
+
+	res, err := s.repo.CreateCity(ctx, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrCityAlreadyExists):
+			return nil, apperror.NewBadReq(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to create city", err)
+		}
+	}
+
+	s.cache.Invalidate()
+	s.auditor.Record(ctx, entity.AuditCityCreated, map[string]any{
+		"code":    res.Code,
+		"name":    res.Name,
+		"enabled": res.Enabled,
+	})
+	return res, nil
+}
+
+func (s *CityServiceImpl) UpdateCity(ctx context.Context, code string, req *request.UpdateCity) (*entity.CityInfo, error) {
+	res, err := s.repo.UpdateCity(ctx, code, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrCityNotFound):
+			return nil, apperror.NewNotFound(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to update city", err)
+		}
+	}
+
+	s.cache.Invalidate()
+	s.auditor.Record(ctx, entity.AuditCityUpdated, map[string]any{
+		"code":    res.Code,
+		"enabled": res.Enabled,
+	})
+	return res, nil
+}
+
+// IsCityEnabled reports whether new PVZ can be created in city.
+func (s *CityServiceImpl) IsCityEnabled(ctx context.Context, city string) (bool, error) {
+	enabled, err := s.cache.Get(ctx)
+	if err != nil {
+		return false, apperror.NewInternal("failed to load cities", err)
+	}
+
+	return enabled[entity.City(city)], nil
+}
+
+func (s *CityServiceImpl) loadEnabled(ctx context.Context) (map[entity.City]bool, error) {
+	onlyEnabled := true
+	cities, err := s.repo.ListCities(ctx, &onlyEnabled)
+	if err != nil {
+		return nil, err
+	}
+
+	enabled := make(map[entity.City]bool, len(cities))
+	for _, c := range cities {
+		enabled[c.Name] = true
+	}
+
+	return enabled, nil
+}
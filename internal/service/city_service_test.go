@@ -0,0 +1,134 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/service"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/service/mocks"
+)
+
+var city = &entity.CityInfo{Code: "kazan", Name: entity.CityKazan, Timezone: "Europe/Moscow", Enabled: true}
+
+func TestCreateCity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockCityRepo(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	srv := service.NewCityService(repo, auditor, 0)
+
+	t.Run("OK with default timezone", func(t *testing.T) {
+		req := &request.CreateCity{Code: city.Code, Name: string(city.Name)}
+		repo.EXPECT().CreateCity(gomock.Any(), &request.CreateCity{
+			Code:     city.Code,
+			Name:     string(city.Name),
+			Timezone: "Europe/Moscow",
+		}).Return(city, nil)
+
+		res, err := srv.CreateCity(context.Background(), req)
+
+		require.NoError(t, err)
+		require.Equal(t, city, res)
+	})
+
+	t.Run("invalid timezone", func(t *testing.T) {
+		res, err := srv.CreateCity(context.Background(), &request.CreateCity{
+			Code:     city.Code,
+			Name:     string(city.Name),
+			Timezone: "Mars/Olympus",
+		})
+
+		require.Nil(t, res)
+		require.Equal(t, apperror.NewBadReq("invalid timezone: Mars/Olympus"), err)
+	})
+
+	t.Run("already exists", func(t *testing.T) {
+		repo.EXPECT().CreateCity(gomock.Any(), gomock.Any()).Return(nil, repository.ErrCityAlreadyExists)
+
+		res, err := srv.CreateCity(context.Background(), &request.CreateCity{Code: city.Code, Name: string(city.Name)})
+
+		require.Nil(t, res)
+		require.Equal(t, apperror.NewBadReq(repository.ErrCityAlreadyExists.Error()), err)
+	})
+}
+
+func TestUpdateCity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockCityRepo(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	srv := service.NewCityService(repo, auditor, 0)
+
+	enabled := false
+	req := &request.UpdateCity{Enabled: &enabled}
+
+	repo.EXPECT().UpdateCity(gomock.Any(), city.Code, req).Return(nil, repository.ErrCityNotFound)
+	res, err := srv.UpdateCity(context.Background(), city.Code, req)
+	require.Nil(t, res)
+	require.Equal(t, apperror.NewNotFound(repository.ErrCityNotFound.Error()), err)
+
+	repo.EXPECT().UpdateCity(gomock.Any(), city.Code, req).Return(nil, errMock)
+	res, err = srv.UpdateCity(context.Background(), city.Code, req)
+	require.Nil(t, res)
+	require.Equal(t, apperror.NewInternal("failed to update city", errMock), err)
+}
+
+func TestIsCityEnabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockCityRepo(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	srv := service.NewCityService(repo, auditor, 0)
+
+	onlyEnabled := true
+
+	// cities are loaded once and then served from cache
+	repo.EXPECT().ListCities(gomock.Any(), &onlyEnabled).Return([]*entity.CityInfo{city}, nil).Times(1)
+
+	ok, err := srv.IsCityEnabled(context.Background(), string(entity.CityKazan))
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	ok, err = srv.IsCityEnabled(context.Background(), string(entity.CityMoscow))
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// update drops cache
+	enabled := true
+	moscow := &entity.CityInfo{Code: "moscow", Name: entity.CityMoscow, Enabled: true}
+	repo.EXPECT().UpdateCity(gomock.Any(), moscow.Code, gomock.Any()).Return(moscow, nil)
+	_, err = srv.UpdateCity(context.Background(), moscow.Code, &request.UpdateCity{Enabled: &enabled})
+	require.NoError(t, err)
+
+	repo.EXPECT().ListCities(gomock.Any(), &onlyEnabled).Return([]*entity.CityInfo{city, moscow}, nil).Times(1)
+
+	ok, err = srv.IsCityEnabled(context.Background(), string(entity.CityMoscow))
+	require.NoError(t, err)
+	require.True(t, ok)
+}
+
+func TestIsCityEnabledLoadErr(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockCityRepo(ctrl)
+	srv := service.NewCityService(repo, mocks.NewMockAuditor(ctrl), 0)
+
+	repo.EXPECT().ListCities(gomock.Any(), gomock.Any()).Return(nil, errMock)
+
+	ok, err := srv.IsCityEnabled(context.Background(), string(entity.CityKazan))
+	require.False(t, ok)
+	require.Equal(t, apperror.NewInternal("failed to load cities", errMock), err)
+}
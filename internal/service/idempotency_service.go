@@ -0,0 +1,130 @@
+//go:generate mockgen -source=./idempotency_service.go -destination=./mocks/idempotency_service.go -package=mocks
+
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/secret"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+)
+
+const (
+	maxIdempotencyKeyLen      = 255
+	defaultIdempotencyTTL     = 24 * time.Hour
+	defaultIdempotencyCleanup = time.Hour
+)
+
+type IdempotencyRepo interface {
+	ReserveKey(ctx context.Context, scope, key, requestHash string, expiresAt time.Time) (bool, error)
+	GetKey(ctx context.Context, scope, key string) (*entity.IdempotencyRecord, error)
+	SaveResponse(ctx context.Context, scope, key string, resp *entity.IdempotentResponse) error
+	DeleteKey(ctx context.Context, scope, key string) error
+	DeleteExpired(ctx context.Context) (int64, error)
+}
+
+type IdempotencyServiceImpl struct {
+	repo IdempotencyRepo
+
+	ttl             time.Duration
+	cleanupInterval time.Duration
+}
+
+func NewIdempotencyService(repo IdempotencyRepo, ttl, cleanupInterval time.Duration) *IdempotencyServiceImpl {
+	if ttl <= 0 {
+		ttl = defaultIdempotencyTTL
+	}
+	if cleanupInterval <= 0 {
+		cleanupInterval = defaultIdempotencyCleanup
+	}
+
+	return &IdempotencyServiceImpl{
+		repo:            repo,
+		ttl:             ttl,
+		cleanupInterval: cleanupInterval,
+	}
+}
+
+// Begin reserves request's key. It returns nil if request
+// should be processed, or stored response to replay if
+// same request was already completed.
+func (s *IdempotencyServiceImpl) Begin(ctx context.Context, req *entity.IdempotentRequest) (*entity.IdempotentResponse, error) {
+	if len(req.Key) > maxIdempotencyKeyLen {
+		return nil, apperror.NewBadReq("idempotency key is too long")
+	}
+
+	scope := idempotencyScope(req)
+	requestHash := secret.Hash(string(req.Body))
+
+	reserved, err := s.repo.ReserveKey(ctx, scope, req.Key, requestHash, time.Now().Add(s.ttl))
+	if err != nil {
+		return nil, apperror.NewInternal("failed to reserve idempotency key", err)
+	}
+	if reserved {
+		return nil, nil
+	}
+
+	rec, err := s.repo.GetKey(ctx, scope, req.Key)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrIdempotencyKeyNotFound):
+			// key was released or expired in between, client may retry
+			return nil, apperror.NewConflict("request with this idempotency key is in progress")
+		default:
+			return nil, apperror.NewInternal("failed to get idempotency key", err)
+		}
+	}
+
+	if rec.RequestHash != requestHash {
+		return nil, apperror.NewUnprocessable("idempotency key is already used with another request")
+	}
+	if rec.Response == nil {
+		return nil, apperror.NewConflict("request with this idempotency key is in progress")
+	}
+
+	return rec.Response, nil
+}
+
+// Complete stores response of request, so retries replay it.
+func (s *IdempotencyServiceImpl) Complete(ctx context.Context, req *entity.IdempotentRequest, resp *entity.IdempotentResponse) error {
+	if err := s.repo.SaveResponse(ctx, idempotencyScope(req), req.Key, resp); err != nil {
+		return apperror.NewInternal("failed to save idempotent response", err)
+	}
+	return nil
+}
+
+// Release frees request's key, so it can be retried.
+// Used when request failed.
+func (s *IdempotencyServiceImpl) Release(ctx context.Context, req *entity.IdempotentRequest) error {
+	if err := s.repo.DeleteKey(ctx, idempotencyScope(req), req.Key); err != nil {
+		return apperror.NewInternal("failed to release idempotency key", err)
+	}
+	return nil
+}
+
+// RunCleanup periodically removes expired keys until ctx is done.
+func (s *IdempotencyServiceImpl) RunCleanup(ctx context.Context) {
+	ticker := time.NewTicker(s.cleanupInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.repo.DeleteExpired(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("failed to delete expired idempotency keys: %v", err)
+			}
+		}
+	}
+}
+
+// idempotencyScope binds key to operation and caller.
+func idempotencyScope(req *entity.IdempotentRequest) string {
+	return req.Operation + " " + req.Caller
+}
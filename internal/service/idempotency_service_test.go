@@ -0,0 +1,153 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/secret"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/service"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/service/mocks"
+)
+
+func TestIdempotencyBegin(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockIdempotencyRepo(ctrl)
+
+	srv := service.NewIdempotencyService(repo, time.Hour, time.Hour)
+
+	req := &entity.IdempotentRequest{
+		Key:       "key",
+		Operation: "POST /products",
+		Caller:    "user:1",
+		Body:      []byte(`{"pvzId":"1"}`),
+	}
+	scope := "POST /products user:1"
+	bodyHash := secret.Hash(string(req.Body))
+	stored := &entity.IdempotentResponse{StatusCode: 201, Body: []byte(`{"id":"1"}`)}
+
+	testCases := []struct {
+		name         string
+		req          *entity.IdempotentRequest
+		mockBehavior func()
+		expRes       *entity.IdempotentResponse
+		expErr       error
+	}{
+		{
+			name: "new key",
+			req:  req,
+			mockBehavior: func() {
+				repo.EXPECT().ReserveKey(gomock.Any(), scope, "key", bodyHash, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _, _, _ string, expiresAt time.Time) (bool, error) {
+						require.WithinDuration(t, time.Now().Add(time.Hour), expiresAt, time.Minute)
+						return true, nil
+					})
+			},
+		},
+		{
+			name: "replay",
+			req:  req,
+			mockBehavior: func() {
+				repo.EXPECT().ReserveKey(gomock.Any(), scope, "key", bodyHash, gomock.Any()).Return(false, nil)
+				repo.EXPECT().GetKey(gomock.Any(), scope, "key").Return(&entity.IdempotencyRecord{
+					RequestHash: bodyHash,
+					Response:    stored,
+				}, nil)
+			},
+			expRes: stored,
+		},
+		{
+			name: "other body",
+			req:  req,
+			mockBehavior: func() {
+				repo.EXPECT().ReserveKey(gomock.Any(), scope, "key", bodyHash, gomock.Any()).Return(false, nil)
+				repo.EXPECT().GetKey(gomock.Any(), scope, "key").Return(&entity.IdempotencyRecord{
+					RequestHash: "other",
+					Response:    stored,
+				}, nil)
+			},
+			expErr: apperror.NewUnprocessable("idempotency key is already used with another request"),
+		},
+		{
+			name: "in progress",
+			req:  req,
+			mockBehavior: func() {
+				repo.EXPECT().ReserveKey(gomock.Any(), scope, "key", bodyHash, gomock.Any()).Return(false, nil)
+				repo.EXPECT().GetKey(gomock.Any(), scope, "key").Return(&entity.IdempotencyRecord{RequestHash: bodyHash}, nil)
+			},
+			expErr: apperror.NewConflict("request with this idempotency key is in progress"),
+		},
+		{
+			name: "released in between",
+			req:  req,
+			mockBehavior: func() {
+				repo.EXPECT().ReserveKey(gomock.Any(), scope, "key", bodyHash, gomock.Any()).Return(false, nil)
+				repo.EXPECT().GetKey(gomock.Any(), scope, "key").Return(nil, repository.ErrIdempotencyKeyNotFound)
+			},
+			expErr: apperror.NewConflict("request with this idempotency key is in progress"),
+		},
+		{
+			name:         "key too long",
+			req:          &entity.IdempotentRequest{Key: string(make([]byte, 256))},
+			mockBehavior: func() {},
+			expErr:       apperror.NewBadReq("idempotency key is too long"),
+		},
+		{
+			name: "reserve err",
+			req:  req,
+			mockBehavior: func() {
+				repo.EXPECT().ReserveKey(gomock.Any(), scope, "key", bodyHash, gomock.Any()).Return(false, errMock)
+			},
+			expErr: apperror.NewInternal("failed to reserve idempotency key", errMock),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := srv.Begin(context.Background(), tc.req)
+
+			require.Equal(t, tc.expErr, err)
+			require.Equal(t, tc.expRes, res)
+		})
+	}
+}
+
+func TestIdempotencyComplete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockIdempotencyRepo(ctrl)
+
+	srv := service.NewIdempotencyService(repo, time.Hour, time.Hour)
+
+	req := &entity.IdempotentRequest{Key: "key", Operation: "POST /pvz", Caller: "user:1"}
+	scope := "POST /pvz user:1"
+	resp := &entity.IdempotentResponse{StatusCode: 201, Body: []byte(`{}`)}
+
+	t.Run("complete", func(t *testing.T) {
+		repo.EXPECT().SaveResponse(gomock.Any(), scope, "key", resp).Return(nil)
+
+		require.NoError(t, srv.Complete(context.Background(), req, resp))
+	})
+
+	t.Run("release", func(t *testing.T) {
+		repo.EXPECT().DeleteKey(gomock.Any(), scope, "key").Return(nil)
+
+		require.NoError(t, srv.Release(context.Background(), req))
+	})
+
+	t.Run("release err", func(t *testing.T) {
+		repo.EXPECT().DeleteKey(gomock.Any(), scope, "key").Return(errMock)
+
+		err := srv.Release(context.Background(), req)
+		require.Equal(t, apperror.NewInternal("failed to release idempotency key", errMock), err)
+	})
+}
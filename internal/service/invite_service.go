@@ -0,0 +1,108 @@
+//go:generate mockgen -source=./invite_service.go -destination=./mocks/invite_service.go -package=mocks
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/mailer"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/secret"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+)
+
+const (
+	inviteTokenLen    = 32
+	defaultInviteTTL  = 72 * time.Hour
+	inviteMailSubject = "Invitation to PVZ service"
+)
+
+type InviteRepo interface {
+	CreateInvite(ctx context.Context, req *request.CreateInvite, tokenHash string, expiresAt time.Time) (*entity.Invite, error)
+	AcceptInvite(ctx context.Context, tokenHash, password string) (*entity.User, error)
+}
+
+type Mailer interface {
+	Send(ctx context.Context, msg mailer.Message) error
+}
+
+type InviteServiceImpl struct {
+	repo InviteRepo
+
+	roleSrv RoleFinder
+	mailer  Mailer
+
+	ttl time.Duration
+}
+
+func NewInviteService(repo InviteRepo, roleSrv RoleFinder, mailer Mailer, ttl time.Duration) *InviteServiceImpl {
+	if ttl <= 0 {
+		ttl = defaultInviteTTL
+	}
+
+	return &InviteServiceImpl{
+		repo:    repo,
+		roleSrv: roleSrv,
+		mailer:  mailer,
+		ttl:     ttl,
+	}
+}
+
+// CreateInvite creates single-use invite and sends
+// its token to invited email. Token itself is not stored.
+func (s *InviteServiceImpl) CreateInvite(ctx context.Context, req *request.CreateInvite) (*entity.Invite, error) {
+	if err := checkRole(ctx, s.roleSrv, req.Role); err != nil {
+		return nil, err
+	}
+
+	token, err := secret.Generate(inviteTokenLen)
+	if err != nil {
+		return nil, apperror.NewInternal("failed to generate invite token", err)
+	}
+
+	invite, err := s.repo.CreateInvite(ctx, req, secret.Hash(token), time.Now().Add(s.ttl))
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrPvzNotFound):
+			return nil, apperror.NewBadReq(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to create invite", err)
+		}
+	}
+
+	err = s.mailer.Send(ctx, mailer.Message{
+		To:      invite.Email,
+		Subject: inviteMailSubject,
+		Body: fmt.Sprintf(
+			"You were invited to PVZ service as %s.\nInvite token: %s\nToken expires at %s.",
+			invite.Role, token, invite.ExpiresAt.Format(time.RFC1123Z),
+		),
+	})
+	if err != nil {
+		return nil, apperror.NewInternal("failed to send invite", err)
+	}
+
+	return invite, nil
+}
+
+// AcceptInvite registers user with invite's email and role.
+func (s *InviteServiceImpl) AcceptInvite(ctx context.Context, req *request.AcceptInvite) (*entity.User, error) {
+	res, err := s.repo.AcceptInvite(ctx, secret.Hash(req.Token), req.Password)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrInviteNotFound):
+			return nil, apperror.NewBadReq(err.Error())
+		case errors.Is(err, repository.ErrUserAlreadyExists):
+			return nil, apperror.NewBadReq(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to accept invite", err)
+		}
+	}
+
+	return res, nil
+}
@@ -0,0 +1,174 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/mailer"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/secret"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/service"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/service/mocks"
+)
+
+var mockInvite = &entity.Invite{
+	ID:        uuid.New(),
+	Email:     "invited@example.com",
+	Role:      entity.RoleModerator,
+	PvzIDs:    []uuid.UUID{uuid.New()},
+	ExpiresAt: time.Now().Add(time.Hour),
+}
+
+func TestCreateInvite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	inviteRepo := mocks.NewMockInviteRepo(ctrl)
+	roleSrv := mocks.NewMockRoleFinder(ctrl)
+	mailSrv := mocks.NewMockMailer(ctrl)
+
+	srv := service.NewInviteService(inviteRepo, roleSrv, mailSrv, time.Hour)
+
+	req := &request.CreateInvite{
+		Email:  mockInvite.Email,
+		Role:   string(mockInvite.Role),
+		PvzIDs: mockInvite.PvzIDs,
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		var tokenHash string
+		roleSrv.EXPECT().HasRole(gomock.Any(), mockInvite.Role).Return(true, nil)
+		inviteRepo.EXPECT().CreateInvite(gomock.Any(), req, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ *request.CreateInvite, hash string, _ time.Time) (*entity.Invite, error) {
+				tokenHash = hash
+				return mockInvite, nil
+			})
+		mailSrv.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, msg mailer.Message) error {
+				require.Equal(t, mockInvite.Email, msg.To)
+
+				// mail must contain token, which hash is stored
+				var token string
+				for _, line := range strings.Split(msg.Body, "\n") {
+					if v, ok := strings.CutPrefix(line, "Invite token: "); ok {
+						token = v
+					}
+				}
+				require.Equal(t, tokenHash, secret.Hash(token))
+				return nil
+			})
+
+		res, err := srv.CreateInvite(context.Background(), req)
+
+		require.NoError(t, err)
+		require.Equal(t, mockInvite, res)
+	})
+
+	t.Run("invalid role", func(t *testing.T) {
+		roleSrv.EXPECT().HasRole(gomock.Any(), mockInvite.Role).Return(false, nil)
+
+		res, err := srv.CreateInvite(context.Background(), req)
+
+		require.Nil(t, res)
+		require.Equal(t, apperror.NewBadReq("invalid role: moderator"), err)
+	})
+
+	t.Run("pvz not found", func(t *testing.T) {
+		roleSrv.EXPECT().HasRole(gomock.Any(), mockInvite.Role).Return(true, nil)
+		inviteRepo.EXPECT().CreateInvite(gomock.Any(), req, gomock.Any(), gomock.Any()).Return(nil, repository.ErrPvzNotFound)
+
+		res, err := srv.CreateInvite(context.Background(), req)
+
+		require.Nil(t, res)
+		require.Equal(t, apperror.NewBadReq(repository.ErrPvzNotFound.Error()), err)
+	})
+
+	t.Run("create err", func(t *testing.T) {
+		roleSrv.EXPECT().HasRole(gomock.Any(), mockInvite.Role).Return(true, nil)
+		inviteRepo.EXPECT().CreateInvite(gomock.Any(), req, gomock.Any(), gomock.Any()).Return(nil, errMock)
+
+		res, err := srv.CreateInvite(context.Background(), req)
+
+		require.Nil(t, res)
+		require.Equal(t, apperror.NewInternal("failed to create invite", errMock), err)
+	})
+
+	t.Run("send err", func(t *testing.T) {
+		roleSrv.EXPECT().HasRole(gomock.Any(), mockInvite.Role).Return(true, nil)
+		inviteRepo.EXPECT().CreateInvite(gomock.Any(), req, gomock.Any(), gomock.Any()).Return(mockInvite, nil)
+		mailSrv.EXPECT().Send(gomock.Any(), gomock.Any()).Return(errMock)
+
+		res, err := srv.CreateInvite(context.Background(), req)
+
+		require.Nil(t, res)
+		require.Equal(t, apperror.NewInternal("failed to send invite", errMock), err)
+	})
+}
+
+func TestAcceptInvite(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	inviteRepo := mocks.NewMockInviteRepo(ctrl)
+
+	srv := service.NewInviteService(inviteRepo, nil, nil, time.Hour)
+
+	req := &request.AcceptInvite{Token: "token", Password: "password"}
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expResp      *entity.User
+		expErr       error
+	}{
+		{
+			name: "OK",
+			mockBehavior: func() {
+				inviteRepo.EXPECT().AcceptInvite(gomock.Any(), secret.Hash(req.Token), req.Password).Return(mockUser, nil)
+			},
+			expResp: mockUser,
+			expErr:  nil,
+		},
+		{
+			name: "invite not found",
+			mockBehavior: func() {
+				inviteRepo.EXPECT().AcceptInvite(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, repository.ErrInviteNotFound)
+			},
+			expResp: nil,
+			expErr:  apperror.NewBadReq(repository.ErrInviteNotFound.Error()),
+		},
+		{
+			name: "user already exists",
+			mockBehavior: func() {
+				inviteRepo.EXPECT().AcceptInvite(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, repository.ErrUserAlreadyExists)
+			},
+			expResp: nil,
+			expErr:  apperror.NewBadReq(repository.ErrUserAlreadyExists.Error()),
+		},
+		{
+			name: "internal error",
+			mockBehavior: func() {
+				inviteRepo.EXPECT().AcceptInvite(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errMock)
+			},
+			expResp: nil,
+			expErr:  apperror.NewInternal("failed to accept invite", errMock),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := srv.AcceptInvite(context.Background(), req)
+
+			require.Equal(t, tc.expResp, res)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
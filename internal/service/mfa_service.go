@@ -0,0 +1,238 @@
+//go:generate mockgen -source=./mfa_service.go -destination=./mocks/mfa_service.go -package=mocks
+
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/secret"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/totp"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+)
+
+const (
+	recoveryCodesCount = 10
+	recoveryCodeLen    = 6
+	defaultMFAIssuer   = "PVZ"
+)
+
+type MFARepo interface {
+	GetUser(ctx context.Context, id uuid.UUID) (*entity.User, error)
+	SetSecret(ctx context.Context, id uuid.UUID, secret string) error
+	Enable(ctx context.Context, id uuid.UUID, codeHashes []string) error
+	UseRecoveryCode(ctx context.Context, userID uuid.UUID, codeHash string) error
+	UseTOTPStep(ctx context.Context, userID uuid.UUID, step int64) error
+}
+
+type MFAServiceImpl struct {
+	repo MFARepo
+
+	tokenSrv TokenService
+	auditor  Auditor
+	// limiter limits code attempts per user, so code
+	// can't be brute-forced within token lifetime.
+	limiter RateLimiter
+
+	issuer string
+}
+
+func NewMFAService(repo MFARepo, tokenSrv TokenService, auditor Auditor, limiter RateLimiter, issuer string) *MFAServiceImpl {
+	if issuer == "" {
+		issuer = defaultMFAIssuer
+	}
+
+	return &MFAServiceImpl{
+		repo:     repo,
+		tokenSrv: tokenSrv,
+		auditor:  auditor,
+		limiter:  limiter,
+		issuer:   issuer,
+	}
+}
+
+// Enroll generates new TOTP secret for user. MFA is enabled
+// only after the first code is verified.
+func (s *MFAServiceImpl) Enroll(ctx context.Context, userID uuid.UUID) (*response.MFAEnroll, error) {
+	usr, err := s.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if usr.MFAEnabled {
+		return nil, apperror.NewBadReq(repository.ErrMFAAlreadyEnabled.Error())
+	}
+
+	totpSecret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, apperror.NewInternal("failed to generate mfa secret", err)
+	}
+
+	if err := s.repo.SetSecret(ctx, userID, totpSecret); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrMFAAlreadyEnabled):
+			return nil, apperror.NewBadReq(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to save mfa secret", err)
+		}
+	}
+
+	return &response.MFAEnroll{
+		Secret: totpSecret,
+		URL:    totp.URL(s.issuer, usr.Email, totpSecret),
+	}, nil
+}
+
+// Verify checks code for pending secret and enables MFA.
+// Recovery codes are returned only here, storage keeps their hashes.
+func (s *MFAServiceImpl) Verify(ctx context.Context, userID uuid.UUID, req *request.VerifyMFA) (*response.MFARecoveryCodes, error) {
+	usr, err := s.getUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if usr.MFAEnabled {
+		return nil, apperror.NewBadReq(repository.ErrMFAAlreadyEnabled.Error())
+	}
+	if usr.MFASecret == "" {
+		return nil, apperror.NewBadReq(repository.ErrMFANotEnrolled.Error())
+	}
+	if ok, _ := s.limiter.Allow(userID.String()); !ok {
+		return nil, apperror.NewTooManyRequests("too many attempts")
+	}
+	step, ok := totp.Validate(usr.MFASecret, req.Code, time.Now())
+	if !ok {
+		return nil, apperror.NewBadReq("invalid code")
+	}
+	if err := s.repo.UseTOTPStep(ctx, userID, step); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrTOTPCodeUsed):
+			return nil, apperror.NewBadReq(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to use code", err)
+		}
+	}
+
+	codes := make([]string, recoveryCodesCount)
+	hashes := make([]string, recoveryCodesCount)
+	for i := range codes {
+		code, err := secret.Generate(recoveryCodeLen)
+		if err != nil {
+			return nil, apperror.NewInternal("failed to generate recovery code", err)
+		}
+		codes[i], hashes[i] = code, secret.Hash(code)
+	}
+
+	if err := s.repo.Enable(ctx, userID, hashes); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrMFANotEnrolled):
+			return nil, apperror.NewBadReq(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to enable mfa", err)
+		}
+	}
+
+	return &response.MFARecoveryCodes{
+		RecoveryCodes: codes,
+	}, nil
+}
+
+// Login exchanges MFA challenge token and TOTP
+// or recovery code for a regular token.
+func (s *MFAServiceImpl) Login(ctx context.Context, req *request.LoginMFA) (*response.Login, error) {
+	userID, err := s.tokenSrv.VerifyMFAToken(ctx, req.MFAToken)
+	if err != nil {
+		return nil, apperror.NewUnauthorized(err.Error())
+	}
+	// caller is not authenticated yet, so actor is set explicitly.
+	ctx = principal.NewContext(ctx, &principal.Principal{UserID: userID})
+
+	usr, recovery, err := s.checkCode(ctx, userID, req.Code)
+	if err != nil {
+		s.auditor.Record(ctx, entity.AuditLoginFailure, map[string]any{
+			"mfa":    true,
+			"reason": err.Error(),
+		})
+		return nil, err
+	}
+
+	ctx = principal.NewContext(ctx, &principal.Principal{UserID: usr.ID, Role: usr.Role})
+	s.auditor.Record(ctx, entity.AuditLoginSuccess, map[string]any{
+		"mfa":           true,
+		"recovery_code": recovery,
+	})
+
+	tokenStr, err := s.tokenSrv.CreateUserToken(usr.ID, string(usr.Role), usr.TokenVersion)
+	if err != nil {
+		return nil, apperror.NewInternal("failed to create token", err)
+	}
+
+	s.auditor.Record(ctx, entity.AuditTokenIssued, map[string]any{
+		"type": "user",
+	})
+	return &response.Login{
+		Token: tokenStr,
+	}, nil
+}
+
+// checkCode validates TOTP or recovery code for active user
+// with enabled MFA. It reports whether recovery code was used.
+// Each TOTP code is accepted only once.
+func (s *MFAServiceImpl) checkCode(ctx context.Context, userID uuid.UUID, code string) (*entity.User, bool, error) {
+	usr, err := s.getUser(ctx, userID)
+	if err != nil {
+		return nil, false, err
+	}
+	if !usr.Active {
+		return nil, false, apperror.NewUnauthorized("user is deactivated")
+	}
+	if !usr.MFAEnabled {
+		return nil, false, apperror.NewUnauthorized("mfa is not enabled")
+	}
+	if ok, _ := s.limiter.Allow(userID.String()); !ok {
+		return nil, false, apperror.NewTooManyRequests("too many attempts")
+	}
+
+	if step, ok := totp.Validate(usr.MFASecret, code, time.Now()); ok {
+		if err := s.repo.UseTOTPStep(ctx, userID, step); err != nil {
+			switch {
+			case errors.Is(err, repository.ErrTOTPCodeUsed):
+				return nil, false, apperror.NewUnauthorized(err.Error())
+			default:
+				return nil, false, apperror.NewInternal("failed to use code", err)
+			}
+		}
+		return usr, false, nil
+	}
+
+	if err := s.repo.UseRecoveryCode(ctx, userID, secret.Hash(code)); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrRecoveryCodeNotFound):
+			return nil, false, apperror.NewUnauthorized("invalid code")
+		default:
+			return nil, false, apperror.NewInternal("failed to check recovery code", err)
+		}
+	}
+
+	return usr, true, nil
+}
+
+func (s *MFAServiceImpl) getUser(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	usr, err := s.repo.GetUser(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrUserNotFound):
+			return nil, apperror.NewNotFound(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to get user", err)
+		}
+	}
+
+	return usr, nil
+}
@@ -0,0 +1,238 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/secret"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/totp"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/service"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/service/mocks"
+)
+
+func TestEnrollMFA(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockMFARepo(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	srv := service.NewMFAService(repo, nil, auditor, nil, "PVZ")
+
+	usr := &entity.User{ID: uuid.New(), Email: "mfa@example.com", Role: entity.RoleModerator, Active: true}
+
+	t.Run("OK", func(t *testing.T) {
+		var stored string
+		repo.EXPECT().GetUser(gomock.Any(), usr.ID).Return(usr, nil)
+		repo.EXPECT().SetSecret(gomock.Any(), usr.ID, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ uuid.UUID, s string) error {
+				stored = s
+				return nil
+			})
+
+		res, err := srv.Enroll(context.Background(), usr.ID)
+
+		require.NoError(t, err)
+		require.Equal(t, stored, res.Secret)
+		require.Contains(t, res.URL, "secret="+stored)
+	})
+
+	t.Run("already enabled", func(t *testing.T) {
+		repo.EXPECT().GetUser(gomock.Any(), usr.ID).Return(&entity.User{ID: usr.ID, MFAEnabled: true}, nil)
+
+		res, err := srv.Enroll(context.Background(), usr.ID)
+
+		require.Nil(t, res)
+		require.Equal(t, apperror.NewBadReq(repository.ErrMFAAlreadyEnabled.Error()), err)
+	})
+}
+
+func TestVerifyMFA(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockMFARepo(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	limiter := mocks.NewMockRateLimiter(ctrl)
+	srv := service.NewMFAService(repo, nil, auditor, limiter, "PVZ")
+
+	totpSecret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+	usr := &entity.User{ID: uuid.New(), Active: true, MFASecret: totpSecret}
+
+	t.Run("OK", func(t *testing.T) {
+		code, err := totp.Code(totpSecret, time.Now())
+		require.NoError(t, err)
+
+		var hashes []string
+		repo.EXPECT().GetUser(gomock.Any(), usr.ID).Return(usr, nil)
+		limiter.EXPECT().Allow(usr.ID.String()).Return(true, time.Duration(0))
+		repo.EXPECT().UseTOTPStep(gomock.Any(), usr.ID, gomock.Any()).Return(nil)
+		repo.EXPECT().Enable(gomock.Any(), usr.ID, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ uuid.UUID, h []string) error {
+				hashes = h
+				return nil
+			})
+
+		res, err := srv.Verify(context.Background(), usr.ID, &request.VerifyMFA{Code: code})
+
+		require.NoError(t, err)
+		require.Len(t, res.RecoveryCodes, len(hashes))
+		for i, c := range res.RecoveryCodes {
+			require.Equal(t, hashes[i], secret.Hash(c))
+		}
+	})
+
+	t.Run("invalid code", func(t *testing.T) {
+		repo.EXPECT().GetUser(gomock.Any(), usr.ID).Return(usr, nil)
+		limiter.EXPECT().Allow(usr.ID.String()).Return(true, time.Duration(0))
+
+		res, err := srv.Verify(context.Background(), usr.ID, &request.VerifyMFA{Code: "invalid"})
+
+		require.Nil(t, res)
+		require.Equal(t, apperror.NewBadReq("invalid code"), err)
+	})
+
+	t.Run("code reused", func(t *testing.T) {
+		code, err := totp.Code(totpSecret, time.Now())
+		require.NoError(t, err)
+
+		repo.EXPECT().GetUser(gomock.Any(), usr.ID).Return(usr, nil)
+		limiter.EXPECT().Allow(usr.ID.String()).Return(true, time.Duration(0))
+		repo.EXPECT().UseTOTPStep(gomock.Any(), usr.ID, gomock.Any()).Return(repository.ErrTOTPCodeUsed)
+
+		res, err := srv.Verify(context.Background(), usr.ID, &request.VerifyMFA{Code: code})
+
+		require.Nil(t, res)
+		require.Equal(t, apperror.NewBadReq(repository.ErrTOTPCodeUsed.Error()), err)
+	})
+
+	t.Run("too many attempts", func(t *testing.T) {
+		repo.EXPECT().GetUser(gomock.Any(), usr.ID).Return(usr, nil)
+		limiter.EXPECT().Allow(usr.ID.String()).Return(false, time.Minute)
+
+		res, err := srv.Verify(context.Background(), usr.ID, &request.VerifyMFA{Code: "123456"})
+
+		require.Nil(t, res)
+		require.Equal(t, apperror.NewTooManyRequests("too many attempts"), err)
+	})
+
+	t.Run("not enrolled", func(t *testing.T) {
+		repo.EXPECT().GetUser(gomock.Any(), usr.ID).Return(&entity.User{ID: usr.ID}, nil)
+
+		res, err := srv.Verify(context.Background(), usr.ID, &request.VerifyMFA{Code: "123456"})
+
+		require.Nil(t, res)
+		require.Equal(t, apperror.NewBadReq(repository.ErrMFANotEnrolled.Error()), err)
+	})
+}
+
+func TestLoginMFA(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockMFARepo(ctrl)
+	tokenSrv := mocks.NewMockTokenService(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	limiter := mocks.NewMockRateLimiter(ctrl)
+	srv := service.NewMFAService(repo, tokenSrv, auditor, limiter, "PVZ")
+
+	totpSecret, err := totp.GenerateSecret()
+	require.NoError(t, err)
+	usr := &entity.User{
+		ID:           uuid.New(),
+		Role:         entity.RoleModerator,
+		Active:       true,
+		TokenVersion: 2,
+		MFASecret:    totpSecret,
+		MFAEnabled:   true,
+	}
+
+	t.Run("OK totp", func(t *testing.T) {
+		code, err := totp.Code(totpSecret, time.Now())
+		require.NoError(t, err)
+
+		tokenSrv.EXPECT().VerifyMFAToken(gomock.Any(), "mfa-token").Return(usr.ID, nil)
+		repo.EXPECT().GetUser(gomock.Any(), usr.ID).Return(usr, nil)
+		limiter.EXPECT().Allow(usr.ID.String()).Return(true, time.Duration(0))
+		repo.EXPECT().UseTOTPStep(gomock.Any(), usr.ID, gomock.Any()).Return(nil)
+		tokenSrv.EXPECT().CreateUserToken(usr.ID, string(usr.Role), usr.TokenVersion).Return(tokenValid, nil)
+
+		res, err := srv.Login(context.Background(), &request.LoginMFA{MFAToken: "mfa-token", Code: code})
+
+		require.NoError(t, err)
+		require.Equal(t, &response.Login{Token: tokenValid}, res)
+	})
+
+	t.Run("totp replayed", func(t *testing.T) {
+		code, err := totp.Code(totpSecret, time.Now())
+		require.NoError(t, err)
+
+		tokenSrv.EXPECT().VerifyMFAToken(gomock.Any(), "mfa-token").Return(usr.ID, nil)
+		repo.EXPECT().GetUser(gomock.Any(), usr.ID).Return(usr, nil)
+		limiter.EXPECT().Allow(usr.ID.String()).Return(true, time.Duration(0))
+		repo.EXPECT().UseTOTPStep(gomock.Any(), usr.ID, gomock.Any()).Return(repository.ErrTOTPCodeUsed)
+
+		res, err := srv.Login(context.Background(), &request.LoginMFA{MFAToken: "mfa-token", Code: code})
+
+		require.Nil(t, res)
+		require.Equal(t, apperror.NewUnauthorized(repository.ErrTOTPCodeUsed.Error()), err)
+	})
+
+	t.Run("too many attempts", func(t *testing.T) {
+		tokenSrv.EXPECT().VerifyMFAToken(gomock.Any(), "mfa-token").Return(usr.ID, nil)
+		repo.EXPECT().GetUser(gomock.Any(), usr.ID).Return(usr, nil)
+		limiter.EXPECT().Allow(usr.ID.String()).Return(false, time.Minute)
+
+		res, err := srv.Login(context.Background(), &request.LoginMFA{MFAToken: "mfa-token", Code: "123456"})
+
+		require.Nil(t, res)
+		require.Equal(t, apperror.NewTooManyRequests("too many attempts"), err)
+	})
+
+	t.Run("OK recovery code", func(t *testing.T) {
+		tokenSrv.EXPECT().VerifyMFAToken(gomock.Any(), "mfa-token").Return(usr.ID, nil)
+		repo.EXPECT().GetUser(gomock.Any(), usr.ID).Return(usr, nil)
+		limiter.EXPECT().Allow(usr.ID.String()).Return(true, time.Duration(0))
+		repo.EXPECT().UseRecoveryCode(gomock.Any(), usr.ID, secret.Hash("recovery")).Return(nil)
+		tokenSrv.EXPECT().CreateUserToken(usr.ID, string(usr.Role), usr.TokenVersion).Return(tokenValid, nil)
+
+		res, err := srv.Login(context.Background(), &request.LoginMFA{MFAToken: "mfa-token", Code: "recovery"})
+
+		require.NoError(t, err)
+		require.Equal(t, &response.Login{Token: tokenValid}, res)
+	})
+
+	t.Run("invalid code", func(t *testing.T) {
+		tokenSrv.EXPECT().VerifyMFAToken(gomock.Any(), "mfa-token").Return(usr.ID, nil)
+		repo.EXPECT().GetUser(gomock.Any(), usr.ID).Return(usr, nil)
+		limiter.EXPECT().Allow(usr.ID.String()).Return(true, time.Duration(0))
+		repo.EXPECT().UseRecoveryCode(gomock.Any(), usr.ID, secret.Hash("invalid")).Return(repository.ErrRecoveryCodeNotFound)
+
+		res, err := srv.Login(context.Background(), &request.LoginMFA{MFAToken: "mfa-token", Code: "invalid"})
+
+		require.Nil(t, res)
+		require.Equal(t, apperror.NewUnauthorized("invalid code"), err)
+	})
+
+	t.Run("invalid token", func(t *testing.T) {
+		tokenSrv.EXPECT().VerifyMFAToken(gomock.Any(), "mfa-token").Return(uuid.Nil, errMock)
+
+		res, err := srv.Login(context.Background(), &request.LoginMFA{MFAToken: "mfa-token", Code: "123456"})
+
+		require.Nil(t, res)
+		require.Equal(t, apperror.NewUnauthorized(errMock.Error()), err)
+	})
+}
@@ -0,0 +1,82 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./city_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	request "github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	entity "github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+)
+
+// MockCityRepo is a mock of CityRepo interface.
+type MockCityRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockCityRepoMockRecorder
+}
+
+// MockCityRepoMockRecorder is the mock recorder for MockCityRepo.
+type MockCityRepoMockRecorder struct {
+	mock *MockCityRepo
+}
+
+// NewMockCityRepo creates a new mock instance.
+func NewMockCityRepo(ctrl *gomock.Controller) *MockCityRepo {
+	mock := &MockCityRepo{ctrl: ctrl}
+	mock.recorder = &MockCityRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCityRepo) EXPECT() *MockCityRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateCity mocks base method.
+func (m *MockCityRepo) CreateCity(ctx context.Context, req *request.CreateCity) (*entity.CityInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCity", ctx, req)
+	ret0, _ := ret[0].(*entity.CityInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCity indicates an expected call of CreateCity.
+func (mr *MockCityRepoMockRecorder) CreateCity(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCity", reflect.TypeOf((*MockCityRepo)(nil).CreateCity), ctx, req)
+}
+
+// ListCities mocks base method.
+func (m *MockCityRepo) ListCities(ctx context.Context, enabled *bool) ([]*entity.CityInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCities", ctx, enabled)
+	ret0, _ := ret[0].([]*entity.CityInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCities indicates an expected call of ListCities.
+func (mr *MockCityRepoMockRecorder) ListCities(ctx, enabled interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCities", reflect.TypeOf((*MockCityRepo)(nil).ListCities), ctx, enabled)
+}
+
+// UpdateCity mocks base method.
+func (m *MockCityRepo) UpdateCity(ctx context.Context, code string, req *request.UpdateCity) (*entity.CityInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCity", ctx, code, req)
+	ret0, _ := ret[0].(*entity.CityInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCity indicates an expected call of UpdateCity.
+func (mr *MockCityRepoMockRecorder) UpdateCity(ctx, code, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCity", reflect.TypeOf((*MockCityRepo)(nil).UpdateCity), ctx, code, req)
+}
@@ -0,0 +1,84 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./product_expiry_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	entity "github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+)
+
+// MockProductExpiryRepo is a mock of ProductExpiryRepo interface.
+type MockProductExpiryRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockProductExpiryRepoMockRecorder
+}
+
+// MockProductExpiryRepoMockRecorder is the mock recorder for MockProductExpiryRepo.
+type MockProductExpiryRepoMockRecorder struct {
+	mock *MockProductExpiryRepo
+}
+
+// NewMockProductExpiryRepo creates a new mock instance.
+func NewMockProductExpiryRepo(ctrl *gomock.Controller) *MockProductExpiryRepo {
+	mock := &MockProductExpiryRepo{ctrl: ctrl}
+	mock.recorder = &MockProductExpiryRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProductExpiryRepo) EXPECT() *MockProductExpiryRepoMockRecorder {
+	return m.recorder
+}
+
+// GetPvzExpiryStats mocks base method.
+func (m *MockProductExpiryRepo) GetPvzExpiryStats(ctx context.Context, pvzID uuid.UUID, defaultBefore time.Time, typeBefores map[entity.ProductType]time.Time) (int64, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPvzExpiryStats", ctx, pvzID, defaultBefore, typeBefores)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPvzExpiryStats indicates an expected call of GetPvzExpiryStats.
+func (mr *MockProductExpiryRepoMockRecorder) GetPvzExpiryStats(ctx, pvzID, defaultBefore, typeBefores interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPvzExpiryStats", reflect.TypeOf((*MockProductExpiryRepo)(nil).GetPvzExpiryStats), ctx, pvzID, defaultBefore, typeBefores)
+}
+
+// ListStoredProductsBefore mocks base method.
+func (m *MockProductExpiryRepo) ListStoredProductsBefore(ctx context.Context, before time.Time) ([]*entity.StoredProduct, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStoredProductsBefore", ctx, before)
+	ret0, _ := ret[0].([]*entity.StoredProduct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStoredProductsBefore indicates an expected call of ListStoredProductsBefore.
+func (mr *MockProductExpiryRepoMockRecorder) ListStoredProductsBefore(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoredProductsBefore", reflect.TypeOf((*MockProductExpiryRepo)(nil).ListStoredProductsBefore), ctx, before)
+}
+
+// UpdateProductState mocks base method.
+func (m *MockProductExpiryRepo) UpdateProductState(ctx context.Context, id uuid.UUID, from, to entity.ProductState, event entity.ProductEventType, actorID uuid.UUID, details map[string]any) (*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProductState", ctx, id, from, to, event, actorID, details)
+	ret0, _ := ret[0].(*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProductState indicates an expected call of UpdateProductState.
+func (mr *MockProductExpiryRepoMockRecorder) UpdateProductState(ctx, id, from, to, event, actorID, details interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductState", reflect.TypeOf((*MockProductExpiryRepo)(nil).UpdateProductState), ctx, id, from, to, event, actorID, details)
+}
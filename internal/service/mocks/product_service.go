@@ -0,0 +1,165 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./product_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	entity "github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+)
+
+// MockProductRepo is a mock of ProductRepo interface.
+type MockProductRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockProductRepoMockRecorder
+}
+
+// MockProductRepoMockRecorder is the mock recorder for MockProductRepo.
+type MockProductRepoMockRecorder struct {
+	mock *MockProductRepo
+}
+
+// NewMockProductRepo creates a new mock instance.
+func NewMockProductRepo(ctrl *gomock.Controller) *MockProductRepo {
+	mock := &MockProductRepo{ctrl: ctrl}
+	mock.recorder = &MockProductRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProductRepo) EXPECT() *MockProductRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateReturnShipment mocks base method.
+func (m *MockProductRepo) CreateReturnShipment(ctx context.Context, pvzID, createdBy uuid.UUID) (*entity.ReturnShipment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReturnShipment", ctx, pvzID, createdBy)
+	ret0, _ := ret[0].(*entity.ReturnShipment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReturnShipment indicates an expected call of CreateReturnShipment.
+func (mr *MockProductRepoMockRecorder) CreateReturnShipment(ctx, pvzID, createdBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReturnShipment", reflect.TypeOf((*MockProductRepo)(nil).CreateReturnShipment), ctx, pvzID, createdBy)
+}
+
+// GetProduct mocks base method.
+func (m *MockProductRepo) GetProduct(ctx context.Context, id uuid.UUID) (*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProduct", ctx, id)
+	ret0, _ := ret[0].(*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProduct indicates an expected call of GetProduct.
+func (mr *MockProductRepoMockRecorder) GetProduct(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProduct", reflect.TypeOf((*MockProductRepo)(nil).GetProduct), ctx, id)
+}
+
+// ListProductEvents mocks base method.
+func (m *MockProductRepo) ListProductEvents(ctx context.Context, productID uuid.UUID) ([]*entity.ProductEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProductEvents", ctx, productID)
+	ret0, _ := ret[0].([]*entity.ProductEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProductEvents indicates an expected call of ListProductEvents.
+func (mr *MockProductRepoMockRecorder) ListProductEvents(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProductEvents", reflect.TypeOf((*MockProductRepo)(nil).ListProductEvents), ctx, productID)
+}
+
+// ListPvzStock mocks base method.
+func (m *MockProductRepo) ListPvzStock(ctx context.Context, pvzID uuid.UUID, page, limit int) ([]*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPvzStock", ctx, pvzID, page, limit)
+	ret0, _ := ret[0].([]*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPvzStock indicates an expected call of ListPvzStock.
+func (mr *MockProductRepoMockRecorder) ListPvzStock(ctx, pvzID, page, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPvzStock", reflect.TypeOf((*MockProductRepo)(nil).ListPvzStock), ctx, pvzID, page, limit)
+}
+
+// SetPickupCode mocks base method.
+func (m *MockProductRepo) SetPickupCode(ctx context.Context, id uuid.UUID, codeHash string) (*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPickupCode", ctx, id, codeHash)
+	ret0, _ := ret[0].(*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetPickupCode indicates an expected call of SetPickupCode.
+func (mr *MockProductRepoMockRecorder) SetPickupCode(ctx, id, codeHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPickupCode", reflect.TypeOf((*MockProductRepo)(nil).SetPickupCode), ctx, id, codeHash)
+}
+
+// UpdateProductState mocks base method.
+func (m *MockProductRepo) UpdateProductState(ctx context.Context, id uuid.UUID, from, to entity.ProductState, event entity.ProductEventType, actorID uuid.UUID, details map[string]any) (*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProductState", ctx, id, from, to, event, actorID, details)
+	ret0, _ := ret[0].(*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProductState indicates an expected call of UpdateProductState.
+func (mr *MockProductRepoMockRecorder) UpdateProductState(ctx, id, from, to, event, actorID, details interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductState", reflect.TypeOf((*MockProductRepo)(nil).UpdateProductState), ctx, id, from, to, event, actorID, details)
+}
+
+// MockReceptionGetter is a mock of ReceptionGetter interface.
+type MockReceptionGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockReceptionGetterMockRecorder
+}
+
+// MockReceptionGetterMockRecorder is the mock recorder for MockReceptionGetter.
+type MockReceptionGetterMockRecorder struct {
+	mock *MockReceptionGetter
+}
+
+// NewMockReceptionGetter creates a new mock instance.
+func NewMockReceptionGetter(ctrl *gomock.Controller) *MockReceptionGetter {
+	mock := &MockReceptionGetter{ctrl: ctrl}
+	mock.recorder = &MockReceptionGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReceptionGetter) EXPECT() *MockReceptionGetterMockRecorder {
+	return m.recorder
+}
+
+// GetProductReception mocks base method.
+func (m *MockReceptionGetter) GetProductReception(ctx context.Context, productID uuid.UUID) (*entity.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductReception", ctx, productID)
+	ret0, _ := ret[0].(*entity.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductReception indicates an expected call of GetProductReception.
+func (mr *MockReceptionGetterMockRecorder) GetProductReception(ctx, productID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductReception", reflect.TypeOf((*MockReceptionGetter)(nil).GetProductReception), ctx, productID)
+}
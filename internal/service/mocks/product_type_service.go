@@ -0,0 +1,96 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./product_type_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	request "github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	entity "github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+)
+
+// MockProductTypeRepo is a mock of ProductTypeRepo interface.
+type MockProductTypeRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockProductTypeRepoMockRecorder
+}
+
+// MockProductTypeRepoMockRecorder is the mock recorder for MockProductTypeRepo.
+type MockProductTypeRepoMockRecorder struct {
+	mock *MockProductTypeRepo
+}
+
+// NewMockProductTypeRepo creates a new mock instance.
+func NewMockProductTypeRepo(ctrl *gomock.Controller) *MockProductTypeRepo {
+	mock := &MockProductTypeRepo{ctrl: ctrl}
+	mock.recorder = &MockProductTypeRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProductTypeRepo) EXPECT() *MockProductTypeRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateProductType mocks base method.
+func (m *MockProductTypeRepo) CreateProductType(ctx context.Context, req *request.CreateProductType) (*entity.ProductTypeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProductType", ctx, req)
+	ret0, _ := ret[0].(*entity.ProductTypeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProductType indicates an expected call of CreateProductType.
+func (mr *MockProductTypeRepoMockRecorder) CreateProductType(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProductType", reflect.TypeOf((*MockProductTypeRepo)(nil).CreateProductType), ctx, req)
+}
+
+// DeleteProductType mocks base method.
+func (m *MockProductTypeRepo) DeleteProductType(ctx context.Context, code string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProductType", ctx, code)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteProductType indicates an expected call of DeleteProductType.
+func (mr *MockProductTypeRepoMockRecorder) DeleteProductType(ctx, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductType", reflect.TypeOf((*MockProductTypeRepo)(nil).DeleteProductType), ctx, code)
+}
+
+// ListProductTypes mocks base method.
+func (m *MockProductTypeRepo) ListProductTypes(ctx context.Context) ([]*entity.ProductTypeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProductTypes", ctx)
+	ret0, _ := ret[0].([]*entity.ProductTypeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProductTypes indicates an expected call of ListProductTypes.
+func (mr *MockProductTypeRepoMockRecorder) ListProductTypes(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProductTypes", reflect.TypeOf((*MockProductTypeRepo)(nil).ListProductTypes), ctx)
+}
+
+// UpdateProductType mocks base method.
+func (m *MockProductTypeRepo) UpdateProductType(ctx context.Context, code string, req *request.UpdateProductType) (*entity.ProductTypeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProductType", ctx, code, req)
+	ret0, _ := ret[0].(*entity.ProductTypeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProductType indicates an expected call of UpdateProductType.
+func (mr *MockProductTypeRepoMockRecorder) UpdateProductType(ctx, code, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProductType", reflect.TypeOf((*MockProductTypeRepo)(nil).UpdateProductType), ctx, code, req)
+}
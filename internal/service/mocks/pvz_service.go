@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
 	request "github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
 	entity "github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
 )
@@ -51,6 +52,36 @@ func (mr *MockPvzRepoMockRecorder) CreatePvz(ctx, req interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePvz", reflect.TypeOf((*MockPvzRepo)(nil).CreatePvz), ctx, req)
 }
 
+// GetPvz mocks base method.
+func (m *MockPvzRepo) GetPvz(ctx context.Context, id uuid.UUID) (*entity.Pvz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPvz", ctx, id)
+	ret0, _ := ret[0].(*entity.Pvz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPvz indicates an expected call of GetPvz.
+func (mr *MockPvzRepoMockRecorder) GetPvz(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPvz", reflect.TypeOf((*MockPvzRepo)(nil).GetPvz), ctx, id)
+}
+
+// ListPvzStockCounts mocks base method.
+func (m *MockPvzRepo) ListPvzStockCounts(ctx context.Context) ([]*entity.Pvz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPvzStockCounts", ctx)
+	ret0, _ := ret[0].([]*entity.Pvz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPvzStockCounts indicates an expected call of ListPvzStockCounts.
+func (mr *MockPvzRepoMockRecorder) ListPvzStockCounts(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPvzStockCounts", reflect.TypeOf((*MockPvzRepo)(nil).ListPvzStockCounts), ctx)
+}
+
 // SearchPvz mocks base method.
 func (m *MockPvzRepo) SearchPvz(ctx context.Context, req *request.SearchPvz) ([]*entity.Pvz, error) {
 	m.ctrl.T.Helper()
@@ -65,3 +96,33 @@ func (mr *MockPvzRepoMockRecorder) SearchPvz(ctx, req interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPvz", reflect.TypeOf((*MockPvzRepo)(nil).SearchPvz), ctx, req)
 }
+
+// UpdatePvzCapacity mocks base method.
+func (m *MockPvzRepo) UpdatePvzCapacity(ctx context.Context, id uuid.UUID, req *request.UpdatePvzCapacity) (*entity.Pvz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePvzCapacity", ctx, id, req)
+	ret0, _ := ret[0].(*entity.Pvz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePvzCapacity indicates an expected call of UpdatePvzCapacity.
+func (mr *MockPvzRepoMockRecorder) UpdatePvzCapacity(ctx, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePvzCapacity", reflect.TypeOf((*MockPvzRepo)(nil).UpdatePvzCapacity), ctx, id, req)
+}
+
+// UpdatePvzStatus mocks base method.
+func (m *MockPvzRepo) UpdatePvzStatus(ctx context.Context, id uuid.UUID, req *request.UpdatePvz, changedBy uuid.UUID) (*entity.Pvz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePvzStatus", ctx, id, req, changedBy)
+	ret0, _ := ret[0].(*entity.Pvz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePvzStatus indicates an expected call of UpdatePvzStatus.
+func (mr *MockPvzRepoMockRecorder) UpdatePvzStatus(ctx, id, req, changedBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePvzStatus", reflect.TypeOf((*MockPvzRepo)(nil).UpdatePvzStatus), ctx, id, req, changedBy)
+}
@@ -7,6 +7,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	uuid "github.com/google/uuid"
@@ -52,6 +53,51 @@ func (mr *MockReceptionRepoMockRecorder) AddProductToReception(ctx, req, recepti
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProductToReception", reflect.TypeOf((*MockReceptionRepo)(nil).AddProductToReception), ctx, req, receptionID)
 }
 
+// AddProductsToReception mocks base method.
+func (m *MockReceptionRepo) AddProductsToReception(ctx context.Context, receptionID uuid.UUID, products []request.BatchProduct) ([]*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddProductsToReception", ctx, receptionID, products)
+	ret0, _ := ret[0].([]*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddProductsToReception indicates an expected call of AddProductsToReception.
+func (mr *MockReceptionRepoMockRecorder) AddProductsToReception(ctx, receptionID, products interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddProductsToReception", reflect.TypeOf((*MockReceptionRepo)(nil).AddProductsToReception), ctx, receptionID, products)
+}
+
+// CountDamagedProducts mocks base method.
+func (m *MockReceptionRepo) CountDamagedProducts(ctx context.Context, receptionIDs []uuid.UUID) (map[uuid.UUID]entity.DamageCounts, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountDamagedProducts", ctx, receptionIDs)
+	ret0, _ := ret[0].(map[uuid.UUID]entity.DamageCounts)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountDamagedProducts indicates an expected call of CountDamagedProducts.
+func (mr *MockReceptionRepoMockRecorder) CountDamagedProducts(ctx, receptionIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountDamagedProducts", reflect.TypeOf((*MockReceptionRepo)(nil).CountDamagedProducts), ctx, receptionIDs)
+}
+
+// CountProductsByType mocks base method.
+func (m *MockReceptionRepo) CountProductsByType(ctx context.Context, receptionIDs []uuid.UUID) (map[uuid.UUID]map[entity.ProductType]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountProductsByType", ctx, receptionIDs)
+	ret0, _ := ret[0].(map[uuid.UUID]map[entity.ProductType]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountProductsByType indicates an expected call of CountProductsByType.
+func (mr *MockReceptionRepoMockRecorder) CountProductsByType(ctx, receptionIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountProductsByType", reflect.TypeOf((*MockReceptionRepo)(nil).CountProductsByType), ctx, receptionIDs)
+}
+
 // CreateReception mocks base method.
 func (m *MockReceptionRepo) CreateReception(ctx context.Context, req *request.CreateReception) (*entity.Reception, error) {
 	m.ctrl.T.Helper()
@@ -81,6 +127,21 @@ func (mr *MockReceptionRepoMockRecorder) DeleteProductInReception(ctx, productID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProductInReception", reflect.TypeOf((*MockReceptionRepo)(nil).DeleteProductInReception), ctx, productID)
 }
 
+// FindProductsByBarcodes mocks base method.
+func (m *MockReceptionRepo) FindProductsByBarcodes(ctx context.Context, barcodes []string, receptionID, pvzID uuid.UUID, since time.Time) ([]*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindProductsByBarcodes", ctx, barcodes, receptionID, pvzID, since)
+	ret0, _ := ret[0].([]*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindProductsByBarcodes indicates an expected call of FindProductsByBarcodes.
+func (mr *MockReceptionRepoMockRecorder) FindProductsByBarcodes(ctx, barcodes, receptionID, pvzID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProductsByBarcodes", reflect.TypeOf((*MockReceptionRepo)(nil).FindProductsByBarcodes), ctx, barcodes, receptionID, pvzID, since)
+}
+
 // FinishReception mocks base method.
 func (m *MockReceptionRepo) FinishReception(ctx context.Context, pvzID uuid.UUID) (*entity.Reception, error) {
 	m.ctrl.T.Helper()
@@ -96,6 +157,36 @@ func (mr *MockReceptionRepoMockRecorder) FinishReception(ctx, pvzID interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishReception", reflect.TypeOf((*MockReceptionRepo)(nil).FinishReception), ctx, pvzID)
 }
 
+// FinishReceptionWithReconciliation mocks base method.
+func (m *MockReceptionRepo) FinishReceptionWithReconciliation(ctx context.Context, receptionID uuid.UUID, rec *entity.Reconciliation) (*entity.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishReceptionWithReconciliation", ctx, receptionID, rec)
+	ret0, _ := ret[0].(*entity.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishReceptionWithReconciliation indicates an expected call of FinishReceptionWithReconciliation.
+func (mr *MockReceptionRepoMockRecorder) FinishReceptionWithReconciliation(ctx, receptionID, rec interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishReceptionWithReconciliation", reflect.TypeOf((*MockReceptionRepo)(nil).FinishReceptionWithReconciliation), ctx, receptionID, rec)
+}
+
+// GetLastClosedReception mocks base method.
+func (m *MockReceptionRepo) GetLastClosedReception(ctx context.Context, pvzID uuid.UUID) (*entity.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastClosedReception", ctx, pvzID)
+	ret0, _ := ret[0].(*entity.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastClosedReception indicates an expected call of GetLastClosedReception.
+func (mr *MockReceptionRepoMockRecorder) GetLastClosedReception(ctx, pvzID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastClosedReception", reflect.TypeOf((*MockReceptionRepo)(nil).GetLastClosedReception), ctx, pvzID)
+}
+
 // GetLastOpenReception mocks base method.
 func (m *MockReceptionRepo) GetLastOpenReception(ctx context.Context, pvzID uuid.UUID) (*entity.Reception, error) {
 	m.ctrl.T.Helper()
@@ -126,6 +217,142 @@ func (mr *MockReceptionRepoMockRecorder) GetLastProductInReception(ctx, receptio
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastProductInReception", reflect.TypeOf((*MockReceptionRepo)(nil).GetLastProductInReception), ctx, receptionID)
 }
 
+// GetProductInReceptionByBarcode mocks base method.
+func (m *MockReceptionRepo) GetProductInReceptionByBarcode(ctx context.Context, receptionID uuid.UUID, barcode string) (*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductInReceptionByBarcode", ctx, receptionID, barcode)
+	ret0, _ := ret[0].(*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductInReceptionByBarcode indicates an expected call of GetProductInReceptionByBarcode.
+func (mr *MockReceptionRepoMockRecorder) GetProductInReceptionByBarcode(ctx, receptionID, barcode interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductInReceptionByBarcode", reflect.TypeOf((*MockReceptionRepo)(nil).GetProductInReceptionByBarcode), ctx, receptionID, barcode)
+}
+
+// GetProductsInReception mocks base method.
+func (m *MockReceptionRepo) GetProductsInReception(ctx context.Context, receptionID uuid.UUID) ([]*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductsInReception", ctx, receptionID)
+	ret0, _ := ret[0].([]*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductsInReception indicates an expected call of GetProductsInReception.
+func (mr *MockReceptionRepoMockRecorder) GetProductsInReception(ctx, receptionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductsInReception", reflect.TypeOf((*MockReceptionRepo)(nil).GetProductsInReception), ctx, receptionID)
+}
+
+// GetProductsInReceptionLIFO mocks base method.
+func (m *MockReceptionRepo) GetProductsInReceptionLIFO(ctx context.Context, receptionID uuid.UUID) ([]*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductsInReceptionLIFO", ctx, receptionID)
+	ret0, _ := ret[0].([]*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductsInReceptionLIFO indicates an expected call of GetProductsInReceptionLIFO.
+func (mr *MockReceptionRepoMockRecorder) GetProductsInReceptionLIFO(ctx, receptionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductsInReceptionLIFO", reflect.TypeOf((*MockReceptionRepo)(nil).GetProductsInReceptionLIFO), ctx, receptionID)
+}
+
+// GetPvzStats mocks base method.
+func (m *MockReceptionRepo) GetPvzStats(ctx context.Context, pvzID uuid.UUID, since time.Time) (int64, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPvzStats", ctx, pvzID, since)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPvzStats indicates an expected call of GetPvzStats.
+func (mr *MockReceptionRepoMockRecorder) GetPvzStats(ctx, pvzID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPvzStats", reflect.TypeOf((*MockReceptionRepo)(nil).GetPvzStats), ctx, pvzID, since)
+}
+
+// GetReceptionByID mocks base method.
+func (m *MockReceptionRepo) GetReceptionByID(ctx context.Context, id uuid.UUID) (*entity.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReceptionByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReceptionByID indicates an expected call of GetReceptionByID.
+func (mr *MockReceptionRepoMockRecorder) GetReceptionByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceptionByID", reflect.TypeOf((*MockReceptionRepo)(nil).GetReceptionByID), ctx, id)
+}
+
+// GetReceptionManifest mocks base method.
+func (m *MockReceptionRepo) GetReceptionManifest(ctx context.Context, receptionID uuid.UUID) (*entity.ReceptionManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReceptionManifest", ctx, receptionID)
+	ret0, _ := ret[0].(*entity.ReceptionManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReceptionManifest indicates an expected call of GetReceptionManifest.
+func (mr *MockReceptionRepoMockRecorder) GetReceptionManifest(ctx, receptionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReceptionManifest", reflect.TypeOf((*MockReceptionRepo)(nil).GetReceptionManifest), ctx, receptionID)
+}
+
+// GetReconciliation mocks base method.
+func (m *MockReceptionRepo) GetReconciliation(ctx context.Context, receptionID uuid.UUID) (*entity.Reconciliation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReconciliation", ctx, receptionID)
+	ret0, _ := ret[0].(*entity.Reconciliation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReconciliation indicates an expected call of GetReconciliation.
+func (mr *MockReceptionRepoMockRecorder) GetReconciliation(ctx, receptionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReconciliation", reflect.TypeOf((*MockReceptionRepo)(nil).GetReconciliation), ctx, receptionID)
+}
+
+// ListPvzReceptions mocks base method.
+func (m *MockReceptionRepo) ListPvzReceptions(ctx context.Context, req *request.ListReceptions) ([]*entity.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPvzReceptions", ctx, req)
+	ret0, _ := ret[0].([]*entity.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPvzReceptions indicates an expected call of ListPvzReceptions.
+func (mr *MockReceptionRepoMockRecorder) ListPvzReceptions(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPvzReceptions", reflect.TypeOf((*MockReceptionRepo)(nil).ListPvzReceptions), ctx, req)
+}
+
+// SearchProductsByBarcode mocks base method.
+func (m *MockReceptionRepo) SearchProductsByBarcode(ctx context.Context, barcode string, pvzIDs []uuid.UUID, since time.Time) ([]*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchProductsByBarcode", ctx, barcode, pvzIDs, since)
+	ret0, _ := ret[0].([]*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchProductsByBarcode indicates an expected call of SearchProductsByBarcode.
+func (mr *MockReceptionRepoMockRecorder) SearchProductsByBarcode(ctx, barcode, pvzIDs, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchProductsByBarcode", reflect.TypeOf((*MockReceptionRepo)(nil).SearchProductsByBarcode), ctx, barcode, pvzIDs, since)
+}
+
 // SearchReceptions mocks base method.
 func (m *MockReceptionRepo) SearchReceptions(ctx context.Context, req *request.SearchPvz, pvzIDs []uuid.UUID) ([]*entity.Reception, error) {
 	m.ctrl.T.Helper()
@@ -141,6 +368,21 @@ func (mr *MockReceptionRepoMockRecorder) SearchReceptions(ctx, req, pvzIDs inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchReceptions", reflect.TypeOf((*MockReceptionRepo)(nil).SearchReceptions), ctx, req, pvzIDs)
 }
 
+// UpdateReceptionStatus mocks base method.
+func (m *MockReceptionRepo) UpdateReceptionStatus(ctx context.Context, id uuid.UUID, from, to entity.Status, changedBy uuid.UUID, reason string) (*entity.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReceptionStatus", ctx, id, from, to, changedBy, reason)
+	ret0, _ := ret[0].(*entity.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateReceptionStatus indicates an expected call of UpdateReceptionStatus.
+func (mr *MockReceptionRepoMockRecorder) UpdateReceptionStatus(ctx, id, from, to, changedBy, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReceptionStatus", reflect.TypeOf((*MockReceptionRepo)(nil).UpdateReceptionStatus), ctx, id, from, to, changedBy, reason)
+}
+
 // MockPvzFinder is a mock of PvzFinder interface.
 type MockPvzFinder struct {
 	ctrl     *gomock.Controller
@@ -164,6 +406,21 @@ func (m *MockPvzFinder) EXPECT() *MockPvzFinderMockRecorder {
 	return m.recorder
 }
 
+// GetPvz mocks base method.
+func (m *MockPvzFinder) GetPvz(ctx context.Context, id uuid.UUID) (*entity.Pvz, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPvz", ctx, id)
+	ret0, _ := ret[0].(*entity.Pvz)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPvz indicates an expected call of GetPvz.
+func (mr *MockPvzFinderMockRecorder) GetPvz(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPvz", reflect.TypeOf((*MockPvzFinder)(nil).GetPvz), ctx, id)
+}
+
 // SearchPvz mocks base method.
 func (m *MockPvzFinder) SearchPvz(ctx context.Context, req *request.SearchPvz) ([]*entity.Pvz, error) {
 	m.ctrl.T.Helper()
@@ -178,3 +435,118 @@ func (mr *MockPvzFinderMockRecorder) SearchPvz(ctx, req interface{}) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPvz", reflect.TypeOf((*MockPvzFinder)(nil).SearchPvz), ctx, req)
 }
+
+// MockProductTypeFinder is a mock of ProductTypeFinder interface.
+type MockProductTypeFinder struct {
+	ctrl     *gomock.Controller
+	recorder *MockProductTypeFinderMockRecorder
+}
+
+// MockProductTypeFinderMockRecorder is the mock recorder for MockProductTypeFinder.
+type MockProductTypeFinderMockRecorder struct {
+	mock *MockProductTypeFinder
+}
+
+// NewMockProductTypeFinder creates a new mock instance.
+func NewMockProductTypeFinder(ctrl *gomock.Controller) *MockProductTypeFinder {
+	mock := &MockProductTypeFinder{ctrl: ctrl}
+	mock.recorder = &MockProductTypeFinderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProductTypeFinder) EXPECT() *MockProductTypeFinderMockRecorder {
+	return m.recorder
+}
+
+// GetProductType mocks base method.
+func (m *MockProductTypeFinder) GetProductType(ctx context.Context, name string) (*entity.ProductTypeInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProductType", ctx, name)
+	ret0, _ := ret[0].(*entity.ProductTypeInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProductType indicates an expected call of GetProductType.
+func (mr *MockProductTypeFinderMockRecorder) GetProductType(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProductType", reflect.TypeOf((*MockProductTypeFinder)(nil).GetProductType), ctx, name)
+}
+
+// MockExpiryCounter is a mock of ExpiryCounter interface.
+type MockExpiryCounter struct {
+	ctrl     *gomock.Controller
+	recorder *MockExpiryCounterMockRecorder
+}
+
+// MockExpiryCounterMockRecorder is the mock recorder for MockExpiryCounter.
+type MockExpiryCounterMockRecorder struct {
+	mock *MockExpiryCounter
+}
+
+// NewMockExpiryCounter creates a new mock instance.
+func NewMockExpiryCounter(ctrl *gomock.Controller) *MockExpiryCounter {
+	mock := &MockExpiryCounter{ctrl: ctrl}
+	mock.recorder = &MockExpiryCounterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExpiryCounter) EXPECT() *MockExpiryCounterMockRecorder {
+	return m.recorder
+}
+
+// CountExpiring mocks base method.
+func (m *MockExpiryCounter) CountExpiring(ctx context.Context, pvz *entity.Pvz) (int64, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountExpiring", ctx, pvz)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CountExpiring indicates an expected call of CountExpiring.
+func (mr *MockExpiryCounterMockRecorder) CountExpiring(ctx, pvz interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountExpiring", reflect.TypeOf((*MockExpiryCounter)(nil).CountExpiring), ctx, pvz)
+}
+
+// MockCellAllocator is a mock of CellAllocator interface.
+type MockCellAllocator struct {
+	ctrl     *gomock.Controller
+	recorder *MockCellAllocatorMockRecorder
+}
+
+// MockCellAllocatorMockRecorder is the mock recorder for MockCellAllocator.
+type MockCellAllocatorMockRecorder struct {
+	mock *MockCellAllocator
+}
+
+// NewMockCellAllocator creates a new mock instance.
+func NewMockCellAllocator(ctrl *gomock.Controller) *MockCellAllocator {
+	mock := &MockCellAllocator{ctrl: ctrl}
+	mock.recorder = &MockCellAllocatorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCellAllocator) EXPECT() *MockCellAllocatorMockRecorder {
+	return m.recorder
+}
+
+// AssignStorageCell mocks base method.
+func (m *MockCellAllocator) AssignStorageCell(ctx context.Context, pvzID uuid.UUID, product *entity.Product) (*entity.StorageCell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignStorageCell", ctx, pvzID, product)
+	ret0, _ := ret[0].(*entity.StorageCell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignStorageCell indicates an expected call of AssignStorageCell.
+func (mr *MockCellAllocatorMockRecorder) AssignStorageCell(ctx, pvzID, product interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignStorageCell", reflect.TypeOf((*MockCellAllocator)(nil).AssignStorageCell), ctx, pvzID, product)
+}
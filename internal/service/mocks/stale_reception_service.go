@@ -0,0 +1,155 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./stale_reception_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	request "github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	entity "github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+)
+
+// MockStaleReceptionRepo is a mock of StaleReceptionRepo interface.
+type MockStaleReceptionRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockStaleReceptionRepoMockRecorder
+}
+
+// MockStaleReceptionRepoMockRecorder is the mock recorder for MockStaleReceptionRepo.
+type MockStaleReceptionRepoMockRecorder struct {
+	mock *MockStaleReceptionRepo
+}
+
+// NewMockStaleReceptionRepo creates a new mock instance.
+func NewMockStaleReceptionRepo(ctrl *gomock.Controller) *MockStaleReceptionRepo {
+	mock := &MockStaleReceptionRepo{ctrl: ctrl}
+	mock.recorder = &MockStaleReceptionRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStaleReceptionRepo) EXPECT() *MockStaleReceptionRepoMockRecorder {
+	return m.recorder
+}
+
+// ListOpenReceptionsBefore mocks base method.
+func (m *MockStaleReceptionRepo) ListOpenReceptionsBefore(ctx context.Context, before time.Time) ([]*entity.OpenReception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOpenReceptionsBefore", ctx, before)
+	ret0, _ := ret[0].([]*entity.OpenReception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOpenReceptionsBefore indicates an expected call of ListOpenReceptionsBefore.
+func (mr *MockStaleReceptionRepoMockRecorder) ListOpenReceptionsBefore(ctx, before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOpenReceptionsBefore", reflect.TypeOf((*MockStaleReceptionRepo)(nil).ListOpenReceptionsBefore), ctx, before)
+}
+
+// MarkStaleAlerted mocks base method.
+func (m *MockStaleReceptionRepo) MarkStaleAlerted(ctx context.Context, receptionID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkStaleAlerted", ctx, receptionID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkStaleAlerted indicates an expected call of MarkStaleAlerted.
+func (mr *MockStaleReceptionRepoMockRecorder) MarkStaleAlerted(ctx, receptionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkStaleAlerted", reflect.TypeOf((*MockStaleReceptionRepo)(nil).MarkStaleAlerted), ctx, receptionID)
+}
+
+// MockReceptionCloser is a mock of ReceptionCloser interface.
+type MockReceptionCloser struct {
+	ctrl     *gomock.Controller
+	recorder *MockReceptionCloserMockRecorder
+}
+
+// MockReceptionCloserMockRecorder is the mock recorder for MockReceptionCloser.
+type MockReceptionCloserMockRecorder struct {
+	mock *MockReceptionCloser
+}
+
+// NewMockReceptionCloser creates a new mock instance.
+func NewMockReceptionCloser(ctrl *gomock.Controller) *MockReceptionCloser {
+	mock := &MockReceptionCloser{ctrl: ctrl}
+	mock.recorder = &MockReceptionCloserMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReceptionCloser) EXPECT() *MockReceptionCloserMockRecorder {
+	return m.recorder
+}
+
+// FinishReception mocks base method.
+func (m *MockReceptionCloser) FinishReception(ctx context.Context, req *request.FinishReception) (*entity.ClosedReception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishReception", ctx, req)
+	ret0, _ := ret[0].(*entity.ClosedReception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishReception indicates an expected call of FinishReception.
+func (mr *MockReceptionCloserMockRecorder) FinishReception(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishReception", reflect.TypeOf((*MockReceptionCloser)(nil).FinishReception), ctx, req)
+}
+
+// MockLeaderElector is a mock of LeaderElector interface.
+type MockLeaderElector struct {
+	ctrl     *gomock.Controller
+	recorder *MockLeaderElectorMockRecorder
+}
+
+// MockLeaderElectorMockRecorder is the mock recorder for MockLeaderElector.
+type MockLeaderElectorMockRecorder struct {
+	mock *MockLeaderElector
+}
+
+// NewMockLeaderElector creates a new mock instance.
+func NewMockLeaderElector(ctrl *gomock.Controller) *MockLeaderElector {
+	mock := &MockLeaderElector{ctrl: ctrl}
+	mock.recorder = &MockLeaderElectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLeaderElector) EXPECT() *MockLeaderElectorMockRecorder {
+	return m.recorder
+}
+
+// IsLeader mocks base method.
+func (m *MockLeaderElector) IsLeader(ctx context.Context) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsLeader", ctx)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsLeader indicates an expected call of IsLeader.
+func (mr *MockLeaderElectorMockRecorder) IsLeader(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsLeader", reflect.TypeOf((*MockLeaderElector)(nil).IsLeader), ctx)
+}
+
+// Release mocks base method.
+func (m *MockLeaderElector) Release() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Release")
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockLeaderElectorMockRecorder) Release() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockLeaderElector)(nil).Release))
+}
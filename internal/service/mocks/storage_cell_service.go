@@ -0,0 +1,151 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./storage_cell_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	request "github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	entity "github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+)
+
+// MockStorageCellRepo is a mock of StorageCellRepo interface.
+type MockStorageCellRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageCellRepoMockRecorder
+}
+
+// MockStorageCellRepoMockRecorder is the mock recorder for MockStorageCellRepo.
+type MockStorageCellRepoMockRecorder struct {
+	mock *MockStorageCellRepo
+}
+
+// NewMockStorageCellRepo creates a new mock instance.
+func NewMockStorageCellRepo(ctrl *gomock.Controller) *MockStorageCellRepo {
+	mock := &MockStorageCellRepo{ctrl: ctrl}
+	mock.recorder = &MockStorageCellRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorageCellRepo) EXPECT() *MockStorageCellRepoMockRecorder {
+	return m.recorder
+}
+
+// AssignFreeStorageCell mocks base method.
+func (m *MockStorageCellRepo) AssignFreeStorageCell(ctx context.Context, pvzID, productID uuid.UUID, sizeClass entity.SizeClass) (*entity.StorageCell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignFreeStorageCell", ctx, pvzID, productID, sizeClass)
+	ret0, _ := ret[0].(*entity.StorageCell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignFreeStorageCell indicates an expected call of AssignFreeStorageCell.
+func (mr *MockStorageCellRepoMockRecorder) AssignFreeStorageCell(ctx, pvzID, productID, sizeClass interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignFreeStorageCell", reflect.TypeOf((*MockStorageCellRepo)(nil).AssignFreeStorageCell), ctx, pvzID, productID, sizeClass)
+}
+
+// CreateStorageCell mocks base method.
+func (m *MockStorageCellRepo) CreateStorageCell(ctx context.Context, pvzID uuid.UUID, req *request.CreateStorageCell) (*entity.StorageCell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStorageCell", ctx, pvzID, req)
+	ret0, _ := ret[0].(*entity.StorageCell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStorageCell indicates an expected call of CreateStorageCell.
+func (mr *MockStorageCellRepoMockRecorder) CreateStorageCell(ctx, pvzID, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStorageCell", reflect.TypeOf((*MockStorageCellRepo)(nil).CreateStorageCell), ctx, pvzID, req)
+}
+
+// GetStorageCell mocks base method.
+func (m *MockStorageCellRepo) GetStorageCell(ctx context.Context, id uuid.UUID) (*entity.StorageCell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStorageCell", ctx, id)
+	ret0, _ := ret[0].(*entity.StorageCell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStorageCell indicates an expected call of GetStorageCell.
+func (mr *MockStorageCellRepoMockRecorder) GetStorageCell(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageCell", reflect.TypeOf((*MockStorageCellRepo)(nil).GetStorageCell), ctx, id)
+}
+
+// ListPvzStorageCells mocks base method.
+func (m *MockStorageCellRepo) ListPvzStorageCells(ctx context.Context, pvzID uuid.UUID) ([]*entity.StorageCell, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPvzStorageCells", ctx, pvzID)
+	ret0, _ := ret[0].([]*entity.StorageCell)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPvzStorageCells indicates an expected call of ListPvzStorageCells.
+func (mr *MockStorageCellRepoMockRecorder) ListPvzStorageCells(ctx, pvzID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPvzStorageCells", reflect.TypeOf((*MockStorageCellRepo)(nil).ListPvzStorageCells), ctx, pvzID)
+}
+
+// MoveProductToCell mocks base method.
+func (m *MockStorageCellRepo) MoveProductToCell(ctx context.Context, productID, cellID, actorID uuid.UUID) (*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveProductToCell", ctx, productID, cellID, actorID)
+	ret0, _ := ret[0].(*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveProductToCell indicates an expected call of MoveProductToCell.
+func (mr *MockStorageCellRepoMockRecorder) MoveProductToCell(ctx, productID, cellID, actorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveProductToCell", reflect.TypeOf((*MockStorageCellRepo)(nil).MoveProductToCell), ctx, productID, cellID, actorID)
+}
+
+// MockProductGetter is a mock of ProductGetter interface.
+type MockProductGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockProductGetterMockRecorder
+}
+
+// MockProductGetterMockRecorder is the mock recorder for MockProductGetter.
+type MockProductGetterMockRecorder struct {
+	mock *MockProductGetter
+}
+
+// NewMockProductGetter creates a new mock instance.
+func NewMockProductGetter(ctrl *gomock.Controller) *MockProductGetter {
+	mock := &MockProductGetter{ctrl: ctrl}
+	mock.recorder = &MockProductGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProductGetter) EXPECT() *MockProductGetterMockRecorder {
+	return m.recorder
+}
+
+// GetProduct mocks base method.
+func (m *MockProductGetter) GetProduct(ctx context.Context, id uuid.UUID) (*entity.Product, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProduct", ctx, id)
+	ret0, _ := ret[0].(*entity.Product)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProduct indicates an expected call of GetProduct.
+func (mr *MockProductGetterMockRecorder) GetProduct(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProduct", reflect.TypeOf((*MockProductGetter)(nil).GetProduct), ctx, id)
+}
@@ -0,0 +1,203 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: ./transfer_service.go
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	uuid "github.com/google/uuid"
+	request "github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	entity "github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+)
+
+// MockTransferRepo is a mock of TransferRepo interface.
+type MockTransferRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferRepoMockRecorder
+}
+
+// MockTransferRepoMockRecorder is the mock recorder for MockTransferRepo.
+type MockTransferRepoMockRecorder struct {
+	mock *MockTransferRepo
+}
+
+// NewMockTransferRepo creates a new mock instance.
+func NewMockTransferRepo(ctrl *gomock.Controller) *MockTransferRepo {
+	mock := &MockTransferRepo{ctrl: ctrl}
+	mock.recorder = &MockTransferRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferRepo) EXPECT() *MockTransferRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateTransfer mocks base method.
+func (m *MockTransferRepo) CreateTransfer(ctx context.Context, fromPvzID, toPvzID uuid.UUID, productIDs []uuid.UUID, createdBy uuid.UUID) (*entity.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransfer", ctx, fromPvzID, toPvzID, productIDs, createdBy)
+	ret0, _ := ret[0].(*entity.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransfer indicates an expected call of CreateTransfer.
+func (mr *MockTransferRepoMockRecorder) CreateTransfer(ctx, fromPvzID, toPvzID, productIDs, createdBy interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransfer", reflect.TypeOf((*MockTransferRepo)(nil).CreateTransfer), ctx, fromPvzID, toPvzID, productIDs, createdBy)
+}
+
+// DispatchTransfer mocks base method.
+func (m *MockTransferRepo) DispatchTransfer(ctx context.Context, id, actorID uuid.UUID) (*entity.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DispatchTransfer", ctx, id, actorID)
+	ret0, _ := ret[0].(*entity.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DispatchTransfer indicates an expected call of DispatchTransfer.
+func (mr *MockTransferRepoMockRecorder) DispatchTransfer(ctx, id, actorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DispatchTransfer", reflect.TypeOf((*MockTransferRepo)(nil).DispatchTransfer), ctx, id, actorID)
+}
+
+// GetTransfer mocks base method.
+func (m *MockTransferRepo) GetTransfer(ctx context.Context, id uuid.UUID) (*entity.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTransfer", ctx, id)
+	ret0, _ := ret[0].(*entity.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTransfer indicates an expected call of GetTransfer.
+func (mr *MockTransferRepoMockRecorder) GetTransfer(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransfer", reflect.TypeOf((*MockTransferRepo)(nil).GetTransfer), ctx, id)
+}
+
+// ListTransferCandidates mocks base method.
+func (m *MockTransferRepo) ListTransferCandidates(ctx context.Context, productIDs []uuid.UUID) ([]*entity.TransferCandidate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTransferCandidates", ctx, productIDs)
+	ret0, _ := ret[0].([]*entity.TransferCandidate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTransferCandidates indicates an expected call of ListTransferCandidates.
+func (mr *MockTransferRepoMockRecorder) ListTransferCandidates(ctx, productIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTransferCandidates", reflect.TypeOf((*MockTransferRepo)(nil).ListTransferCandidates), ctx, productIDs)
+}
+
+// ReceiveTransfer mocks base method.
+func (m *MockTransferRepo) ReceiveTransfer(ctx context.Context, id, receptionID, actorID uuid.UUID) (*entity.Transfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReceiveTransfer", ctx, id, receptionID, actorID)
+	ret0, _ := ret[0].(*entity.Transfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReceiveTransfer indicates an expected call of ReceiveTransfer.
+func (mr *MockTransferRepoMockRecorder) ReceiveTransfer(ctx, id, receptionID, actorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReceiveTransfer", reflect.TypeOf((*MockTransferRepo)(nil).ReceiveTransfer), ctx, id, receptionID, actorID)
+}
+
+// MockOpenReceptionFinder is a mock of OpenReceptionFinder interface.
+type MockOpenReceptionFinder struct {
+	ctrl     *gomock.Controller
+	recorder *MockOpenReceptionFinderMockRecorder
+}
+
+// MockOpenReceptionFinderMockRecorder is the mock recorder for MockOpenReceptionFinder.
+type MockOpenReceptionFinderMockRecorder struct {
+	mock *MockOpenReceptionFinder
+}
+
+// NewMockOpenReceptionFinder creates a new mock instance.
+func NewMockOpenReceptionFinder(ctrl *gomock.Controller) *MockOpenReceptionFinder {
+	mock := &MockOpenReceptionFinder{ctrl: ctrl}
+	mock.recorder = &MockOpenReceptionFinderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOpenReceptionFinder) EXPECT() *MockOpenReceptionFinderMockRecorder {
+	return m.recorder
+}
+
+// DeleteEmptyReception mocks base method.
+func (m *MockOpenReceptionFinder) DeleteEmptyReception(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEmptyReception", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEmptyReception indicates an expected call of DeleteEmptyReception.
+func (mr *MockOpenReceptionFinderMockRecorder) DeleteEmptyReception(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmptyReception", reflect.TypeOf((*MockOpenReceptionFinder)(nil).DeleteEmptyReception), ctx, id)
+}
+
+// GetLastOpenReception mocks base method.
+func (m *MockOpenReceptionFinder) GetLastOpenReception(ctx context.Context, pvzID uuid.UUID) (*entity.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastOpenReception", ctx, pvzID)
+	ret0, _ := ret[0].(*entity.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastOpenReception indicates an expected call of GetLastOpenReception.
+func (mr *MockOpenReceptionFinderMockRecorder) GetLastOpenReception(ctx, pvzID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastOpenReception", reflect.TypeOf((*MockOpenReceptionFinder)(nil).GetLastOpenReception), ctx, pvzID)
+}
+
+// MockReceptionOpener is a mock of ReceptionOpener interface.
+type MockReceptionOpener struct {
+	ctrl     *gomock.Controller
+	recorder *MockReceptionOpenerMockRecorder
+}
+
+// MockReceptionOpenerMockRecorder is the mock recorder for MockReceptionOpener.
+type MockReceptionOpenerMockRecorder struct {
+	mock *MockReceptionOpener
+}
+
+// NewMockReceptionOpener creates a new mock instance.
+func NewMockReceptionOpener(ctrl *gomock.Controller) *MockReceptionOpener {
+	mock := &MockReceptionOpener{ctrl: ctrl}
+	mock.recorder = &MockReceptionOpenerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReceptionOpener) EXPECT() *MockReceptionOpenerMockRecorder {
+	return m.recorder
+}
+
+// CreateReception mocks base method.
+func (m *MockReceptionOpener) CreateReception(ctx context.Context, req *request.CreateReception) (*entity.Reception, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReception", ctx, req)
+	ret0, _ := ret[0].(*entity.Reception)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReception indicates an expected call of CreateReception.
+func (mr *MockReceptionOpenerMockRecorder) CreateReception(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReception", reflect.TypeOf((*MockReceptionOpener)(nil).CreateReception), ctx, req)
+}
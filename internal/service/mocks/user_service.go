@@ -52,34 +52,64 @@ func (mr *MockTokenServiceMockRecorder) CreateDummyToken(role interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDummyToken", reflect.TypeOf((*MockTokenService)(nil).CreateDummyToken), role)
 }
 
+// CreateMFAToken mocks base method.
+func (m *MockTokenService) CreateMFAToken(id uuid.UUID, version int32) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMFAToken", id, version)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMFAToken indicates an expected call of CreateMFAToken.
+func (mr *MockTokenServiceMockRecorder) CreateMFAToken(id, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMFAToken", reflect.TypeOf((*MockTokenService)(nil).CreateMFAToken), id, version)
+}
+
 // CreateUserToken mocks base method.
-func (m *MockTokenService) CreateUserToken(id uuid.UUID, role string) (string, error) {
+func (m *MockTokenService) CreateUserToken(id uuid.UUID, role string, version int32) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUserToken", id, role)
+	ret := m.ctrl.Call(m, "CreateUserToken", id, role, version)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // CreateUserToken indicates an expected call of CreateUserToken.
-func (mr *MockTokenServiceMockRecorder) CreateUserToken(id, role interface{}) *gomock.Call {
+func (mr *MockTokenServiceMockRecorder) CreateUserToken(id, role, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserToken", reflect.TypeOf((*MockTokenService)(nil).CreateUserToken), id, role)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserToken", reflect.TypeOf((*MockTokenService)(nil).CreateUserToken), id, role, version)
+}
+
+// VerifyMFAToken mocks base method.
+func (m *MockTokenService) VerifyMFAToken(ctx context.Context, tokenStr string) (uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyMFAToken", ctx, tokenStr)
+	ret0, _ := ret[0].(uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyMFAToken indicates an expected call of VerifyMFAToken.
+func (mr *MockTokenServiceMockRecorder) VerifyMFAToken(ctx, tokenStr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyMFAToken", reflect.TypeOf((*MockTokenService)(nil).VerifyMFAToken), ctx, tokenStr)
 }
 
 // VerifyToken mocks base method.
-func (m *MockTokenService) VerifyToken(tokenStr string) (map[string]interface{}, error) {
+func (m *MockTokenService) VerifyToken(ctx context.Context, tokenStr string) (map[string]interface{}, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "VerifyToken", tokenStr)
+	ret := m.ctrl.Call(m, "VerifyToken", ctx, tokenStr)
 	ret0, _ := ret[0].(map[string]interface{})
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // VerifyToken indicates an expected call of VerifyToken.
-func (mr *MockTokenServiceMockRecorder) VerifyToken(tokenStr interface{}) *gomock.Call {
+func (mr *MockTokenServiceMockRecorder) VerifyToken(ctx, tokenStr interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyToken", reflect.TypeOf((*MockTokenService)(nil).VerifyToken), tokenStr)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyToken", reflect.TypeOf((*MockTokenService)(nil).VerifyToken), ctx, tokenStr)
 }
 
 // MockUserRepo is a mock of UserRepo interface.
@@ -134,3 +164,100 @@ func (mr *MockUserRepoMockRecorder) GetUser(ctx, req interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockUserRepo)(nil).GetUser), ctx, req)
 }
+
+// GetUserByID mocks base method.
+func (m *MockUserRepo) GetUserByID(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserByID", ctx, id)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserByID indicates an expected call of GetUserByID.
+func (mr *MockUserRepoMockRecorder) GetUserByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockUserRepo)(nil).GetUserByID), ctx, id)
+}
+
+// ListUsers mocks base method.
+func (m *MockUserRepo) ListUsers(ctx context.Context, req *request.ListUsers) ([]*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsers", ctx, req)
+	ret0, _ := ret[0].([]*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsers indicates an expected call of ListUsers.
+func (mr *MockUserRepoMockRecorder) ListUsers(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockUserRepo)(nil).ListUsers), ctx, req)
+}
+
+// UpdatePassword mocks base method.
+func (m *MockUserRepo) UpdatePassword(ctx context.Context, id uuid.UUID, password string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePassword", ctx, id, password)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePassword indicates an expected call of UpdatePassword.
+func (mr *MockUserRepoMockRecorder) UpdatePassword(ctx, id, password interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePassword", reflect.TypeOf((*MockUserRepo)(nil).UpdatePassword), ctx, id, password)
+}
+
+// UpdateUser mocks base method.
+func (m *MockUserRepo) UpdateUser(ctx context.Context, id uuid.UUID, req *request.UpdateUser) (*entity.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUser", ctx, id, req)
+	ret0, _ := ret[0].(*entity.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUser indicates an expected call of UpdateUser.
+func (mr *MockUserRepoMockRecorder) UpdateUser(ctx, id, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUserRepo)(nil).UpdateUser), ctx, id, req)
+}
+
+// MockRoleFinder is a mock of RoleFinder interface.
+type MockRoleFinder struct {
+	ctrl     *gomock.Controller
+	recorder *MockRoleFinderMockRecorder
+}
+
+// MockRoleFinderMockRecorder is the mock recorder for MockRoleFinder.
+type MockRoleFinderMockRecorder struct {
+	mock *MockRoleFinder
+}
+
+// NewMockRoleFinder creates a new mock instance.
+func NewMockRoleFinder(ctrl *gomock.Controller) *MockRoleFinder {
+	mock := &MockRoleFinder{ctrl: ctrl}
+	mock.recorder = &MockRoleFinderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRoleFinder) EXPECT() *MockRoleFinderMockRecorder {
+	return m.recorder
+}
+
+// HasRole mocks base method.
+func (m *MockRoleFinder) HasRole(ctx context.Context, role entity.Role) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasRole", ctx, role)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasRole indicates an expected call of HasRole.
+func (mr *MockRoleFinderMockRecorder) HasRole(ctx, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasRole", reflect.TypeOf((*MockRoleFinder)(nil).HasRole), ctx, role)
+}
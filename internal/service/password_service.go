@@ -0,0 +1,114 @@
+//go:generate mockgen -source=./password_service.go -destination=./mocks/password_service.go -package=mocks
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/mailer"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/secret"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+)
+
+const (
+	resetCodeLen        = 16
+	defaultResetCodeTTL = 15 * time.Minute
+	resetMailSubject    = "Password reset"
+)
+
+type PasswordResetRepo interface {
+	CreateResetCode(ctx context.Context, email, codeHash string, expiresAt time.Time) (uuid.UUID, error)
+	ResetPassword(ctx context.Context, codeHash, password string) (uuid.UUID, error)
+}
+
+// RateLimiter limits how often key can be used.
+type RateLimiter interface {
+	Allow(key string) (bool, time.Duration)
+}
+
+type PasswordServiceImpl struct {
+	repo PasswordResetRepo
+
+	mailer       Mailer
+	emailLimiter RateLimiter
+
+	codeTTL time.Duration
+}
+
+func NewPasswordService(repo PasswordResetRepo, mailer Mailer, emailLimiter RateLimiter, codeTTL time.Duration) *PasswordServiceImpl {
+	if codeTTL <= 0 {
+		codeTTL = defaultResetCodeTTL
+	}
+
+	return &PasswordServiceImpl{
+		repo:         repo,
+		mailer:       mailer,
+		emailLimiter: emailLimiter,
+		codeTTL:      codeTTL,
+	}
+}
+
+// ForgotPassword sends single-use reset code to email.
+// Unknown email is not an error, so response doesn't
+// reveal whether user exists. Requests over per-email
+// limit are dropped the same way, so nobody can flood
+// user's mailbox by changing client IP.
+func (s *PasswordServiceImpl) ForgotPassword(ctx context.Context, req *request.ForgotPassword) error {
+	if ok, _ := s.emailLimiter.Allow(strings.ToLower(req.Email)); !ok {
+		log.Println("reset code rate limit exceeded")
+		return nil
+	}
+
+	code, err := secret.Generate(resetCodeLen)
+	if err != nil {
+		return apperror.NewInternal("failed to generate reset code", err)
+	}
+
+	expiresAt := time.Now().Add(s.codeTTL)
+	if _, err := s.repo.CreateResetCode(ctx, req.Email, secret.Hash(code), expiresAt); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrUserNotFound):
+			return nil
+		default:
+			return apperror.NewInternal("failed to create reset code", err)
+		}
+	}
+
+	err = s.mailer.Send(ctx, mailer.Message{
+		To:      req.Email,
+		Subject: resetMailSubject,
+		Body: fmt.Sprintf(
+			"Password reset was requested for your account.\nReset code: %s\nCode expires at %s.\nIgnore this message if it wasn't you.",
+			code, expiresAt.Format(time.RFC1123Z),
+		),
+	})
+	if err != nil {
+		return apperror.NewInternal("failed to send reset code", err)
+	}
+
+	return nil
+}
+
+// ResetPassword sets new password by reset code.
+// All previously issued user's tokens become invalid.
+func (s *PasswordServiceImpl) ResetPassword(ctx context.Context, req *request.ResetPassword) error {
+	if _, err := s.repo.ResetPassword(ctx, secret.Hash(req.Code), req.Password); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrResetCodeNotFound):
+			return apperror.NewBadReq(err.Error())
+		default:
+			return apperror.NewInternal("failed to reset password", err)
+		}
+	}
+
+	return nil
+}
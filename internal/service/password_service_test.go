@@ -0,0 +1,144 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/mailer"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/secret"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/service"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/service/mocks"
+)
+
+func TestForgotPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	resetRepo := mocks.NewMockPasswordResetRepo(ctrl)
+	mailSrv := mocks.NewMockMailer(ctrl)
+	limiter := mocks.NewMockRateLimiter(ctrl)
+
+	srv := service.NewPasswordService(resetRepo, mailSrv, limiter, time.Minute)
+
+	req := &request.ForgotPassword{Email: mockUser.Email}
+
+	t.Run("OK", func(t *testing.T) {
+		limiter.EXPECT().Allow(req.Email).Return(true, time.Duration(0))
+		var codeHash string
+		resetRepo.EXPECT().CreateResetCode(gomock.Any(), req.Email, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _, hash string, _ time.Time) (uuid.UUID, error) {
+				codeHash = hash
+				return mockUser.ID, nil
+			})
+		mailSrv.EXPECT().Send(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, msg mailer.Message) error {
+				require.Equal(t, req.Email, msg.To)
+
+				// mail must contain code, which hash is stored
+				var code string
+				for _, line := range strings.Split(msg.Body, "\n") {
+					if v, ok := strings.CutPrefix(line, "Reset code: "); ok {
+						code = v
+					}
+				}
+				require.Equal(t, codeHash, secret.Hash(code))
+				return nil
+			})
+
+		err := srv.ForgotPassword(context.Background(), req)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("unknown email", func(t *testing.T) {
+		limiter.EXPECT().Allow(req.Email).Return(true, time.Duration(0))
+		resetRepo.EXPECT().CreateResetCode(gomock.Any(), req.Email, gomock.Any(), gomock.Any()).
+			Return(uuid.Nil, repository.ErrUserNotFound)
+
+		err := srv.ForgotPassword(context.Background(), req)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("email rate limited", func(t *testing.T) {
+		limiter.EXPECT().Allow(req.Email).Return(false, time.Minute)
+
+		err := srv.ForgotPassword(context.Background(), &request.ForgotPassword{Email: strings.ToUpper(req.Email)})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("create err", func(t *testing.T) {
+		limiter.EXPECT().Allow(req.Email).Return(true, time.Duration(0))
+		resetRepo.EXPECT().CreateResetCode(gomock.Any(), req.Email, gomock.Any(), gomock.Any()).Return(uuid.Nil, errMock)
+
+		err := srv.ForgotPassword(context.Background(), req)
+
+		require.Equal(t, apperror.NewInternal("failed to create reset code", errMock), err)
+	})
+
+	t.Run("send err", func(t *testing.T) {
+		limiter.EXPECT().Allow(req.Email).Return(true, time.Duration(0))
+		resetRepo.EXPECT().CreateResetCode(gomock.Any(), req.Email, gomock.Any(), gomock.Any()).Return(mockUser.ID, nil)
+		mailSrv.EXPECT().Send(gomock.Any(), gomock.Any()).Return(errMock)
+
+		err := srv.ForgotPassword(context.Background(), req)
+
+		require.Equal(t, apperror.NewInternal("failed to send reset code", errMock), err)
+	})
+}
+
+func TestResetPasswordByCode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	resetRepo := mocks.NewMockPasswordResetRepo(ctrl)
+
+	srv := service.NewPasswordService(resetRepo, nil, nil, time.Minute)
+
+	req := &request.ResetPassword{Code: "code", Password: "new"}
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expErr       error
+	}{
+		{
+			name: "OK",
+			mockBehavior: func() {
+				resetRepo.EXPECT().ResetPassword(gomock.Any(), secret.Hash(req.Code), req.Password).Return(mockUser.ID, nil)
+			},
+			expErr: nil,
+		},
+		{
+			name: "code not found",
+			mockBehavior: func() {
+				resetRepo.EXPECT().ResetPassword(gomock.Any(), gomock.Any(), gomock.Any()).Return(uuid.Nil, repository.ErrResetCodeNotFound)
+			},
+			expErr: apperror.NewBadReq(repository.ErrResetCodeNotFound.Error()),
+		},
+		{
+			name: "internal error",
+			mockBehavior: func() {
+				resetRepo.EXPECT().ResetPassword(gomock.Any(), gomock.Any(), gomock.Any()).Return(uuid.Nil, errMock)
+			},
+			expErr: apperror.NewInternal("failed to reset password", errMock),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			err := srv.ResetPassword(context.Background(), req)
+
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
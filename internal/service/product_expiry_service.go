@@ -0,0 +1,170 @@
+//go:generate mockgen -source=./product_expiry_service.go -destination=./mocks/product_expiry_service.go -package=mocks
+
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/metrics"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+)
+
+const (
+	defaultStoragePeriod       = 7 * 24 * time.Hour
+	defaultExpiringWindow      = 24 * time.Hour
+	defaultExpiryCheckInterval = 24 * time.Hour
+)
+
+// StoragePeriods is how long product is stored in PVZ
+// since acceptance. Period of product type wins over
+// period of city, which wins over default one.
+type StoragePeriods struct {
+	Default time.Duration
+	Cities  map[entity.City]time.Duration
+	Types   map[entity.ProductType]time.Duration
+}
+
+// For returns storage period of product type in city.
+func (p StoragePeriods) For(city entity.City, productType entity.ProductType) time.Duration {
+	if d, ok := p.Types[productType]; ok {
+		return d
+	}
+	return p.forCity(city)
+}
+
+func (p StoragePeriods) forCity(city entity.City) time.Duration {
+	if d, ok := p.Cities[city]; ok {
+		return d
+	}
+	return p.Default
+}
+
+func (p StoragePeriods) shortest() time.Duration {
+	res := p.Default
+	for _, d := range p.Cities {
+		res = min(res, d)
+	}
+	for _, d := range p.Types {
+		res = min(res, d)
+	}
+	return res
+}
+
+type ProductExpiryRepo interface {
+	ListStoredProductsBefore(ctx context.Context, before time.Time) ([]*entity.StoredProduct, error)
+	UpdateProductState(ctx context.Context, id uuid.UUID, from, to entity.ProductState, event entity.ProductEventType, actorID uuid.UUID, details map[string]any) (*entity.Product, error)
+	GetPvzExpiryStats(ctx context.Context, pvzID uuid.UUID, defaultBefore time.Time, typeBefores map[entity.ProductType]time.Time) (expiring, toReturn int64, err error)
+}
+
+type ProductExpiryServiceImpl struct {
+	repo   ProductExpiryRepo
+	leader LeaderElector
+
+	periods StoragePeriods
+	// expiringWindow is how soon storage period must end
+	// for product to be counted as expiring.
+	expiringWindow time.Duration
+	checkInterval  time.Duration
+}
+
+func NewProductExpiryService(repo ProductExpiryRepo, leader LeaderElector, periods StoragePeriods, expiringWindow, checkInterval time.Duration) *ProductExpiryServiceImpl {
+	if periods.Default <= 0 {
+		periods.Default = defaultStoragePeriod
+	}
+	if expiringWindow <= 0 {
+		expiringWindow = defaultExpiringWindow
+	}
+	if checkInterval <= 0 {
+		checkInterval = defaultExpiryCheckInterval
+	}
+
+	return &ProductExpiryServiceImpl{
+		repo:           repo,
+		leader:         leader,
+		periods:        periods,
+		expiringWindow: expiringWindow,
+		checkInterval:  checkInterval,
+	}
+}
+
+// Run periodically marks expired products until ctx is done.
+// Check interval is long, so first check is done on start,
+// otherwise restarts could postpone it forever.
+func (s *ProductExpiryServiceImpl) Run(ctx context.Context) {
+	defer s.leader.Release()
+
+	ticker := time.NewTicker(s.checkInterval)
+	defer ticker.Stop()
+
+	for {
+		if s.leader.IsLeader(ctx) {
+			if err := s.ExpireProducts(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("failed to expire products: %v", err)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ExpireProducts marks stored products, whose storage
+// period is over, as waiting for return to sender.
+func (s *ProductExpiryServiceImpl) ExpireProducts(ctx context.Context) error {
+	now := time.Now()
+	stored, err := s.repo.ListStoredProductsBefore(ctx, now.Add(-s.periods.shortest()))
+	if err != nil {
+		return apperror.NewInternal("failed to list stored products", err)
+	}
+
+	for _, p := range stored {
+		period := s.periods.For(p.City, p.Product.Type)
+		if now.Sub(p.Product.DateTime) < period {
+			continue
+		}
+
+		_, err := s.repo.UpdateProductState(ctx, p.Product.ID, entity.ProductStateStored, entity.ProductStateToReturn, entity.ProductEventExpired, uuid.Nil, map[string]any{
+			"storage_period": period.String(),
+		})
+		if err != nil {
+			// product issued since it was listed
+			if !errors.Is(err, repository.ErrProductStateStale) {
+				log.Printf("failed to expire product %s: %v", p.Product.ID, err)
+			}
+			continue
+		}
+
+		metrics.ExpiredProduct(string(p.City))
+	}
+
+	return nil
+}
+
+// CountExpiring returns number of PVZ products whose storage
+// period ends within expiring window, and products already
+// waiting for return.
+func (s *ProductExpiryServiceImpl) CountExpiring(ctx context.Context, pvz *entity.Pvz) (expiring, toReturn int64, err error) {
+	deadline := time.Now().Add(s.expiringWindow)
+
+	typeBefores := make(map[entity.ProductType]time.Time, len(s.periods.Types))
+	for t, d := range s.periods.Types {
+		typeBefores[t] = deadline.Add(-d)
+	}
+
+	expiring, toReturn, err = s.repo.GetPvzExpiryStats(ctx, pvz.ID, deadline.Add(-s.periods.forCity(pvz.City)), typeBefores)
+	if err != nil {
+		return 0, 0, apperror.NewInternal("failed to get pvz expiry stats", err)
+	}
+
+	return expiring, toReturn, nil
+}
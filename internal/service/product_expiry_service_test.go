@@ -0,0 +1,110 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/service"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/service/mocks"
+)
+
+var storagePeriods = service.StoragePeriods{
+	Default: 7 * 24 * time.Hour,
+	Cities:  map[entity.City]time.Duration{entity.CityMoscow: 5 * 24 * time.Hour},
+	Types:   map[entity.ProductType]time.Duration{entity.ProductTypeElectronics: 14 * 24 * time.Hour},
+}
+
+func TestStoragePeriodsFor(t *testing.T) {
+	require.Equal(t, 7*24*time.Hour, storagePeriods.For(entity.CityKazan, entity.ProductTypeClothes))
+	require.Equal(t, 5*24*time.Hour, storagePeriods.For(entity.CityMoscow, entity.ProductTypeClothes))
+	// type wins over city
+	require.Equal(t, 14*24*time.Hour, storagePeriods.For(entity.CityMoscow, entity.ProductTypeElectronics))
+}
+
+func TestExpireProducts(t *testing.T) {
+	storedFor := func(city entity.City, productType entity.ProductType, days int) *entity.StoredProduct {
+		return &entity.StoredProduct{
+			Product: &entity.Product{ID: uuid.New(), DateTime: time.Now().AddDate(0, 0, -days), Type: productType, State: entity.ProductStateStored},
+			PvzID:   uuid.New(),
+			City:    city,
+		}
+	}
+	moscowExpired := storedFor(entity.CityMoscow, entity.ProductTypeClothes, 6)
+	kazanFresh := storedFor(entity.CityKazan, entity.ProductTypeClothes, 6)
+	electronicsFresh := storedFor(entity.CityMoscow, entity.ProductTypeElectronics, 10)
+	kazanExpired := storedFor(entity.CityKazan, entity.ProductTypeShoes, 8)
+
+	t.Run("ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		repo := mocks.NewMockProductExpiryRepo(ctrl)
+		srv := service.NewProductExpiryService(repo, nil, storagePeriods, 0, 0)
+
+		// shortest period is used to look up candidates
+		repo.EXPECT().ListStoredProductsBefore(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, before time.Time) ([]*entity.StoredProduct, error) {
+				require.WithinDuration(t, time.Now().AddDate(0, 0, -5), before, time.Minute)
+				return []*entity.StoredProduct{moscowExpired, kazanFresh, electronicsFresh, kazanExpired}, nil
+			},
+		)
+		repo.EXPECT().UpdateProductState(gomock.Any(), moscowExpired.Product.ID, entity.ProductStateStored, entity.ProductStateToReturn, entity.ProductEventExpired, uuid.Nil, map[string]any{"storage_period": "120h0m0s"}).Return(nil, nil)
+		// issued since it was listed
+		repo.EXPECT().UpdateProductState(gomock.Any(), kazanExpired.Product.ID, entity.ProductStateStored, entity.ProductStateToReturn, entity.ProductEventExpired, uuid.Nil, map[string]any{"storage_period": "168h0m0s"}).Return(nil, repository.ErrProductStateStale)
+
+		require.NoError(t, srv.ExpireProducts(context.Background()))
+	})
+
+	t.Run("list err", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		repo := mocks.NewMockProductExpiryRepo(ctrl)
+		srv := service.NewProductExpiryService(repo, nil, storagePeriods, 0, 0)
+
+		repo.EXPECT().ListStoredProductsBefore(gomock.Any(), gomock.Any()).Return(nil, errMock)
+
+		err := srv.ExpireProducts(context.Background())
+		require.Equal(t, apperror.NewInternal("failed to list stored products", errMock), err)
+	})
+}
+
+func TestCountExpiring(t *testing.T) {
+	moscowPvz := &entity.Pvz{ID: uuid.New(), City: entity.CityMoscow}
+
+	t.Run("ok", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		repo := mocks.NewMockProductExpiryRepo(ctrl)
+		srv := service.NewProductExpiryService(repo, nil, storagePeriods, 24*time.Hour, 0)
+
+		repo.EXPECT().GetPvzExpiryStats(gomock.Any(), moscowPvz.ID, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ uuid.UUID, defaultBefore time.Time, typeBefores map[entity.ProductType]time.Time) (int64, int64, error) {
+				// storage period of city ends within a day
+				require.WithinDuration(t, time.Now().AddDate(0, 0, -4), defaultBefore, time.Minute)
+				require.Len(t, typeBefores, 1)
+				require.WithinDuration(t, time.Now().AddDate(0, 0, -13), typeBefores[entity.ProductTypeElectronics], time.Minute)
+				return 2, 3, nil
+			},
+		)
+
+		expiring, toReturn, err := srv.CountExpiring(context.Background(), moscowPvz)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), expiring)
+		require.Equal(t, int64(3), toReturn)
+	})
+
+	t.Run("unk err", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		repo := mocks.NewMockProductExpiryRepo(ctrl)
+		srv := service.NewProductExpiryService(repo, nil, storagePeriods, 24*time.Hour, 0)
+
+		repo.EXPECT().GetPvzExpiryStats(gomock.Any(), moscowPvz.ID, gomock.Any(), gomock.Any()).Return(int64(0), int64(0), errMock)
+
+		_, _, err := srv.CountExpiring(context.Background(), moscowPvz)
+		require.Equal(t, apperror.NewInternal("failed to get pvz expiry stats", errMock), err)
+	})
+}
@@ -0,0 +1,231 @@
+//go:generate mockgen -source=./product_service.go -destination=./mocks/product_service.go -package=mocks
+
+package service
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/secret"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+)
+
+type ProductRepo interface {
+	GetProduct(ctx context.Context, id uuid.UUID) (*entity.Product, error)
+	UpdateProductState(ctx context.Context, id uuid.UUID, from, to entity.ProductState, event entity.ProductEventType, actorID uuid.UUID, details map[string]any) (*entity.Product, error)
+	ListPvzStock(ctx context.Context, pvzID uuid.UUID, page, limit int) ([]*entity.Product, error)
+	ListProductEvents(ctx context.Context, productID uuid.UUID) ([]*entity.ProductEvent, error)
+	CreateReturnShipment(ctx context.Context, pvzID, createdBy uuid.UUID) (*entity.ReturnShipment, error)
+	SetPickupCode(ctx context.Context, id uuid.UUID, codeHash string) (*entity.Product, error)
+}
+
+type ReceptionGetter interface {
+	GetProductReception(ctx context.Context, productID uuid.UUID) (*entity.Reception, error)
+}
+
+type ProductServiceImpl struct {
+	repo          ProductRepo
+	receptionRepo ReceptionGetter
+	pvzSrv        PvzFinder
+	auditor       Auditor
+	// codeLimiter limits pickup code attempts per product.
+	codeLimiter RateLimiter
+	// pickupCodeKey is HMAC key of pickup codes hashes.
+	pickupCodeKey string
+}
+
+func NewProductService(repo ProductRepo, receptionRepo ReceptionGetter, pvzSrv PvzFinder, auditor Auditor, codeLimiter RateLimiter, pickupCodeKey string) *ProductServiceImpl {
+	return &ProductServiceImpl{
+		repo:          repo,
+		receptionRepo: receptionRepo,
+		pvzSrv:        pvzSrv,
+		auditor:       auditor,
+		codeLimiter:   codeLimiter,
+		pickupCodeKey: pickupCodeKey,
+	}
+}
+
+// hashPickupCode returns keyed hash of pickup code, so short
+// codes can't be brute forced from database without the key.
+// Empty code has no hash.
+func hashPickupCode(key, code string) string {
+	if code == "" {
+		return ""
+	}
+	return secret.HMAC(key, code)
+}
+
+// IssueProduct hands stored product to customer, who must
+// present its pickup code. Products of reception which is
+// not closed yet can't be issued. Code attempts are limited
+// per product, so it can't be guessed.
+func (s *ProductServiceImpl) IssueProduct(ctx context.Context, id uuid.UUID, req *request.IssueProduct) (*entity.Product, error) {
+	product, reception, err := s.getProductInScope(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch reception.Status {
+	case entity.StatusInProgress:
+		return nil, apperror.NewConflict("product reception is in progress")
+	case entity.StatusCancelled:
+		return nil, apperror.NewConflict("product reception is cancelled")
+	}
+	if product.State != entity.ProductStateStored {
+		return nil, apperror.NewConflict("product is " + string(product.State))
+	}
+
+	if product.PickupCodeHash == "" {
+		return nil, apperror.NewBadReq("product has no pickup code")
+	}
+	if ok, _ := s.codeLimiter.Allow(id.String()); !ok {
+		return nil, apperror.NewTooManyRequests("too many pickup code attempts")
+	}
+	if subtle.ConstantTimeCompare([]byte(hashPickupCode(s.pickupCodeKey, req.PickupCode)), []byte(product.PickupCodeHash)) != 1 {
+		return nil, apperror.NewBadReq("invalid pickup code")
+	}
+
+	var actorID uuid.UUID
+	if p, ok := principal.FromContext(ctx); ok {
+		actorID = p.UserID
+	}
+
+	res, err := s.repo.UpdateProductState(ctx, id, entity.ProductStateStored, entity.ProductStateIssued, entity.ProductEventIssued, actorID, nil)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrProductStateStale):
+			return nil, apperror.NewConflict(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to issue product", err)
+		}
+	}
+
+	s.auditor.Record(ctx, entity.AuditProductIssued, map[string]any{
+		"pvz_id":     reception.PvzID,
+		"product_id": res.ID,
+	})
+	return res, nil
+}
+
+// SetPickupCode sets pickup code of stored product accepted
+// without it, so it can be issued. Code can't be changed.
+func (s *ProductServiceImpl) SetPickupCode(ctx context.Context, id uuid.UUID, req *request.SetPickupCode) (*entity.Product, error) {
+	product, reception, err := s.getProductInScope(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if product.State != entity.ProductStateStored {
+		return nil, apperror.NewConflict("product is " + string(product.State))
+	}
+	if product.PickupCodeHash != "" {
+		return nil, apperror.NewConflict("product already has pickup code")
+	}
+
+	res, err := s.repo.SetPickupCode(ctx, id, hashPickupCode(s.pickupCodeKey, req.PickupCode))
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrPickupCodeNotSettable):
+			return nil, apperror.NewConflict(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to set pickup code", err)
+		}
+	}
+
+	s.auditor.Record(ctx, entity.AuditProductPickupCodeSet, map[string]any{
+		"pvz_id":     reception.PvzID,
+		"product_id": res.ID,
+	})
+	return res, nil
+}
+
+// ListPvzStock returns page of products on hand in PVZ,
+// including ones waiting for return, oldest first.
+func (s *ProductServiceImpl) ListPvzStock(ctx context.Context, req *request.ListStock) ([]*entity.Product, error) {
+	if _, err := s.pvzSrv.GetPvz(ctx, req.PvzID); err != nil {
+		return nil, err
+	}
+
+	res, err := s.repo.ListPvzStock(ctx, req.PvzID, req.Page, req.Limit)
+	if err != nil {
+		return nil, apperror.NewInternal("failed to list pvz stock", err)
+	}
+
+	return res, nil
+}
+
+// GetProductEvents returns product history, oldest first.
+func (s *ProductServiceImpl) GetProductEvents(ctx context.Context, id uuid.UUID) ([]*entity.ProductEvent, error) {
+	if _, _, err := s.getProductInScope(ctx, id); err != nil {
+		return nil, err
+	}
+
+	res, err := s.repo.ListProductEvents(ctx, id)
+	if err != nil {
+		return nil, apperror.NewInternal("failed to get product events", err)
+	}
+
+	return res, nil
+}
+
+// CreateReturnShipment sends all PVZ products, whose storage
+// period is over, back to sender in one shipment.
+func (s *ProductServiceImpl) CreateReturnShipment(ctx context.Context, pvzID uuid.UUID) (*entity.ReturnShipment, error) {
+	if _, err := s.pvzSrv.GetPvz(ctx, pvzID); err != nil {
+		return nil, err
+	}
+
+	var actorID uuid.UUID
+	if p, ok := principal.FromContext(ctx); ok {
+		actorID = p.UserID
+	}
+
+	res, err := s.repo.CreateReturnShipment(ctx, pvzID, actorID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNothingToReturn):
+			return nil, apperror.NewConflict(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to create return shipment", err)
+		}
+	}
+
+	s.auditor.Record(ctx, entity.AuditReturnShipmentCreated, map[string]any{
+		"pvz_id":      pvzID,
+		"shipment_id": res.ID,
+		"products":    len(res.Products),
+	})
+	return res, nil
+}
+
+// getProductInScope returns product with its reception,
+// caller must have access to reception PVZ.
+func (s *ProductServiceImpl) getProductInScope(ctx context.Context, id uuid.UUID) (*entity.Product, *entity.Reception, error) {
+	product, err := s.repo.GetProduct(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrProductNotFound):
+			return nil, nil, apperror.NewNotFound(err.Error())
+		default:
+			return nil, nil, apperror.NewInternal("failed to get product", err)
+		}
+	}
+
+	reception, err := s.receptionRepo.GetProductReception(ctx, product.ID)
+	if err != nil {
+		return nil, nil, apperror.NewInternal("failed to get product reception", err)
+	}
+
+	if p, ok := principal.FromContext(ctx); ok && !p.CanAccessPvz(reception.PvzID) {
+		return nil, nil, apperror.NewForbidden("no access to pvz")
+	}
+
+	return product, reception, nil
+}
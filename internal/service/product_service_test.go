@@ -0,0 +1,493 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/secret"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/service"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/service/mocks"
+)
+
+const pickupCodeKey = "pickup-code-key"
+
+var storedProduct *entity.Product = &entity.Product{
+	ID:             uuid.New(),
+	DateTime:       time.Now(),
+	Type:           entity.ProductTypeClothes,
+	ReceptionID:    reception1.ID,
+	State:          entity.ProductStateStored,
+	PickupCodeHash: secret.HMAC(pickupCodeKey, "1234"),
+}
+
+func TestIssueProduct(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	productRepo := mocks.NewMockProductRepo(ctrl)
+	receptionRepo := mocks.NewMockReceptionGetter(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	limiter := mocks.NewMockRateLimiter(ctrl)
+	srv := service.NewProductService(productRepo, receptionRepo, nil, auditor, limiter, pickupCodeKey)
+
+	userID := uuid.New()
+	userCtx := principal.NewContext(context.Background(), &principal.Principal{UserID: userID, AllPvz: true})
+	issued := *storedProduct
+	issued.State = entity.ProductStateIssued
+	cancelled := &entity.Reception{ID: reception1.ID, PvzID: reception1.PvzID, Status: entity.StatusCancelled}
+
+	testCases := []struct {
+		name         string
+		ctx          context.Context
+		product      *entity.Product
+		code         string
+		mockBehavior func(p *entity.Product)
+		expResp      *entity.Product
+		expErr       error
+	}{
+		{
+			name:    "ok",
+			ctx:     userCtx,
+			product: storedProduct,
+			code:    "1234",
+			mockBehavior: func(p *entity.Product) {
+				productRepo.EXPECT().GetProduct(gomock.Any(), p.ID).Return(p, nil)
+				receptionRepo.EXPECT().GetProductReception(gomock.Any(), p.ID).Return(reception1, nil)
+				limiter.EXPECT().Allow(p.ID.String()).Return(true, time.Duration(0))
+				productRepo.EXPECT().UpdateProductState(gomock.Any(), p.ID, entity.ProductStateStored, entity.ProductStateIssued, entity.ProductEventIssued, userID, nil).Return(&issued, nil)
+				auditor.EXPECT().Record(gomock.Any(), entity.AuditProductIssued, gomock.Any())
+			},
+			expResp: &issued,
+			expErr:  nil,
+		},
+		{
+			name:    "not found",
+			ctx:     userCtx,
+			product: storedProduct,
+			code:    "1234",
+			mockBehavior: func(p *entity.Product) {
+				productRepo.EXPECT().GetProduct(gomock.Any(), p.ID).Return(nil, repository.ErrProductNotFound)
+			},
+			expResp: nil,
+			expErr:  apperror.NewNotFound(repository.ErrProductNotFound.Error()),
+		},
+		{
+			name:    "other pvz",
+			ctx:     principal.NewContext(context.Background(), &principal.Principal{PvzIDs: []uuid.UUID{pvz2.ID}}),
+			product: storedProduct,
+			code:    "1234",
+			mockBehavior: func(p *entity.Product) {
+				productRepo.EXPECT().GetProduct(gomock.Any(), p.ID).Return(p, nil)
+				receptionRepo.EXPECT().GetProductReception(gomock.Any(), p.ID).Return(reception1, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewForbidden("no access to pvz"),
+		},
+		{
+			name:    "reception in progress",
+			ctx:     userCtx,
+			product: product,
+			code:    "1234",
+			mockBehavior: func(p *entity.Product) {
+				productRepo.EXPECT().GetProduct(gomock.Any(), p.ID).Return(p, nil)
+				receptionRepo.EXPECT().GetProductReception(gomock.Any(), p.ID).Return(reception3, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewConflict("product reception is in progress"),
+		},
+		{
+			name:    "reception cancelled",
+			ctx:     userCtx,
+			product: storedProduct,
+			code:    "1234",
+			mockBehavior: func(p *entity.Product) {
+				productRepo.EXPECT().GetProduct(gomock.Any(), p.ID).Return(p, nil)
+				receptionRepo.EXPECT().GetProductReception(gomock.Any(), p.ID).Return(cancelled, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewConflict("product reception is cancelled"),
+		},
+		{
+			name:    "already issued",
+			ctx:     userCtx,
+			product: &issued,
+			code:    "1234",
+			mockBehavior: func(p *entity.Product) {
+				productRepo.EXPECT().GetProduct(gomock.Any(), p.ID).Return(p, nil)
+				receptionRepo.EXPECT().GetProductReception(gomock.Any(), p.ID).Return(reception1, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewConflict("product is issued"),
+		},
+		{
+			name:    "no pickup code",
+			ctx:     userCtx,
+			product: &entity.Product{ID: uuid.New(), ReceptionID: reception1.ID, State: entity.ProductStateStored},
+			code:    "1234",
+			mockBehavior: func(p *entity.Product) {
+				productRepo.EXPECT().GetProduct(gomock.Any(), p.ID).Return(p, nil)
+				receptionRepo.EXPECT().GetProductReception(gomock.Any(), p.ID).Return(reception1, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewBadReq("product has no pickup code"),
+		},
+		{
+			name:    "invalid pickup code",
+			ctx:     userCtx,
+			product: storedProduct,
+			code:    "4321",
+			mockBehavior: func(p *entity.Product) {
+				productRepo.EXPECT().GetProduct(gomock.Any(), p.ID).Return(p, nil)
+				receptionRepo.EXPECT().GetProductReception(gomock.Any(), p.ID).Return(reception1, nil)
+				limiter.EXPECT().Allow(p.ID.String()).Return(true, time.Duration(0))
+			},
+			expResp: nil,
+			expErr:  apperror.NewBadReq("invalid pickup code"),
+		},
+		{
+			name:    "too many attempts",
+			ctx:     userCtx,
+			product: storedProduct,
+			code:    "1234",
+			mockBehavior: func(p *entity.Product) {
+				productRepo.EXPECT().GetProduct(gomock.Any(), p.ID).Return(p, nil)
+				receptionRepo.EXPECT().GetProductReception(gomock.Any(), p.ID).Return(reception1, nil)
+				limiter.EXPECT().Allow(p.ID.String()).Return(false, time.Minute)
+			},
+			expResp: nil,
+			expErr:  apperror.NewTooManyRequests("too many pickup code attempts"),
+		},
+		{
+			name:    "issued concurrently",
+			ctx:     userCtx,
+			product: storedProduct,
+			code:    "1234",
+			mockBehavior: func(p *entity.Product) {
+				productRepo.EXPECT().GetProduct(gomock.Any(), p.ID).Return(p, nil)
+				receptionRepo.EXPECT().GetProductReception(gomock.Any(), p.ID).Return(reception1, nil)
+				limiter.EXPECT().Allow(p.ID.String()).Return(true, time.Duration(0))
+				productRepo.EXPECT().UpdateProductState(gomock.Any(), p.ID, entity.ProductStateStored, entity.ProductStateIssued, entity.ProductEventIssued, userID, nil).Return(nil, repository.ErrProductStateStale)
+			},
+			expResp: nil,
+			expErr:  apperror.NewConflict(repository.ErrProductStateStale.Error()),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(tc.product)
+
+			res, err := srv.IssueProduct(tc.ctx, tc.product.ID, &request.IssueProduct{PickupCode: tc.code})
+
+			require.Equal(t, tc.expResp, res)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestSetPickupCode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	productRepo := mocks.NewMockProductRepo(ctrl)
+	receptionRepo := mocks.NewMockReceptionGetter(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	srv := service.NewProductService(productRepo, receptionRepo, nil, auditor, nil, pickupCodeKey)
+
+	noCode := &entity.Product{ID: uuid.New(), ReceptionID: reception1.ID, State: entity.ProductStateStored}
+	withCode := *noCode
+	withCode.PickupCodeHash = secret.HMAC(pickupCodeKey, "1234")
+	issued := *noCode
+	issued.State = entity.ProductStateIssued
+
+	testCases := []struct {
+		name         string
+		ctx          context.Context
+		product      *entity.Product
+		mockBehavior func(p *entity.Product)
+		expResp      *entity.Product
+		expErr       error
+	}{
+		{
+			name:    "ok",
+			ctx:     context.Background(),
+			product: noCode,
+			mockBehavior: func(p *entity.Product) {
+				productRepo.EXPECT().GetProduct(gomock.Any(), p.ID).Return(p, nil)
+				receptionRepo.EXPECT().GetProductReception(gomock.Any(), p.ID).Return(reception1, nil)
+				productRepo.EXPECT().SetPickupCode(gomock.Any(), p.ID, withCode.PickupCodeHash).Return(&withCode, nil)
+				auditor.EXPECT().Record(gomock.Any(), entity.AuditProductPickupCodeSet, gomock.Any())
+			},
+			expResp: &withCode,
+			expErr:  nil,
+		},
+		{
+			name:    "other pvz",
+			ctx:     principal.NewContext(context.Background(), &principal.Principal{PvzIDs: []uuid.UUID{pvz2.ID}}),
+			product: noCode,
+			mockBehavior: func(p *entity.Product) {
+				productRepo.EXPECT().GetProduct(gomock.Any(), p.ID).Return(p, nil)
+				receptionRepo.EXPECT().GetProductReception(gomock.Any(), p.ID).Return(reception1, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewForbidden("no access to pvz"),
+		},
+		{
+			name:    "already has code",
+			ctx:     context.Background(),
+			product: &withCode,
+			mockBehavior: func(p *entity.Product) {
+				productRepo.EXPECT().GetProduct(gomock.Any(), p.ID).Return(p, nil)
+				receptionRepo.EXPECT().GetProductReception(gomock.Any(), p.ID).Return(reception1, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewConflict("product already has pickup code"),
+		},
+		{
+			name:    "not stored",
+			ctx:     context.Background(),
+			product: &issued,
+			mockBehavior: func(p *entity.Product) {
+				productRepo.EXPECT().GetProduct(gomock.Any(), p.ID).Return(p, nil)
+				receptionRepo.EXPECT().GetProductReception(gomock.Any(), p.ID).Return(reception1, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewConflict("product is issued"),
+		},
+		{
+			name:    "set concurrently",
+			ctx:     context.Background(),
+			product: noCode,
+			mockBehavior: func(p *entity.Product) {
+				productRepo.EXPECT().GetProduct(gomock.Any(), p.ID).Return(p, nil)
+				receptionRepo.EXPECT().GetProductReception(gomock.Any(), p.ID).Return(reception1, nil)
+				productRepo.EXPECT().SetPickupCode(gomock.Any(), p.ID, withCode.PickupCodeHash).Return(nil, repository.ErrPickupCodeNotSettable)
+			},
+			expResp: nil,
+			expErr:  apperror.NewConflict(repository.ErrPickupCodeNotSettable.Error()),
+		},
+		{
+			name:    "unk err",
+			ctx:     context.Background(),
+			product: noCode,
+			mockBehavior: func(p *entity.Product) {
+				productRepo.EXPECT().GetProduct(gomock.Any(), p.ID).Return(p, nil)
+				receptionRepo.EXPECT().GetProductReception(gomock.Any(), p.ID).Return(reception1, nil)
+				productRepo.EXPECT().SetPickupCode(gomock.Any(), p.ID, withCode.PickupCodeHash).Return(nil, errMock)
+			},
+			expResp: nil,
+			expErr:  apperror.NewInternal("failed to set pickup code", errMock),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(tc.product)
+
+			res, err := srv.SetPickupCode(tc.ctx, tc.product.ID, &request.SetPickupCode{PickupCode: "1234"})
+
+			require.Equal(t, tc.expResp, res)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestListPvzStock(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	productRepo := mocks.NewMockProductRepo(ctrl)
+	pvzSrv := mocks.NewMockPvzFinder(ctrl)
+
+	srv := service.NewProductService(productRepo, nil, pvzSrv, nil, nil, pickupCodeKey)
+
+	req := &request.ListStock{PvzID: pvz1.ID, Page: 1, Limit: 10}
+
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expResp      []*entity.Product
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz1.ID).Return(pvz1, nil)
+				productRepo.EXPECT().ListPvzStock(gomock.Any(), pvz1.ID, 1, 10).Return([]*entity.Product{storedProduct}, nil)
+			},
+			expResp: []*entity.Product{storedProduct},
+			expErr:  nil,
+		},
+		{
+			name: "pvz not found",
+			mockBehavior: func() {
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz1.ID).Return(nil, apperror.NewNotFound("pvz not found"))
+			},
+			expResp: nil,
+			expErr:  apperror.NewNotFound("pvz not found"),
+		},
+		{
+			name: "unk err",
+			mockBehavior: func() {
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz1.ID).Return(pvz1, nil)
+				productRepo.EXPECT().ListPvzStock(gomock.Any(), pvz1.ID, 1, 10).Return(nil, errMock)
+			},
+			expResp: nil,
+			expErr:  apperror.NewInternal("failed to list pvz stock", errMock),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := srv.ListPvzStock(context.Background(), req)
+
+			require.Equal(t, tc.expResp, res)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestGetProductEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	productRepo := mocks.NewMockProductRepo(ctrl)
+	receptionRepo := mocks.NewMockReceptionGetter(ctrl)
+
+	srv := service.NewProductService(productRepo, receptionRepo, nil, nil, nil, pickupCodeKey)
+
+	events := []*entity.ProductEvent{{ID: 1, ProductID: storedProduct.ID, PvzID: pvz1.ID, Type: entity.ProductEventReceived}}
+
+	testCases := []struct {
+		name         string
+		ctx          context.Context
+		mockBehavior func()
+		expResp      []*entity.ProductEvent
+		expErr       error
+	}{
+		{
+			name: "ok",
+			ctx:  context.Background(),
+			mockBehavior: func() {
+				productRepo.EXPECT().GetProduct(gomock.Any(), storedProduct.ID).Return(storedProduct, nil)
+				receptionRepo.EXPECT().GetProductReception(gomock.Any(), storedProduct.ID).Return(reception1, nil)
+				productRepo.EXPECT().ListProductEvents(gomock.Any(), storedProduct.ID).Return(events, nil)
+			},
+			expResp: events,
+			expErr:  nil,
+		},
+		{
+			name: "other pvz",
+			ctx:  principal.NewContext(context.Background(), &principal.Principal{PvzIDs: []uuid.UUID{pvz2.ID}}),
+			mockBehavior: func() {
+				productRepo.EXPECT().GetProduct(gomock.Any(), storedProduct.ID).Return(storedProduct, nil)
+				receptionRepo.EXPECT().GetProductReception(gomock.Any(), storedProduct.ID).Return(reception1, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewForbidden("no access to pvz"),
+		},
+		{
+			name: "unk err",
+			ctx:  context.Background(),
+			mockBehavior: func() {
+				productRepo.EXPECT().GetProduct(gomock.Any(), storedProduct.ID).Return(storedProduct, nil)
+				receptionRepo.EXPECT().GetProductReception(gomock.Any(), storedProduct.ID).Return(reception1, nil)
+				productRepo.EXPECT().ListProductEvents(gomock.Any(), storedProduct.ID).Return(nil, errMock)
+			},
+			expResp: nil,
+			expErr:  apperror.NewInternal("failed to get product events", errMock),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := srv.GetProductEvents(tc.ctx, storedProduct.ID)
+
+			require.Equal(t, tc.expResp, res)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestCreateReturnShipment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	productRepo := mocks.NewMockProductRepo(ctrl)
+	pvzSrv := mocks.NewMockPvzFinder(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	srv := service.NewProductService(productRepo, nil, pvzSrv, auditor, nil, pickupCodeKey)
+
+	userID := uuid.New()
+	userCtx := principal.NewContext(context.Background(), &principal.Principal{UserID: userID, AllPvz: true})
+	returned := *storedProduct
+	returned.State = entity.ProductStateReturnedToSender
+	shipment := &entity.ReturnShipment{ID: uuid.New(), PvzID: pvz1.ID, CreatedBy: userID, CreatedAt: time.Now(), Products: []*entity.Product{&returned}}
+
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expResp      *entity.ReturnShipment
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz1.ID).Return(pvz1, nil)
+				productRepo.EXPECT().CreateReturnShipment(gomock.Any(), pvz1.ID, userID).Return(shipment, nil)
+				auditor.EXPECT().Record(gomock.Any(), entity.AuditReturnShipmentCreated, gomock.Any())
+			},
+			expResp: shipment,
+			expErr:  nil,
+		},
+		{
+			name: "pvz not found",
+			mockBehavior: func() {
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz1.ID).Return(nil, apperror.NewNotFound("pvz not found"))
+			},
+			expResp: nil,
+			expErr:  apperror.NewNotFound("pvz not found"),
+		},
+		{
+			name: "nothing to return",
+			mockBehavior: func() {
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz1.ID).Return(pvz1, nil)
+				productRepo.EXPECT().CreateReturnShipment(gomock.Any(), pvz1.ID, userID).Return(nil, repository.ErrNothingToReturn)
+			},
+			expResp: nil,
+			expErr:  apperror.NewConflict(repository.ErrNothingToReturn.Error()),
+		},
+		{
+			name: "unk err",
+			mockBehavior: func() {
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz1.ID).Return(pvz1, nil)
+				productRepo.EXPECT().CreateReturnShipment(gomock.Any(), pvz1.ID, userID).Return(nil, errMock)
+			},
+			expResp: nil,
+			expErr:  apperror.NewInternal("failed to create return shipment", errMock),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := srv.CreateReturnShipment(userCtx, pvz1.ID)
+
+			require.Equal(t, tc.expResp, res)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
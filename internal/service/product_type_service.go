@@ -0,0 +1,170 @@
+//go:generate mockgen -source=./product_type_service.go -destination=./mocks/product_type_service.go -package=mocks
+
+package service
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"time"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/refcache"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+)
+
+const defaultProductTypeCacheTTL = time.Minute
+
+type ProductTypeRepo interface {
+	ListProductTypes(ctx context.Context) ([]*entity.ProductTypeInfo, error)
+	CreateProductType(ctx context.Context, req *request.CreateProductType) (*entity.ProductTypeInfo, error)
+	UpdateProductType(ctx context.Context, code string, req *request.UpdateProductType) (*entity.ProductTypeInfo, error)
+	DeleteProductType(ctx context.Context, code string) error
+}
+
+// ProductTypeServiceImpl manages product type catalog.
+// Catalog is read on every product acceptance, so it is
+// cached the same way cities are.
+type ProductTypeServiceImpl struct {
+	repo    ProductTypeRepo
+	auditor Auditor
+
+	cache *refcache.Cache[entity.ProductType, *entity.ProductTypeInfo]
+}
+
+func NewProductTypeService(repo ProductTypeRepo, auditor Auditor, ttl time.Duration) *ProductTypeServiceImpl {
+	if ttl <= 0 {
+		ttl = defaultProductTypeCacheTTL
+	}
+
+	s := &ProductTypeServiceImpl{
+		repo:    repo,
+		auditor: auditor,
+	}
+	s.cache = refcache.New(ttl, s.loadTypes)
+
+	return s
+}
+
+func (s *ProductTypeServiceImpl) ListProductTypes(ctx context.Context) ([]*entity.ProductTypeInfo, error) {
+	res, err := s.repo.ListProductTypes(ctx)
+	if err != nil {
+		return nil, apperror.NewInternal("failed to list product types", err)
+	}
+
+	return res, nil
+}
+
+func (s *ProductTypeServiceImpl) CreateProductType(ctx context.Context, req *request.CreateProductType) (*entity.ProductTypeInfo, error) {
+	if err := validateProductAttributes(req.Attributes); err != nil {
+		return nil, err
+	}
+
+	res, err := s.repo.CreateProductType(ctx, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrProductTypeAlreadyExists):
+			return nil, apperror.NewBadReq(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to create product type", err)
+		}
+	}
+
+	s.cache.Invalidate()
+	s.auditor.Record(ctx, entity.AuditProductTypeCreated, map[string]any{
+		"code": res.Code,
+		"name": res.Name,
+	})
+	return res, nil
+}
+
+func (s *ProductTypeServiceImpl) UpdateProductType(ctx context.Context, code string, req *request.UpdateProductType) (*entity.ProductTypeInfo, error) {
+	if req.Attributes != nil {
+		if err := validateProductAttributes(*req.Attributes); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := s.repo.UpdateProductType(ctx, code, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrProductTypeNotFound):
+			return nil, apperror.NewNotFound(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to update product type", err)
+		}
+	}
+
+	s.cache.Invalidate()
+	s.auditor.Record(ctx, entity.AuditProductTypeUpdated, map[string]any{
+		"code": res.Code,
+	})
+	return res, nil
+}
+
+func (s *ProductTypeServiceImpl) DeleteProductType(ctx context.Context, code string) error {
+	err := s.repo.DeleteProductType(ctx, code)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrProductTypeNotFound):
+			return apperror.NewNotFound(err.Error())
+		case errors.Is(err, repository.ErrProductTypeInUse):
+			return apperror.NewBadReq(err.Error())
+		default:
+			return apperror.NewInternal("failed to delete product type", err)
+		}
+	}
+
+	s.cache.Invalidate()
+	s.auditor.Record(ctx, entity.AuditProductTypeDeleted, map[string]any{
+		"code": code,
+	})
+	return nil
+}
+
+// GetProductType returns product type by its name.
+func (s *ProductTypeServiceImpl) GetProductType(ctx context.Context, name string) (*entity.ProductTypeInfo, error) {
+	types, err := s.cache.Get(ctx)
+	if err != nil {
+		return nil, apperror.NewInternal("failed to load product types", err)
+	}
+
+	t, ok := types[entity.ProductType(name)]
+	if !ok {
+		return nil, apperror.NewBadReq("invalid product type: " + name)
+	}
+
+	return t, nil
+}
+
+func (s *ProductTypeServiceImpl) loadTypes(ctx context.Context) (map[entity.ProductType]*entity.ProductTypeInfo, error) {
+	types, err := s.repo.ListProductTypes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[entity.ProductType]*entity.ProductTypeInfo, len(types))
+	for _, t := range types {
+		res[t.Name] = t
+	}
+
+	return res, nil
+}
+
+func validateProductAttributes(attrs []request.ProductAttribute) error {
+	seen := make(map[string]bool, len(attrs))
+	for _, a := range attrs {
+		if seen[a.Name] {
+			return apperror.NewBadReq("duplicate attribute: " + a.Name)
+		}
+		seen[a.Name] = true
+
+		if _, err := regexp.Compile(a.Pattern); err != nil {
+			return apperror.NewBadReq("invalid pattern of attribute " + a.Name + ": " + err.Error())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,226 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/service"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/service/mocks"
+)
+
+func TestCreateProductType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockProductTypeRepo(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	srv := service.NewProductTypeService(repo, auditor, 0)
+
+	testCases := []struct {
+		name         string
+		req          *request.CreateProductType
+		mockBehavior func(req *request.CreateProductType)
+		expRes       *entity.ProductTypeInfo
+		expErr       error
+	}{
+		{
+			name: "ok",
+			req: &request.CreateProductType{
+				Code:       electronics.Code,
+				Name:       string(electronics.Name),
+				Attributes: []request.ProductAttribute{{Name: "imei", Required: true, Pattern: `^[0-9]{15}$`}},
+			},
+			mockBehavior: func(req *request.CreateProductType) {
+				repo.EXPECT().CreateProductType(gomock.Any(), req).Return(electronics, nil)
+			},
+			expRes: electronics,
+			expErr: nil,
+		},
+		{
+			name: "err duplicate attribute",
+			req: &request.CreateProductType{
+				Code:       electronics.Code,
+				Name:       string(electronics.Name),
+				Attributes: []request.ProductAttribute{{Name: "imei"}, {Name: "imei"}},
+			},
+			mockBehavior: func(req *request.CreateProductType) {},
+			expRes:       nil,
+			expErr:       apperror.NewBadReq("duplicate attribute: imei"),
+		},
+		{
+			name: "err invalid pattern",
+			req: &request.CreateProductType{
+				Code:       electronics.Code,
+				Name:       string(electronics.Name),
+				Attributes: []request.ProductAttribute{{Name: "imei", Pattern: "[0-9"}},
+			},
+			mockBehavior: func(req *request.CreateProductType) {},
+			expRes:       nil,
+			expErr:       apperror.NewBadReq("invalid pattern of attribute imei: error parsing regexp: missing closing ]: `[0-9`"),
+		},
+		{
+			name: "err already exists",
+			req:  &request.CreateProductType{Code: clothes.Code, Name: string(clothes.Name)},
+			mockBehavior: func(req *request.CreateProductType) {
+				repo.EXPECT().CreateProductType(gomock.Any(), req).Return(nil, repository.ErrProductTypeAlreadyExists)
+			},
+			expRes: nil,
+			expErr: apperror.NewBadReq(repository.ErrProductTypeAlreadyExists.Error()),
+		},
+		{
+			name: "unk err",
+			req:  &request.CreateProductType{Code: clothes.Code, Name: string(clothes.Name)},
+			mockBehavior: func(req *request.CreateProductType) {
+				repo.EXPECT().CreateProductType(gomock.Any(), req).Return(nil, errMock)
+			},
+			expRes: nil,
+			expErr: apperror.NewInternal("failed to create product type", errMock),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(tc.req)
+
+			res, err := srv.CreateProductType(context.Background(), tc.req)
+			require.Equal(t, tc.expErr, err)
+			require.Equal(t, tc.expRes, res)
+		})
+	}
+}
+
+func TestUpdateProductType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockProductTypeRepo(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	srv := service.NewProductTypeService(repo, auditor, 0)
+
+	fragile := true
+	req := &request.UpdateProductType{Fragile: &fragile}
+
+	repo.EXPECT().UpdateProductType(gomock.Any(), clothes.Code, req).Return(nil, repository.ErrProductTypeNotFound)
+	res, err := srv.UpdateProductType(context.Background(), clothes.Code, req)
+	require.Nil(t, res)
+	require.Equal(t, apperror.NewNotFound(repository.ErrProductTypeNotFound.Error()), err)
+
+	repo.EXPECT().UpdateProductType(gomock.Any(), clothes.Code, req).Return(nil, errMock)
+	res, err = srv.UpdateProductType(context.Background(), clothes.Code, req)
+	require.Nil(t, res)
+	require.Equal(t, apperror.NewInternal("failed to update product type", errMock), err)
+
+	attrs := []request.ProductAttribute{{Name: "size", Pattern: "("}}
+	res, err = srv.UpdateProductType(context.Background(), clothes.Code, &request.UpdateProductType{Attributes: &attrs})
+	require.Nil(t, res)
+	require.Equal(t, apperror.NewBadReq("invalid pattern of attribute size: error parsing regexp: missing closing ): `(`"), err)
+}
+
+func TestDeleteProductType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockProductTypeRepo(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	srv := service.NewProductTypeService(repo, auditor, 0)
+
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				repo.EXPECT().DeleteProductType(gomock.Any(), clothes.Code).Return(nil)
+			},
+			expErr: nil,
+		},
+		{
+			name: "err not found",
+			mockBehavior: func() {
+				repo.EXPECT().DeleteProductType(gomock.Any(), clothes.Code).Return(repository.ErrProductTypeNotFound)
+			},
+			expErr: apperror.NewNotFound(repository.ErrProductTypeNotFound.Error()),
+		},
+		{
+			name: "err in use",
+			mockBehavior: func() {
+				repo.EXPECT().DeleteProductType(gomock.Any(), clothes.Code).Return(repository.ErrProductTypeInUse)
+			},
+			expErr: apperror.NewBadReq(repository.ErrProductTypeInUse.Error()),
+		},
+		{
+			name: "unk err",
+			mockBehavior: func() {
+				repo.EXPECT().DeleteProductType(gomock.Any(), clothes.Code).Return(errMock)
+			},
+			expErr: apperror.NewInternal("failed to delete product type", errMock),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			err := srv.DeleteProductType(context.Background(), clothes.Code)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestGetProductType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockProductTypeRepo(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	srv := service.NewProductTypeService(repo, auditor, 0)
+
+	// catalog is loaded once and then served from cache
+	repo.EXPECT().ListProductTypes(gomock.Any()).Return([]*entity.ProductTypeInfo{clothes}, nil).Times(1)
+
+	res, err := srv.GetProductType(context.Background(), string(entity.ProductTypeClothes))
+	require.NoError(t, err)
+	require.Equal(t, clothes, res)
+
+	res, err = srv.GetProductType(context.Background(), string(entity.ProductTypeElectronics))
+	require.Nil(t, res)
+	require.Equal(t, apperror.NewBadReq("invalid product type: электроника"), err)
+
+	// create drops cache
+	req := &request.CreateProductType{Code: electronics.Code, Name: string(electronics.Name)}
+	repo.EXPECT().CreateProductType(gomock.Any(), req).Return(electronics, nil)
+	_, err = srv.CreateProductType(context.Background(), req)
+	require.NoError(t, err)
+
+	repo.EXPECT().ListProductTypes(gomock.Any()).Return([]*entity.ProductTypeInfo{clothes, electronics}, nil).Times(1)
+
+	res, err = srv.GetProductType(context.Background(), string(entity.ProductTypeElectronics))
+	require.NoError(t, err)
+	require.Equal(t, electronics, res)
+}
+
+func TestGetProductTypeLoadErr(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockProductTypeRepo(ctrl)
+	srv := service.NewProductTypeService(repo, mocks.NewMockAuditor(ctrl), 0)
+
+	repo.EXPECT().ListProductTypes(gomock.Any()).Return(nil, errMock)
+
+	res, err := srv.GetProductType(context.Background(), string(entity.ProductTypeClothes))
+	require.Nil(t, res)
+	require.Equal(t, apperror.NewInternal("failed to load product types", errMock), err)
+}
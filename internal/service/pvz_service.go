@@ -5,10 +5,15 @@ package service
 import (
 	"context"
 	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
 
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/metrics"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
 )
@@ -16,15 +21,31 @@ import (
 type PvzRepo interface {
 	CreatePvz(ctx context.Context, req *request.CreatePvz) (*entity.Pvz, error)
 	SearchPvz(ctx context.Context, req *request.SearchPvz) ([]*entity.Pvz, error)
+	GetPvz(ctx context.Context, id uuid.UUID) (*entity.Pvz, error)
+	UpdatePvzStatus(ctx context.Context, id uuid.UUID, req *request.UpdatePvz, changedBy uuid.UUID) (*entity.Pvz, error)
+	UpdatePvzCapacity(ctx context.Context, id uuid.UUID, req *request.UpdatePvzCapacity) (*entity.Pvz, error)
+	ListPvzStockCounts(ctx context.Context) ([]*entity.Pvz, error)
 }
 
+const defaultStockMetricsInterval = time.Minute
+
 type PvzServiceImpl struct {
 	repo PvzRepo
+
+	auditor Auditor
+
+	stockMetricsInterval time.Duration
 }
 
-func NewPvzService(repo PvzRepo) *PvzServiceImpl {
+func NewPvzService(repo PvzRepo, auditor Auditor, stockMetricsInterval time.Duration) *PvzServiceImpl {
+	if stockMetricsInterval <= 0 {
+		stockMetricsInterval = defaultStockMetricsInterval
+	}
+
 	return &PvzServiceImpl{
-		repo: repo,
+		repo:                 repo,
+		auditor:              auditor,
+		stockMetricsInterval: stockMetricsInterval,
 	}
 }
 
@@ -37,11 +58,30 @@ func (s *PvzServiceImpl) SearchPvz(ctx context.Context, req *request.SearchPvz)
 	return res, nil
 }
 
+func (s *PvzServiceImpl) GetPvz(ctx context.Context, id uuid.UUID) (*entity.Pvz, error) {
+	res, err := s.repo.GetPvz(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrPvzNotFound):
+			return nil, apperror.NewNotFound(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to get pvz", err)
+		}
+	}
+
+	return res, nil
+}
+
 func (s *PvzServiceImpl) CreatePvz(ctx context.Context, req *request.CreatePvz) (*entity.Pvz, error) {
+	if err := validateCapacity(req.Capacity, req.SoftCapacity); err != nil {
+		return nil, err
+	}
+
 	resp, err := s.repo.CreatePvz(ctx, req)
 	if err != nil {
 		switch {
-		case errors.Is(err, repository.ErrPvzAlreadyExists):
+		case errors.Is(err, repository.ErrPvzAlreadyExists),
+			errors.Is(err, repository.ErrCityNotFound):
 			return nil, apperror.NewBadReq(err.Error())
 		default:
 			return nil, apperror.NewInternal("failed to create repository", err)
@@ -49,5 +89,102 @@ func (s *PvzServiceImpl) CreatePvz(ctx context.Context, req *request.CreatePvz)
 	}
 
 	metrics.CreatePVZ()
+	s.auditor.Record(ctx, entity.AuditPvzCreated, map[string]any{
+		"pvz_id": resp.ID,
+		"city":   resp.City,
+	})
 	return resp, err
 }
+
+// UpdatePvzStatus moves PVZ to another lifecycle state.
+// Decommissioned PVZ can't be changed anymore.
+func (s *PvzServiceImpl) UpdatePvzStatus(ctx context.Context, id uuid.UUID, req *request.UpdatePvz) (*entity.Pvz, error) {
+	var changedBy uuid.UUID
+	if p, ok := principal.FromContext(ctx); ok {
+		changedBy = p.UserID
+	}
+
+	res, err := s.repo.UpdatePvzStatus(ctx, id, req, changedBy)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrPvzNotFound):
+			return nil, apperror.NewNotFound(err.Error())
+		case errors.Is(err, repository.ErrPvzHasOpenReception),
+			errors.Is(err, repository.ErrPvzDecommissioned):
+			return nil, apperror.NewBadReq(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to update pvz", err)
+		}
+	}
+
+	s.auditor.Record(ctx, entity.AuditPvzStatusChanged, map[string]any{
+		"pvz_id": id,
+		"status": req.Status,
+		"reason": req.Reason,
+	})
+	return res, nil
+}
+
+// UpdatePvzCapacity replaces PVZ capacity limits. Lowering
+// capacity below current stock only blocks further intake.
+func (s *PvzServiceImpl) UpdatePvzCapacity(ctx context.Context, id uuid.UUID, req *request.UpdatePvzCapacity) (*entity.Pvz, error) {
+	if err := validateCapacity(req.Capacity, req.SoftCapacity); err != nil {
+		return nil, err
+	}
+
+	res, err := s.repo.UpdatePvzCapacity(ctx, id, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrPvzNotFound):
+			return nil, apperror.NewNotFound(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to update pvz capacity", err)
+		}
+	}
+
+	metrics.SetPvzStock(res.ID.String(), res.StockCount, res.Capacity)
+	s.auditor.Record(ctx, entity.AuditPvzCapacityChanged, map[string]any{
+		"pvz_id":        id,
+		"capacity":      req.Capacity,
+		"soft_capacity": req.SoftCapacity,
+	})
+	return res, nil
+}
+
+// RunStockMetrics periodically refreshes PVZ stock
+// gauges until ctx is done.
+func (s *PvzServiceImpl) RunStockMetrics(ctx context.Context) {
+	ticker := time.NewTicker(s.stockMetricsInterval)
+	defer ticker.Stop()
+
+	for {
+		s.refreshStockMetrics(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *PvzServiceImpl) refreshStockMetrics(ctx context.Context) {
+	stock, err := s.repo.ListPvzStockCounts(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			log.Printf("failed to list pvz stock: %v", err)
+		}
+		return
+	}
+
+	for _, pvz := range stock {
+		metrics.SetPvzStock(pvz.ID.String(), pvz.StockCount, pvz.Capacity)
+	}
+}
+
+func validateCapacity(capacity, softCapacity *int) error {
+	if capacity != nil && softCapacity != nil && *softCapacity > *capacity {
+		return apperror.NewBadReq("soft capacity can't be greater than capacity")
+	}
+	return nil
+}
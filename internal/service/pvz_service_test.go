@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
@@ -21,7 +22,9 @@ func TestSearchPvz(t *testing.T) {
 
 	pvzRepo := mocks.NewMockPvzRepo(ctrl)
 
-	srv := service.NewPvzService(pvzRepo)
+	auditor := mocks.NewMockAuditor(ctrl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	srv := service.NewPvzService(pvzRepo, auditor, time.Minute)
 	testCases := []struct {
 		name         string
 		req          *request.SearchPvz
@@ -76,7 +79,11 @@ func TestCreatePvz(t *testing.T) {
 
 	pvzRepo := mocks.NewMockPvzRepo(ctrl)
 
-	srv := service.NewPvzService(pvzRepo)
+	auditor := mocks.NewMockAuditor(ctrl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	srv := service.NewPvzService(pvzRepo, auditor, time.Minute)
+
+	capacity, softCapacity := 10, 20
 	testCases := []struct {
 		name         string
 		req          *request.CreatePvz
@@ -97,6 +104,19 @@ func TestCreatePvz(t *testing.T) {
 			expResp: pvz1,
 			expErr:  nil,
 		},
+		{
+			name: "err soft capacity over capacity",
+			req: &request.CreatePvz{
+				ID:               pvz1.ID,
+				RegistrationDate: pvz1.RegistrationDate,
+				City:             string(pvz1.City),
+				Capacity:         &capacity,
+				SoftCapacity:     &softCapacity,
+			},
+			mockBehavior: func(req *request.CreatePvz) {},
+			expResp:      nil,
+			expErr:       apperror.NewBadReq("soft capacity can't be greater than capacity"),
+		},
 		{
 			name: "err pvz already exists",
 			req: &request.CreatePvz{
@@ -110,6 +130,19 @@ func TestCreatePvz(t *testing.T) {
 			expResp: nil,
 			expErr:  apperror.NewBadReq(repository.ErrPvzAlreadyExists.Error()),
 		},
+		{
+			name: "err unknown city",
+			req: &request.CreatePvz{
+				ID:               pvz1.ID,
+				RegistrationDate: pvz1.RegistrationDate,
+				City:             "Атлантида",
+			},
+			mockBehavior: func(req *request.CreatePvz) {
+				pvzRepo.EXPECT().CreatePvz(gomock.Any(), req).Return(nil, repository.ErrCityNotFound)
+			},
+			expResp: nil,
+			expErr:  apperror.NewBadReq(repository.ErrCityNotFound.Error()),
+		},
 		{
 			name: "create pvz unk err",
 			req: &request.CreatePvz{
@@ -136,3 +169,200 @@ func TestCreatePvz(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPvz(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	pvzRepo := mocks.NewMockPvzRepo(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	srv := service.NewPvzService(pvzRepo, auditor, time.Minute)
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expResp      *entity.Pvz
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				pvzRepo.EXPECT().GetPvz(gomock.Any(), pvz1.ID).Return(pvz1, nil)
+			},
+			expResp: pvz1,
+			expErr:  nil,
+		},
+		{
+			name: "not found",
+			mockBehavior: func() {
+				pvzRepo.EXPECT().GetPvz(gomock.Any(), pvz1.ID).Return(nil, repository.ErrPvzNotFound)
+			},
+			expResp: nil,
+			expErr:  apperror.NewNotFound(repository.ErrPvzNotFound.Error()),
+		},
+		{
+			name: "get pvz unk err",
+			mockBehavior: func() {
+				pvzRepo.EXPECT().GetPvz(gomock.Any(), pvz1.ID).Return(nil, errMock)
+			},
+			expResp: nil,
+			expErr:  apperror.NewInternal("failed to get pvz", errMock),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			resp, err := srv.GetPvz(context.Background(), pvz1.ID)
+
+			require.Equal(t, tc.expResp, resp)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestUpdatePvzStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	pvzRepo := mocks.NewMockPvzRepo(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	srv := service.NewPvzService(pvzRepo, auditor, time.Minute)
+
+	req := &request.UpdatePvz{Status: string(entity.PvzStatusDecommissioned)}
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expResp      *entity.Pvz
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				pvzRepo.EXPECT().UpdatePvzStatus(gomock.Any(), pvz1.ID, req, uuid.Nil).Return(pvz1, nil)
+			},
+			expResp: pvz1,
+			expErr:  nil,
+		},
+		{
+			name: "not found",
+			mockBehavior: func() {
+				pvzRepo.EXPECT().UpdatePvzStatus(gomock.Any(), pvz1.ID, req, uuid.Nil).Return(nil, repository.ErrPvzNotFound)
+			},
+			expResp: nil,
+			expErr:  apperror.NewNotFound(repository.ErrPvzNotFound.Error()),
+		},
+		{
+			name: "reception in progress",
+			mockBehavior: func() {
+				pvzRepo.EXPECT().UpdatePvzStatus(gomock.Any(), pvz1.ID, req, uuid.Nil).Return(nil, repository.ErrPvzHasOpenReception)
+			},
+			expResp: nil,
+			expErr:  apperror.NewBadReq(repository.ErrPvzHasOpenReception.Error()),
+		},
+		{
+			name: "already decommissioned",
+			mockBehavior: func() {
+				pvzRepo.EXPECT().UpdatePvzStatus(gomock.Any(), pvz1.ID, req, uuid.Nil).Return(nil, repository.ErrPvzDecommissioned)
+			},
+			expResp: nil,
+			expErr:  apperror.NewBadReq(repository.ErrPvzDecommissioned.Error()),
+		},
+		{
+			name: "update unk err",
+			mockBehavior: func() {
+				pvzRepo.EXPECT().UpdatePvzStatus(gomock.Any(), pvz1.ID, req, uuid.Nil).Return(nil, errMock)
+			},
+			expResp: nil,
+			expErr:  apperror.NewInternal("failed to update pvz", errMock),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			resp, err := srv.UpdatePvzStatus(context.Background(), pvz1.ID, req)
+
+			require.Equal(t, tc.expResp, resp)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestUpdatePvzCapacity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	pvzRepo := mocks.NewMockPvzRepo(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	srv := service.NewPvzService(pvzRepo, auditor, time.Minute)
+
+	capacity, softCapacity := 100, 80
+	limited := &entity.Pvz{ID: pvz1.ID, Status: entity.PvzStatusActive, Capacity: capacity, SoftCapacity: softCapacity, StockCount: 40}
+
+	testCases := []struct {
+		name         string
+		req          *request.UpdatePvzCapacity
+		mockBehavior func(req *request.UpdatePvzCapacity)
+		expResp      *entity.Pvz
+		expErr       error
+	}{
+		{
+			name: "ok",
+			req:  &request.UpdatePvzCapacity{Capacity: &capacity, SoftCapacity: &softCapacity},
+			mockBehavior: func(req *request.UpdatePvzCapacity) {
+				pvzRepo.EXPECT().UpdatePvzCapacity(gomock.Any(), pvz1.ID, req).Return(limited, nil)
+			},
+			expResp: limited,
+			expErr:  nil,
+		},
+		{
+			name: "ok remove limits",
+			req:  &request.UpdatePvzCapacity{},
+			mockBehavior: func(req *request.UpdatePvzCapacity) {
+				pvzRepo.EXPECT().UpdatePvzCapacity(gomock.Any(), pvz1.ID, req).Return(pvz1, nil)
+			},
+			expResp: pvz1,
+			expErr:  nil,
+		},
+		{
+			name:         "soft capacity over capacity",
+			req:          &request.UpdatePvzCapacity{Capacity: &softCapacity, SoftCapacity: &capacity},
+			mockBehavior: func(req *request.UpdatePvzCapacity) {},
+			expResp:      nil,
+			expErr:       apperror.NewBadReq("soft capacity can't be greater than capacity"),
+		},
+		{
+			name: "not found",
+			req:  &request.UpdatePvzCapacity{Capacity: &capacity},
+			mockBehavior: func(req *request.UpdatePvzCapacity) {
+				pvzRepo.EXPECT().UpdatePvzCapacity(gomock.Any(), pvz1.ID, req).Return(nil, repository.ErrPvzNotFound)
+			},
+			expResp: nil,
+			expErr:  apperror.NewNotFound(repository.ErrPvzNotFound.Error()),
+		},
+		{
+			name: "update unk err",
+			req:  &request.UpdatePvzCapacity{Capacity: &capacity},
+			mockBehavior: func(req *request.UpdatePvzCapacity) {
+				pvzRepo.EXPECT().UpdatePvzCapacity(gomock.Any(), pvz1.ID, req).Return(nil, errMock)
+			},
+			expResp: nil,
+			expErr:  apperror.NewInternal("failed to update pvz capacity", errMock),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(tc.req)
+
+			resp, err := srv.UpdatePvzCapacity(context.Background(), pvz1.ID, tc.req)
+
+			require.Equal(t, tc.expResp, resp)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
@@ -6,16 +6,24 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"slices"
+	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/metrics"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
 )
 
+const defaultBatchLimit = 100
+
 type ReceptionRepo interface {
 	AddProductToReception(ctx context.Context, req *request.AddProduct, receptionID uuid.UUID) (*entity.Product, error)
 	CreateReception(ctx context.Context, req *request.CreateReception) (*entity.Reception, error)
@@ -24,24 +32,83 @@ type ReceptionRepo interface {
 	GetLastOpenReception(ctx context.Context, pvzID uuid.UUID) (*entity.Reception, error)
 	SearchReceptions(ctx context.Context, req *request.SearchPvz, pvzIDs []uuid.UUID) ([]*entity.Reception, error)
 	GetLastProductInReception(ctx context.Context, receptionID uuid.UUID) (*entity.Product, error)
+	GetLastClosedReception(ctx context.Context, pvzID uuid.UUID) (*entity.Reception, error)
+	GetProductsInReception(ctx context.Context, receptionID uuid.UUID) ([]*entity.Product, error)
+	GetPvzStats(ctx context.Context, pvzID uuid.UUID, since time.Time) (receptions, products int64, err error)
+	GetProductInReceptionByBarcode(ctx context.Context, receptionID uuid.UUID, barcode string) (*entity.Product, error)
+	SearchProductsByBarcode(ctx context.Context, barcode string, pvzIDs []uuid.UUID, since time.Time) ([]*entity.Product, error)
+	AddProductsToReception(ctx context.Context, receptionID uuid.UUID, products []request.BatchProduct) ([]*entity.Product, error)
+	FindProductsByBarcodes(ctx context.Context, barcodes []string, receptionID, pvzID uuid.UUID, since time.Time) ([]*entity.Product, error)
+	GetReceptionByID(ctx context.Context, id uuid.UUID) (*entity.Reception, error)
+	GetProductsInReceptionLIFO(ctx context.Context, receptionID uuid.UUID) ([]*entity.Product, error)
+	ListPvzReceptions(ctx context.Context, req *request.ListReceptions) ([]*entity.Reception, error)
+	CountProductsByType(ctx context.Context, receptionIDs []uuid.UUID) (map[uuid.UUID]map[entity.ProductType]int64, error)
+	CountDamagedProducts(ctx context.Context, receptionIDs []uuid.UUID) (map[uuid.UUID]entity.DamageCounts, error)
+	GetReceptionManifest(ctx context.Context, receptionID uuid.UUID) (*entity.ReceptionManifest, error)
+	FinishReceptionWithReconciliation(ctx context.Context, receptionID uuid.UUID, rec *entity.Reconciliation) (*entity.Reception, error)
+	GetReconciliation(ctx context.Context, receptionID uuid.UUID) (*entity.Reconciliation, error)
+	UpdateReceptionStatus(ctx context.Context, id uuid.UUID, from, to entity.Status, changedBy uuid.UUID, reason string) (*entity.Reception, error)
 }
 
 type PvzFinder interface {
 	SearchPvz(ctx context.Context, req *request.SearchPvz) ([]*entity.Pvz, error)
+	GetPvz(ctx context.Context, id uuid.UUID) (*entity.Pvz, error)
+}
+
+type ProductTypeFinder interface {
+	GetProductType(ctx context.Context, name string) (*entity.ProductTypeInfo, error)
+}
+
+// ExpiryCounter counts PVZ products which storage period ends.
+type ExpiryCounter interface {
+	CountExpiring(ctx context.Context, pvz *entity.Pvz) (expiring, toReturn int64, err error)
+}
+
+// CellAllocator places accepted products into storage cells.
+type CellAllocator interface {
+	AssignStorageCell(ctx context.Context, pvzID uuid.UUID, product *entity.Product) (*entity.StorageCell, error)
 }
 
 type ReceptionServiceImpl struct {
-	receptionRepo ReceptionRepo
-	pvzSrv        PvzFinder
+	receptionRepo  ReceptionRepo
+	pvzSrv         PvzFinder
+	productTypeSrv ProductTypeFinder
+	auditor        Auditor
+	expirySrv      ExpiryCounter
+	cellSrv        CellAllocator
+
+	// duplicateWindow is how far back barcode is looked up
+	// in other receptions, 0 disables the check. Within one
+	// reception duplicates are always rejected.
+	duplicateWindow time.Duration
+	// batchLimit is max number of products in one batch.
+	batchLimit int
+	// blockOnDiscrepancy forbids closing reception which doesn't
+	// match its manifest, unless moderator overrides it.
+	blockOnDiscrepancy bool
+	// pickupCodeKey is HMAC key of pickup codes hashes.
+	pickupCodeKey string
 
 	conn *sql.DB
 }
 
-func NewReceptionService(repo ReceptionRepo, conn *sql.DB, pvzSrv PvzFinder) *ReceptionServiceImpl {
+func NewReceptionService(repo ReceptionRepo, conn *sql.DB, pvzSrv PvzFinder, productTypeSrv ProductTypeFinder, auditor Auditor, expirySrv ExpiryCounter, cellSrv CellAllocator, duplicateWindow time.Duration, batchLimit int, blockOnDiscrepancy bool, pickupCodeKey string) *ReceptionServiceImpl {
+	if batchLimit <= 0 {
+		batchLimit = defaultBatchLimit
+	}
+
 	return &ReceptionServiceImpl{
-		receptionRepo: repo,
-		conn:          conn,
-		pvzSrv:        pvzSrv,
+		receptionRepo:      repo,
+		conn:               conn,
+		pvzSrv:             pvzSrv,
+		productTypeSrv:     productTypeSrv,
+		auditor:            auditor,
+		expirySrv:          expirySrv,
+		cellSrv:            cellSrv,
+		duplicateWindow:    duplicateWindow,
+		batchLimit:         batchLimit,
+		blockOnDiscrepancy: blockOnDiscrepancy,
+		pickupCodeKey:      pickupCodeKey,
 	}
 }
 
@@ -78,8 +145,177 @@ func (s *ReceptionServiceImpl) SearchReceptions(ctx context.Context, req *reques
 	return res, nil
 }
 
-func (s *ReceptionServiceImpl) FinishReception(ctx context.Context, pvzID uuid.UUID) (*entity.Reception, error) {
-	res, err := s.receptionRepo.FinishReception(ctx, pvzID)
+// GetPvzDetails returns PVZ with its open reception, last closed
+// reception, today's stats and counts of expiring products.
+func (s *ReceptionServiceImpl) GetPvzDetails(ctx context.Context, pvzID uuid.UUID) (*entity.PvzDetails, error) {
+	pvz, err := s.pvzSrv.GetPvz(ctx, pvzID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &entity.PvzDetails{Pvz: pvz}
+
+	res.OpenReception, err = s.receptionRepo.GetLastOpenReception(ctx, pvzID)
+	switch {
+	case errors.Is(err, repository.ErrNoOpenReceptionFound):
+		res.OpenReception = nil
+	case err != nil:
+		return nil, apperror.NewInternal("failed to find open reception", err)
+	default:
+		res.OpenReceptionProducts, err = s.receptionRepo.GetProductsInReception(ctx, res.OpenReception.ID)
+		if err != nil {
+			return nil, apperror.NewInternal("failed to get reception products", err)
+		}
+	}
+
+	res.LastClosedReception, err = s.receptionRepo.GetLastClosedReception(ctx, pvzID)
+	if err != nil && !errors.Is(err, repository.ErrNoClosedReception) {
+		return nil, apperror.NewInternal("failed to find last closed reception", err)
+	}
+
+	now := time.Now()
+	startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	res.ReceptionsToday, res.ProductsToday, err = s.receptionRepo.GetPvzStats(ctx, pvzID, startOfDay)
+	if err != nil {
+		return nil, apperror.NewInternal("failed to get pvz stats", err)
+	}
+
+	res.ProductsExpiring, res.ProductsToReturn, err = s.expirySrv.CountExpiring(ctx, pvz)
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// GetReception returns reception with its products, last
+// added first. Caller must have access to reception PVZ.
+func (s *ReceptionServiceImpl) GetReception(ctx context.Context, id uuid.UUID) (*entity.ReceptionDetails, error) {
+	reception, err := s.receptionRepo.GetReceptionByID(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrReceptionNotFound):
+			return nil, apperror.NewNotFound(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to get reception", err)
+		}
+	}
+
+	if p, ok := principal.FromContext(ctx); ok && !p.CanAccessPvz(reception.PvzID) {
+		return nil, apperror.NewForbidden("no access to pvz")
+	}
+
+	products, err := s.receptionRepo.GetProductsInReceptionLIFO(ctx, reception.ID)
+	if err != nil {
+		return nil, apperror.NewInternal("failed to get reception products", err)
+	}
+
+	rec, err := s.receptionRepo.GetReconciliation(ctx, reception.ID)
+	if err != nil && !errors.Is(err, repository.ErrNoReconciliation) {
+		return nil, apperror.NewInternal("failed to get reception reconciliation", err)
+	}
+
+	return &entity.ReceptionDetails{
+		Reception:      reception,
+		Products:       products,
+		Reconciliation: rec,
+	}, nil
+}
+
+// ListPvzReceptions returns page of PVZ receptions, newest
+// first, with count of products of each type and of
+// products accepted damaged or opened.
+func (s *ReceptionServiceImpl) ListPvzReceptions(ctx context.Context, req *request.ListReceptions) ([]*entity.ReceptionSummary, error) {
+	if req.Status != nil {
+		if !entity.Statuses[entity.Status(*req.Status)] {
+			return nil, apperror.NewBadReq("invalid status: " + *req.Status)
+		}
+	}
+	if req.From != nil && req.To != nil && !req.From.Before(*req.To) {
+		return nil, apperror.NewBadReq("from must be before to")
+	}
+
+	if _, err := s.pvzSrv.GetPvz(ctx, req.PvzID); err != nil {
+		return nil, err
+	}
+
+	receptions, err := s.receptionRepo.ListPvzReceptions(ctx, req)
+	if err != nil {
+		return nil, apperror.NewInternal("failed to list receptions", err)
+	}
+
+	ids := make([]uuid.UUID, len(receptions))
+	for i, r := range receptions {
+		ids[i] = r.ID
+	}
+
+	counts, err := s.receptionRepo.CountProductsByType(ctx, ids)
+	if err != nil {
+		return nil, apperror.NewInternal("failed to count reception products", err)
+	}
+	damaged, err := s.receptionRepo.CountDamagedProducts(ctx, ids)
+	if err != nil {
+		return nil, apperror.NewInternal("failed to count reception products", err)
+	}
+
+	res := make([]*entity.ReceptionSummary, len(receptions))
+	for i, r := range receptions {
+		res[i] = &entity.ReceptionSummary{
+			Reception:     r,
+			ProductCounts: counts[r.ID],
+			DamageCounts:  damaged[r.ID],
+		}
+	}
+
+	return res, nil
+}
+
+// FinishReception closes open reception of PVZ. If reception has
+// manifest, products are reconciled with it and the report is
+// stored along with closing. Summary tells how many products
+// were accepted damaged or opened.
+func (s *ReceptionServiceImpl) FinishReception(ctx context.Context, req *request.FinishReception) (*entity.ClosedReception, error) {
+	openReception, err := s.receptionRepo.GetLastOpenReception(ctx, req.PvzID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNoOpenReceptionFound):
+			return nil, apperror.NewBadReq(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to find open reception", err)
+		}
+	}
+	if req.ReceptionID != uuid.Nil && req.ReceptionID != openReception.ID {
+		return nil, apperror.NewBadReq("reception is not open: " + req.ReceptionID.String())
+	}
+
+	damaged, err := s.receptionRepo.CountDamagedProducts(ctx, []uuid.UUID{openReception.ID})
+	if err != nil {
+		return nil, apperror.NewInternal("failed to count reception products", err)
+	}
+	damage := damaged[openReception.ID]
+
+	manifest, err := s.receptionRepo.GetReceptionManifest(ctx, openReception.ID)
+	switch {
+	case errors.Is(err, repository.ErrNoManifest):
+		return s.finishWithoutManifest(ctx, req.PvzID, damage)
+	case err != nil:
+		return nil, apperror.NewInternal("failed to get reception manifest", err)
+	}
+
+	products, err := s.receptionRepo.GetProductsInReception(ctx, openReception.ID)
+	if err != nil {
+		return nil, apperror.NewInternal("failed to get reception products", err)
+	}
+
+	rec := &entity.Reconciliation{Discrepancies: reconcile(manifest, products)}
+	if len(rec.Discrepancies) > 0 {
+		if s.blockOnDiscrepancy && !req.Override {
+			return nil, &entity.ReconciliationError{Reconciliation: rec}
+		}
+		rec.Overridden = req.Override
+	}
+
+	res, err := s.receptionRepo.FinishReceptionWithReconciliation(ctx, openReception.ID, rec)
 	if err != nil {
 		switch {
 		case errors.Is(err, repository.ErrNoOpenReceptionFound):
@@ -89,9 +325,193 @@ func (s *ReceptionServiceImpl) FinishReception(ctx context.Context, pvzID uuid.U
 		}
 	}
 
+	s.auditor.Record(ctx, entity.AuditReceptionClosed, map[string]any{
+		"pvz_id":        req.PvzID,
+		"reception_id":  res.ID,
+		"discrepancies": len(rec.Discrepancies),
+		"overridden":    rec.Overridden,
+		"damaged":       damage.Damaged,
+		"opened":        damage.Opened,
+	})
+	return &entity.ClosedReception{Reception: res, Reconciliation: rec, DamageCounts: damage}, nil
+}
+
+// ReopenReception moves closed reception back to in_progress.
+// Only the latest reception of PVZ can be reopened, and only if
+// no other reception is in progress.
+func (s *ReceptionServiceImpl) ReopenReception(ctx context.Context, id uuid.UUID, req *request.ChangeReceptionStatus) (*entity.Reception, error) {
+	reception, err := s.getReceptionInScope(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if reception.Status != entity.StatusFinished {
+		return nil, apperror.NewBadReq("can't reopen reception, it is " + string(reception.Status))
+	}
+
+	res, err := s.changeReceptionStatus(ctx, reception, entity.StatusInProgress, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditor.Record(ctx, entity.AuditReceptionReopened, map[string]any{
+		"pvz_id":       res.PvzID,
+		"reception_id": res.ID,
+		"reason":       req.Reason,
+	})
+	return res, nil
+}
+
+// CancelReception marks open or closed reception as cancelled.
+// Cancelled reception is final and is excluded from reports.
+func (s *ReceptionServiceImpl) CancelReception(ctx context.Context, id uuid.UUID, req *request.ChangeReceptionStatus) (*entity.Reception, error) {
+	reception, err := s.getReceptionInScope(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if reception.Status == entity.StatusCancelled {
+		return nil, apperror.NewBadReq("reception is already cancelled")
+	}
+
+	res, err := s.changeReceptionStatus(ctx, reception, entity.StatusCancelled, req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	s.auditor.Record(ctx, entity.AuditReceptionCancelled, map[string]any{
+		"pvz_id":       res.PvzID,
+		"reception_id": res.ID,
+		"from_status":  reception.Status,
+		"reason":       req.Reason,
+	})
+	return res, nil
+}
+
+func (s *ReceptionServiceImpl) getReceptionInScope(ctx context.Context, id uuid.UUID) (*entity.Reception, error) {
+	reception, err := s.receptionRepo.GetReceptionByID(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrReceptionNotFound):
+			return nil, apperror.NewNotFound(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to get reception", err)
+		}
+	}
+
+	if p, ok := principal.FromContext(ctx); ok && !p.CanAccessPvz(reception.PvzID) {
+		return nil, apperror.NewForbidden("no access to pvz")
+	}
+
+	return reception, nil
+}
+
+func (s *ReceptionServiceImpl) changeReceptionStatus(ctx context.Context, reception *entity.Reception, to entity.Status, reason string) (*entity.Reception, error) {
+	var changedBy uuid.UUID
+	if p, ok := principal.FromContext(ctx); ok {
+		changedBy = p.UserID
+	}
+
+	res, err := s.receptionRepo.UpdateReceptionStatus(ctx, reception.ID, reception.Status, to, changedBy, reason)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrReceptionStatusStale),
+			errors.Is(err, repository.ErrReceptionInProgress),
+			errors.Is(err, repository.ErrNewerReceptionExists):
+			return nil, apperror.NewConflict(err.Error())
+		case errors.Is(err, repository.ErrReceptionCancelled),
+			errors.Is(err, repository.ErrPvzNotActive):
+			return nil, apperror.NewBadReq(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to update reception status", err)
+		}
+	}
+
 	return res, nil
 }
 
+func (s *ReceptionServiceImpl) finishWithoutManifest(ctx context.Context, pvzID uuid.UUID, damage entity.DamageCounts) (*entity.ClosedReception, error) {
+	res, err := s.receptionRepo.FinishReception(ctx, pvzID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNoOpenReceptionFound):
+			return nil, apperror.NewBadReq(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to close last reception", err)
+		}
+	}
+
+	s.auditor.Record(ctx, entity.AuditReceptionClosed, map[string]any{
+		"pvz_id":       pvzID,
+		"reception_id": res.ID,
+		"damaged":      damage.Damaged,
+		"opened":       damage.Opened,
+	})
+	return &entity.ClosedReception{Reception: res, DamageCounts: damage}, nil
+}
+
+// reconcile compares products with manifest. Barcodes are compared
+// only if manifest lists them, products without barcode are skipped.
+// Per-type counts are compared only if manifest has them.
+func reconcile(manifest *entity.ReceptionManifest, products []*entity.Product) []*entity.Discrepancy {
+	var res []*entity.Discrepancy
+
+	if len(manifest.Barcodes) > 0 {
+		expected := make(map[string]bool, len(manifest.Barcodes))
+		for _, b := range manifest.Barcodes {
+			expected[b] = true
+		}
+		accepted := make(map[string]bool, len(products))
+		for _, p := range products {
+			if p.Barcode == "" {
+				continue
+			}
+			accepted[p.Barcode] = true
+		}
+
+		for _, b := range manifest.Barcodes {
+			if !accepted[b] {
+				res = append(res, &entity.Discrepancy{Kind: entity.DiscrepancyMissing, Barcode: b, Expected: 1})
+			}
+		}
+		for _, p := range products {
+			if p.Barcode != "" && !expected[p.Barcode] {
+				res = append(res, &entity.Discrepancy{Kind: entity.DiscrepancyUnexpected, Barcode: p.Barcode, Actual: 1})
+			}
+		}
+	}
+
+	if len(manifest.TypeCounts) > 0 {
+		actual := make(map[entity.ProductType]int)
+		for _, p := range products {
+			actual[p.Type]++
+		}
+
+		types := make([]entity.ProductType, 0, len(manifest.TypeCounts)+len(actual))
+		for t := range manifest.TypeCounts {
+			types = append(types, t)
+		}
+		for t := range actual {
+			if _, ok := manifest.TypeCounts[t]; !ok {
+				types = append(types, t)
+			}
+		}
+		slices.Sort(types)
+
+		for _, t := range types {
+			expected, ok := manifest.TypeCounts[t]
+			switch {
+			case !ok:
+				res = append(res, &entity.Discrepancy{Kind: entity.DiscrepancyUnexpected, Type: t, Actual: actual[t]})
+			case actual[t] < expected:
+				res = append(res, &entity.Discrepancy{Kind: entity.DiscrepancyMissing, Type: t, Expected: expected, Actual: actual[t]})
+			case actual[t] > expected:
+				res = append(res, &entity.Discrepancy{Kind: entity.DiscrepancyOverCount, Type: t, Expected: expected, Actual: actual[t]})
+			}
+		}
+	}
+
+	return res
+}
+
 func (s *ReceptionServiceImpl) DeleteLastProduct(ctx context.Context, pvzID uuid.UUID) error {
 	tx, err := s.conn.BeginTx(ctx, nil)
 	if err != nil {
@@ -139,10 +559,36 @@ func (s *ReceptionServiceImpl) DeleteLastProduct(ctx context.Context, pvzID uuid
 	}
 
 	tx.Commit()
+	s.auditor.Record(ctx, entity.AuditProductDeleted, map[string]any{
+		"pvz_id":       pvzID,
+		"reception_id": openReception.ID,
+		"product_id":   lastProduct.ID,
+		"type":         lastProduct.Type,
+	})
 	return nil
 }
 
 func (s *ReceptionServiceImpl) CreateReception(ctx context.Context, req *request.CreateReception) (*entity.Reception, error) {
+	if req.Manifest != nil {
+		if err := s.validateManifest(ctx, req.Manifest); err != nil {
+			return nil, err
+		}
+	}
+
+	pvz, err := s.pvzSrv.GetPvz(ctx, req.PvzID)
+	if err != nil {
+		return nil, err
+	}
+	if pvz.Status != entity.PvzStatusActive {
+		return nil, apperror.NewBadReq("can't start new reception, pvz is " + string(pvz.Status))
+	}
+	if expected := manifestSize(req.Manifest); !pvz.CanAccept(expected) {
+		return nil, apperror.NewConflict(fmt.Sprintf("can't start new reception, pvz capacity exceeded: %d of %d products on hand, %d expected", pvz.StockCount, pvz.Capacity, expected))
+	}
+	if pvz.OverSoftCapacity() {
+		warnSoftCapacity(pvz)
+	}
+
 	tx, err := s.conn.BeginTx(ctx, nil)
 	if err != nil {
 		return nil, apperror.NewInternal("failed to create reception", err)
@@ -171,6 +617,8 @@ func (s *ReceptionServiceImpl) CreateReception(ctx context.Context, req *request
 		switch {
 		case errors.Is(err, repository.ErrReceptionInProgress):
 			return nil, apperror.NewBadReq("can't start new reception, already in-progress")
+		case errors.Is(err, repository.ErrPvzNotActive):
+			return nil, apperror.NewBadReq(err.Error())
 		default:
 			return nil, apperror.NewInternal("failed to create reception", err)
 		}
@@ -178,10 +626,81 @@ func (s *ReceptionServiceImpl) CreateReception(ctx context.Context, req *request
 
 	tx.Commit()
 	metrics.CreateReception()
+	s.auditor.Record(ctx, entity.AuditReceptionOpened, map[string]any{
+		"pvz_id":       req.PvzID,
+		"reception_id": reception.ID,
+	})
 	return reception, nil
 }
 
+func (s *ReceptionServiceImpl) validateManifest(ctx context.Context, manifest *request.ReceptionManifest) error {
+	if len(manifest.Barcodes) == 0 && len(manifest.TypeCounts) == 0 {
+		return apperror.NewBadReq("manifest must list barcodes or type counts")
+	}
+
+	seen := make(map[string]bool, len(manifest.Barcodes))
+	for _, b := range manifest.Barcodes {
+		if b == "" {
+			return apperror.NewBadReq("manifest has empty barcode")
+		}
+		if seen[b] {
+			return apperror.NewBadReq("manifest has repeated barcode " + b)
+		}
+		seen[b] = true
+	}
+
+	for t, count := range manifest.TypeCounts {
+		if count <= 0 {
+			return apperror.NewBadReq(fmt.Sprintf("manifest count of %s must be positive", t))
+		}
+		if _, err := s.productTypeSrv.GetProductType(ctx, t); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SearchProductsByBarcode looks product up by barcode
+// in PVZs caller has access to.
+func (s *ReceptionServiceImpl) SearchProductsByBarcode(ctx context.Context, barcode string) ([]*entity.Product, error) {
+	var pvzIDs []uuid.UUID
+	if p, ok := principal.FromContext(ctx); ok {
+		pvzIDs = p.PvzScope()
+	}
+
+	res, err := s.receptionRepo.SearchProductsByBarcode(ctx, barcode, pvzIDs, time.Time{})
+	if err != nil {
+		return nil, apperror.NewInternal("failed to search products", err)
+	}
+
+	return res, nil
+}
+
+// AddProductToReception adds product to open reception of
+// PVZ and places it into free storage cell, if there is one.
 func (s *ReceptionServiceImpl) AddProductToReception(ctx context.Context, req *request.AddProduct) (*entity.Product, error) {
+	productType, err := s.productTypeSrv.GetProductType(ctx, req.Type)
+	if err != nil {
+		return nil, err
+	}
+	if err := productType.ValidateAttributes(req.Attributes); err != nil {
+		return nil, apperror.NewBadReq(err.Error())
+	}
+	if req.SizeClass == "" {
+		req.SizeClass = string(entity.SizeClassMedium)
+	}
+	if !entity.SizeClasses[entity.SizeClass(req.SizeClass)] {
+		return nil, apperror.NewBadReq("invalid size class: " + req.SizeClass)
+	}
+	if req.Condition == "" {
+		req.Condition = string(entity.ProductConditionOK)
+	}
+	if !entity.ProductConditions[entity.ProductCondition(req.Condition)] {
+		return nil, apperror.NewBadReq("invalid condition: " + req.Condition)
+	}
+	req.PickupCodeHash = hashPickupCode(s.pickupCodeKey, req.PickupCode)
+
 	tx, err := s.conn.BeginTx(ctx, nil)
 	if err != nil {
 		return nil, apperror.NewInternal("failed to add product to reception", err)
@@ -198,17 +717,220 @@ func (s *ReceptionServiceImpl) AddProductToReception(ctx context.Context, req *r
 		}
 	}
 
+	// products without barcode can't be checked for duplicates,
+	// ones still in reception are rejected by unique constraint
+	if s.duplicateWindow > 0 && req.Barcode != "" {
+		dups, err := s.receptionRepo.FindProductsByBarcodes(ctx, []string{req.Barcode}, openReception.ID, openReception.PvzID, time.Now().Add(-s.duplicateWindow))
+		if err != nil {
+			return nil, apperror.NewInternal("failed to add product to reception", err)
+		}
+		if len(dups) > 0 {
+			return nil, &entity.DuplicateProductError{Existing: dups[0]}
+		}
+	}
+
 	res, err := s.receptionRepo.AddProductToReception(ctx, req, openReception.ID)
 	if err != nil {
 		switch {
 		case errors.Is(err, repository.ErrReceptionInProgress):
 			return nil, apperror.NewInternal("failed to add product to reception", errors.New("tried to add product to other open reception: id:"+openReception.ID.String()))
+		case errors.Is(err, repository.ErrProductTypeNotFound):
+			return nil, apperror.NewBadReq("invalid product type: " + req.Type)
+		case errors.Is(err, repository.ErrPvzCapacityExceeded):
+			return nil, apperror.NewConflict(err.Error())
+		case errors.Is(err, repository.ErrDuplicateBarcode):
+			existing, err := s.receptionRepo.GetProductInReceptionByBarcode(ctx, openReception.ID, req.Barcode)
+			if err != nil {
+				return nil, apperror.NewInternal("failed to add product to reception", err)
+			}
+			return nil, &entity.DuplicateProductError{Existing: existing}
 		default:
 			return nil, apperror.NewInternal("failed to add product to reception", err)
 		}
 	}
 
+	// product is accepted even if it isn't placed,
+	// employee can move it into cell later
+	cell, err := s.cellSrv.AssignStorageCell(ctx, openReception.PvzID, res)
+	if err != nil {
+		log.Printf("failed to assign storage cell to product %s: %v", res.ID, err)
+	}
+	if cell != nil {
+		res.CellID = cell.ID
+		res.Cell = cell
+	}
+
 	tx.Commit()
 	metrics.AddProduct()
+	s.reportStock(ctx, openReception.PvzID)
 	return res, nil
 }
+
+// AddProductsBatch adds products to open reception of PVZ at once.
+// Products are validated first, and if any of them is invalid or
+// already accepted, nothing is added and batch result tells why.
+// Added products are placed into cells like single product.
+func (s *ReceptionServiceImpl) AddProductsBatch(ctx context.Context, req *request.AddProductsBatch) (*entity.ProductsBatch, error) {
+	if len(req.Products) > s.batchLimit {
+		return nil, apperror.NewBadReq(fmt.Sprintf("too many products in batch, max %d", s.batchLimit))
+	}
+
+	tx, err := s.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, apperror.NewInternal("failed to add products to reception", err)
+	}
+	defer tx.Rollback()
+
+	openReception, err := s.receptionRepo.GetLastOpenReception(ctx, req.PvzID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNoOpenReceptionFound):
+			return nil, apperror.NewBadReq("no in-progress reception found")
+		default:
+			return nil, apperror.NewInternal("failed to add products to reception", err)
+		}
+	}
+
+	batch := &entity.ProductsBatch{Results: make([]*entity.BatchProductResult, len(req.Products))}
+	barcodes := make([]string, 0, len(req.Products))
+	seen := make(map[string]bool, len(req.Products))
+	for i, p := range req.Products {
+		res := &entity.BatchProductResult{}
+		batch.Results[i] = res
+		if p.Barcode != "" {
+			barcodes = append(barcodes, p.Barcode)
+		}
+
+		productType, err := s.productTypeSrv.GetProductType(ctx, p.Type)
+		if err != nil {
+			var httpErr apperror.HTTPError
+			if !errors.As(err, &httpErr) || httpErr.Code != http.StatusBadRequest {
+				return nil, err
+			}
+			res.Err = err
+			continue
+		}
+		if err := productType.ValidateAttributes(p.Attributes); err != nil {
+			res.Err = err
+			continue
+		}
+		if p.SizeClass == "" {
+			req.Products[i].SizeClass = string(entity.SizeClassMedium)
+		} else if !entity.SizeClasses[entity.SizeClass(p.SizeClass)] {
+			res.Err = errors.New("invalid size class: " + p.SizeClass)
+			continue
+		}
+		if p.Condition == "" {
+			req.Products[i].Condition = string(entity.ProductConditionOK)
+		} else if !entity.ProductConditions[entity.ProductCondition(p.Condition)] {
+			res.Err = errors.New("invalid condition: " + p.Condition)
+			continue
+		}
+		if p.Barcode != "" && seen[p.Barcode] {
+			res.Err = errors.New("barcode " + p.Barcode + " repeats in batch")
+			continue
+		}
+		seen[p.Barcode] = true
+		req.Products[i].PickupCodeHash = hashPickupCode(s.pickupCodeKey, p.PickupCode)
+	}
+
+	var since time.Time
+	if s.duplicateWindow > 0 {
+		since = time.Now().Add(-s.duplicateWindow)
+	}
+	// products without barcode can't be checked for duplicates
+	if len(barcodes) > 0 {
+		dups, err := s.receptionRepo.FindProductsByBarcodes(ctx, barcodes, openReception.ID, openReception.PvzID, since)
+		if err != nil {
+			return nil, apperror.NewInternal("failed to add products to reception", err)
+		}
+		existing := make(map[string]*entity.Product, len(dups))
+		for _, d := range dups {
+			if _, ok := existing[d.Barcode]; !ok { // newest first
+				existing[d.Barcode] = d
+			}
+		}
+		for i, p := range req.Products {
+			if e, ok := existing[p.Barcode]; ok && p.Barcode != "" && batch.Results[i].Err == nil {
+				batch.Results[i].Err = &entity.DuplicateProductError{Existing: e}
+			}
+		}
+	}
+
+	if !batch.Accepted() {
+		return batch, nil
+	}
+
+	products, err := s.receptionRepo.AddProductsToReception(ctx, openReception.ID, req.Products)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrReceptionInProgress):
+			// reception was closed after lookup
+			return nil, apperror.NewBadReq("no in-progress reception found")
+		case errors.Is(err, repository.ErrProductTypeNotFound):
+			return nil, apperror.NewBadReq("invalid product type in batch")
+		case errors.Is(err, repository.ErrDuplicateBarcode):
+			return nil, apperror.NewConflict("products with same barcodes were accepted concurrently, retry batch")
+		case errors.Is(err, repository.ErrPvzCapacityExceeded):
+			return nil, apperror.NewConflict(fmt.Sprintf("%s, batch of %d products doesn't fit", err, len(req.Products)))
+		default:
+			return nil, apperror.NewInternal("failed to add products to reception", err)
+		}
+	}
+
+	for i, p := range products {
+		// product is accepted even if it isn't placed,
+		// employee can move it into cell later
+		cell, err := s.cellSrv.AssignStorageCell(ctx, openReception.PvzID, p)
+		if err != nil {
+			log.Printf("failed to assign storage cell to product %s: %v", p.ID, err)
+		}
+		if cell != nil {
+			p.CellID = cell.ID
+			p.Cell = cell
+		}
+		batch.Results[i].Product = p
+	}
+
+	tx.Commit()
+	for range products {
+		metrics.AddProduct()
+	}
+	s.reportStock(ctx, openReception.PvzID)
+	return batch, nil
+}
+
+// reportStock refreshes PVZ stock gauges after intake and
+// warns if stock reached soft capacity. Intake is already
+// done, so errors are only logged.
+func (s *ReceptionServiceImpl) reportStock(ctx context.Context, pvzID uuid.UUID) {
+	pvz, err := s.pvzSrv.GetPvz(ctx, pvzID)
+	if err != nil {
+		log.Printf("failed to get pvz %s stock: %v", pvzID, err)
+		return
+	}
+
+	metrics.SetPvzStock(pvz.ID.String(), pvz.StockCount, pvz.Capacity)
+	if pvz.OverSoftCapacity() {
+		warnSoftCapacity(pvz)
+	}
+}
+
+func warnSoftCapacity(pvz *entity.Pvz) {
+	log.Printf("pvz %s is over soft capacity: %d of %d products on hand", pvz.ID, pvz.StockCount, pvz.SoftCapacity)
+	metrics.CapacityWarning(pvz.ID.String())
+}
+
+// manifestSize is how many products reception is
+// expected to take, at least one.
+func manifestSize(manifest *request.ReceptionManifest) int {
+	if manifest == nil {
+		return 1
+	}
+
+	n := 0
+	for _, c := range manifest.TypeCounts {
+		n += c
+	}
+	return max(n, len(manifest.Barcodes), 1)
+}
@@ -13,6 +13,8 @@ import (
 
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/secret"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/service"
@@ -20,9 +22,9 @@ import (
 )
 
 var (
-	pvz1 *entity.Pvz = &entity.Pvz{ID: uuid.New(), RegistrationDate: time.Now().AddDate(0, 0, -3)}
-	pvz2 *entity.Pvz = &entity.Pvz{ID: uuid.New(), RegistrationDate: time.Now().AddDate(0, 0, -1)}
-	pvz3 *entity.Pvz = &entity.Pvz{ID: uuid.New(), RegistrationDate: time.Now().AddDate(0, 0, 0)}
+	pvz1 *entity.Pvz = &entity.Pvz{ID: uuid.New(), Status: entity.PvzStatusActive, RegistrationDate: time.Now().AddDate(0, 0, -3)}
+	pvz2 *entity.Pvz = &entity.Pvz{ID: uuid.New(), Status: entity.PvzStatusActive, RegistrationDate: time.Now().AddDate(0, 0, -1)}
+	pvz3 *entity.Pvz = &entity.Pvz{ID: uuid.New(), Status: entity.PvzStatusActive, RegistrationDate: time.Now().AddDate(0, 0, 0)}
 
 	pvzs []*entity.Pvz = []*entity.Pvz{pvz1, pvz2, pvz3}
 
@@ -31,6 +33,13 @@ var (
 	reception3 *entity.Reception = &entity.Reception{ID: uuid.New(), DateTime: time.Now().AddDate(0, 0, 0), PvzID: pvz3.ID, Status: entity.StatusInProgress}
 
 	product *entity.Product = &entity.Product{ID: uuid.New(), DateTime: time.Now(), Type: entity.ProductTypeClothes, ReceptionID: reception3.ID}
+
+	clothes     *entity.ProductTypeInfo = &entity.ProductTypeInfo{Code: "clothes", Name: entity.ProductTypeClothes}
+	electronics *entity.ProductTypeInfo = &entity.ProductTypeInfo{
+		Code:       "electronics",
+		Name:       entity.ProductTypeElectronics,
+		Attributes: []entity.ProductAttribute{{Name: "imei", Required: true, Pattern: `^[0-9]{15}$`}},
+	}
 )
 
 func TestSearchReception(t *testing.T) {
@@ -39,7 +48,9 @@ func TestSearchReception(t *testing.T) {
 	receptionRepo := mocks.NewMockReceptionRepo(ctrl)
 	pvzSrv := mocks.NewMockPvzFinder(ctrl)
 
-	srv := service.NewReceptionService(receptionRepo, nil, pvzSrv)
+	auditor := mocks.NewMockAuditor(ctrl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	srv := service.NewReceptionService(receptionRepo, nil, pvzSrv, nil, auditor, nil, nil, 0, 0, false, pickupCodeKey)
 
 	testCases := []struct {
 		name         string
@@ -124,38 +135,147 @@ func TestFinishReception(t *testing.T) {
 
 	receptionRepo := mocks.NewMockReceptionRepo(ctrl)
 
-	srv := service.NewReceptionService(receptionRepo, nil, nil)
+	auditor := mocks.NewMockAuditor(ctrl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	srv := service.NewReceptionService(receptionRepo, nil, nil, nil, auditor, nil, nil, 0, 0, false, pickupCodeKey)
+	strictSrv := service.NewReceptionService(receptionRepo, nil, nil, nil, auditor, nil, nil, 0, 0, true, pickupCodeKey)
+
+	manifest := &entity.ReceptionManifest{
+		Barcodes:   []string{"001", "002"},
+		TypeCounts: map[entity.ProductType]int{entity.ProductTypeClothes: 1, entity.ProductTypeShoes: 1},
+	}
+	products := []*entity.Product{
+		{ID: uuid.New(), Type: entity.ProductTypeClothes, ReceptionID: reception3.ID, Barcode: "001"},
+		{ID: uuid.New(), Type: entity.ProductTypeClothes, ReceptionID: reception3.ID, Barcode: "003"},
+		{ID: uuid.New(), Type: entity.ProductTypeElectronics, ReceptionID: reception3.ID},
+	}
+	discrepancies := []*entity.Discrepancy{
+		{Kind: entity.DiscrepancyMissing, Barcode: "002", Expected: 1},
+		{Kind: entity.DiscrepancyUnexpected, Barcode: "003", Actual: 1},
+		// types go in order of their names
+		{Kind: entity.DiscrepancyMissing, Type: entity.ProductTypeShoes, Expected: 1},
+		{Kind: entity.DiscrepancyOverCount, Type: entity.ProductTypeClothes, Expected: 1, Actual: 2},
+		{Kind: entity.DiscrepancyUnexpected, Type: entity.ProductTypeElectronics, Actual: 1},
+	}
 
 	testCases := []struct {
 		name         string
-		req          uuid.UUID
-		mockBehavior func(req uuid.UUID)
-		expResp      *entity.Reception
+		srv          *service.ReceptionServiceImpl
+		req          *request.FinishReception
+		mockBehavior func(req *request.FinishReception)
+		expResp      *entity.ClosedReception
 		expErr       error
 	}{
 		{
-			name: "ok",
-			req:  pvz1.ID,
-			mockBehavior: func(req uuid.UUID) {
-				receptionRepo.EXPECT().FinishReception(gomock.Any(), req).Return(reception1, nil)
+			name: "reception is not open anymore",
+			srv:  srv,
+			req:  &request.FinishReception{PvzID: pvz3.ID, ReceptionID: reception2.ID},
+			mockBehavior: func(req *request.FinishReception) {
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), req.PvzID).Return(reception3, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewBadReq("reception is not open: " + reception2.ID.String()),
+		},
+		{
+			name: "ok without manifest",
+			srv:  srv,
+			req:  &request.FinishReception{PvzID: pvz3.ID},
+			mockBehavior: func(req *request.FinishReception) {
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), req.PvzID).Return(reception3, nil)
+				receptionRepo.EXPECT().CountDamagedProducts(gomock.Any(), []uuid.UUID{reception3.ID}).Return(map[uuid.UUID]entity.DamageCounts{
+					reception3.ID: {Damaged: 2, Opened: 1},
+				}, nil)
+				receptionRepo.EXPECT().GetReceptionManifest(gomock.Any(), reception3.ID).Return(nil, repository.ErrNoManifest)
+				receptionRepo.EXPECT().FinishReception(gomock.Any(), req.PvzID).Return(reception3, nil)
+			},
+			expResp: &entity.ClosedReception{Reception: reception3, DamageCounts: entity.DamageCounts{Damaged: 2, Opened: 1}},
+			expErr:  nil,
+		},
+		{
+			name: "ok with matching manifest",
+			srv:  strictSrv,
+			req:  &request.FinishReception{PvzID: pvz3.ID},
+			mockBehavior: func(req *request.FinishReception) {
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), req.PvzID).Return(reception3, nil)
+				receptionRepo.EXPECT().CountDamagedProducts(gomock.Any(), []uuid.UUID{reception3.ID}).Return(map[uuid.UUID]entity.DamageCounts{}, nil)
+				receptionRepo.EXPECT().GetReceptionManifest(gomock.Any(), reception3.ID).Return(&entity.ReceptionManifest{Barcodes: []string{"001"}}, nil)
+				receptionRepo.EXPECT().GetProductsInReception(gomock.Any(), reception3.ID).Return(products[:1], nil)
+				receptionRepo.EXPECT().FinishReceptionWithReconciliation(gomock.Any(), reception3.ID, &entity.Reconciliation{}).Return(reception3, nil)
+			},
+			expResp: &entity.ClosedReception{Reception: reception3, Reconciliation: &entity.Reconciliation{}},
+			expErr:  nil,
+		},
+		{
+			name: "discrepancies are reported",
+			srv:  srv,
+			req:  &request.FinishReception{PvzID: pvz3.ID},
+			mockBehavior: func(req *request.FinishReception) {
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), req.PvzID).Return(reception3, nil)
+				receptionRepo.EXPECT().CountDamagedProducts(gomock.Any(), []uuid.UUID{reception3.ID}).Return(map[uuid.UUID]entity.DamageCounts{}, nil)
+				receptionRepo.EXPECT().GetReceptionManifest(gomock.Any(), reception3.ID).Return(manifest, nil)
+				receptionRepo.EXPECT().GetProductsInReception(gomock.Any(), reception3.ID).Return(products, nil)
+				receptionRepo.EXPECT().FinishReceptionWithReconciliation(gomock.Any(), reception3.ID, &entity.Reconciliation{Discrepancies: discrepancies}).Return(reception3, nil)
+			},
+			expResp: &entity.ClosedReception{Reception: reception3, Reconciliation: &entity.Reconciliation{Discrepancies: discrepancies}},
+			expErr:  nil,
+		},
+		{
+			name: "discrepancies block closing",
+			srv:  strictSrv,
+			req:  &request.FinishReception{PvzID: pvz3.ID},
+			mockBehavior: func(req *request.FinishReception) {
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), req.PvzID).Return(reception3, nil)
+				receptionRepo.EXPECT().CountDamagedProducts(gomock.Any(), []uuid.UUID{reception3.ID}).Return(map[uuid.UUID]entity.DamageCounts{}, nil)
+				receptionRepo.EXPECT().GetReceptionManifest(gomock.Any(), reception3.ID).Return(manifest, nil)
+				receptionRepo.EXPECT().GetProductsInReception(gomock.Any(), reception3.ID).Return(products, nil)
 			},
-			expResp: reception1,
+			expResp: nil,
+			expErr:  &entity.ReconciliationError{Reconciliation: &entity.Reconciliation{Discrepancies: discrepancies}},
+		},
+		{
+			name: "override discrepancies",
+			srv:  strictSrv,
+			req:  &request.FinishReception{PvzID: pvz3.ID, Override: true},
+			mockBehavior: func(req *request.FinishReception) {
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), req.PvzID).Return(reception3, nil)
+				receptionRepo.EXPECT().CountDamagedProducts(gomock.Any(), []uuid.UUID{reception3.ID}).Return(map[uuid.UUID]entity.DamageCounts{}, nil)
+				receptionRepo.EXPECT().GetReceptionManifest(gomock.Any(), reception3.ID).Return(manifest, nil)
+				receptionRepo.EXPECT().GetProductsInReception(gomock.Any(), reception3.ID).Return(products, nil)
+				receptionRepo.EXPECT().FinishReceptionWithReconciliation(gomock.Any(), reception3.ID, &entity.Reconciliation{Discrepancies: discrepancies, Overridden: true}).Return(reception3, nil)
+			},
+			expResp: &entity.ClosedReception{Reception: reception3, Reconciliation: &entity.Reconciliation{Discrepancies: discrepancies, Overridden: true}},
 			expErr:  nil,
 		},
+		{
+			name: "count damaged unk err",
+			srv:  srv,
+			req:  &request.FinishReception{PvzID: pvz3.ID},
+			mockBehavior: func(req *request.FinishReception) {
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), req.PvzID).Return(reception3, nil)
+				receptionRepo.EXPECT().CountDamagedProducts(gomock.Any(), []uuid.UUID{reception3.ID}).Return(nil, errMock)
+			},
+			expResp: nil,
+			expErr:  apperror.NewInternal("failed to count reception products", errMock),
+		},
 		{
 			name: "no open reception err",
-			req:  pvz1.ID,
-			mockBehavior: func(req uuid.UUID) {
-				receptionRepo.EXPECT().FinishReception(gomock.Any(), req).Return(nil, repository.ErrNoOpenReceptionFound)
+			srv:  srv,
+			req:  &request.FinishReception{PvzID: pvz1.ID},
+			mockBehavior: func(req *request.FinishReception) {
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), req.PvzID).Return(nil, repository.ErrNoOpenReceptionFound)
 			},
 			expResp: nil,
 			expErr:  apperror.NewBadReq(repository.ErrNoOpenReceptionFound.Error()),
 		},
 		{
 			name: "finish reception unk err",
-			req:  pvz1.ID,
-			mockBehavior: func(req uuid.UUID) {
-				receptionRepo.EXPECT().FinishReception(gomock.Any(), req).Return(nil, errMock)
+			srv:  srv,
+			req:  &request.FinishReception{PvzID: pvz3.ID},
+			mockBehavior: func(req *request.FinishReception) {
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), req.PvzID).Return(reception3, nil)
+				receptionRepo.EXPECT().CountDamagedProducts(gomock.Any(), []uuid.UUID{reception3.ID}).Return(map[uuid.UUID]entity.DamageCounts{}, nil)
+				receptionRepo.EXPECT().GetReceptionManifest(gomock.Any(), reception3.ID).Return(nil, repository.ErrNoManifest)
+				receptionRepo.EXPECT().FinishReception(gomock.Any(), req.PvzID).Return(nil, errMock)
 			},
 			expResp: nil,
 			expErr:  apperror.NewInternal("failed to close last reception", errMock),
@@ -166,7 +286,7 @@ func TestFinishReception(t *testing.T) {
 		t.Run(tc.name, func(t *testing.T) {
 			tc.mockBehavior(tc.req)
 
-			res, err := srv.FinishReception(context.Background(), tc.req)
+			res, err := tc.srv.FinishReception(context.Background(), tc.req)
 
 			require.Equal(t, tc.expResp, res)
 			require.Equal(t, tc.expErr, err)
@@ -183,7 +303,9 @@ func TestDeleteLastProduct(t *testing.T) {
 
 	receptionRepo := mocks.NewMockReceptionRepo(ctrl)
 
-	srv := service.NewReceptionService(receptionRepo, dbConn, nil)
+	auditor := mocks.NewMockAuditor(ctrl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	srv := service.NewReceptionService(receptionRepo, dbConn, nil, nil, auditor, nil, nil, 0, 0, false, pickupCodeKey)
 
 	testCases := []struct {
 		name         string
@@ -321,8 +443,14 @@ func TestCreateReception(t *testing.T) {
 	defer dbConn.Close()
 
 	receptionRepo := mocks.NewMockReceptionRepo(ctrl)
+	pvzSrv := mocks.NewMockPvzFinder(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	srv := service.NewReceptionService(receptionRepo, dbConn, pvzSrv, nil, auditor, nil, nil, 0, 0, false, pickupCodeKey)
 
-	srv := service.NewReceptionService(receptionRepo, dbConn, nil)
+	fullPvz := &entity.Pvz{ID: pvz3.ID, Status: entity.PvzStatusActive, Capacity: 10, StockCount: 10}
+	nearlyFullPvz := &entity.Pvz{ID: pvz3.ID, Status: entity.PvzStatusActive, Capacity: 10, SoftCapacity: 8, StockCount: 8}
 
 	testCases := []struct {
 		name         string
@@ -337,6 +465,7 @@ func TestCreateReception(t *testing.T) {
 				PvzID: pvz3.ID,
 			},
 			mockBehavior: func(req *request.CreateReception) {
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), req.PvzID).Return(pvz3, nil)
 				txMock.ExpectBegin()
 				txMock.ExpectCommit()
 
@@ -346,6 +475,45 @@ func TestCreateReception(t *testing.T) {
 			expResp: reception3,
 			expErr:  nil,
 		},
+		{
+			name: "ok over soft capacity",
+			req: &request.CreateReception{
+				PvzID: pvz3.ID,
+			},
+			mockBehavior: func(req *request.CreateReception) {
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), req.PvzID).Return(nearlyFullPvz, nil)
+				txMock.ExpectBegin()
+				txMock.ExpectCommit()
+
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), req.PvzID).Return(nil, repository.ErrNoOpenReceptionFound)
+				receptionRepo.EXPECT().CreateReception(gomock.Any(), req).Return(reception3, nil)
+			},
+			expResp: reception3,
+			expErr:  nil,
+		},
+		{
+			name: "err pvz at capacity",
+			req: &request.CreateReception{
+				PvzID: pvz3.ID,
+			},
+			mockBehavior: func(req *request.CreateReception) {
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), req.PvzID).Return(fullPvz, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewConflict("can't start new reception, pvz capacity exceeded: 10 of 10 products on hand, 1 expected"),
+		},
+		{
+			name: "err manifest over capacity",
+			req: &request.CreateReception{
+				PvzID:    pvz3.ID,
+				Manifest: &request.ReceptionManifest{Barcodes: []string{"1", "2", "3"}},
+			},
+			mockBehavior: func(req *request.CreateReception) {
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), req.PvzID).Return(nearlyFullPvz, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewConflict("can't start new reception, pvz capacity exceeded: 8 of 10 products on hand, 3 expected"),
+		},
 		{
 			name: "tx err",
 			req: &request.CreateReception{
@@ -353,6 +521,7 @@ func TestCreateReception(t *testing.T) {
 			},
 			expResp: nil,
 			mockBehavior: func(req *request.CreateReception) {
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), req.PvzID).Return(pvz3, nil)
 				txMock.ExpectBegin().WillReturnError(errMock)
 			},
 			expErr: apperror.NewInternal("failed to create reception", errMock),
@@ -363,6 +532,7 @@ func TestCreateReception(t *testing.T) {
 				PvzID: pvz3.ID,
 			},
 			mockBehavior: func(req *request.CreateReception) {
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), req.PvzID).Return(pvz3, nil)
 				txMock.ExpectBegin()
 				txMock.ExpectRollback()
 
@@ -377,6 +547,7 @@ func TestCreateReception(t *testing.T) {
 				PvzID: pvz3.ID,
 			},
 			mockBehavior: func(req *request.CreateReception) {
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), req.PvzID).Return(pvz3, nil)
 				txMock.ExpectBegin()
 				txMock.ExpectRollback()
 
@@ -391,6 +562,7 @@ func TestCreateReception(t *testing.T) {
 				PvzID: pvz3.ID,
 			},
 			mockBehavior: func(req *request.CreateReception) {
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), req.PvzID).Return(pvz3, nil)
 				txMock.ExpectBegin()
 				txMock.ExpectRollback()
 
@@ -409,6 +581,7 @@ func TestCreateReception(t *testing.T) {
 				PvzID: pvz3.ID,
 			},
 			mockBehavior: func(req *request.CreateReception) {
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), req.PvzID).Return(pvz3, nil)
 				txMock.ExpectBegin()
 				txMock.ExpectRollback()
 
@@ -419,11 +592,12 @@ func TestCreateReception(t *testing.T) {
 			expErr:  apperror.NewBadReq("can't start new reception, already in-progress"),
 		},
 		{
-			name: "create reception other in progress err",
+			name: "create reception unk err",
 			req: &request.CreateReception{
 				PvzID: pvz3.ID,
 			},
 			mockBehavior: func(req *request.CreateReception) {
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), req.PvzID).Return(pvz3, nil)
 				txMock.ExpectBegin()
 				txMock.ExpectRollback()
 
@@ -433,6 +607,46 @@ func TestCreateReception(t *testing.T) {
 			expResp: nil,
 			expErr:  apperror.NewInternal("failed to create reception", errMock),
 		},
+		{
+			name: "pvz not found",
+			req: &request.CreateReception{
+				PvzID: pvz3.ID,
+			},
+			mockBehavior: func(req *request.CreateReception) {
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), req.PvzID).Return(nil, apperror.NewNotFound(repository.ErrPvzNotFound.Error()))
+			},
+			expResp: nil,
+			expErr:  apperror.NewNotFound(repository.ErrPvzNotFound.Error()),
+		},
+		{
+			name: "pvz not active",
+			req: &request.CreateReception{
+				PvzID: pvz3.ID,
+			},
+			mockBehavior: func(req *request.CreateReception) {
+				closed := *pvz3
+				closed.Status = entity.PvzStatusTemporarilyClosed
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), req.PvzID).Return(&closed, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewBadReq("can't start new reception, pvz is temporarily_closed"),
+		},
+		{
+			name: "pvz closed concurrently",
+			req: &request.CreateReception{
+				PvzID: pvz3.ID,
+			},
+			mockBehavior: func(req *request.CreateReception) {
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), req.PvzID).Return(pvz3, nil)
+				txMock.ExpectBegin()
+				txMock.ExpectRollback()
+
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), req.PvzID).Return(nil, repository.ErrNoOpenReceptionFound)
+				receptionRepo.EXPECT().CreateReception(gomock.Any(), req).Return(nil, repository.ErrPvzNotActive)
+			},
+			expResp: nil,
+			expErr:  apperror.NewBadReq(repository.ErrPvzNotActive.Error()),
+		},
 	}
 
 	for _, tc := range testCases {
@@ -447,6 +661,84 @@ func TestCreateReception(t *testing.T) {
 	}
 }
 
+func TestCreateReceptionWithManifest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	dbConn, txMock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer dbConn.Close()
+
+	receptionRepo := mocks.NewMockReceptionRepo(ctrl)
+	pvzSrv := mocks.NewMockPvzFinder(ctrl)
+	productTypeSrv := mocks.NewMockProductTypeFinder(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	srv := service.NewReceptionService(receptionRepo, dbConn, pvzSrv, productTypeSrv, auditor, nil, nil, 0, 0, false, pickupCodeKey)
+
+	testCases := []struct {
+		name         string
+		manifest     *request.ReceptionManifest
+		mockBehavior func(req *request.CreateReception)
+		expResp      *entity.Reception
+		expErr       error
+	}{
+		{
+			name:     "ok",
+			manifest: &request.ReceptionManifest{Barcodes: []string{"001"}, TypeCounts: map[string]int{"clothes": 2}},
+			mockBehavior: func(req *request.CreateReception) {
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), "clothes").Return(clothes, nil)
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), req.PvzID).Return(pvz3, nil)
+				txMock.ExpectBegin()
+				txMock.ExpectCommit()
+
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), req.PvzID).Return(nil, repository.ErrNoOpenReceptionFound)
+				receptionRepo.EXPECT().CreateReception(gomock.Any(), req).Return(reception3, nil)
+			},
+			expResp: reception3,
+			expErr:  nil,
+		},
+		{
+			name:         "empty manifest",
+			manifest:     &request.ReceptionManifest{},
+			mockBehavior: func(req *request.CreateReception) {},
+			expErr:       apperror.NewBadReq("manifest must list barcodes or type counts"),
+		},
+		{
+			name:         "repeated barcode",
+			manifest:     &request.ReceptionManifest{Barcodes: []string{"001", "001"}},
+			mockBehavior: func(req *request.CreateReception) {},
+			expErr:       apperror.NewBadReq("manifest has repeated barcode 001"),
+		},
+		{
+			name:         "non-positive count",
+			manifest:     &request.ReceptionManifest{TypeCounts: map[string]int{"clothes": 0}},
+			mockBehavior: func(req *request.CreateReception) {},
+			expErr:       apperror.NewBadReq("manifest count of clothes must be positive"),
+		},
+		{
+			name:     "unknown type",
+			manifest: &request.ReceptionManifest{TypeCounts: map[string]int{"food": 1}},
+			mockBehavior: func(req *request.CreateReception) {
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), "food").Return(nil, apperror.NewBadReq("invalid product type: food"))
+			},
+			expErr: apperror.NewBadReq("invalid product type: food"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := &request.CreateReception{PvzID: pvz3.ID, Manifest: tc.manifest}
+			tc.mockBehavior(req)
+
+			res, err := srv.CreateReception(context.Background(), req)
+
+			require.Equal(t, tc.expResp, res)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
+
 func TestAddProductToReception(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
@@ -455,8 +747,17 @@ func TestAddProductToReception(t *testing.T) {
 	defer dbConn.Close()
 
 	receptionRepo := mocks.NewMockReceptionRepo(ctrl)
+	productTypeSrv := mocks.NewMockProductTypeFinder(ctrl)
+	cellSrv := mocks.NewMockCellAllocator(ctrl)
+	pvzSrv := mocks.NewMockPvzFinder(ctrl)
 
-	srv := service.NewReceptionService(receptionRepo, dbConn, nil)
+	auditor := mocks.NewMockAuditor(ctrl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	srv := service.NewReceptionService(receptionRepo, dbConn, pvzSrv, productTypeSrv, auditor, nil, cellSrv, 0, 0, false, pickupCodeKey)
+
+	accepted := &entity.Product{ID: uuid.New(), Type: entity.ProductTypeShoes, ReceptionID: reception3.ID, SizeClass: entity.SizeClassLarge}
+	nearlyFull := &entity.Pvz{ID: pvz3.ID, Status: entity.PvzStatusActive, Capacity: 10, SoftCapacity: 8, StockCount: 9}
+	cell := &entity.StorageCell{ID: uuid.New(), PvzID: pvz3.ID, Zone: "A", Rack: 1, Shelf: 2, SizeClass: entity.SizeClassLarge, Capacity: 5, Occupied: 1}
 
 	testCases := []struct {
 		name         string
@@ -472,15 +773,113 @@ func TestAddProductToReception(t *testing.T) {
 				Type:  string(product.Type),
 			},
 			mockBehavior: func(req *request.AddProduct) {
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), req.Type).Return(clothes, nil)
+				txMock.ExpectBegin()
+				txMock.ExpectCommit()
+
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), req.PvzID).Return(reception3, nil)
+				receptionRepo.EXPECT().AddProductToReception(gomock.Any(), req, reception3.ID).Return(product, nil)
+				cellSrv.EXPECT().AssignStorageCell(gomock.Any(), pvz3.ID, product).Return(nil, nil)
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz3.ID).Return(pvz3, nil)
+			},
+			expResp: product,
+			expErr:  nil,
+		},
+		{
+			name: "ok pickup code hashed",
+			req: &request.AddProduct{
+				PvzID:      pvz3.ID,
+				Type:       string(product.Type),
+				PickupCode: "1234",
+			},
+			mockBehavior: func(req *request.AddProduct) {
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), req.Type).Return(clothes, nil)
+				txMock.ExpectBegin()
+				txMock.ExpectCommit()
+
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), req.PvzID).Return(reception3, nil)
+				receptionRepo.EXPECT().AddProductToReception(gomock.Any(), gomock.Any(), reception3.ID).DoAndReturn(func(_ context.Context, r *request.AddProduct, _ uuid.UUID) (*entity.Product, error) {
+					require.Equal(t, secret.HMAC(pickupCodeKey, "1234"), r.PickupCodeHash)
+					return product, nil
+				})
+				cellSrv.EXPECT().AssignStorageCell(gomock.Any(), pvz3.ID, product).Return(nil, nil)
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz3.ID).Return(pvz3, nil)
+			},
+			expResp: product,
+			expErr:  nil,
+		},
+		{
+			name: "ok placed into cell",
+			req: &request.AddProduct{
+				PvzID:     pvz3.ID,
+				Type:      string(accepted.Type),
+				SizeClass: string(entity.SizeClassLarge),
+			},
+			mockBehavior: func(req *request.AddProduct) {
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), req.Type).Return(clothes, nil)
+				txMock.ExpectBegin()
+				txMock.ExpectCommit()
+
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), req.PvzID).Return(reception3, nil)
+				receptionRepo.EXPECT().AddProductToReception(gomock.Any(), req, reception3.ID).Return(accepted, nil)
+				cellSrv.EXPECT().AssignStorageCell(gomock.Any(), pvz3.ID, accepted).Return(cell, nil)
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz3.ID).Return(nearlyFull, nil)
+			},
+			expResp: &entity.Product{
+				ID:          accepted.ID,
+				Type:        accepted.Type,
+				ReceptionID: reception3.ID,
+				SizeClass:   entity.SizeClassLarge,
+				CellID:      cell.ID,
+				Cell:        cell,
+			},
+			expErr: nil,
+		},
+		{
+			name: "ok assign cell err",
+			req: &request.AddProduct{
+				PvzID: pvz3.ID,
+				Type:  string(product.Type),
+			},
+			mockBehavior: func(req *request.AddProduct) {
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), req.Type).Return(clothes, nil)
 				txMock.ExpectBegin()
 				txMock.ExpectCommit()
 
 				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), req.PvzID).Return(reception3, nil)
 				receptionRepo.EXPECT().AddProductToReception(gomock.Any(), req, reception3.ID).Return(product, nil)
+				cellSrv.EXPECT().AssignStorageCell(gomock.Any(), pvz3.ID, product).Return(nil, apperror.NewInternal("failed to assign storage cell", errMock))
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz3.ID).Return(nil, apperror.NewInternal("failed to get pvz", errMock))
 			},
 			expResp: product,
 			expErr:  nil,
 		},
+		{
+			name: "err invalid size class",
+			req: &request.AddProduct{
+				PvzID:     pvz3.ID,
+				Type:      string(product.Type),
+				SizeClass: "huge",
+			},
+			mockBehavior: func(req *request.AddProduct) {
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), req.Type).Return(clothes, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewBadReq("invalid size class: huge"),
+		},
+		{
+			name: "err invalid condition",
+			req: &request.AddProduct{
+				PvzID:     pvz3.ID,
+				Type:      string(product.Type),
+				Condition: "wet",
+			},
+			mockBehavior: func(req *request.AddProduct) {
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), req.Type).Return(clothes, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewBadReq("invalid condition: wet"),
+		},
 		{
 			name: "tx err",
 			req: &request.AddProduct{
@@ -488,6 +887,7 @@ func TestAddProductToReception(t *testing.T) {
 				Type:  string(product.Type),
 			},
 			mockBehavior: func(req *request.AddProduct) {
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), req.Type).Return(clothes, nil)
 				txMock.ExpectBegin().WillReturnError(errMock)
 			},
 			expResp: nil,
@@ -500,6 +900,7 @@ func TestAddProductToReception(t *testing.T) {
 				Type:  string(product.Type),
 			},
 			mockBehavior: func(req *request.AddProduct) {
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), req.Type).Return(clothes, nil)
 				txMock.ExpectBegin()
 				txMock.ExpectRollback()
 
@@ -515,6 +916,7 @@ func TestAddProductToReception(t *testing.T) {
 				Type:  string(product.Type),
 			},
 			mockBehavior: func(req *request.AddProduct) {
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), req.Type).Return(clothes, nil)
 				txMock.ExpectBegin()
 				txMock.ExpectRollback()
 
@@ -530,6 +932,7 @@ func TestAddProductToReception(t *testing.T) {
 				Type:  string(product.Type),
 			},
 			mockBehavior: func(req *request.AddProduct) {
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), req.Type).Return(clothes, nil)
 				txMock.ExpectBegin()
 				txMock.ExpectRollback()
 
@@ -546,6 +949,7 @@ func TestAddProductToReception(t *testing.T) {
 				Type:  string(product.Type),
 			},
 			mockBehavior: func(req *request.AddProduct) {
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), req.Type).Return(clothes, nil)
 				txMock.ExpectBegin()
 				txMock.ExpectRollback()
 
@@ -555,15 +959,951 @@ func TestAddProductToReception(t *testing.T) {
 			expResp: nil,
 			expErr:  apperror.NewInternal("failed to add product to reception", errMock),
 		},
-	}
-
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			tc.mockBehavior(tc.req)
+		{
+			name: "err pvz at capacity",
+			req: &request.AddProduct{
+				PvzID: pvz3.ID,
+				Type:  string(product.Type),
+			},
+			mockBehavior: func(req *request.AddProduct) {
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), req.Type).Return(clothes, nil)
+				txMock.ExpectBegin()
+				txMock.ExpectRollback()
 
-			resp, err := srv.AddProductToReception(context.Background(), tc.req)
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), req.PvzID).Return(reception3, nil)
+				receptionRepo.EXPECT().AddProductToReception(gomock.Any(), req, reception3.ID).Return(nil, repository.ErrPvzCapacityExceeded)
+			},
+			expResp: nil,
+			expErr:  apperror.NewConflict("pvz is at capacity"),
+		},
+		{
+			name: "ok with attributes",
+			req: &request.AddProduct{
+				PvzID:      pvz3.ID,
+				Type:       string(entity.ProductTypeElectronics),
+				Attributes: map[string]string{"imei": "356938035643809"},
+			},
+			mockBehavior: func(req *request.AddProduct) {
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), req.Type).Return(electronics, nil)
+				txMock.ExpectBegin()
+				txMock.ExpectCommit()
 
-			require.Equal(t, tc.expResp, resp)
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), req.PvzID).Return(reception3, nil)
+				receptionRepo.EXPECT().AddProductToReception(gomock.Any(), req, reception3.ID).Return(product, nil)
+				cellSrv.EXPECT().AssignStorageCell(gomock.Any(), pvz3.ID, product).Return(nil, nil)
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz3.ID).Return(pvz3, nil)
+			},
+			expResp: product,
+			expErr:  nil,
+		},
+		{
+			name: "err unknown product type",
+			req: &request.AddProduct{
+				PvzID: pvz3.ID,
+				Type:  "unknown",
+			},
+			mockBehavior: func(req *request.AddProduct) {
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), req.Type).Return(nil, apperror.NewBadReq("invalid product type: unknown"))
+			},
+			expResp: nil,
+			expErr:  apperror.NewBadReq("invalid product type: unknown"),
+		},
+		{
+			name: "err missing required attribute",
+			req: &request.AddProduct{
+				PvzID: pvz3.ID,
+				Type:  string(entity.ProductTypeElectronics),
+			},
+			mockBehavior: func(req *request.AddProduct) {
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), req.Type).Return(electronics, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewBadReq(`missing required attribute "imei" for product type электроника`),
+		},
+		{
+			name: "err invalid attribute value",
+			req: &request.AddProduct{
+				PvzID:      pvz3.ID,
+				Type:       string(entity.ProductTypeElectronics),
+				Attributes: map[string]string{"imei": "123"},
+			},
+			mockBehavior: func(req *request.AddProduct) {
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), req.Type).Return(electronics, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewBadReq(`invalid value of attribute "imei"`),
+		},
+		{
+			name: "err unknown attribute",
+			req: &request.AddProduct{
+				PvzID:      pvz3.ID,
+				Type:       string(product.Type),
+				Attributes: map[string]string{"size": "42"},
+			},
+			mockBehavior: func(req *request.AddProduct) {
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), req.Type).Return(clothes, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewBadReq(`unknown attribute "size" for product type одежда`),
+		},
+		{
+			name: "err product type deleted concurrently",
+			req: &request.AddProduct{
+				PvzID: pvz3.ID,
+				Type:  string(product.Type),
+			},
+			mockBehavior: func(req *request.AddProduct) {
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), req.Type).Return(clothes, nil)
+				txMock.ExpectBegin()
+				txMock.ExpectRollback()
+
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), req.PvzID).Return(reception3, nil)
+				receptionRepo.EXPECT().AddProductToReception(gomock.Any(), req, reception3.ID).Return(nil, repository.ErrProductTypeNotFound)
+			},
+			expResp: nil,
+			expErr:  apperror.NewBadReq("invalid product type: " + string(product.Type)),
+		},
+		{
+			name: "err barcode already in reception",
+			req: &request.AddProduct{
+				PvzID:   pvz3.ID,
+				Type:    string(product.Type),
+				Barcode: "4601234567890",
+			},
+			mockBehavior: func(req *request.AddProduct) {
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), req.Type).Return(clothes, nil)
+				txMock.ExpectBegin()
+				txMock.ExpectRollback()
+
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), req.PvzID).Return(reception3, nil)
+				receptionRepo.EXPECT().AddProductToReception(gomock.Any(), req, reception3.ID).Return(nil, repository.ErrDuplicateBarcode)
+				receptionRepo.EXPECT().GetProductInReceptionByBarcode(gomock.Any(), reception3.ID, req.Barcode).Return(product, nil)
+			},
+			expResp: nil,
+			expErr:  &entity.DuplicateProductError{Existing: product},
+		},
+		{
+			name: "err get duplicate",
+			req: &request.AddProduct{
+				PvzID:   pvz3.ID,
+				Type:    string(product.Type),
+				Barcode: "4601234567890",
+			},
+			mockBehavior: func(req *request.AddProduct) {
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), req.Type).Return(clothes, nil)
+				txMock.ExpectBegin()
+				txMock.ExpectRollback()
+
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), req.PvzID).Return(reception3, nil)
+				receptionRepo.EXPECT().AddProductToReception(gomock.Any(), req, reception3.ID).Return(nil, repository.ErrDuplicateBarcode)
+				receptionRepo.EXPECT().GetProductInReceptionByBarcode(gomock.Any(), reception3.ID, req.Barcode).Return(nil, errMock)
+			},
+			expResp: nil,
+			expErr:  apperror.NewInternal("failed to add product to reception", errMock),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(tc.req)
+
+			resp, err := srv.AddProductToReception(context.Background(), tc.req)
+
+			require.Equal(t, tc.expResp, resp)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestAddProductToReceptionCrossReceptionDuplicate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	dbConn, txMock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer dbConn.Close()
+
+	receptionRepo := mocks.NewMockReceptionRepo(ctrl)
+	productTypeSrv := mocks.NewMockProductTypeFinder(ctrl)
+	cellSrv := mocks.NewMockCellAllocator(ctrl)
+	pvzSrv := mocks.NewMockPvzFinder(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	srv := service.NewReceptionService(receptionRepo, dbConn, pvzSrv, productTypeSrv, auditor, nil, cellSrv, 24*time.Hour, 0, false, pickupCodeKey)
+
+	req := &request.AddProduct{PvzID: pvz3.ID, Type: string(product.Type), Barcode: "4601234567890"}
+	noBarcode := &request.AddProduct{PvzID: pvz3.ID, Type: string(product.Type)}
+	testCases := []struct {
+		name         string
+		req          *request.AddProduct
+		mockBehavior func()
+		expResp      *entity.Product
+		expErr       error
+	}{
+		{
+			name: "ok",
+			req:  req,
+			mockBehavior: func() {
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), req.Type).Return(clothes, nil)
+				txMock.ExpectBegin()
+				txMock.ExpectCommit()
+
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), req.PvzID).Return(reception3, nil)
+				receptionRepo.EXPECT().FindProductsByBarcodes(gomock.Any(), []string{req.Barcode}, reception3.ID, reception3.PvzID, gomock.Any()).Return([]*entity.Product{}, nil)
+				receptionRepo.EXPECT().AddProductToReception(gomock.Any(), req, reception3.ID).Return(product, nil)
+				cellSrv.EXPECT().AssignStorageCell(gomock.Any(), pvz3.ID, product).Return(nil, nil)
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz3.ID).Return(pvz3, nil)
+			},
+			expResp: product,
+			expErr:  nil,
+		},
+		{
+			name: "ok no barcode not checked",
+			req:  noBarcode,
+			mockBehavior: func() {
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), noBarcode.Type).Return(clothes, nil)
+				txMock.ExpectBegin()
+				txMock.ExpectCommit()
+
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), noBarcode.PvzID).Return(reception3, nil)
+				receptionRepo.EXPECT().AddProductToReception(gomock.Any(), noBarcode, reception3.ID).Return(product, nil)
+				cellSrv.EXPECT().AssignStorageCell(gomock.Any(), pvz3.ID, product).Return(nil, nil)
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz3.ID).Return(pvz3, nil)
+			},
+			expResp: product,
+			expErr:  nil,
+		},
+		{
+			name: "err accepted in other reception",
+			req:  req,
+			mockBehavior: func() {
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), req.Type).Return(clothes, nil)
+				txMock.ExpectBegin()
+				txMock.ExpectRollback()
+
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), req.PvzID).Return(reception3, nil)
+				receptionRepo.EXPECT().FindProductsByBarcodes(gomock.Any(), []string{req.Barcode}, reception3.ID, reception3.PvzID, gomock.Any()).Return([]*entity.Product{product}, nil)
+			},
+			expResp: nil,
+			expErr:  &entity.DuplicateProductError{Existing: product},
+		},
+		{
+			name: "err search",
+			req:  req,
+			mockBehavior: func() {
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), req.Type).Return(clothes, nil)
+				txMock.ExpectBegin()
+				txMock.ExpectRollback()
+
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), req.PvzID).Return(reception3, nil)
+				receptionRepo.EXPECT().FindProductsByBarcodes(gomock.Any(), []string{req.Barcode}, reception3.ID, reception3.PvzID, gomock.Any()).Return(nil, errMock)
+			},
+			expResp: nil,
+			expErr:  apperror.NewInternal("failed to add product to reception", errMock),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			resp, err := srv.AddProductToReception(context.Background(), tc.req)
+
+			require.Equal(t, tc.expResp, resp)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestSearchProductsByBarcode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	receptionRepo := mocks.NewMockReceptionRepo(ctrl)
+	srv := service.NewReceptionService(receptionRepo, nil, nil, nil, mocks.NewMockAuditor(ctrl), nil, nil, 0, 0, false, pickupCodeKey)
+
+	// caller restricted to PVZs sees only their products
+	ctx := principal.NewContext(context.Background(), &principal.Principal{PvzIDs: []uuid.UUID{pvz3.ID}})
+	receptionRepo.EXPECT().SearchProductsByBarcode(gomock.Any(), "4601234567890", []uuid.UUID{pvz3.ID}, time.Time{}).Return([]*entity.Product{product}, nil)
+
+	res, err := srv.SearchProductsByBarcode(ctx, "4601234567890")
+	require.NoError(t, err)
+	require.Equal(t, []*entity.Product{product}, res)
+
+	// employee without assigned PVZs sees nothing
+	ctx = principal.NewContext(context.Background(), &principal.Principal{UserID: uuid.New(), Role: entity.RoleEmployee})
+	receptionRepo.EXPECT().SearchProductsByBarcode(gomock.Any(), "4601234567890", []uuid.UUID{}, time.Time{}).Return([]*entity.Product{}, nil)
+
+	res, err = srv.SearchProductsByBarcode(ctx, "4601234567890")
+	require.NoError(t, err)
+	require.Empty(t, res)
+
+	receptionRepo.EXPECT().SearchProductsByBarcode(gomock.Any(), "4601234567890", nil, time.Time{}).Return(nil, errMock)
+
+	res, err = srv.SearchProductsByBarcode(context.Background(), "4601234567890")
+	require.Nil(t, res)
+	require.Equal(t, apperror.NewInternal("failed to search products", errMock), err)
+}
+
+func TestGetPvzDetails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	receptionRepo := mocks.NewMockReceptionRepo(ctrl)
+	pvzSrv := mocks.NewMockPvzFinder(ctrl)
+
+	expirySrv := mocks.NewMockExpiryCounter(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	srv := service.NewReceptionService(receptionRepo, nil, pvzSrv, nil, auditor, expirySrv, nil, 0, 0, false, pickupCodeKey)
+
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expResp      *entity.PvzDetails
+		expErr       error
+	}{
+		{
+			name: "ok with open reception",
+			mockBehavior: func() {
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz3.ID).Return(pvz3, nil)
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), pvz3.ID).Return(reception3, nil)
+				receptionRepo.EXPECT().GetProductsInReception(gomock.Any(), reception3.ID).Return([]*entity.Product{product}, nil)
+				receptionRepo.EXPECT().GetLastClosedReception(gomock.Any(), pvz3.ID).Return(nil, repository.ErrNoClosedReception)
+				receptionRepo.EXPECT().GetPvzStats(gomock.Any(), pvz3.ID, gomock.Any()).Return(int64(1), int64(1), nil)
+				expirySrv.EXPECT().CountExpiring(gomock.Any(), pvz3).Return(int64(2), int64(3), nil)
+			},
+			expResp: &entity.PvzDetails{
+				Pvz:                   pvz3,
+				OpenReception:         reception3,
+				OpenReceptionProducts: []*entity.Product{product},
+				ReceptionsToday:       1,
+				ProductsToday:         1,
+				ProductsExpiring:      2,
+				ProductsToReturn:      3,
+			},
+			expErr: nil,
+		},
+		{
+			name: "ok without open reception",
+			mockBehavior: func() {
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz3.ID).Return(pvz3, nil)
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), pvz3.ID).Return(nil, repository.ErrNoOpenReceptionFound)
+				receptionRepo.EXPECT().GetLastClosedReception(gomock.Any(), pvz3.ID).Return(reception1, nil)
+				receptionRepo.EXPECT().GetPvzStats(gomock.Any(), pvz3.ID, gomock.Any()).Return(int64(0), int64(0), nil)
+				expirySrv.EXPECT().CountExpiring(gomock.Any(), pvz3).Return(int64(0), int64(0), nil)
+			},
+			expResp: &entity.PvzDetails{
+				Pvz:                 pvz3,
+				LastClosedReception: reception1,
+			},
+			expErr: nil,
+		},
+		{
+			name: "pvz not found",
+			mockBehavior: func() {
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz3.ID).Return(nil, apperror.NewNotFound(repository.ErrPvzNotFound.Error()))
+			},
+			expResp: nil,
+			expErr:  apperror.NewNotFound(repository.ErrPvzNotFound.Error()),
+		},
+		{
+			name: "get stats unk err",
+			mockBehavior: func() {
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz3.ID).Return(pvz3, nil)
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), pvz3.ID).Return(nil, repository.ErrNoOpenReceptionFound)
+				receptionRepo.EXPECT().GetLastClosedReception(gomock.Any(), pvz3.ID).Return(nil, repository.ErrNoClosedReception)
+				receptionRepo.EXPECT().GetPvzStats(gomock.Any(), pvz3.ID, gomock.Any()).Return(int64(0), int64(0), errMock)
+			},
+			expResp: nil,
+			expErr:  apperror.NewInternal("failed to get pvz stats", errMock),
+		},
+		{
+			name: "count expiring unk err",
+			mockBehavior: func() {
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz3.ID).Return(pvz3, nil)
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), pvz3.ID).Return(nil, repository.ErrNoOpenReceptionFound)
+				receptionRepo.EXPECT().GetLastClosedReception(gomock.Any(), pvz3.ID).Return(nil, repository.ErrNoClosedReception)
+				receptionRepo.EXPECT().GetPvzStats(gomock.Any(), pvz3.ID, gomock.Any()).Return(int64(0), int64(0), nil)
+				expirySrv.EXPECT().CountExpiring(gomock.Any(), pvz3).Return(int64(0), int64(0), apperror.NewInternal("failed to get pvz expiry stats", errMock))
+			},
+			expResp: nil,
+			expErr:  apperror.NewInternal("failed to get pvz expiry stats", errMock),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := srv.GetPvzDetails(context.Background(), pvz3.ID)
+
+			require.Equal(t, tc.expResp, res)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestAddProductsBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	dbConn, txMock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer dbConn.Close()
+
+	receptionRepo := mocks.NewMockReceptionRepo(ctrl)
+	productTypeSrv := mocks.NewMockProductTypeFinder(ctrl)
+	cellSrv := mocks.NewMockCellAllocator(ctrl)
+	pvzSrv := mocks.NewMockPvzFinder(ctrl)
+
+	srv := service.NewReceptionService(receptionRepo, dbConn, pvzSrv, productTypeSrv, mocks.NewMockAuditor(ctrl), nil, cellSrv, 0, 2, false, pickupCodeKey)
+
+	item1 := request.BatchProduct{Type: string(entity.ProductTypeClothes), Barcode: "1"}
+	item2 := request.BatchProduct{Type: string(entity.ProductTypeClothes), Barcode: "2", SizeClass: string(entity.SizeClassLarge)}
+	req := &request.AddProductsBatch{PvzID: pvz3.ID, Products: []request.BatchProduct{item1, item2}}
+
+	product2 := &entity.Product{ID: uuid.New(), Type: entity.ProductTypeClothes, ReceptionID: reception3.ID, Barcode: "2", SizeClass: entity.SizeClassLarge}
+	cell := &entity.StorageCell{ID: uuid.New(), PvzID: pvz3.ID, Zone: "A", Rack: 1, Shelf: 1, SizeClass: entity.SizeClassLarge, Capacity: 5}
+	noBarcode := &entity.Product{ID: uuid.New(), Type: entity.ProductTypeClothes, ReceptionID: reception3.ID}
+	existing := &entity.Product{ID: uuid.New(), Type: entity.ProductTypeClothes, ReceptionID: reception3.ID, Barcode: "2"}
+
+	testCases := []struct {
+		name         string
+		req          *request.AddProductsBatch
+		mockBehavior func()
+		expResp      *entity.ProductsBatch
+		expErr       error
+	}{
+		{
+			name: "ok",
+			req:  req,
+			mockBehavior: func() {
+				txMock.ExpectBegin()
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), pvz3.ID).Return(reception3, nil)
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), item1.Type).Return(clothes, nil).Times(2)
+				receptionRepo.EXPECT().FindProductsByBarcodes(gomock.Any(), []string{"1", "2"}, reception3.ID, reception3.PvzID, time.Time{}).Return([]*entity.Product{}, nil)
+				receptionRepo.EXPECT().AddProductsToReception(gomock.Any(), reception3.ID, gomock.Any()).DoAndReturn(func(_ context.Context, _ uuid.UUID, products []request.BatchProduct) ([]*entity.Product, error) {
+					require.Equal(t, string(entity.SizeClassMedium), products[0].SizeClass)
+					require.Equal(t, string(entity.SizeClassLarge), products[1].SizeClass)
+					return []*entity.Product{product, product2}, nil
+				})
+				cellSrv.EXPECT().AssignStorageCell(gomock.Any(), pvz3.ID, product).Return(nil, nil)
+				cellSrv.EXPECT().AssignStorageCell(gomock.Any(), pvz3.ID, product2).Return(cell, nil)
+				txMock.ExpectCommit()
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz3.ID).Return(pvz3, nil)
+			},
+			expResp: &entity.ProductsBatch{Results: []*entity.BatchProductResult{
+				{Product: product},
+				{Product: &entity.Product{ID: product2.ID, Type: product2.Type, ReceptionID: reception3.ID, Barcode: "2", SizeClass: entity.SizeClassLarge, CellID: cell.ID, Cell: cell}},
+			}},
+		},
+		{
+			name: "ok no barcodes not checked",
+			req: &request.AddProductsBatch{PvzID: pvz3.ID, Products: []request.BatchProduct{
+				{Type: item1.Type},
+				{Type: item1.Type},
+			}},
+			mockBehavior: func() {
+				txMock.ExpectBegin()
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), pvz3.ID).Return(reception3, nil)
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), item1.Type).Return(clothes, nil).Times(2)
+				receptionRepo.EXPECT().AddProductsToReception(gomock.Any(), reception3.ID, gomock.Any()).Return([]*entity.Product{product, noBarcode}, nil)
+				cellSrv.EXPECT().AssignStorageCell(gomock.Any(), pvz3.ID, product).Return(nil, nil)
+				cellSrv.EXPECT().AssignStorageCell(gomock.Any(), pvz3.ID, noBarcode).Return(nil, nil)
+				txMock.ExpectCommit()
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz3.ID).Return(pvz3, nil)
+			},
+			expResp: &entity.ProductsBatch{Results: []*entity.BatchProductResult{{Product: product}, {Product: noBarcode}}},
+		},
+		{
+			name: "duplicate rejects batch",
+			req:  req,
+			mockBehavior: func() {
+				txMock.ExpectBegin()
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), pvz3.ID).Return(reception3, nil)
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), item1.Type).Return(clothes, nil).Times(2)
+				receptionRepo.EXPECT().FindProductsByBarcodes(gomock.Any(), []string{"1", "2"}, reception3.ID, reception3.PvzID, time.Time{}).Return([]*entity.Product{existing}, nil)
+				txMock.ExpectRollback()
+			},
+			expResp: &entity.ProductsBatch{Results: []*entity.BatchProductResult{
+				{},
+				{Err: &entity.DuplicateProductError{Existing: existing}},
+			}},
+		},
+		{
+			name: "invalid product rejects batch",
+			req: &request.AddProductsBatch{PvzID: pvz3.ID, Products: []request.BatchProduct{
+				{Type: "unknown", Barcode: "1"},
+				item1,
+			}},
+			mockBehavior: func() {
+				txMock.ExpectBegin()
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), pvz3.ID).Return(reception3, nil)
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), "unknown").Return(nil, apperror.NewBadReq("invalid product type: unknown"))
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), item1.Type).Return(clothes, nil)
+				receptionRepo.EXPECT().FindProductsByBarcodes(gomock.Any(), []string{"1", "1"}, reception3.ID, reception3.PvzID, time.Time{}).Return([]*entity.Product{}, nil)
+				txMock.ExpectRollback()
+			},
+			expResp: &entity.ProductsBatch{Results: []*entity.BatchProductResult{
+				{Err: apperror.NewBadReq("invalid product type: unknown")},
+				{},
+			}},
+		},
+		{
+			name: "invalid size class rejects batch",
+			req: &request.AddProductsBatch{PvzID: pvz3.ID, Products: []request.BatchProduct{
+				item1,
+				{Type: item2.Type, Barcode: item2.Barcode, SizeClass: "huge"},
+			}},
+			mockBehavior: func() {
+				txMock.ExpectBegin()
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), pvz3.ID).Return(reception3, nil)
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), item1.Type).Return(clothes, nil).Times(2)
+				receptionRepo.EXPECT().FindProductsByBarcodes(gomock.Any(), []string{"1", "2"}, reception3.ID, reception3.PvzID, time.Time{}).Return([]*entity.Product{}, nil)
+				txMock.ExpectRollback()
+			},
+			expResp: &entity.ProductsBatch{Results: []*entity.BatchProductResult{
+				{},
+				{Err: errors.New("invalid size class: huge")},
+			}},
+		},
+		{
+			name: "invalid condition rejects batch",
+			req: &request.AddProductsBatch{PvzID: pvz3.ID, Products: []request.BatchProduct{
+				item1,
+				{Type: item2.Type, Barcode: item2.Barcode, Condition: "wet"},
+			}},
+			mockBehavior: func() {
+				txMock.ExpectBegin()
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), pvz3.ID).Return(reception3, nil)
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), item1.Type).Return(clothes, nil).Times(2)
+				receptionRepo.EXPECT().FindProductsByBarcodes(gomock.Any(), []string{"1", "2"}, reception3.ID, reception3.PvzID, time.Time{}).Return([]*entity.Product{}, nil)
+				txMock.ExpectRollback()
+			},
+			expResp: &entity.ProductsBatch{Results: []*entity.BatchProductResult{
+				{},
+				{Err: errors.New("invalid condition: wet")},
+			}},
+		},
+		{
+			name: "repeated barcode",
+			req:  &request.AddProductsBatch{PvzID: pvz3.ID, Products: []request.BatchProduct{item1, item1}},
+			mockBehavior: func() {
+				txMock.ExpectBegin()
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), pvz3.ID).Return(reception3, nil)
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), item1.Type).Return(clothes, nil).Times(2)
+				receptionRepo.EXPECT().FindProductsByBarcodes(gomock.Any(), []string{"1", "1"}, reception3.ID, reception3.PvzID, time.Time{}).Return([]*entity.Product{}, nil)
+				txMock.ExpectRollback()
+			},
+			expResp: &entity.ProductsBatch{Results: []*entity.BatchProductResult{
+				{},
+				{Err: errors.New("barcode 1 repeats in batch")},
+			}},
+		},
+		{
+			name:         "too many products",
+			req:          &request.AddProductsBatch{PvzID: pvz3.ID, Products: []request.BatchProduct{item1, item2, item1}},
+			mockBehavior: func() {},
+			expErr:       apperror.NewBadReq("too many products in batch, max 2"),
+		},
+		{
+			name: "no open reception",
+			req:  req,
+			mockBehavior: func() {
+				txMock.ExpectBegin()
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), pvz3.ID).Return(nil, repository.ErrNoOpenReceptionFound)
+				txMock.ExpectRollback()
+			},
+			expErr: apperror.NewBadReq("no in-progress reception found"),
+		},
+		{
+			name: "reception closed meanwhile",
+			req:  req,
+			mockBehavior: func() {
+				txMock.ExpectBegin()
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), pvz3.ID).Return(reception3, nil)
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), item1.Type).Return(clothes, nil).Times(2)
+				receptionRepo.EXPECT().FindProductsByBarcodes(gomock.Any(), []string{"1", "2"}, reception3.ID, reception3.PvzID, time.Time{}).Return([]*entity.Product{}, nil)
+				receptionRepo.EXPECT().AddProductsToReception(gomock.Any(), reception3.ID, req.Products).Return(nil, repository.ErrReceptionInProgress)
+				txMock.ExpectRollback()
+			},
+			expErr: apperror.NewBadReq("no in-progress reception found"),
+		},
+		{
+			name: "concurrent duplicate",
+			req:  req,
+			mockBehavior: func() {
+				txMock.ExpectBegin()
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), pvz3.ID).Return(reception3, nil)
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), item1.Type).Return(clothes, nil).Times(2)
+				receptionRepo.EXPECT().FindProductsByBarcodes(gomock.Any(), []string{"1", "2"}, reception3.ID, reception3.PvzID, time.Time{}).Return([]*entity.Product{}, nil)
+				receptionRepo.EXPECT().AddProductsToReception(gomock.Any(), reception3.ID, req.Products).Return(nil, repository.ErrDuplicateBarcode)
+				txMock.ExpectRollback()
+			},
+			expErr: apperror.NewConflict("products with same barcodes were accepted concurrently, retry batch"),
+		},
+		{
+			name: "batch over capacity",
+			req:  req,
+			mockBehavior: func() {
+				txMock.ExpectBegin()
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), pvz3.ID).Return(reception3, nil)
+				productTypeSrv.EXPECT().GetProductType(gomock.Any(), item1.Type).Return(clothes, nil).Times(2)
+				receptionRepo.EXPECT().FindProductsByBarcodes(gomock.Any(), []string{"1", "2"}, reception3.ID, reception3.PvzID, time.Time{}).Return([]*entity.Product{}, nil)
+				receptionRepo.EXPECT().AddProductsToReception(gomock.Any(), reception3.ID, req.Products).Return(nil, repository.ErrPvzCapacityExceeded)
+				txMock.ExpectRollback()
+			},
+			expErr: apperror.NewConflict("pvz is at capacity, batch of 2 products doesn't fit"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			resp, err := srv.AddProductsBatch(context.Background(), tc.req)
+
+			require.Equal(t, tc.expErr, err)
+			require.Equal(t, tc.expResp, resp)
+		})
+	}
+}
+
+func TestGetReception(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	receptionRepo := mocks.NewMockReceptionRepo(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	srv := service.NewReceptionService(receptionRepo, nil, nil, nil, auditor, nil, nil, 0, 0, false, pickupCodeKey)
+
+	testCases := []struct {
+		name         string
+		ctx          context.Context
+		mockBehavior func()
+		expResp      *entity.ReceptionDetails
+		expErr       error
+	}{
+		{
+			name: "ok",
+			ctx:  context.Background(),
+			mockBehavior: func() {
+				receptionRepo.EXPECT().GetReceptionByID(gomock.Any(), reception3.ID).Return(reception3, nil)
+				receptionRepo.EXPECT().GetProductsInReceptionLIFO(gomock.Any(), reception3.ID).Return([]*entity.Product{product}, nil)
+				receptionRepo.EXPECT().GetReconciliation(gomock.Any(), reception3.ID).Return(nil, repository.ErrNoReconciliation)
+			},
+			expResp: &entity.ReceptionDetails{Reception: reception3, Products: []*entity.Product{product}},
+			expErr:  nil,
+		},
+		{
+			name: "ok with reconciliation",
+			ctx:  context.Background(),
+			mockBehavior: func() {
+				receptionRepo.EXPECT().GetReceptionByID(gomock.Any(), reception3.ID).Return(reception3, nil)
+				receptionRepo.EXPECT().GetProductsInReceptionLIFO(gomock.Any(), reception3.ID).Return([]*entity.Product{product}, nil)
+				receptionRepo.EXPECT().GetReconciliation(gomock.Any(), reception3.ID).Return(&entity.Reconciliation{Overridden: true}, nil)
+			},
+			expResp: &entity.ReceptionDetails{
+				Reception:      reception3,
+				Products:       []*entity.Product{product},
+				Reconciliation: &entity.Reconciliation{Overridden: true},
+			},
+			expErr: nil,
+		},
+		{
+			name: "not found",
+			ctx:  context.Background(),
+			mockBehavior: func() {
+				receptionRepo.EXPECT().GetReceptionByID(gomock.Any(), reception3.ID).Return(nil, repository.ErrReceptionNotFound)
+			},
+			expResp: nil,
+			expErr:  apperror.NewNotFound(repository.ErrReceptionNotFound.Error()),
+		},
+		{
+			name: "no access to pvz",
+			ctx:  principal.NewContext(context.Background(), &principal.Principal{APIKeyID: uuid.New(), PvzIDs: []uuid.UUID{pvz1.ID}}),
+			mockBehavior: func() {
+				receptionRepo.EXPECT().GetReceptionByID(gomock.Any(), reception3.ID).Return(reception3, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewForbidden("no access to pvz"),
+		},
+		{
+			name: "get products unk err",
+			ctx:  context.Background(),
+			mockBehavior: func() {
+				receptionRepo.EXPECT().GetReceptionByID(gomock.Any(), reception3.ID).Return(reception3, nil)
+				receptionRepo.EXPECT().GetProductsInReceptionLIFO(gomock.Any(), reception3.ID).Return(nil, errMock)
+			},
+			expResp: nil,
+			expErr:  apperror.NewInternal("failed to get reception products", errMock),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := srv.GetReception(tc.ctx, reception3.ID)
+
+			require.Equal(t, tc.expResp, res)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestListPvzReceptions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	receptionRepo := mocks.NewMockReceptionRepo(ctrl)
+	pvzSrv := mocks.NewMockPvzFinder(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	srv := service.NewReceptionService(receptionRepo, nil, pvzSrv, nil, auditor, nil, nil, 0, 0, false, pickupCodeKey)
+
+	invalidStatus := "finished"
+	from, to := time.Now(), time.Now().Add(-time.Hour)
+
+	testCases := []struct {
+		name         string
+		req          *request.ListReceptions
+		mockBehavior func(req *request.ListReceptions)
+		expResp      []*entity.ReceptionSummary
+		expErr       error
+	}{
+		{
+			name: "ok",
+			req:  &request.ListReceptions{PvzID: pvz1.ID, Limit: 10},
+			mockBehavior: func(req *request.ListReceptions) {
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz1.ID).Return(pvz1, nil)
+				receptionRepo.EXPECT().ListPvzReceptions(gomock.Any(), req).Return([]*entity.Reception{reception3, reception1}, nil)
+				receptionRepo.EXPECT().CountProductsByType(gomock.Any(), []uuid.UUID{reception3.ID, reception1.ID}).
+					Return(map[uuid.UUID]map[entity.ProductType]int64{reception3.ID: {entity.ProductTypeClothes: 2}}, nil)
+				receptionRepo.EXPECT().CountDamagedProducts(gomock.Any(), []uuid.UUID{reception3.ID, reception1.ID}).
+					Return(map[uuid.UUID]entity.DamageCounts{reception3.ID: {Damaged: 1}}, nil)
+			},
+			expResp: []*entity.ReceptionSummary{
+				{
+					Reception:     reception3,
+					ProductCounts: map[entity.ProductType]int64{entity.ProductTypeClothes: 2},
+					DamageCounts:  entity.DamageCounts{Damaged: 1},
+				},
+				{Reception: reception1},
+			},
+			expErr: nil,
+		},
+		{
+			name:         "invalid status",
+			req:          &request.ListReceptions{PvzID: pvz1.ID, Status: &invalidStatus, Limit: 10},
+			mockBehavior: func(req *request.ListReceptions) {},
+			expResp:      nil,
+			expErr:       apperror.NewBadReq("invalid status: finished"),
+		},
+		{
+			name:         "from after to",
+			req:          &request.ListReceptions{PvzID: pvz1.ID, From: &from, To: &to, Limit: 10},
+			mockBehavior: func(req *request.ListReceptions) {},
+			expResp:      nil,
+			expErr:       apperror.NewBadReq("from must be before to"),
+		},
+		{
+			name: "pvz not found",
+			req:  &request.ListReceptions{PvzID: pvz1.ID, Limit: 10},
+			mockBehavior: func(req *request.ListReceptions) {
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz1.ID).Return(nil, apperror.NewNotFound(repository.ErrPvzNotFound.Error()))
+			},
+			expResp: nil,
+			expErr:  apperror.NewNotFound(repository.ErrPvzNotFound.Error()),
+		},
+		{
+			name: "count products unk err",
+			req:  &request.ListReceptions{PvzID: pvz1.ID, Limit: 10},
+			mockBehavior: func(req *request.ListReceptions) {
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz1.ID).Return(pvz1, nil)
+				receptionRepo.EXPECT().ListPvzReceptions(gomock.Any(), req).Return([]*entity.Reception{reception1}, nil)
+				receptionRepo.EXPECT().CountProductsByType(gomock.Any(), []uuid.UUID{reception1.ID}).Return(nil, errMock)
+			},
+			expResp: nil,
+			expErr:  apperror.NewInternal("failed to count reception products", errMock),
+		},
+		{
+			name: "count damaged unk err",
+			req:  &request.ListReceptions{PvzID: pvz1.ID, Limit: 10},
+			mockBehavior: func(req *request.ListReceptions) {
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz1.ID).Return(pvz1, nil)
+				receptionRepo.EXPECT().ListPvzReceptions(gomock.Any(), req).Return([]*entity.Reception{reception1}, nil)
+				receptionRepo.EXPECT().CountProductsByType(gomock.Any(), []uuid.UUID{reception1.ID}).Return(map[uuid.UUID]map[entity.ProductType]int64{}, nil)
+				receptionRepo.EXPECT().CountDamagedProducts(gomock.Any(), []uuid.UUID{reception1.ID}).Return(nil, errMock)
+			},
+			expResp: nil,
+			expErr:  apperror.NewInternal("failed to count reception products", errMock),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(tc.req)
+
+			res, err := srv.ListPvzReceptions(context.Background(), tc.req)
+
+			require.Equal(t, tc.expResp, res)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestReopenReception(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	receptionRepo := mocks.NewMockReceptionRepo(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	srv := service.NewReceptionService(receptionRepo, nil, nil, nil, auditor, nil, nil, 0, 0, false, pickupCodeKey)
+
+	moderator := &principal.Principal{UserID: uuid.New(), Role: entity.RoleModerator, AllPvz: true}
+	req := &request.ChangeReceptionStatus{Reason: "closed by mistake"}
+	reopened := &entity.Reception{ID: reception2.ID, DateTime: reception2.DateTime, PvzID: reception2.PvzID, Status: entity.StatusInProgress}
+
+	testCases := []struct {
+		name         string
+		ctx          context.Context
+		mockBehavior func()
+		expResp      *entity.Reception
+		expErr       error
+	}{
+		{
+			name: "ok",
+			ctx:  principal.NewContext(context.Background(), moderator),
+			mockBehavior: func() {
+				receptionRepo.EXPECT().GetReceptionByID(gomock.Any(), reception2.ID).Return(reception2, nil)
+				receptionRepo.EXPECT().UpdateReceptionStatus(gomock.Any(), reception2.ID, entity.StatusFinished, entity.StatusInProgress, moderator.UserID, req.Reason).Return(reopened, nil)
+				auditor.EXPECT().Record(gomock.Any(), entity.AuditReceptionReopened, gomock.Any())
+			},
+			expResp: reopened,
+			expErr:  nil,
+		},
+		{
+			name: "not found",
+			ctx:  context.Background(),
+			mockBehavior: func() {
+				receptionRepo.EXPECT().GetReceptionByID(gomock.Any(), reception2.ID).Return(nil, repository.ErrReceptionNotFound)
+			},
+			expResp: nil,
+			expErr:  apperror.NewNotFound(repository.ErrReceptionNotFound.Error()),
+		},
+		{
+			name: "no access to pvz",
+			ctx:  principal.NewContext(context.Background(), &principal.Principal{APIKeyID: uuid.New(), PvzIDs: []uuid.UUID{pvz1.ID}}),
+			mockBehavior: func() {
+				receptionRepo.EXPECT().GetReceptionByID(gomock.Any(), reception2.ID).Return(reception2, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewForbidden("no access to pvz"),
+		},
+		{
+			name: "not closed",
+			ctx:  context.Background(),
+			mockBehavior: func() {
+				receptionRepo.EXPECT().GetReceptionByID(gomock.Any(), reception2.ID).Return(reopened, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewBadReq("can't reopen reception, it is in_progress"),
+		},
+		{
+			name: "newer reception exists",
+			ctx:  context.Background(),
+			mockBehavior: func() {
+				receptionRepo.EXPECT().GetReceptionByID(gomock.Any(), reception2.ID).Return(reception2, nil)
+				receptionRepo.EXPECT().UpdateReceptionStatus(gomock.Any(), reception2.ID, entity.StatusFinished, entity.StatusInProgress, uuid.Nil, req.Reason).Return(nil, repository.ErrNewerReceptionExists)
+			},
+			expResp: nil,
+			expErr:  apperror.NewConflict(repository.ErrNewerReceptionExists.Error()),
+		},
+		{
+			name: "pvz not active",
+			ctx:  context.Background(),
+			mockBehavior: func() {
+				receptionRepo.EXPECT().GetReceptionByID(gomock.Any(), reception2.ID).Return(reception2, nil)
+				receptionRepo.EXPECT().UpdateReceptionStatus(gomock.Any(), reception2.ID, entity.StatusFinished, entity.StatusInProgress, uuid.Nil, req.Reason).Return(nil, repository.ErrPvzNotActive)
+			},
+			expResp: nil,
+			expErr:  apperror.NewBadReq(repository.ErrPvzNotActive.Error()),
+		},
+		{
+			name: "update unk err",
+			ctx:  context.Background(),
+			mockBehavior: func() {
+				receptionRepo.EXPECT().GetReceptionByID(gomock.Any(), reception2.ID).Return(reception2, nil)
+				receptionRepo.EXPECT().UpdateReceptionStatus(gomock.Any(), reception2.ID, entity.StatusFinished, entity.StatusInProgress, uuid.Nil, req.Reason).Return(nil, errMock)
+			},
+			expResp: nil,
+			expErr:  apperror.NewInternal("failed to update reception status", errMock),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := srv.ReopenReception(tc.ctx, reception2.ID, req)
+
+			require.Equal(t, tc.expResp, res)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestCancelReception(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	receptionRepo := mocks.NewMockReceptionRepo(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	srv := service.NewReceptionService(receptionRepo, nil, nil, nil, auditor, nil, nil, 0, 0, false, pickupCodeKey)
+
+	req := &request.ChangeReceptionStatus{Reason: "test reception"}
+	cancelled := &entity.Reception{ID: reception3.ID, DateTime: reception3.DateTime, PvzID: reception3.PvzID, Status: entity.StatusCancelled}
+
+	testCases := []struct {
+		name         string
+		mockBehavior func()
+		expResp      *entity.Reception
+		expErr       error
+	}{
+		{
+			name: "ok",
+			mockBehavior: func() {
+				receptionRepo.EXPECT().GetReceptionByID(gomock.Any(), reception3.ID).Return(reception3, nil)
+				receptionRepo.EXPECT().UpdateReceptionStatus(gomock.Any(), reception3.ID, entity.StatusInProgress, entity.StatusCancelled, uuid.Nil, req.Reason).Return(cancelled, nil)
+				auditor.EXPECT().Record(gomock.Any(), entity.AuditReceptionCancelled, gomock.Any())
+			},
+			expResp: cancelled,
+			expErr:  nil,
+		},
+		{
+			name: "already cancelled",
+			mockBehavior: func() {
+				receptionRepo.EXPECT().GetReceptionByID(gomock.Any(), reception3.ID).Return(cancelled, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewBadReq("reception is already cancelled"),
+		},
+		{
+			name: "status changed",
+			mockBehavior: func() {
+				receptionRepo.EXPECT().GetReceptionByID(gomock.Any(), reception3.ID).Return(reception3, nil)
+				receptionRepo.EXPECT().UpdateReceptionStatus(gomock.Any(), reception3.ID, entity.StatusInProgress, entity.StatusCancelled, uuid.Nil, req.Reason).Return(nil, repository.ErrReceptionStatusStale)
+			},
+			expResp: nil,
+			expErr:  apperror.NewConflict(repository.ErrReceptionStatusStale.Error()),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			res, err := srv.CancelReception(context.Background(), reception3.ID, req)
+
+			require.Equal(t, tc.expResp, res)
 			require.Equal(t, tc.expErr, err)
 		})
 	}
@@ -1,7 +1,22 @@
 package service
 
 type Service struct {
-	UserService      UserServiceImpl
-	PvzService       PvzServiceImpl
-	ReceptionService ReceptionServiceImpl
+	UserService        UserServiceImpl
+	InviteService      InviteServiceImpl
+	PasswordService    PasswordServiceImpl
+	APIKeyService      APIKeyServiceImpl
+	MFAService         MFAServiceImpl
+	AuditService       AuditServiceImpl
+	CityService        CityServiceImpl
+	ProductTypeService ProductTypeServiceImpl
+	PvzService         PvzServiceImpl
+	ReceptionService   ReceptionServiceImpl
+	ProductService     ProductServiceImpl
+	StorageCellService StorageCellServiceImpl
+	TransferService    TransferServiceImpl
+	AttachmentService  AttachmentServiceImpl
+	IdempotencyService IdempotencyServiceImpl
+
+	StaleReceptionService StaleReceptionServiceImpl
+	ProductExpiryService  ProductExpiryServiceImpl
 }
@@ -0,0 +1,198 @@
+//go:generate mockgen -source=./stale_reception_service.go -destination=./mocks/stale_reception_service.go -package=mocks
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/metrics"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+)
+
+const (
+	defaultStaleThreshold     = 12 * time.Hour
+	defaultStaleCheckInterval = 10 * time.Minute
+)
+
+// StaleAction is what is done with reception
+// open longer than threshold.
+type StaleAction string
+
+const (
+	StaleActionNone  StaleAction = ""
+	StaleActionClose StaleAction = "close"
+	StaleActionAlert StaleAction = "alert"
+	StaleActionBoth  StaleAction = "both"
+)
+
+// ParseStaleAction validates action from config,
+// empty action disables stale receptions check.
+func ParseStaleAction(s string) (StaleAction, error) {
+	switch a := StaleAction(s); a {
+	case StaleActionNone, StaleActionClose, StaleActionAlert, StaleActionBoth:
+		return a, nil
+	default:
+		return "", fmt.Errorf("unknown stale reception action: %q", s)
+	}
+}
+
+type StaleReceptionRepo interface {
+	ListOpenReceptionsBefore(ctx context.Context, before time.Time) ([]*entity.OpenReception, error)
+	MarkStaleAlerted(ctx context.Context, receptionID uuid.UUID) error
+}
+
+type ReceptionCloser interface {
+	FinishReception(ctx context.Context, req *request.FinishReception) (*entity.ClosedReception, error)
+}
+
+// LeaderElector makes sure only one replica runs the check.
+type LeaderElector interface {
+	IsLeader(ctx context.Context) bool
+	Release()
+}
+
+type StaleReceptionServiceImpl struct {
+	repo    StaleReceptionRepo
+	closer  ReceptionCloser
+	auditor Auditor
+	leader  LeaderElector
+
+	action StaleAction
+	// threshold is how long reception may stay open,
+	// cityThresholds override it for some cities.
+	threshold      time.Duration
+	cityThresholds map[entity.City]time.Duration
+	checkInterval  time.Duration
+}
+
+func NewStaleReceptionService(repo StaleReceptionRepo, closer ReceptionCloser, auditor Auditor, leader LeaderElector, action StaleAction, threshold time.Duration, cityThresholds map[entity.City]time.Duration, checkInterval time.Duration) *StaleReceptionServiceImpl {
+	if threshold <= 0 {
+		threshold = defaultStaleThreshold
+	}
+	if checkInterval <= 0 {
+		checkInterval = defaultStaleCheckInterval
+	}
+
+	return &StaleReceptionServiceImpl{
+		repo:           repo,
+		closer:         closer,
+		auditor:        auditor,
+		leader:         leader,
+		action:         action,
+		threshold:      threshold,
+		cityThresholds: cityThresholds,
+		checkInterval:  checkInterval,
+	}
+}
+
+// Run periodically checks stale receptions until ctx is done.
+// Replica which is not a leader skips the check.
+func (s *StaleReceptionServiceImpl) Run(ctx context.Context) {
+	if s.action == StaleActionNone {
+		return
+	}
+	defer s.leader.Release()
+
+	ticker := time.NewTicker(s.checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !s.leader.IsLeader(ctx) {
+				continue
+			}
+			if err := s.CheckStale(ctx); err != nil && ctx.Err() == nil {
+				log.Printf("failed to check stale receptions: %v", err)
+			}
+		}
+	}
+}
+
+// CheckStale alerts about and/or closes receptions open longer
+// than threshold of their city, counting from last reopen.
+// Alert is stored, so it is emitted once per opening even
+// if leader changes. Receptions are closed on behalf of
+// system actor with manifest discrepancies overridden.
+func (s *StaleReceptionServiceImpl) CheckStale(ctx context.Context) error {
+	now := time.Now()
+	open, err := s.repo.ListOpenReceptionsBefore(ctx, now.Add(-s.minThreshold()))
+	if err != nil {
+		return apperror.NewInternal("failed to list open receptions", err)
+	}
+
+	ctx = principal.NewContext(ctx, principal.System)
+
+	for _, r := range open {
+		threshold := s.thresholdFor(r.City)
+		openFor := now.Sub(r.OpenedAt)
+		if openFor < threshold {
+			continue
+		}
+
+		if s.action != StaleActionClose && !r.Alerted {
+			s.alert(ctx, r, openFor, threshold)
+		}
+		if s.action != StaleActionAlert {
+			s.close(ctx, r)
+		}
+	}
+
+	return nil
+}
+
+func (s *StaleReceptionServiceImpl) alert(ctx context.Context, r *entity.OpenReception, openFor, threshold time.Duration) {
+	log.Printf("reception %s in pvz %s is open for %s", r.Reception.ID, r.Reception.PvzID, openFor.Round(time.Minute))
+
+	metrics.StaleReception(string(r.City), "alerted")
+	s.auditor.Record(ctx, entity.AuditReceptionStale, map[string]any{
+		"pvz_id":       r.Reception.PvzID,
+		"reception_id": r.Reception.ID,
+		"city":         r.City,
+		"open_for":     openFor.Round(time.Second).String(),
+		"threshold":    threshold.String(),
+		"auto_close":   s.action == StaleActionBoth,
+	})
+	if err := s.repo.MarkStaleAlerted(ctx, r.Reception.ID); err != nil {
+		log.Printf("failed to mark reception %s alerted: %v", r.Reception.ID, err)
+	}
+}
+
+func (s *StaleReceptionServiceImpl) close(ctx context.Context, r *entity.OpenReception) {
+	_, err := s.closer.FinishReception(ctx, &request.FinishReception{
+		PvzID:       r.Reception.PvzID,
+		ReceptionID: r.Reception.ID,
+		Override:    true,
+	})
+	if err != nil {
+		log.Printf("failed to close stale reception %s: %v", r.Reception.ID, err)
+		return
+	}
+
+	metrics.StaleReception(string(r.City), "closed")
+}
+
+func (s *StaleReceptionServiceImpl) thresholdFor(city entity.City) time.Duration {
+	if t, ok := s.cityThresholds[city]; ok {
+		return t
+	}
+	return s.threshold
+}
+
+func (s *StaleReceptionServiceImpl) minThreshold() time.Duration {
+	res := s.threshold
+	for _, t := range s.cityThresholds {
+		res = min(res, t)
+	}
+	return res
+}
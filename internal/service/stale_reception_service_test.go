@@ -0,0 +1,136 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/service"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/service/mocks"
+)
+
+func TestParseStaleAction(t *testing.T) {
+	for _, s := range []string{"", "close", "alert", "both"} {
+		a, err := service.ParseStaleAction(s)
+		require.NoError(t, err)
+		require.Equal(t, service.StaleAction(s), a)
+	}
+
+	_, err := service.ParseStaleAction("delete")
+	require.Error(t, err)
+}
+
+func TestCheckStale(t *testing.T) {
+	openReception := func(city entity.City, createdAgo, openedAgo time.Duration) *entity.OpenReception {
+		return &entity.OpenReception{
+			Reception: &entity.Reception{ID: uuid.New(), DateTime: time.Now().Add(-createdAgo), PvzID: uuid.New(), Status: entity.StatusInProgress},
+			City:      city,
+			OpenedAt:  time.Now().Add(-openedAgo),
+		}
+	}
+	moscowStale := openReception(entity.CityMoscow, 20*time.Hour, 20*time.Hour)
+	// open longer than default threshold, but not
+	// longer than threshold of its city
+	moscowFresh := openReception(entity.CityMoscow, 14*time.Hour, 14*time.Hour)
+	kazanStale := openReception(entity.CityKazan, 14*time.Hour, 14*time.Hour)
+	// created long ago, but reopened recently
+	kazanReopened := openReception(entity.CityKazan, 48*time.Hour, time.Hour)
+	open := []*entity.OpenReception{moscowStale, moscowFresh, kazanStale, kazanReopened}
+
+	cityThresholds := map[entity.City]time.Duration{entity.CityMoscow: 16 * time.Hour}
+	requireSystem := func(ctx context.Context) {
+		p, ok := principal.FromContext(ctx)
+		require.True(t, ok)
+		require.Equal(t, entity.RoleSystem, p.Role)
+	}
+	closeReq := func(r *entity.OpenReception) *request.FinishReception {
+		return &request.FinishReception{PvzID: r.Reception.PvzID, ReceptionID: r.Reception.ID, Override: true}
+	}
+
+	t.Run("alert once", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		repo := mocks.NewMockStaleReceptionRepo(ctrl)
+		closer := mocks.NewMockReceptionCloser(ctrl)
+		auditor := mocks.NewMockAuditor(ctrl)
+		srv := service.NewStaleReceptionService(repo, closer, auditor, nil, service.StaleActionAlert, 12*time.Hour, cityThresholds, time.Minute)
+
+		// smallest threshold is used to look up candidates
+		repo.EXPECT().ListOpenReceptionsBefore(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, before time.Time) ([]*entity.OpenReception, error) {
+				require.WithinDuration(t, time.Now().Add(-12*time.Hour), before, time.Minute)
+				return open, nil
+			},
+		)
+		var alerted []uuid.UUID
+		auditor.EXPECT().Record(gomock.Any(), entity.AuditReceptionStale, gomock.Any()).Do(
+			func(ctx context.Context, _ entity.AuditAction, payload map[string]any) {
+				requireSystem(ctx)
+				alerted = append(alerted, payload["reception_id"].(uuid.UUID))
+			},
+		).Times(2)
+		repo.EXPECT().MarkStaleAlerted(gomock.Any(), moscowStale.Reception.ID).Return(nil)
+		repo.EXPECT().MarkStaleAlerted(gomock.Any(), kazanStale.Reception.ID).Return(errMock)
+
+		require.NoError(t, srv.CheckStale(context.Background()))
+		require.Equal(t, []uuid.UUID{moscowStale.Reception.ID, kazanStale.Reception.ID}, alerted)
+
+		// stored alert is not emitted again
+		alertedStale := *kazanStale
+		alertedStale.Alerted = true
+		repo.EXPECT().ListOpenReceptionsBefore(gomock.Any(), gomock.Any()).Return([]*entity.OpenReception{&alertedStale}, nil)
+
+		require.NoError(t, srv.CheckStale(context.Background()))
+	})
+
+	t.Run("close", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		repo := mocks.NewMockStaleReceptionRepo(ctrl)
+		closer := mocks.NewMockReceptionCloser(ctrl)
+		auditor := mocks.NewMockAuditor(ctrl)
+		srv := service.NewStaleReceptionService(repo, closer, auditor, nil, service.StaleActionClose, 12*time.Hour, cityThresholds, time.Minute)
+
+		repo.EXPECT().ListOpenReceptionsBefore(gomock.Any(), gomock.Any()).Return(open, nil)
+		closer.EXPECT().FinishReception(gomock.Any(), closeReq(moscowStale)).DoAndReturn(
+			func(ctx context.Context, _ *request.FinishReception) (*entity.ClosedReception, error) {
+				requireSystem(ctx)
+				return &entity.ClosedReception{}, nil
+			},
+		)
+		closer.EXPECT().FinishReception(gomock.Any(), closeReq(kazanStale)).Return(nil, apperror.NewBadReq("reception is not open"))
+
+		require.NoError(t, srv.CheckStale(context.Background()))
+	})
+
+	t.Run("alert and close", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		repo := mocks.NewMockStaleReceptionRepo(ctrl)
+		closer := mocks.NewMockReceptionCloser(ctrl)
+		auditor := mocks.NewMockAuditor(ctrl)
+		srv := service.NewStaleReceptionService(repo, closer, auditor, nil, service.StaleActionBoth, 12*time.Hour, cityThresholds, time.Minute)
+
+		repo.EXPECT().ListOpenReceptionsBefore(gomock.Any(), gomock.Any()).Return([]*entity.OpenReception{kazanStale}, nil)
+		auditor.EXPECT().Record(gomock.Any(), entity.AuditReceptionStale, gomock.Any())
+		repo.EXPECT().MarkStaleAlerted(gomock.Any(), kazanStale.Reception.ID).Return(nil)
+		closer.EXPECT().FinishReception(gomock.Any(), closeReq(kazanStale)).Return(&entity.ClosedReception{}, nil)
+
+		require.NoError(t, srv.CheckStale(context.Background()))
+	})
+
+	t.Run("list err", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		repo := mocks.NewMockStaleReceptionRepo(ctrl)
+		srv := service.NewStaleReceptionService(repo, nil, nil, nil, service.StaleActionAlert, 12*time.Hour, nil, time.Minute)
+
+		repo.EXPECT().ListOpenReceptionsBefore(gomock.Any(), gomock.Any()).Return(nil, errMock)
+
+		require.Equal(t, apperror.NewInternal("failed to list open receptions", errMock), srv.CheckStale(context.Background()))
+	})
+}
@@ -0,0 +1,178 @@
+//go:generate mockgen -source=./storage_cell_service.go -destination=./mocks/storage_cell_service.go -package=mocks
+
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+)
+
+type StorageCellRepo interface {
+	CreateStorageCell(ctx context.Context, pvzID uuid.UUID, req *request.CreateStorageCell) (*entity.StorageCell, error)
+	GetStorageCell(ctx context.Context, id uuid.UUID) (*entity.StorageCell, error)
+	ListPvzStorageCells(ctx context.Context, pvzID uuid.UUID) ([]*entity.StorageCell, error)
+	AssignFreeStorageCell(ctx context.Context, pvzID, productID uuid.UUID, sizeClass entity.SizeClass) (*entity.StorageCell, error)
+	MoveProductToCell(ctx context.Context, productID, cellID, actorID uuid.UUID) (*entity.Product, error)
+}
+
+type ProductGetter interface {
+	GetProduct(ctx context.Context, id uuid.UUID) (*entity.Product, error)
+}
+
+// StorageCellServiceImpl manages shelf cells of PVZ and
+// placement of products on hand into them. Cell occupancy
+// is kept by DB, so concurrent placements can't overfill it.
+type StorageCellServiceImpl struct {
+	repo          StorageCellRepo
+	productRepo   ProductGetter
+	receptionRepo ReceptionGetter
+	pvzSrv        PvzFinder
+	auditor       Auditor
+}
+
+func NewStorageCellService(repo StorageCellRepo, productRepo ProductGetter, receptionRepo ReceptionGetter, pvzSrv PvzFinder, auditor Auditor) *StorageCellServiceImpl {
+	return &StorageCellServiceImpl{
+		repo:          repo,
+		productRepo:   productRepo,
+		receptionRepo: receptionRepo,
+		pvzSrv:        pvzSrv,
+		auditor:       auditor,
+	}
+}
+
+func (s *StorageCellServiceImpl) CreateStorageCell(ctx context.Context, pvzID uuid.UUID, req *request.CreateStorageCell) (*entity.StorageCell, error) {
+	if !entity.SizeClasses[entity.SizeClass(req.SizeClass)] {
+		return nil, apperror.NewBadReq("invalid size class: " + req.SizeClass)
+	}
+
+	if _, err := s.pvzSrv.GetPvz(ctx, pvzID); err != nil {
+		return nil, err
+	}
+
+	res, err := s.repo.CreateStorageCell(ctx, pvzID, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrStorageCellAlreadyExists):
+			return nil, apperror.NewConflict(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to create storage cell", err)
+		}
+	}
+
+	s.auditor.Record(ctx, entity.AuditStorageCellCreated, map[string]any{
+		"pvz_id":  pvzID,
+		"cell_id": res.ID,
+		"label":   res.Label(),
+	})
+	return res, nil
+}
+
+// ListPvzStorageCells returns PVZ cells with their occupancy.
+func (s *StorageCellServiceImpl) ListPvzStorageCells(ctx context.Context, pvzID uuid.UUID) ([]*entity.StorageCell, error) {
+	if _, err := s.pvzSrv.GetPvz(ctx, pvzID); err != nil {
+		return nil, err
+	}
+
+	res, err := s.repo.ListPvzStorageCells(ctx, pvzID)
+	if err != nil {
+		return nil, apperror.NewInternal("failed to list storage cells", err)
+	}
+
+	return res, nil
+}
+
+// AssignStorageCell places just accepted product into free
+// cell of PVZ. If there is no free cell, product is left
+// unplaced and nil cell is returned.
+func (s *StorageCellServiceImpl) AssignStorageCell(ctx context.Context, pvzID uuid.UUID, product *entity.Product) (*entity.StorageCell, error) {
+	res, err := s.repo.AssignFreeStorageCell(ctx, pvzID, product.ID, product.SizeClass)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNoFreeStorageCell):
+			return nil, nil
+		default:
+			return nil, apperror.NewInternal("failed to assign storage cell", err)
+		}
+	}
+
+	return res, nil
+}
+
+// MoveProduct moves product on hand into another cell
+// of its PVZ. Cell must fit product size class and have
+// free place.
+func (s *StorageCellServiceImpl) MoveProduct(ctx context.Context, productID uuid.UUID, req *request.MoveProduct) (*entity.Product, error) {
+	product, err := s.productRepo.GetProduct(ctx, productID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrProductNotFound):
+			return nil, apperror.NewNotFound(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to get product", err)
+		}
+	}
+
+	reception, err := s.receptionRepo.GetProductReception(ctx, product.ID)
+	if err != nil {
+		return nil, apperror.NewInternal("failed to get product reception", err)
+	}
+
+	var actorID uuid.UUID
+	if p, ok := principal.FromContext(ctx); ok {
+		if !p.CanAccessPvz(reception.PvzID) {
+			return nil, apperror.NewForbidden("no access to pvz")
+		}
+		actorID = p.UserID
+	}
+
+	if reception.Status == entity.StatusCancelled {
+		return nil, apperror.NewConflict("product reception is cancelled")
+	}
+	if product.State != entity.ProductStateStored && product.State != entity.ProductStateToReturn {
+		return nil, apperror.NewConflict("product is " + string(product.State))
+	}
+
+	cell, err := s.repo.GetStorageCell(ctx, req.CellID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrStorageCellNotFound):
+			return nil, apperror.NewNotFound(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to get storage cell", err)
+		}
+	}
+	if cell.PvzID != reception.PvzID {
+		return nil, apperror.NewBadReq("storage cell is in other pvz")
+	}
+	if !product.SizeClass.Fits(cell.SizeClass) {
+		return nil, apperror.NewBadReq("product doesn't fit into storage cell")
+	}
+	if product.CellID == cell.ID {
+		product.Cell = cell
+		return product, nil
+	}
+
+	res, err := s.repo.MoveProductToCell(ctx, productID, cell.ID, actorID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrStorageCellFull),
+			errors.Is(err, repository.ErrProductNotMovable):
+			return nil, apperror.NewConflict(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to move product", err)
+		}
+	}
+
+	// cell was read before product was placed into it
+	cell.Occupied++
+	res.Cell = cell
+	return res, nil
+}
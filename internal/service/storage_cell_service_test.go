@@ -0,0 +1,312 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/service"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/service/mocks"
+)
+
+var storageCell = &entity.StorageCell{
+	ID:        uuid.New(),
+	PvzID:     pvz1.ID,
+	Zone:      "A",
+	Rack:      3,
+	Shelf:     2,
+	SizeClass: entity.SizeClassMedium,
+	Capacity:  10,
+	Occupied:  4,
+	CreatedAt: time.Now(),
+}
+
+func TestCreateStorageCell(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockStorageCellRepo(ctrl)
+	pvzSrv := mocks.NewMockPvzFinder(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	srv := service.NewStorageCellService(repo, nil, nil, pvzSrv, auditor)
+
+	req := &request.CreateStorageCell{Zone: "A", Rack: 3, Shelf: 2, SizeClass: "medium", Capacity: 10}
+
+	testCases := []struct {
+		name         string
+		req          *request.CreateStorageCell
+		mockBehavior func(req *request.CreateStorageCell)
+		expResp      *entity.StorageCell
+		expErr       error
+	}{
+		{
+			name: "ok",
+			req:  req,
+			mockBehavior: func(req *request.CreateStorageCell) {
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz1.ID).Return(pvz1, nil)
+				repo.EXPECT().CreateStorageCell(gomock.Any(), pvz1.ID, req).Return(storageCell, nil)
+				auditor.EXPECT().Record(gomock.Any(), entity.AuditStorageCellCreated, map[string]any{
+					"pvz_id":  pvz1.ID,
+					"cell_id": storageCell.ID,
+					"label":   "A-3-2",
+				})
+			},
+			expResp: storageCell,
+			expErr:  nil,
+		},
+		{
+			name:         "invalid size class",
+			req:          &request.CreateStorageCell{Zone: "A", Rack: 3, Shelf: 2, SizeClass: "huge", Capacity: 10},
+			mockBehavior: func(req *request.CreateStorageCell) {},
+			expResp:      nil,
+			expErr:       apperror.NewBadReq("invalid size class: huge"),
+		},
+		{
+			name: "pvz not found",
+			req:  req,
+			mockBehavior: func(req *request.CreateStorageCell) {
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz1.ID).Return(nil, apperror.NewNotFound("pvz not found"))
+			},
+			expResp: nil,
+			expErr:  apperror.NewNotFound("pvz not found"),
+		},
+		{
+			name: "already exists",
+			req:  req,
+			mockBehavior: func(req *request.CreateStorageCell) {
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz1.ID).Return(pvz1, nil)
+				repo.EXPECT().CreateStorageCell(gomock.Any(), pvz1.ID, req).Return(nil, repository.ErrStorageCellAlreadyExists)
+			},
+			expResp: nil,
+			expErr:  apperror.NewConflict(repository.ErrStorageCellAlreadyExists.Error()),
+		},
+		{
+			name: "unk err",
+			req:  req,
+			mockBehavior: func(req *request.CreateStorageCell) {
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz1.ID).Return(pvz1, nil)
+				repo.EXPECT().CreateStorageCell(gomock.Any(), pvz1.ID, req).Return(nil, errMock)
+			},
+			expResp: nil,
+			expErr:  apperror.NewInternal("failed to create storage cell", errMock),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(tc.req)
+
+			resp, err := srv.CreateStorageCell(context.Background(), pvz1.ID, tc.req)
+			require.Equal(t, tc.expResp, resp)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestAssignStorageCell(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockStorageCellRepo(ctrl)
+	srv := service.NewStorageCellService(repo, nil, nil, nil, nil)
+
+	accepted := &entity.Product{ID: uuid.New(), ReceptionID: reception3.ID, SizeClass: entity.SizeClassSmall}
+
+	repo.EXPECT().AssignFreeStorageCell(gomock.Any(), pvz3.ID, accepted.ID, entity.SizeClassSmall).Return(storageCell, nil)
+	cell, err := srv.AssignStorageCell(context.Background(), pvz3.ID, accepted)
+	require.NoError(t, err)
+	require.Equal(t, storageCell, cell)
+
+	// product is left unplaced
+	repo.EXPECT().AssignFreeStorageCell(gomock.Any(), pvz3.ID, accepted.ID, entity.SizeClassSmall).Return(nil, repository.ErrNoFreeStorageCell)
+	cell, err = srv.AssignStorageCell(context.Background(), pvz3.ID, accepted)
+	require.NoError(t, err)
+	require.Nil(t, cell)
+
+	repo.EXPECT().AssignFreeStorageCell(gomock.Any(), pvz3.ID, accepted.ID, entity.SizeClassSmall).Return(nil, errMock)
+	_, err = srv.AssignStorageCell(context.Background(), pvz3.ID, accepted)
+	require.Equal(t, apperror.NewInternal("failed to assign storage cell", errMock), err)
+}
+
+func TestMoveProduct(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockStorageCellRepo(ctrl)
+	productRepo := mocks.NewMockProductGetter(ctrl)
+	receptionRepo := mocks.NewMockReceptionGetter(ctrl)
+	srv := service.NewStorageCellService(repo, productRepo, receptionRepo, nil, nil)
+
+	userID := uuid.New()
+	userCtx := principal.NewContext(context.Background(), &principal.Principal{UserID: userID, AllPvz: true})
+	otherPvzCtx := principal.NewContext(context.Background(), &principal.Principal{APIKeyID: uuid.New(), PvzIDs: []uuid.UUID{pvz2.ID}})
+
+	stored := &entity.Product{ID: uuid.New(), ReceptionID: reception1.ID, State: entity.ProductStateStored, SizeClass: entity.SizeClassSmall}
+	large := &entity.Product{ID: uuid.New(), ReceptionID: reception1.ID, State: entity.ProductStateStored, SizeClass: entity.SizeClassLarge}
+	issued := &entity.Product{ID: uuid.New(), ReceptionID: reception1.ID, State: entity.ProductStateIssued, SizeClass: entity.SizeClassSmall}
+	moved := &entity.Product{ID: stored.ID, ReceptionID: reception1.ID, State: entity.ProductStateStored, SizeClass: entity.SizeClassSmall, CellID: storageCell.ID}
+	otherPvzCell := &entity.StorageCell{ID: uuid.New(), PvzID: pvz2.ID, SizeClass: entity.SizeClassLarge, Capacity: 1}
+	req := &request.MoveProduct{CellID: storageCell.ID}
+
+	testCases := []struct {
+		name         string
+		ctx          context.Context
+		product      *entity.Product
+		req          *request.MoveProduct
+		mockBehavior func(p *entity.Product)
+		expResp      *entity.Product
+		expErr       error
+	}{
+		{
+			name:    "ok",
+			ctx:     userCtx,
+			product: stored,
+			req:     req,
+			mockBehavior: func(p *entity.Product) {
+				productRepo.EXPECT().GetProduct(gomock.Any(), p.ID).Return(p, nil)
+				receptionRepo.EXPECT().GetProductReception(gomock.Any(), p.ID).Return(reception1, nil)
+				cell := *storageCell
+				repo.EXPECT().GetStorageCell(gomock.Any(), storageCell.ID).Return(&cell, nil)
+				repo.EXPECT().MoveProductToCell(gomock.Any(), p.ID, storageCell.ID, userID).Return(moved, nil)
+			},
+			expResp: &entity.Product{
+				ID:          stored.ID,
+				ReceptionID: reception1.ID,
+				State:       entity.ProductStateStored,
+				SizeClass:   entity.SizeClassSmall,
+				CellID:      storageCell.ID,
+				Cell: &entity.StorageCell{
+					ID:        storageCell.ID,
+					PvzID:     pvz1.ID,
+					Zone:      "A",
+					Rack:      3,
+					Shelf:     2,
+					SizeClass: entity.SizeClassMedium,
+					Capacity:  10,
+					Occupied:  5,
+					CreatedAt: storageCell.CreatedAt,
+				},
+			},
+			expErr: nil,
+		},
+		{
+			name:    "product not found",
+			ctx:     userCtx,
+			product: stored,
+			req:     req,
+			mockBehavior: func(p *entity.Product) {
+				productRepo.EXPECT().GetProduct(gomock.Any(), p.ID).Return(nil, repository.ErrProductNotFound)
+			},
+			expResp: nil,
+			expErr:  apperror.NewNotFound(repository.ErrProductNotFound.Error()),
+		},
+		{
+			name:    "other pvz",
+			ctx:     otherPvzCtx,
+			product: stored,
+			req:     req,
+			mockBehavior: func(p *entity.Product) {
+				productRepo.EXPECT().GetProduct(gomock.Any(), p.ID).Return(p, nil)
+				receptionRepo.EXPECT().GetProductReception(gomock.Any(), p.ID).Return(reception1, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewForbidden("no access to pvz"),
+		},
+		{
+			name:    "issued",
+			ctx:     userCtx,
+			product: issued,
+			req:     req,
+			mockBehavior: func(p *entity.Product) {
+				productRepo.EXPECT().GetProduct(gomock.Any(), p.ID).Return(p, nil)
+				receptionRepo.EXPECT().GetProductReception(gomock.Any(), p.ID).Return(reception1, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewConflict("product is issued"),
+		},
+		{
+			name:    "cell not found",
+			ctx:     userCtx,
+			product: stored,
+			req:     req,
+			mockBehavior: func(p *entity.Product) {
+				productRepo.EXPECT().GetProduct(gomock.Any(), p.ID).Return(p, nil)
+				receptionRepo.EXPECT().GetProductReception(gomock.Any(), p.ID).Return(reception1, nil)
+				repo.EXPECT().GetStorageCell(gomock.Any(), storageCell.ID).Return(nil, repository.ErrStorageCellNotFound)
+			},
+			expResp: nil,
+			expErr:  apperror.NewNotFound(repository.ErrStorageCellNotFound.Error()),
+		},
+		{
+			name:    "cell in other pvz",
+			ctx:     userCtx,
+			product: stored,
+			req:     &request.MoveProduct{CellID: otherPvzCell.ID},
+			mockBehavior: func(p *entity.Product) {
+				productRepo.EXPECT().GetProduct(gomock.Any(), p.ID).Return(p, nil)
+				receptionRepo.EXPECT().GetProductReception(gomock.Any(), p.ID).Return(reception1, nil)
+				repo.EXPECT().GetStorageCell(gomock.Any(), otherPvzCell.ID).Return(otherPvzCell, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewBadReq("storage cell is in other pvz"),
+		},
+		{
+			name:    "doesn't fit",
+			ctx:     userCtx,
+			product: large,
+			req:     req,
+			mockBehavior: func(p *entity.Product) {
+				productRepo.EXPECT().GetProduct(gomock.Any(), p.ID).Return(p, nil)
+				receptionRepo.EXPECT().GetProductReception(gomock.Any(), p.ID).Return(reception1, nil)
+				repo.EXPECT().GetStorageCell(gomock.Any(), storageCell.ID).Return(storageCell, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewBadReq("product doesn't fit into storage cell"),
+		},
+		{
+			name:    "cell full",
+			ctx:     userCtx,
+			product: stored,
+			req:     req,
+			mockBehavior: func(p *entity.Product) {
+				productRepo.EXPECT().GetProduct(gomock.Any(), p.ID).Return(p, nil)
+				receptionRepo.EXPECT().GetProductReception(gomock.Any(), p.ID).Return(reception1, nil)
+				repo.EXPECT().GetStorageCell(gomock.Any(), storageCell.ID).Return(storageCell, nil)
+				repo.EXPECT().MoveProductToCell(gomock.Any(), p.ID, storageCell.ID, userID).Return(nil, repository.ErrStorageCellFull)
+			},
+			expResp: nil,
+			expErr:  apperror.NewConflict(repository.ErrStorageCellFull.Error()),
+		},
+		{
+			name:    "unk err",
+			ctx:     userCtx,
+			product: stored,
+			req:     req,
+			mockBehavior: func(p *entity.Product) {
+				productRepo.EXPECT().GetProduct(gomock.Any(), p.ID).Return(p, nil)
+				receptionRepo.EXPECT().GetProductReception(gomock.Any(), p.ID).Return(reception1, nil)
+				repo.EXPECT().GetStorageCell(gomock.Any(), storageCell.ID).Return(storageCell, nil)
+				repo.EXPECT().MoveProductToCell(gomock.Any(), p.ID, storageCell.ID, userID).Return(nil, errMock)
+			},
+			expResp: nil,
+			expErr:  apperror.NewInternal("failed to move product", errMock),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(tc.product)
+
+			resp, err := srv.MoveProduct(tc.ctx, tc.product.ID, tc.req)
+			require.Equal(t, tc.expResp, resp)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
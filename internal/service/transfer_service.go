@@ -0,0 +1,301 @@
+//go:generate mockgen -source=./transfer_service.go -destination=./mocks/transfer_service.go -package=mocks
+
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/google/uuid"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/metrics"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+)
+
+type TransferRepo interface {
+	CreateTransfer(ctx context.Context, fromPvzID, toPvzID uuid.UUID, productIDs []uuid.UUID, createdBy uuid.UUID) (*entity.Transfer, error)
+	GetTransfer(ctx context.Context, id uuid.UUID) (*entity.Transfer, error)
+	ListTransferCandidates(ctx context.Context, productIDs []uuid.UUID) ([]*entity.TransferCandidate, error)
+	DispatchTransfer(ctx context.Context, id, actorID uuid.UUID) (*entity.Transfer, error)
+	ReceiveTransfer(ctx context.Context, id, receptionID, actorID uuid.UUID) (*entity.Transfer, error)
+}
+
+// OpenReceptionFinder finds reception transfer is received into.
+// Reception started for transfer is deleted if receive fails.
+type OpenReceptionFinder interface {
+	GetLastOpenReception(ctx context.Context, pvzID uuid.UUID) (*entity.Reception, error)
+	DeleteEmptyReception(ctx context.Context, id uuid.UUID) error
+}
+
+// ReceptionOpener starts reception of PVZ with
+// the same checks as employee opening it.
+type ReceptionOpener interface {
+	CreateReception(ctx context.Context, req *request.CreateReception) (*entity.Reception, error)
+}
+
+type TransferServiceImpl struct {
+	repo          TransferRepo
+	receptionRepo OpenReceptionFinder
+	receptionSrv  ReceptionOpener
+	pvzSrv        PvzFinder
+	cellSrv       CellAllocator
+	auditor       Auditor
+}
+
+func NewTransferService(repo TransferRepo, receptionRepo OpenReceptionFinder, receptionSrv ReceptionOpener, pvzSrv PvzFinder, cellSrv CellAllocator, auditor Auditor) *TransferServiceImpl {
+	return &TransferServiceImpl{
+		repo:          repo,
+		receptionRepo: receptionRepo,
+		receptionSrv:  receptionSrv,
+		pvzSrv:        pvzSrv,
+		cellSrv:       cellSrv,
+		auditor:       auditor,
+	}
+}
+
+// CreateTransfer plans move of stored products to neighbour PVZ.
+// Products must be on hand in source PVZ and not planned in other
+// transfer, destination must be active and have room for them.
+func (s *TransferServiceImpl) CreateTransfer(ctx context.Context, req *request.CreateTransfer) (*entity.Transfer, error) {
+	if req.FromPvzID == req.ToPvzID {
+		return nil, apperror.NewBadReq("can't transfer products to the same pvz")
+	}
+	if p, ok := principal.FromContext(ctx); ok && !p.CanAccessPvz(req.FromPvzID) {
+		return nil, apperror.NewForbidden("no access to pvz")
+	}
+
+	if _, err := s.pvzSrv.GetPvz(ctx, req.FromPvzID); err != nil {
+		return nil, err
+	}
+	to, err := s.pvzSrv.GetPvz(ctx, req.ToPvzID)
+	if err != nil {
+		return nil, err
+	}
+	if to.Status != entity.PvzStatusActive {
+		return nil, apperror.NewBadReq("can't transfer products, destination pvz is " + string(to.Status))
+	}
+
+	seen := make(map[uuid.UUID]bool, len(req.ProductIDs))
+	for _, id := range req.ProductIDs {
+		if seen[id] {
+			return nil, apperror.NewBadReq("duplicate product in transfer: " + id.String())
+		}
+		seen[id] = true
+	}
+
+	candidates, err := s.repo.ListTransferCandidates(ctx, req.ProductIDs)
+	if err != nil {
+		return nil, apperror.NewInternal("failed to create transfer", err)
+	}
+	for _, c := range candidates {
+		delete(seen, c.ProductID)
+	}
+	for id := range seen {
+		return nil, apperror.NewNotFound("product not found: " + id.String())
+	}
+
+	for _, c := range candidates {
+		switch {
+		case c.PvzID != req.FromPvzID:
+			return nil, apperror.NewBadReq("product is not in source pvz: " + c.ProductID.String())
+		case c.ReceptionStatus != entity.StatusFinished:
+			return nil, apperror.NewConflict("product reception is not closed: " + c.ProductID.String())
+		case c.State != entity.ProductStateStored:
+			return nil, apperror.NewConflict(fmt.Sprintf("product %s is %s", c.ProductID, c.State))
+		case c.InTransfer:
+			return nil, apperror.NewConflict("product is already in transfer: " + c.ProductID.String())
+		}
+	}
+
+	if !to.CanAccept(len(req.ProductIDs)) {
+		return nil, apperror.NewConflict(fmt.Sprintf("destination pvz capacity exceeded: %d of %d products on hand, %d in transfer", to.StockCount, to.Capacity, len(req.ProductIDs)))
+	}
+
+	var actorID uuid.UUID
+	if p, ok := principal.FromContext(ctx); ok {
+		actorID = p.UserID
+	}
+
+	res, err := s.repo.CreateTransfer(ctx, req.FromPvzID, req.ToPvzID, req.ProductIDs, actorID)
+	if err != nil {
+		return nil, apperror.NewInternal("failed to create transfer", err)
+	}
+
+	s.auditor.Record(ctx, entity.AuditTransferCreated, map[string]any{
+		"transfer_id": res.ID,
+		"from_pvz_id": res.FromPvzID,
+		"to_pvz_id":   res.ToPvzID,
+		"products":    len(res.Products),
+	})
+	return res, nil
+}
+
+// GetTransfer returns transfer with its products. It is
+// visible to employees of both source and destination PVZ.
+func (s *TransferServiceImpl) GetTransfer(ctx context.Context, id uuid.UUID) (*entity.Transfer, error) {
+	transfer, err := s.getTransfer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if p, ok := principal.FromContext(ctx); ok && !p.CanAccessPvz(transfer.FromPvzID) && !p.CanAccessPvz(transfer.ToPvzID) {
+		return nil, apperror.NewForbidden("no access to pvz")
+	}
+
+	return transfer, nil
+}
+
+// DispatchTransfer hands transfer products to courier.
+// They are no longer on hand in source PVZ.
+func (s *TransferServiceImpl) DispatchTransfer(ctx context.Context, id uuid.UUID) (*entity.Transfer, error) {
+	transfer, err := s.getTransfer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if p, ok := principal.FromContext(ctx); ok && !p.CanAccessPvz(transfer.FromPvzID) {
+		return nil, apperror.NewForbidden("no access to pvz")
+	}
+	if transfer.Status != entity.TransferStatusCreated {
+		return nil, apperror.NewConflict("transfer is " + string(transfer.Status))
+	}
+
+	var actorID uuid.UUID
+	if p, ok := principal.FromContext(ctx); ok {
+		actorID = p.UserID
+	}
+
+	res, err := s.repo.DispatchTransfer(ctx, id, actorID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrTransferProductsUnavailable):
+			return nil, apperror.NewConflict(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to dispatch transfer", err)
+		}
+	}
+
+	s.reportStock(ctx, res.FromPvzID)
+	s.auditor.Record(ctx, entity.AuditTransferDispatched, map[string]any{
+		"transfer_id": res.ID,
+		"from_pvz_id": res.FromPvzID,
+		"to_pvz_id":   res.ToPvzID,
+	})
+	return res, nil
+}
+
+// ReceiveTransfer accepts transfer products in destination PVZ.
+// They are added to its open reception, which is started if there
+// is none, so intake rules and capacity limits apply to them.
+// Started reception is deleted again if products aren't received.
+func (s *TransferServiceImpl) ReceiveTransfer(ctx context.Context, id uuid.UUID) (*entity.Transfer, error) {
+	transfer, err := s.getTransfer(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if p, ok := principal.FromContext(ctx); ok && !p.CanAccessPvz(transfer.ToPvzID) {
+		return nil, apperror.NewForbidden("no access to pvz")
+	}
+	if transfer.Status != entity.TransferStatusInTransit {
+		return nil, apperror.NewConflict("transfer is " + string(transfer.Status))
+	}
+
+	started := false
+	reception, err := s.receptionRepo.GetLastOpenReception(ctx, transfer.ToPvzID)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNoOpenReceptionFound):
+			reception, err = s.receptionSrv.CreateReception(ctx, &request.CreateReception{PvzID: transfer.ToPvzID})
+			if err != nil {
+				return nil, err
+			}
+			started = true
+		default:
+			return nil, apperror.NewInternal("failed to find open reception", err)
+		}
+	}
+
+	var actorID uuid.UUID
+	if p, ok := principal.FromContext(ctx); ok {
+		actorID = p.UserID
+	}
+
+	res, err := s.repo.ReceiveTransfer(ctx, id, reception.ID, actorID)
+	if err != nil {
+		if started {
+			s.discardReception(ctx, reception.ID)
+		}
+
+		switch {
+		case errors.Is(err, repository.ErrTransferNotInTransit),
+			errors.Is(err, repository.ErrTransferReceptionNotOpen),
+			errors.Is(err, repository.ErrPvzCapacityExceeded):
+			return nil, apperror.NewConflict(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to receive transfer", err)
+		}
+	}
+
+	// as for regular intake, products are received
+	// even if there is no free cell for them
+	for _, p := range res.Products {
+		cell, err := s.cellSrv.AssignStorageCell(ctx, res.ToPvzID, p)
+		if err != nil {
+			log.Printf("failed to assign storage cell to product %s: %v", p.ID, err)
+		}
+		if cell != nil {
+			p.CellID = cell.ID
+			p.Cell = cell
+		}
+	}
+
+	s.reportStock(ctx, res.ToPvzID)
+	s.auditor.Record(ctx, entity.AuditTransferReceived, map[string]any{
+		"transfer_id":  res.ID,
+		"from_pvz_id":  res.FromPvzID,
+		"to_pvz_id":    res.ToPvzID,
+		"reception_id": res.ReceptionID,
+	})
+	return res, nil
+}
+
+// discardReception deletes reception started for transfer
+// which failed to be received. Errors are only logged.
+func (s *TransferServiceImpl) discardReception(ctx context.Context, id uuid.UUID) {
+	if err := s.receptionRepo.DeleteEmptyReception(context.WithoutCancel(ctx), id); err != nil {
+		log.Printf("failed to delete reception %s started for transfer: %v", id, err)
+	}
+}
+
+func (s *TransferServiceImpl) getTransfer(ctx context.Context, id uuid.UUID) (*entity.Transfer, error) {
+	res, err := s.repo.GetTransfer(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrTransferNotFound):
+			return nil, apperror.NewNotFound(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to get transfer", err)
+		}
+	}
+
+	return res, nil
+}
+
+// reportStock refreshes stock gauge of PVZ products
+// left or arrived to. Errors are only logged.
+func (s *TransferServiceImpl) reportStock(ctx context.Context, pvzID uuid.UUID) {
+	pvz, err := s.pvzSrv.GetPvz(ctx, pvzID)
+	if err != nil {
+		log.Printf("failed to get pvz %s stock: %v", pvzID, err)
+		return
+	}
+
+	metrics.SetPvzStock(pvz.ID.String(), pvz.StockCount, pvz.Capacity)
+}
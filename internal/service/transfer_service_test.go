@@ -0,0 +1,450 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/service"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/service/mocks"
+)
+
+func TestCreateTransfer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockTransferRepo(ctrl)
+	pvzSrv := mocks.NewMockPvzFinder(ctrl)
+	auditor := mocks.NewMockAuditor(ctrl)
+	srv := service.NewTransferService(repo, nil, nil, pvzSrv, nil, auditor)
+
+	userID := uuid.New()
+	userCtx := principal.NewContext(context.Background(), &principal.Principal{UserID: userID, AllPvz: true})
+	otherPvzCtx := principal.NewContext(context.Background(), &principal.Principal{APIKeyID: uuid.New(), PvzIDs: []uuid.UUID{pvz2.ID}})
+
+	req := &request.CreateTransfer{FromPvzID: pvz1.ID, ToPvzID: pvz2.ID, ProductIDs: []uuid.UUID{storedProduct.ID}}
+	candidate := &entity.TransferCandidate{
+		ProductID:       storedProduct.ID,
+		State:           entity.ProductStateStored,
+		PvzID:           pvz1.ID,
+		ReceptionStatus: entity.StatusFinished,
+	}
+	transfer := &entity.Transfer{
+		ID:        uuid.New(),
+		FromPvzID: pvz1.ID,
+		ToPvzID:   pvz2.ID,
+		Status:    entity.TransferStatusCreated,
+		CreatedBy: userID,
+		CreatedAt: time.Now(),
+		Products:  []*entity.Product{storedProduct},
+	}
+	closedPvz := &entity.Pvz{ID: pvz2.ID, Status: entity.PvzStatusTemporarilyClosed}
+	fullPvz := &entity.Pvz{ID: pvz2.ID, Status: entity.PvzStatusActive, Capacity: 10, StockCount: 10}
+	with := func(change func(c *entity.TransferCandidate)) []*entity.TransferCandidate {
+		c := *candidate
+		change(&c)
+		return []*entity.TransferCandidate{&c}
+	}
+	expectPvzs := func(to *entity.Pvz) {
+		pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz1.ID).Return(pvz1, nil)
+		pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz2.ID).Return(to, nil)
+	}
+
+	testCases := []struct {
+		name         string
+		ctx          context.Context
+		req          *request.CreateTransfer
+		mockBehavior func()
+		expResp      *entity.Transfer
+		expErr       error
+	}{
+		{
+			name: "ok",
+			ctx:  userCtx,
+			req:  req,
+			mockBehavior: func() {
+				expectPvzs(pvz2)
+				repo.EXPECT().ListTransferCandidates(gomock.Any(), req.ProductIDs).Return([]*entity.TransferCandidate{candidate}, nil)
+				repo.EXPECT().CreateTransfer(gomock.Any(), pvz1.ID, pvz2.ID, req.ProductIDs, userID).Return(transfer, nil)
+				auditor.EXPECT().Record(gomock.Any(), entity.AuditTransferCreated, map[string]any{
+					"transfer_id": transfer.ID,
+					"from_pvz_id": pvz1.ID,
+					"to_pvz_id":   pvz2.ID,
+					"products":    1,
+				})
+			},
+			expResp: transfer,
+			expErr:  nil,
+		},
+		{
+			name:         "same pvz",
+			ctx:          userCtx,
+			req:          &request.CreateTransfer{FromPvzID: pvz1.ID, ToPvzID: pvz1.ID, ProductIDs: req.ProductIDs},
+			mockBehavior: func() {},
+			expResp:      nil,
+			expErr:       apperror.NewBadReq("can't transfer products to the same pvz"),
+		},
+		{
+			name:         "no access to source pvz",
+			ctx:          otherPvzCtx,
+			req:          req,
+			mockBehavior: func() {},
+			expResp:      nil,
+			expErr:       apperror.NewForbidden("no access to pvz"),
+		},
+		{
+			name: "destination not active",
+			ctx:  userCtx,
+			req:  req,
+			mockBehavior: func() {
+				expectPvzs(closedPvz)
+			},
+			expResp: nil,
+			expErr:  apperror.NewBadReq("can't transfer products, destination pvz is temporarily_closed"),
+		},
+		{
+			name: "duplicate product",
+			ctx:  userCtx,
+			req:  &request.CreateTransfer{FromPvzID: pvz1.ID, ToPvzID: pvz2.ID, ProductIDs: []uuid.UUID{storedProduct.ID, storedProduct.ID}},
+			mockBehavior: func() {
+				expectPvzs(pvz2)
+			},
+			expResp: nil,
+			expErr:  apperror.NewBadReq("duplicate product in transfer: " + storedProduct.ID.String()),
+		},
+		{
+			name: "product not found",
+			ctx:  userCtx,
+			req:  req,
+			mockBehavior: func() {
+				expectPvzs(pvz2)
+				repo.EXPECT().ListTransferCandidates(gomock.Any(), req.ProductIDs).Return([]*entity.TransferCandidate{}, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewNotFound("product not found: " + storedProduct.ID.String()),
+		},
+		{
+			name: "product in other pvz",
+			ctx:  userCtx,
+			req:  req,
+			mockBehavior: func() {
+				expectPvzs(pvz2)
+				repo.EXPECT().ListTransferCandidates(gomock.Any(), req.ProductIDs).Return(with(func(c *entity.TransferCandidate) { c.PvzID = pvz3.ID }), nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewBadReq("product is not in source pvz: " + storedProduct.ID.String()),
+		},
+		{
+			name: "reception in progress",
+			ctx:  userCtx,
+			req:  req,
+			mockBehavior: func() {
+				expectPvzs(pvz2)
+				repo.EXPECT().ListTransferCandidates(gomock.Any(), req.ProductIDs).Return(with(func(c *entity.TransferCandidate) { c.ReceptionStatus = entity.StatusInProgress }), nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewConflict("product reception is not closed: " + storedProduct.ID.String()),
+		},
+		{
+			name: "product issued",
+			ctx:  userCtx,
+			req:  req,
+			mockBehavior: func() {
+				expectPvzs(pvz2)
+				repo.EXPECT().ListTransferCandidates(gomock.Any(), req.ProductIDs).Return(with(func(c *entity.TransferCandidate) { c.State = entity.ProductStateIssued }), nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewConflict("product " + storedProduct.ID.String() + " is issued"),
+		},
+		{
+			name: "product already in transfer",
+			ctx:  userCtx,
+			req:  req,
+			mockBehavior: func() {
+				expectPvzs(pvz2)
+				repo.EXPECT().ListTransferCandidates(gomock.Any(), req.ProductIDs).Return(with(func(c *entity.TransferCandidate) { c.InTransfer = true }), nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewConflict("product is already in transfer: " + storedProduct.ID.String()),
+		},
+		{
+			name: "destination at capacity",
+			ctx:  userCtx,
+			req:  req,
+			mockBehavior: func() {
+				expectPvzs(fullPvz)
+				repo.EXPECT().ListTransferCandidates(gomock.Any(), req.ProductIDs).Return([]*entity.TransferCandidate{candidate}, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewConflict("destination pvz capacity exceeded: 10 of 10 products on hand, 1 in transfer"),
+		},
+		{
+			name: "unk err",
+			ctx:  userCtx,
+			req:  req,
+			mockBehavior: func() {
+				expectPvzs(pvz2)
+				repo.EXPECT().ListTransferCandidates(gomock.Any(), req.ProductIDs).Return([]*entity.TransferCandidate{candidate}, nil)
+				repo.EXPECT().CreateTransfer(gomock.Any(), pvz1.ID, pvz2.ID, req.ProductIDs, userID).Return(nil, errMock)
+			},
+			expResp: nil,
+			expErr:  apperror.NewInternal("failed to create transfer", errMock),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			resp, err := srv.CreateTransfer(tc.ctx, tc.req)
+			require.Equal(t, tc.expResp, resp)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestDispatchTransfer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockTransferRepo(ctrl)
+	pvzSrv := mocks.NewMockPvzFinder(ctrl)
+	auditor := mocks.NewMockAuditor(ctrl)
+	srv := service.NewTransferService(repo, nil, nil, pvzSrv, nil, auditor)
+
+	userID := uuid.New()
+	userCtx := principal.NewContext(context.Background(), &principal.Principal{UserID: userID, AllPvz: true})
+	destinationCtx := principal.NewContext(context.Background(), &principal.Principal{APIKeyID: uuid.New(), PvzIDs: []uuid.UUID{pvz2.ID}})
+
+	created := &entity.Transfer{ID: uuid.New(), FromPvzID: pvz1.ID, ToPvzID: pvz2.ID, Status: entity.TransferStatusCreated}
+	dispatched := &entity.Transfer{ID: created.ID, FromPvzID: pvz1.ID, ToPvzID: pvz2.ID, Status: entity.TransferStatusInTransit, DispatchedAt: time.Now()}
+
+	testCases := []struct {
+		name         string
+		ctx          context.Context
+		mockBehavior func()
+		expResp      *entity.Transfer
+		expErr       error
+	}{
+		{
+			name: "ok",
+			ctx:  userCtx,
+			mockBehavior: func() {
+				repo.EXPECT().GetTransfer(gomock.Any(), created.ID).Return(created, nil)
+				repo.EXPECT().DispatchTransfer(gomock.Any(), created.ID, userID).Return(dispatched, nil)
+				pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz1.ID).Return(pvz1, nil)
+				auditor.EXPECT().Record(gomock.Any(), entity.AuditTransferDispatched, map[string]any{
+					"transfer_id": created.ID,
+					"from_pvz_id": pvz1.ID,
+					"to_pvz_id":   pvz2.ID,
+				})
+			},
+			expResp: dispatched,
+			expErr:  nil,
+		},
+		{
+			name: "not found",
+			ctx:  userCtx,
+			mockBehavior: func() {
+				repo.EXPECT().GetTransfer(gomock.Any(), created.ID).Return(nil, repository.ErrTransferNotFound)
+			},
+			expResp: nil,
+			expErr:  apperror.NewNotFound(repository.ErrTransferNotFound.Error()),
+		},
+		{
+			name: "no access to source pvz",
+			ctx:  destinationCtx,
+			mockBehavior: func() {
+				repo.EXPECT().GetTransfer(gomock.Any(), created.ID).Return(created, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewForbidden("no access to pvz"),
+		},
+		{
+			name: "already dispatched",
+			ctx:  userCtx,
+			mockBehavior: func() {
+				repo.EXPECT().GetTransfer(gomock.Any(), created.ID).Return(dispatched, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewConflict("transfer is in_transit"),
+		},
+		{
+			name: "products unavailable",
+			ctx:  userCtx,
+			mockBehavior: func() {
+				repo.EXPECT().GetTransfer(gomock.Any(), created.ID).Return(created, nil)
+				repo.EXPECT().DispatchTransfer(gomock.Any(), created.ID, userID).Return(nil, repository.ErrTransferProductsUnavailable)
+			},
+			expResp: nil,
+			expErr:  apperror.NewConflict(repository.ErrTransferProductsUnavailable.Error()),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			resp, err := srv.DispatchTransfer(tc.ctx, created.ID)
+			require.Equal(t, tc.expResp, resp)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestReceiveTransfer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	repo := mocks.NewMockTransferRepo(ctrl)
+	receptionRepo := mocks.NewMockOpenReceptionFinder(ctrl)
+	receptionSrv := mocks.NewMockReceptionOpener(ctrl)
+	pvzSrv := mocks.NewMockPvzFinder(ctrl)
+	cellSrv := mocks.NewMockCellAllocator(ctrl)
+	auditor := mocks.NewMockAuditor(ctrl)
+	srv := service.NewTransferService(repo, receptionRepo, receptionSrv, pvzSrv, cellSrv, auditor)
+
+	userID := uuid.New()
+	userCtx := principal.NewContext(context.Background(), &principal.Principal{UserID: userID, AllPvz: true})
+	sourceCtx := principal.NewContext(context.Background(), &principal.Principal{APIKeyID: uuid.New(), PvzIDs: []uuid.UUID{pvz1.ID}})
+
+	openReception := &entity.Reception{ID: uuid.New(), DateTime: time.Now(), PvzID: pvz2.ID, Status: entity.StatusInProgress}
+	inTransit := &entity.Transfer{ID: uuid.New(), FromPvzID: pvz1.ID, ToPvzID: pvz2.ID, Status: entity.TransferStatusInTransit}
+	cell := &entity.StorageCell{ID: uuid.New(), PvzID: pvz2.ID, Zone: "B", Rack: 1, Shelf: 1, SizeClass: entity.SizeClassMedium, Capacity: 5}
+	received := func() *entity.Transfer {
+		return &entity.Transfer{
+			ID:          inTransit.ID,
+			FromPvzID:   pvz1.ID,
+			ToPvzID:     pvz2.ID,
+			Status:      entity.TransferStatusReceived,
+			ReceptionID: openReception.ID,
+			Products:    []*entity.Product{{ID: storedProduct.ID, ReceptionID: openReception.ID, State: entity.ProductStateStored}},
+		}
+	}
+	expectReceived := func(res *entity.Transfer) {
+		repo.EXPECT().ReceiveTransfer(gomock.Any(), inTransit.ID, openReception.ID, userID).Return(res, nil)
+		cellSrv.EXPECT().AssignStorageCell(gomock.Any(), pvz2.ID, res.Products[0]).Return(cell, nil)
+		pvzSrv.EXPECT().GetPvz(gomock.Any(), pvz2.ID).Return(pvz2, nil)
+		auditor.EXPECT().Record(gomock.Any(), entity.AuditTransferReceived, map[string]any{
+			"transfer_id":  inTransit.ID,
+			"from_pvz_id":  pvz1.ID,
+			"to_pvz_id":    pvz2.ID,
+			"reception_id": openReception.ID,
+		})
+	}
+	placed := received()
+	placed.Products[0].CellID = cell.ID
+	placed.Products[0].Cell = cell
+
+	testCases := []struct {
+		name         string
+		ctx          context.Context
+		mockBehavior func()
+		expResp      *entity.Transfer
+		expErr       error
+	}{
+		{
+			name: "ok attached to open reception",
+			ctx:  userCtx,
+			mockBehavior: func() {
+				repo.EXPECT().GetTransfer(gomock.Any(), inTransit.ID).Return(inTransit, nil)
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), pvz2.ID).Return(openReception, nil)
+				expectReceived(received())
+			},
+			expResp: placed,
+			expErr:  nil,
+		},
+		{
+			name: "ok reception opened",
+			ctx:  userCtx,
+			mockBehavior: func() {
+				repo.EXPECT().GetTransfer(gomock.Any(), inTransit.ID).Return(inTransit, nil)
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), pvz2.ID).Return(nil, repository.ErrNoOpenReceptionFound)
+				receptionSrv.EXPECT().CreateReception(gomock.Any(), &request.CreateReception{PvzID: pvz2.ID}).Return(openReception, nil)
+				expectReceived(received())
+			},
+			expResp: placed,
+			expErr:  nil,
+		},
+		{
+			name: "no access to destination pvz",
+			ctx:  sourceCtx,
+			mockBehavior: func() {
+				repo.EXPECT().GetTransfer(gomock.Any(), inTransit.ID).Return(inTransit, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewForbidden("no access to pvz"),
+		},
+		{
+			name: "not dispatched",
+			ctx:  userCtx,
+			mockBehavior: func() {
+				repo.EXPECT().GetTransfer(gomock.Any(), inTransit.ID).Return(&entity.Transfer{ID: inTransit.ID, FromPvzID: pvz1.ID, ToPvzID: pvz2.ID, Status: entity.TransferStatusCreated}, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewConflict("transfer is created"),
+		},
+		{
+			name: "reception can't be opened",
+			ctx:  userCtx,
+			mockBehavior: func() {
+				repo.EXPECT().GetTransfer(gomock.Any(), inTransit.ID).Return(inTransit, nil)
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), pvz2.ID).Return(nil, repository.ErrNoOpenReceptionFound)
+				receptionSrv.EXPECT().CreateReception(gomock.Any(), &request.CreateReception{PvzID: pvz2.ID}).Return(nil, apperror.NewBadReq("can't start new reception, pvz is closed"))
+			},
+			expResp: nil,
+			expErr:  apperror.NewBadReq("can't start new reception, pvz is closed"),
+		},
+		{
+			name: "started reception deleted on failure",
+			ctx:  userCtx,
+			mockBehavior: func() {
+				repo.EXPECT().GetTransfer(gomock.Any(), inTransit.ID).Return(inTransit, nil)
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), pvz2.ID).Return(nil, repository.ErrNoOpenReceptionFound)
+				receptionSrv.EXPECT().CreateReception(gomock.Any(), &request.CreateReception{PvzID: pvz2.ID}).Return(openReception, nil)
+				repo.EXPECT().ReceiveTransfer(gomock.Any(), inTransit.ID, openReception.ID, userID).Return(nil, repository.ErrPvzCapacityExceeded)
+				receptionRepo.EXPECT().DeleteEmptyReception(gomock.Any(), openReception.ID).Return(nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewConflict(repository.ErrPvzCapacityExceeded.Error()),
+		},
+		{
+			name: "pvz at capacity",
+			ctx:  userCtx,
+			mockBehavior: func() {
+				repo.EXPECT().GetTransfer(gomock.Any(), inTransit.ID).Return(inTransit, nil)
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), pvz2.ID).Return(openReception, nil)
+				repo.EXPECT().ReceiveTransfer(gomock.Any(), inTransit.ID, openReception.ID, userID).Return(nil, repository.ErrPvzCapacityExceeded)
+			},
+			expResp: nil,
+			expErr:  apperror.NewConflict(repository.ErrPvzCapacityExceeded.Error()),
+		},
+		{
+			name: "unk err",
+			ctx:  userCtx,
+			mockBehavior: func() {
+				repo.EXPECT().GetTransfer(gomock.Any(), inTransit.ID).Return(inTransit, nil)
+				receptionRepo.EXPECT().GetLastOpenReception(gomock.Any(), pvz2.ID).Return(openReception, nil)
+				repo.EXPECT().ReceiveTransfer(gomock.Any(), inTransit.ID, openReception.ID, userID).Return(nil, errMock)
+			},
+			expResp: nil,
+			expErr:  apperror.NewInternal("failed to receive transfer", errMock),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior()
+
+			resp, err := srv.ReceiveTransfer(tc.ctx, inTransit.ID)
+			require.Equal(t, tc.expResp, resp)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
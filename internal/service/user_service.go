@@ -12,19 +12,33 @@ import (
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/secret"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
 )
 
+const temporaryPasswordLen = 12
+
 type TokenService interface {
 	CreateDummyToken(role string) (string, error)
-	CreateUserToken(id uuid.UUID, role string) (string, error)
-	VerifyToken(tokenStr string) (map[string]interface{}, error)
+	CreateUserToken(id uuid.UUID, role string, version int32) (string, error)
+	CreateMFAToken(id uuid.UUID, version int32) (string, error)
+	VerifyToken(ctx context.Context, tokenStr string) (map[string]interface{}, error)
+	VerifyMFAToken(ctx context.Context, tokenStr string) (uuid.UUID, error)
 }
 
 type UserRepo interface {
 	CreateUser(ctx context.Context, req *request.Register) (*entity.User, error)
 	GetUser(ctx context.Context, req *request.Login) (*entity.User, error)
+	GetUserByID(ctx context.Context, id uuid.UUID) (*entity.User, error)
+	ListUsers(ctx context.Context, req *request.ListUsers) ([]*entity.User, error)
+	UpdateUser(ctx context.Context, id uuid.UUID, req *request.UpdateUser) (*entity.User, error)
+	UpdatePassword(ctx context.Context, id uuid.UUID, password string) error
+}
+
+type RoleFinder interface {
+	HasRole(ctx context.Context, role entity.Role) (bool, error)
 }
 
 type UserServiceImpl struct {
@@ -33,28 +47,72 @@ type UserServiceImpl struct {
 	conn *sql.DB
 
 	tokenSrv TokenService
+	roleSrv  RoleFinder
+	auditor  Auditor
+
+	// mfaRoles are roles, that must pass MFA on login.
+	mfaRoles map[entity.Role]bool
 }
 
-func NewUserService(repo UserRepo, conn *sql.DB, tokenSrv TokenService) *UserServiceImpl {
+func NewUserService(repo UserRepo, conn *sql.DB, tokenSrv TokenService, roleSrv RoleFinder, auditor Auditor, mfaRoles ...entity.Role) *UserServiceImpl {
+	required := make(map[entity.Role]bool, len(mfaRoles))
+	for _, r := range mfaRoles {
+		required[r] = true
+	}
+
 	return &UserServiceImpl{
 		repo:     repo,
 		conn:     conn,
 		tokenSrv: tokenSrv,
+		roleSrv:  roleSrv,
+		auditor:  auditor,
+		mfaRoles: required,
+	}
+}
+
+// checkRole returns BadReq error if role doesn't exist.
+func checkRole(ctx context.Context, roleSrv RoleFinder, role string) error {
+	ok, err := roleSrv.HasRole(ctx, entity.Role(role))
+	if err != nil {
+		return apperror.NewInternal("failed to check role", err)
+	}
+	if !ok {
+		return apperror.NewBadReq("invalid role: " + role)
 	}
+
+	return nil
 }
 
-func (s *UserServiceImpl) DummyLogin(_ context.Context, req *request.DummyLogin) (*response.Login, error) {
+func (s *UserServiceImpl) DummyLogin(ctx context.Context, req *request.DummyLogin) (*response.Login, error) {
+	if err := checkRole(ctx, s.roleSrv, req.Role); err != nil {
+		return nil, err
+	}
+
 	tokenStr, err := s.tokenSrv.CreateDummyToken(req.Role)
 	if err != nil {
 		return nil, apperror.NewUnauthorized(err.Error())
 	}
 
+	s.auditor.Record(ctx, entity.AuditTokenIssued, map[string]any{
+		"type": "dummy",
+		"role": req.Role,
+	})
+
 	return &response.Login{
 		Token: tokenStr,
 	}, nil
 }
 
+// Register creates a new user. Only employees can register
+// themselves, other roles are given by moderators.
 func (s *UserServiceImpl) Register(ctx context.Context, req *request.Register) (*entity.User, error) {
+	if err := checkRole(ctx, s.roleSrv, req.Role); err != nil {
+		return nil, err
+	}
+	if entity.Role(req.Role) != entity.RoleEmployee {
+		return nil, apperror.NewForbidden("registration as " + req.Role + " requires an invite")
+	}
+
 	res, err := s.repo.CreateUser(ctx, req)
 	if err != nil {
 		switch {
@@ -68,22 +126,151 @@ func (s *UserServiceImpl) Register(ctx context.Context, req *request.Register) (
 	return res, nil
 }
 
+// Login checks credentials. If user has MFA enabled or it is
+// mandatory for user's role, MFA challenge token is returned
+// instead of a regular one.
 func (s *UserServiceImpl) Login(ctx context.Context, req *request.Login) (*response.Login, error) {
-	res, err := s.repo.GetUser(ctx, req)
+	res, err := s.checkCredentials(ctx, req)
 	if err != nil {
+		s.auditor.Record(ctx, entity.AuditLoginFailure, map[string]any{
+			"email":  req.Email,
+			"reason": err.Error(),
+		})
 		return nil, err
 	}
 
-	if req.Password != res.Password {
-		return nil, apperror.NewUnauthorized("user not found")
+	// caller is not authenticated yet, so actor is set explicitly.
+	ctx = principal.NewContext(ctx, &principal.Principal{UserID: res.ID, Role: res.Role})
+
+	mfaRequired := res.MFAEnabled || s.mfaRoles[res.Role]
+	s.auditor.Record(ctx, entity.AuditLoginSuccess, map[string]any{
+		"email":        req.Email,
+		"mfa_required": mfaRequired,
+	})
+
+	if mfaRequired {
+		mfaToken, err := s.tokenSrv.CreateMFAToken(res.ID, res.TokenVersion)
+		if err != nil {
+			return nil, apperror.NewInternal("failed to create mfa token", err)
+		}
+
+		return &response.Login{
+			MFAToken:          mfaToken,
+			MFAEnrollRequired: !res.MFAEnabled,
+		}, nil
 	}
 
-	tokenStr, err := s.tokenSrv.CreateUserToken(res.ID, string(res.Role))
+	tokenStr, err := s.tokenSrv.CreateUserToken(res.ID, string(res.Role), res.TokenVersion)
 	if err != nil {
 		return nil, apperror.NewInternal("failed to create token", err)
 	}
 
+	s.auditor.Record(ctx, entity.AuditTokenIssued, map[string]any{
+		"type": "user",
+	})
 	return &response.Login{
 		Token: tokenStr,
 	}, nil
 }
+
+func (s *UserServiceImpl) checkCredentials(ctx context.Context, req *request.Login) (*entity.User, error) {
+	res, err := s.repo.GetUser(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Password != res.Password {
+		return nil, apperror.NewUnauthorized("user not found")
+	}
+	if !res.Active {
+		return nil, apperror.NewUnauthorized("user is deactivated")
+	}
+
+	return res, nil
+}
+
+func (s *UserServiceImpl) ListUsers(ctx context.Context, req *request.ListUsers) ([]*entity.User, error) {
+	if req.Role != nil {
+		if err := checkRole(ctx, s.roleSrv, *req.Role); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := s.repo.ListUsers(ctx, req)
+	if err != nil {
+		return nil, apperror.NewInternal("failed to list users", err)
+	}
+
+	return res, nil
+}
+
+func (s *UserServiceImpl) GetUser(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	res, err := s.repo.GetUserByID(ctx, id)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrUserNotFound):
+			return nil, apperror.NewNotFound(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to get user", err)
+		}
+	}
+
+	return res, nil
+}
+
+// UpdateUser changes user role and/or activity.
+func (s *UserServiceImpl) UpdateUser(ctx context.Context, id uuid.UUID, req *request.UpdateUser) (*entity.User, error) {
+	if req.Role != nil {
+		if err := checkRole(ctx, s.roleSrv, *req.Role); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := s.repo.UpdateUser(ctx, id, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrUserNotFound):
+			return nil, apperror.NewNotFound(err.Error())
+		case errors.Is(err, repository.ErrPvzNotFound):
+			return nil, apperror.NewBadReq(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to update user", err)
+		}
+	}
+
+	payload := map[string]any{"user_id": id}
+	if req.Role != nil {
+		payload["role"] = *req.Role
+	}
+	if req.Active != nil {
+		payload["active"] = *req.Active
+	}
+	if req.PvzIDs != nil {
+		payload["pvz_ids"] = *req.PvzIDs
+	}
+	s.auditor.Record(ctx, entity.AuditUserUpdated, payload)
+
+	return res, nil
+}
+
+// ResetPassword sets random temporary password
+// and returns it.
+func (s *UserServiceImpl) ResetPassword(ctx context.Context, id uuid.UUID) (*response.ResetPassword, error) {
+	password, err := secret.Generate(temporaryPasswordLen)
+	if err != nil {
+		return nil, apperror.NewInternal("failed to generate password", err)
+	}
+
+	if err := s.repo.UpdatePassword(ctx, id, password); err != nil {
+		switch {
+		case errors.Is(err, repository.ErrUserNotFound):
+			return nil, apperror.NewNotFound(err.Error())
+		default:
+			return nil, apperror.NewInternal("failed to update password", err)
+		}
+	}
+
+	return &response.ResetPassword{
+		TemporaryPassword: password,
+	}, nil
+}
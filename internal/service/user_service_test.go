@@ -12,6 +12,7 @@ import (
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/request"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/dto/response"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/models/entity"
+	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/principal"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/pkg/web/apperror"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/repository"
 	"github.com/myacey/avito-backend-assignment-pvz/internal/service"
@@ -21,14 +22,17 @@ import (
 var (
 	tokenValid              = "valid"
 	errMock    error        = errors.New("mock error")
-	mockUser   *entity.User = &entity.User{ID: uuid.New(), Email: "mock@example.com", Password: "string", Role: entity.RoleEmployee}
+	mockUser   *entity.User = &entity.User{ID: uuid.New(), Email: "mock@example.com", Password: "string", Role: entity.RoleEmployee, Active: true}
 )
 
 func TestDummyLogin(t *testing.T) {
 	ctrl := gomock.NewController(t)
 
 	tokenSrv := mocks.NewMockTokenService(ctrl)
-	srv := service.NewUserService(nil, nil, tokenSrv)
+	roleSrv := mocks.NewMockRoleFinder(ctrl)
+	auditor := mocks.NewMockAuditor(ctrl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	srv := service.NewUserService(nil, nil, tokenSrv, roleSrv, auditor)
 	testCases := []struct {
 		name         string
 		req          *request.DummyLogin
@@ -42,17 +46,41 @@ func TestDummyLogin(t *testing.T) {
 				Role: string(entity.RoleEmployee),
 			},
 			mockBehavior: func(req *request.DummyLogin) {
+				roleSrv.EXPECT().HasRole(gomock.Any(), entity.Role(req.Role)).Return(true, nil)
 				tokenSrv.EXPECT().CreateDummyToken(req.Role).Return(tokenValid, nil)
 			},
-			expResp: &response.Login{tokenValid},
+			expResp: &response.Login{Token: tokenValid},
 			expErr:  nil,
 		},
+		{
+			name: "invalid role",
+			req: &request.DummyLogin{
+				Role: "invalid",
+			},
+			mockBehavior: func(req *request.DummyLogin) {
+				roleSrv.EXPECT().HasRole(gomock.Any(), entity.Role(req.Role)).Return(false, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewBadReq("invalid role: invalid"),
+		},
+		{
+			name: "check role err",
+			req: &request.DummyLogin{
+				Role: string(entity.RoleEmployee),
+			},
+			mockBehavior: func(req *request.DummyLogin) {
+				roleSrv.EXPECT().HasRole(gomock.Any(), entity.Role(req.Role)).Return(false, errMock)
+			},
+			expResp: nil,
+			expErr:  apperror.NewInternal("failed to check role", errMock),
+		},
 		{
 			name: "create dummy token err",
 			req: &request.DummyLogin{
 				Role: string(entity.RoleEmployee),
 			},
 			mockBehavior: func(req *request.DummyLogin) {
+				roleSrv.EXPECT().HasRole(gomock.Any(), entity.Role(req.Role)).Return(true, nil)
 				tokenSrv.EXPECT().CreateDummyToken(req.Role).Return("", errMock)
 			},
 			expResp: nil,
@@ -77,8 +105,11 @@ func TestRegister(t *testing.T) {
 
 	tokenSrv := mocks.NewMockTokenService(ctrl)
 	userRepo := mocks.NewMockUserRepo(ctrl)
+	roleSrv := mocks.NewMockRoleFinder(ctrl)
 
-	srv := service.NewUserService(userRepo, nil, tokenSrv)
+	auditor := mocks.NewMockAuditor(ctrl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	srv := service.NewUserService(userRepo, nil, tokenSrv, roleSrv, auditor)
 	testCases := []struct {
 		name         string
 		req          *request.Register
@@ -94,11 +125,38 @@ func TestRegister(t *testing.T) {
 				Role:     string(mockUser.Role),
 			},
 			mockBehavior: func(req *request.Register) {
+				roleSrv.EXPECT().HasRole(gomock.Any(), entity.Role(req.Role)).Return(true, nil)
 				userRepo.EXPECT().CreateUser(gomock.Any(), req).Return(mockUser, nil)
 			},
 			expResp: mockUser,
 			expErr:  nil,
 		},
+		{
+			name: "invalid role",
+			req: &request.Register{
+				Email:    mockUser.Email,
+				Password: mockUser.Password,
+				Role:     "invalid",
+			},
+			mockBehavior: func(req *request.Register) {
+				roleSrv.EXPECT().HasRole(gomock.Any(), entity.Role(req.Role)).Return(false, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewBadReq("invalid role: invalid"),
+		},
+		{
+			name: "moderator without invite",
+			req: &request.Register{
+				Email:    mockUser.Email,
+				Password: mockUser.Password,
+				Role:     string(entity.RoleModerator),
+			},
+			mockBehavior: func(req *request.Register) {
+				roleSrv.EXPECT().HasRole(gomock.Any(), entity.Role(req.Role)).Return(true, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewForbidden("registration as moderator requires an invite"),
+		},
 		{
 			name: "err user already exists",
 			req: &request.Register{
@@ -107,6 +165,7 @@ func TestRegister(t *testing.T) {
 				Role:     string(mockUser.Role),
 			},
 			mockBehavior: func(req *request.Register) {
+				roleSrv.EXPECT().HasRole(gomock.Any(), entity.Role(req.Role)).Return(true, nil)
 				userRepo.EXPECT().CreateUser(gomock.Any(), req).Return(nil, repository.ErrUserAlreadyExists)
 			},
 			expResp: nil,
@@ -120,6 +179,7 @@ func TestRegister(t *testing.T) {
 				Role:     string(mockUser.Role),
 			},
 			mockBehavior: func(req *request.Register) {
+				roleSrv.EXPECT().HasRole(gomock.Any(), entity.Role(req.Role)).Return(true, nil)
 				userRepo.EXPECT().CreateUser(gomock.Any(), req).Return(nil, errMock)
 			},
 			expResp: nil,
@@ -145,7 +205,9 @@ func TestLogin(t *testing.T) {
 	tokenSrv := mocks.NewMockTokenService(ctrl)
 	userRepo := mocks.NewMockUserRepo(ctrl)
 
-	srv := service.NewUserService(userRepo, nil, tokenSrv)
+	auditor := mocks.NewMockAuditor(ctrl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	srv := service.NewUserService(userRepo, nil, tokenSrv, nil, auditor)
 	testCases := []struct {
 		name         string
 		req          *request.Login
@@ -161,7 +223,7 @@ func TestLogin(t *testing.T) {
 			},
 			mockBehavior: func(req *request.Login) {
 				userRepo.EXPECT().GetUser(gomock.Any(), req).Return(mockUser, nil)
-				tokenSrv.EXPECT().CreateUserToken(mockUser.ID, string(mockUser.Role)).Return(tokenValid, nil)
+				tokenSrv.EXPECT().CreateUserToken(mockUser.ID, string(mockUser.Role), mockUser.TokenVersion).Return(tokenValid, nil)
 			},
 			expResp: &response.Login{
 				Token: tokenValid,
@@ -192,6 +254,24 @@ func TestLogin(t *testing.T) {
 			expResp: nil,
 			expErr:  apperror.NewUnauthorized("user not found"),
 		},
+		{
+			name: "deactivated user",
+			req: &request.Login{
+				Email:    mockUser.Email,
+				Password: mockUser.Password,
+			},
+			mockBehavior: func(req *request.Login) {
+				userRepo.EXPECT().GetUser(gomock.Any(), req).Return(&entity.User{
+					ID:       mockUser.ID,
+					Email:    mockUser.Email,
+					Password: mockUser.Password,
+					Role:     mockUser.Role,
+					Active:   false,
+				}, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewUnauthorized("user is deactivated"),
+		},
 		{
 			name: "creation token err",
 			req: &request.Login{
@@ -200,11 +280,33 @@ func TestLogin(t *testing.T) {
 			},
 			mockBehavior: func(req *request.Login) {
 				userRepo.EXPECT().GetUser(gomock.Any(), req).Return(mockUser, nil)
-				tokenSrv.EXPECT().CreateUserToken(mockUser.ID, string(mockUser.Role)).Return("", errMock)
+				tokenSrv.EXPECT().CreateUserToken(mockUser.ID, string(mockUser.Role), mockUser.TokenVersion).Return("", errMock)
 			},
 			expResp: nil,
 			expErr:  apperror.NewInternal("failed to create token", errMock),
 		},
+		{
+			name: "mfa enabled",
+			req: &request.Login{
+				Email:    mockUser.Email,
+				Password: mockUser.Password,
+			},
+			mockBehavior: func(req *request.Login) {
+				userRepo.EXPECT().GetUser(gomock.Any(), req).Return(&entity.User{
+					ID:         mockUser.ID,
+					Email:      mockUser.Email,
+					Password:   mockUser.Password,
+					Role:       mockUser.Role,
+					Active:     true,
+					MFAEnabled: true,
+				}, nil)
+				tokenSrv.EXPECT().CreateMFAToken(mockUser.ID, mockUser.TokenVersion).Return(tokenValid, nil)
+			},
+			expResp: &response.Login{
+				MFAToken: tokenValid,
+			},
+			expErr: nil,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -218,3 +320,310 @@ func TestLogin(t *testing.T) {
 		})
 	}
 }
+
+func TestLoginMFARequired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	tokenSrv := mocks.NewMockTokenService(ctrl)
+	userRepo := mocks.NewMockUserRepo(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	srv := service.NewUserService(userRepo, nil, tokenSrv, nil, auditor, mockUser.Role)
+
+	req := &request.Login{
+		Email:    mockUser.Email,
+		Password: mockUser.Password,
+	}
+	userRepo.EXPECT().GetUser(gomock.Any(), req).Return(mockUser, nil)
+	tokenSrv.EXPECT().CreateMFAToken(mockUser.ID, mockUser.TokenVersion).Return(tokenValid, nil)
+
+	res, err := srv.Login(context.Background(), req)
+
+	require.NoError(t, err)
+	require.Equal(t, &response.Login{
+		MFAToken:          tokenValid,
+		MFAEnrollRequired: true,
+	}, res)
+}
+
+func TestLoginAudit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	tokenSrv := mocks.NewMockTokenService(ctrl)
+	userRepo := mocks.NewMockUserRepo(ctrl)
+	auditor := mocks.NewMockAuditor(ctrl)
+
+	srv := service.NewUserService(userRepo, nil, tokenSrv, nil, auditor)
+
+	t.Run("success", func(t *testing.T) {
+		req := &request.Login{Email: mockUser.Email, Password: mockUser.Password}
+		userRepo.EXPECT().GetUser(gomock.Any(), req).Return(mockUser, nil)
+		tokenSrv.EXPECT().CreateUserToken(mockUser.ID, string(mockUser.Role), mockUser.TokenVersion).Return(tokenValid, nil)
+
+		checkActor := func(ctx context.Context, _ entity.AuditAction, _ map[string]any) {
+			p, ok := principal.FromContext(ctx)
+			require.True(t, ok)
+			require.Equal(t, mockUser.ID, p.UserID)
+		}
+		gomock.InOrder(
+			auditor.EXPECT().Record(gomock.Any(), entity.AuditLoginSuccess, gomock.Any()).Do(checkActor),
+			auditor.EXPECT().Record(gomock.Any(), entity.AuditTokenIssued, map[string]any{"type": "user"}).Do(checkActor),
+		)
+
+		_, err := srv.Login(context.Background(), req)
+		require.NoError(t, err)
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		req := &request.Login{Email: mockUser.Email, Password: "invalid"}
+		userRepo.EXPECT().GetUser(gomock.Any(), req).Return(mockUser, nil)
+		auditor.EXPECT().Record(gomock.Any(), entity.AuditLoginFailure, map[string]any{
+			"email":  mockUser.Email,
+			"reason": "user not found",
+		})
+
+		_, err := srv.Login(context.Background(), req)
+		require.Equal(t, apperror.NewUnauthorized("user not found"), err)
+	})
+}
+
+func TestListUsers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	userRepo := mocks.NewMockUserRepo(ctrl)
+	roleSrv := mocks.NewMockRoleFinder(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	srv := service.NewUserService(userRepo, nil, nil, roleSrv, auditor)
+
+	role := string(entity.RoleEmployee)
+	invalidRole := "invalid"
+	testCases := []struct {
+		name         string
+		req          *request.ListUsers
+		mockBehavior func(req *request.ListUsers)
+		expResp      []*entity.User
+		expErr       error
+	}{
+		{
+			name: "OK",
+			req:  &request.ListUsers{Role: &role, Page: 1, Limit: 10},
+			mockBehavior: func(req *request.ListUsers) {
+				roleSrv.EXPECT().HasRole(gomock.Any(), entity.Role(role)).Return(true, nil)
+				userRepo.EXPECT().ListUsers(gomock.Any(), req).Return([]*entity.User{mockUser}, nil)
+			},
+			expResp: []*entity.User{mockUser},
+			expErr:  nil,
+		},
+		{
+			name: "invalid role",
+			req:  &request.ListUsers{Role: &invalidRole, Page: 1, Limit: 10},
+			mockBehavior: func(req *request.ListUsers) {
+				roleSrv.EXPECT().HasRole(gomock.Any(), entity.Role(invalidRole)).Return(false, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewBadReq("invalid role: invalid"),
+		},
+		{
+			name: "internal error",
+			req:  &request.ListUsers{Page: 1, Limit: 10},
+			mockBehavior: func(req *request.ListUsers) {
+				userRepo.EXPECT().ListUsers(gomock.Any(), req).Return(nil, errMock)
+			},
+			expResp: nil,
+			expErr:  apperror.NewInternal("failed to list users", errMock),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(tc.req)
+
+			res, err := srv.ListUsers(context.Background(), tc.req)
+
+			require.Equal(t, tc.expResp, res)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestGetUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	userRepo := mocks.NewMockUserRepo(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	srv := service.NewUserService(userRepo, nil, nil, nil, auditor)
+	testCases := []struct {
+		name         string
+		id           uuid.UUID
+		mockBehavior func(id uuid.UUID)
+		expResp      *entity.User
+		expErr       error
+	}{
+		{
+			name: "OK",
+			id:   mockUser.ID,
+			mockBehavior: func(id uuid.UUID) {
+				userRepo.EXPECT().GetUserByID(gomock.Any(), id).Return(mockUser, nil)
+			},
+			expResp: mockUser,
+			expErr:  nil,
+		},
+		{
+			name: "not found",
+			id:   mockUser.ID,
+			mockBehavior: func(id uuid.UUID) {
+				userRepo.EXPECT().GetUserByID(gomock.Any(), id).Return(nil, repository.ErrUserNotFound)
+			},
+			expResp: nil,
+			expErr:  apperror.NewNotFound(repository.ErrUserNotFound.Error()),
+		},
+		{
+			name: "internal error",
+			id:   mockUser.ID,
+			mockBehavior: func(id uuid.UUID) {
+				userRepo.EXPECT().GetUserByID(gomock.Any(), id).Return(nil, errMock)
+			},
+			expResp: nil,
+			expErr:  apperror.NewInternal("failed to get user", errMock),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(tc.id)
+
+			res, err := srv.GetUser(context.Background(), tc.id)
+
+			require.Equal(t, tc.expResp, res)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestUpdateUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	userRepo := mocks.NewMockUserRepo(ctrl)
+	roleSrv := mocks.NewMockRoleFinder(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	srv := service.NewUserService(userRepo, nil, nil, roleSrv, auditor)
+
+	moderator := string(entity.RoleModerator)
+	invalidRole := "invalid"
+	inactive := false
+	testCases := []struct {
+		name         string
+		req          *request.UpdateUser
+		mockBehavior func(req *request.UpdateUser)
+		expResp      *entity.User
+		expErr       error
+	}{
+		{
+			name: "OK",
+			req:  &request.UpdateUser{Role: &moderator},
+			mockBehavior: func(req *request.UpdateUser) {
+				roleSrv.EXPECT().HasRole(gomock.Any(), entity.RoleModerator).Return(true, nil)
+				userRepo.EXPECT().UpdateUser(gomock.Any(), mockUser.ID, req).Return(mockUser, nil)
+			},
+			expResp: mockUser,
+			expErr:  nil,
+		},
+		{
+			name: "invalid role",
+			req:  &request.UpdateUser{Role: &invalidRole},
+			mockBehavior: func(req *request.UpdateUser) {
+				roleSrv.EXPECT().HasRole(gomock.Any(), entity.Role(invalidRole)).Return(false, nil)
+			},
+			expResp: nil,
+			expErr:  apperror.NewBadReq("invalid role: invalid"),
+		},
+		{
+			name: "not found",
+			req:  &request.UpdateUser{Active: &inactive},
+			mockBehavior: func(req *request.UpdateUser) {
+				userRepo.EXPECT().UpdateUser(gomock.Any(), mockUser.ID, req).Return(nil, repository.ErrUserNotFound)
+			},
+			expResp: nil,
+			expErr:  apperror.NewNotFound(repository.ErrUserNotFound.Error()),
+		},
+		{
+			name: "pvz not found",
+			req:  &request.UpdateUser{PvzIDs: &[]uuid.UUID{uuid.New()}},
+			mockBehavior: func(req *request.UpdateUser) {
+				userRepo.EXPECT().UpdateUser(gomock.Any(), mockUser.ID, req).Return(nil, repository.ErrPvzNotFound)
+			},
+			expResp: nil,
+			expErr:  apperror.NewBadReq(repository.ErrPvzNotFound.Error()),
+		},
+		{
+			name: "internal error",
+			req:  &request.UpdateUser{Active: &inactive},
+			mockBehavior: func(req *request.UpdateUser) {
+				userRepo.EXPECT().UpdateUser(gomock.Any(), mockUser.ID, req).Return(nil, errMock)
+			},
+			expResp: nil,
+			expErr:  apperror.NewInternal("failed to update user", errMock),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.mockBehavior(tc.req)
+
+			res, err := srv.UpdateUser(context.Background(), mockUser.ID, tc.req)
+
+			require.Equal(t, tc.expResp, res)
+			require.Equal(t, tc.expErr, err)
+		})
+	}
+}
+
+func TestResetPassword(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	userRepo := mocks.NewMockUserRepo(ctrl)
+
+	auditor := mocks.NewMockAuditor(ctrl)
+	auditor.EXPECT().Record(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	srv := service.NewUserService(userRepo, nil, nil, nil, auditor)
+
+	t.Run("OK", func(t *testing.T) {
+		var saved string
+		userRepo.EXPECT().UpdatePassword(gomock.Any(), mockUser.ID, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ uuid.UUID, password string) error {
+				saved = password
+				return nil
+			})
+
+		res, err := srv.ResetPassword(context.Background(), mockUser.ID)
+
+		require.NoError(t, err)
+		require.NotEmpty(t, res.TemporaryPassword)
+		require.Equal(t, saved, res.TemporaryPassword)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		userRepo.EXPECT().UpdatePassword(gomock.Any(), mockUser.ID, gomock.Any()).Return(repository.ErrUserNotFound)
+
+		res, err := srv.ResetPassword(context.Background(), mockUser.ID)
+
+		require.Nil(t, res)
+		require.Equal(t, apperror.NewNotFound(repository.ErrUserNotFound.Error()), err)
+	})
+
+	t.Run("internal error", func(t *testing.T) {
+		userRepo.EXPECT().UpdatePassword(gomock.Any(), mockUser.ID, gomock.Any()).Return(errMock)
+
+		res, err := srv.ResetPassword(context.Background(), mockUser.ID)
+
+		require.Nil(t, res)
+		require.Equal(t, apperror.NewInternal("failed to update password", errMock), err)
+	})
+}
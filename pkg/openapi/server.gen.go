@@ -10,6 +10,8 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 	"path"
@@ -25,79 +27,447 @@ import (
 )
 
 const (
+	ApiKeyAuthScopes = "apiKeyAuth.Scopes"
 	BearerAuthScopes = "bearerAuth.Scopes"
 )
 
-// Defines values for PVZCity.
+// Defines values for AuditEntryAction.
 const (
-	Казань         PVZCity = "Казань"
-	Москва         PVZCity = "Москва"
-	СанктПетербург PVZCity = "Санкт-Петербург"
+	AuditEntryActionLoginFailure          AuditEntryAction = "login.failure"
+	AuditEntryActionLoginSuccess          AuditEntryAction = "login.success"
+	AuditEntryActionProductDeleted        AuditEntryAction = "product.deleted"
+	AuditEntryActionProductIssued         AuditEntryAction = "product.issued"
+	AuditEntryActionPvzCapacityChanged    AuditEntryAction = "pvz.capacity_changed"
+	AuditEntryActionPvzCreated            AuditEntryAction = "pvz.created"
+	AuditEntryActionReceptionCancelled    AuditEntryAction = "reception.cancelled"
+	AuditEntryActionReceptionClosed       AuditEntryAction = "reception.closed"
+	AuditEntryActionReceptionOpened       AuditEntryAction = "reception.opened"
+	AuditEntryActionReceptionReopened     AuditEntryAction = "reception.reopened"
+	AuditEntryActionReceptionStale        AuditEntryAction = "reception.stale"
+	AuditEntryActionReturnShipmentCreated AuditEntryAction = "return_shipment.created"
+	AuditEntryActionStorageCellCreated    AuditEntryAction = "storage_cell.created"
+	AuditEntryActionTokenIssued           AuditEntryAction = "token.issued"
+	AuditEntryActionTransferCreated       AuditEntryAction = "transfer.created"
+	AuditEntryActionTransferDispatched    AuditEntryAction = "transfer.dispatched"
+	AuditEntryActionTransferReceived      AuditEntryAction = "transfer.received"
+	AuditEntryActionUserUpdated           AuditEntryAction = "user.updated"
 )
 
-// Defines values for ProductType.
+// Defines values for BatchProductResultStatus.
 const (
-	ProductTypeОбувь       ProductType = "обувь"
-	ProductTypeОдежда      ProductType = "одежда"
-	ProductTypeЭлектроника ProductType = "электроника"
+	BatchProductResultStatusCreated   BatchProductResultStatus = "created"
+	BatchProductResultStatusDuplicate BatchProductResultStatus = "duplicate"
+	BatchProductResultStatusInvalid   BatchProductResultStatus = "invalid"
+	BatchProductResultStatusSkipped   BatchProductResultStatus = "skipped"
+)
+
+// Defines values for ClosedReceptionStatus.
+const (
+	ClosedReceptionStatusCancelled  ClosedReceptionStatus = "cancelled"
+	ClosedReceptionStatusClose      ClosedReceptionStatus = "close"
+	ClosedReceptionStatusInProgress ClosedReceptionStatus = "in_progress"
+)
+
+// Defines values for DiscrepancyKind.
+const (
+	Missing    DiscrepancyKind = "missing"
+	OverCount  DiscrepancyKind = "over_count"
+	Unexpected DiscrepancyKind = "unexpected"
+)
+
+// Defines values for PVZStatus.
+const (
+	Active            PVZStatus = "active"
+	Decommissioned    PVZStatus = "decommissioned"
+	TemporarilyClosed PVZStatus = "temporarily_closed"
+)
+
+// Defines values for ProductCondition.
+const (
+	ProductConditionDamaged ProductCondition = "damaged"
+	ProductConditionOk      ProductCondition = "ok"
+	ProductConditionOpened  ProductCondition = "opened"
+)
+
+// Defines values for ProductSizeClass.
+const (
+	ProductSizeClassLarge  ProductSizeClass = "large"
+	ProductSizeClassMedium ProductSizeClass = "medium"
+	ProductSizeClassSmall  ProductSizeClass = "small"
+)
+
+// Defines values for ProductState.
+const (
+	InTransit        ProductState = "in_transit"
+	Issued           ProductState = "issued"
+	ReturnedToSender ProductState = "returned_to_sender"
+	Stored           ProductState = "stored"
+	ToReturn         ProductState = "to_return"
+)
+
+// Defines values for ProductEventType.
+const (
+	ProductEventTypeDeleted            ProductEventType = "deleted"
+	ProductEventTypeExpired            ProductEventType = "expired"
+	ProductEventTypeIssued             ProductEventType = "issued"
+	ProductEventTypeMoved              ProductEventType = "moved"
+	ProductEventTypeReceived           ProductEventType = "received"
+	ProductEventTypeReturnedToSender   ProductEventType = "returned_to_sender"
+	ProductEventTypeTransferDispatched ProductEventType = "transfer_dispatched"
+	ProductEventTypeTransferReceived   ProductEventType = "transfer_received"
 )
 
 // Defines values for ReceptionStatus.
 const (
-	Close      ReceptionStatus = "close"
-	InProgress ReceptionStatus = "in_progress"
+	ReceptionStatusCancelled  ReceptionStatus = "cancelled"
+	ReceptionStatusClose      ReceptionStatus = "close"
+	ReceptionStatusInProgress ReceptionStatus = "in_progress"
+)
+
+// Defines values for ReceptionSummaryStatus.
+const (
+	ReceptionSummaryStatusCancelled  ReceptionSummaryStatus = "cancelled"
+	ReceptionSummaryStatusClose      ReceptionSummaryStatus = "close"
+	ReceptionSummaryStatusInProgress ReceptionSummaryStatus = "in_progress"
+)
+
+// Defines values for StorageCellSizeClass.
+const (
+	StorageCellSizeClassLarge  StorageCellSizeClass = "large"
+	StorageCellSizeClassMedium StorageCellSizeClass = "medium"
+	StorageCellSizeClassSmall  StorageCellSizeClass = "small"
+)
+
+// Defines values for TransferStatus.
+const (
+	TransferStatusCreated   TransferStatus = "created"
+	TransferStatusInTransit TransferStatus = "in_transit"
+	TransferStatusReceived  TransferStatus = "received"
+)
+
+// Defines values for PostProductsJSONBodyCondition.
+const (
+	PostProductsJSONBodyConditionDamaged PostProductsJSONBodyCondition = "damaged"
+	PostProductsJSONBodyConditionOk      PostProductsJSONBodyCondition = "ok"
+	PostProductsJSONBodyConditionOpened  PostProductsJSONBodyCondition = "opened"
+)
+
+// Defines values for PostProductsJSONBodySizeClass.
+const (
+	PostProductsJSONBodySizeClassLarge  PostProductsJSONBodySizeClass = "large"
+	PostProductsJSONBodySizeClassMedium PostProductsJSONBodySizeClass = "medium"
+	PostProductsJSONBodySizeClassSmall  PostProductsJSONBodySizeClass = "small"
 )
 
-// Defines values for UserRole.
+// Defines values for PostProductsBatchJSONBodyProductsCondition.
 const (
-	UserRoleEmployee  UserRole = "employee"
-	UserRoleModerator UserRole = "moderator"
+	Damaged PostProductsBatchJSONBodyProductsCondition = "damaged"
+	Ok      PostProductsBatchJSONBodyProductsCondition = "ok"
+	Opened  PostProductsBatchJSONBodyProductsCondition = "opened"
 )
 
-// Defines values for PostDummyLoginJSONBodyRole.
+// Defines values for PostProductsBatchJSONBodyProductsSizeClass.
 const (
-	PostDummyLoginJSONBodyRoleEmployee  PostDummyLoginJSONBodyRole = "employee"
-	PostDummyLoginJSONBodyRoleModerator PostDummyLoginJSONBodyRole = "moderator"
+	PostProductsBatchJSONBodyProductsSizeClassLarge  PostProductsBatchJSONBodyProductsSizeClass = "large"
+	PostProductsBatchJSONBodyProductsSizeClassMedium PostProductsBatchJSONBodyProductsSizeClass = "medium"
+	PostProductsBatchJSONBodyProductsSizeClassSmall  PostProductsBatchJSONBodyProductsSizeClass = "small"
 )
 
-// Defines values for PostProductsJSONBodyType.
+// Defines values for PostPvzPvzIdCellsJSONBodySizeClass.
 const (
-	PostProductsJSONBodyTypeОбувь       PostProductsJSONBodyType = "обувь"
-	PostProductsJSONBodyTypeОдежда      PostProductsJSONBodyType = "одежда"
-	PostProductsJSONBodyTypeЭлектроника PostProductsJSONBodyType = "электроника"
+	Large  PostPvzPvzIdCellsJSONBodySizeClass = "large"
+	Medium PostPvzPvzIdCellsJSONBodySizeClass = "medium"
+	Small  PostPvzPvzIdCellsJSONBodySizeClass = "small"
 )
 
-// Defines values for PostRegisterJSONBodyRole.
+// Defines values for GetPvzPvzIdReceptionsParamsStatus.
 const (
-	Employee  PostRegisterJSONBodyRole = "employee"
-	Moderator PostRegisterJSONBodyRole = "moderator"
+	GetPvzPvzIdReceptionsParamsStatusCancelled  GetPvzPvzIdReceptionsParamsStatus = "cancelled"
+	GetPvzPvzIdReceptionsParamsStatusClose      GetPvzPvzIdReceptionsParamsStatus = "close"
+	GetPvzPvzIdReceptionsParamsStatusInProgress GetPvzPvzIdReceptionsParamsStatus = "in_progress"
 )
 
+// APIKey defines model for APIKey.
+type APIKey struct {
+	CreatedAt time.Time  `json:"created_at"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	Id        uuid.UUID  `json:"id"`
+
+	// Key Значение ключа, показывается только при создании
+	Key         *string    `json:"key,omitempty"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+	Name        string     `json:"name"`
+	Permissions []string   `json:"permissions"`
+
+	// Prefix Начало ключа, по которому его можно узнать
+	Prefix string `json:"prefix"`
+
+	// PvzIds ПВЗ, к которым ограничен доступ ключа. Пустой список - доступ ко всем ПВЗ
+	PvzIds    []uuid.UUID `json:"pvz_ids"`
+	RevokedAt *time.Time  `json:"revoked_at,omitempty"`
+}
+
+// AuditEntry defines model for AuditEntry.
+type AuditEntry struct {
+	Action    AuditEntryAction       `json:"action"`
+	ActorId   *uuid.UUID             `json:"actor_id,omitempty"`
+	ActorRole *string                `json:"actor_role,omitempty"`
+	ApiKeyId  *uuid.UUID             `json:"api_key_id,omitempty"`
+	CreatedAt time.Time              `json:"created_at"`
+	Id        int64                  `json:"id"`
+	Ip        string                 `json:"ip"`
+	Payload   map[string]interface{} `json:"payload"`
+	RequestId string                 `json:"request_id"`
+	UserAgent string                 `json:"user_agent"`
+}
+
+// AuditEntryAction defines model for AuditEntry.Action.
+type AuditEntryAction string
+
+// AuditPage defines model for AuditPage.
+type AuditPage struct {
+	Items []AuditEntry `json:"items"`
+
+	// NextCursor Курсор следующей страницы, отсутствует на последней
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// BatchProductResult defines model for BatchProductResult.
+type BatchProductResult struct {
+	Error    *string  `json:"error,omitempty"`
+	Existing *Product `json:"existing,omitempty"`
+	Product  *Product `json:"product,omitempty"`
+
+	// Status skipped - товар корректен, но пакет отклонен из-за других товаров
+	Status BatchProductResultStatus `json:"status"`
+}
+
+// BatchProductResultStatus skipped - товар корректен, но пакет отклонен из-за других товаров
+type BatchProductResultStatus string
+
+// BatchProducts defines model for BatchProducts.
+type BatchProducts struct {
+	Accepted bool `json:"accepted"`
+
+	// Results Результаты в порядке товаров в запросе
+	Results []BatchProductResult `json:"results"`
+}
+
+// City defines model for City.
+type City struct {
+	Code      string     `json:"code"`
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// Enabled В выключенном городе нельзя создавать новые ПВЗ
+	Enabled bool    `json:"enabled"`
+	Name    string  `json:"name"`
+	NameEn  *string `json:"name_en,omitempty"`
+	Region  *string `json:"region,omitempty"`
+
+	// Timezone Часовой пояс IANA
+	Timezone string `json:"timezone"`
+}
+
+// ClosedReception defines model for ClosedReception.
+type ClosedReception struct {
+	// DamagedCount Количество товаров, принятых с поврежденной упаковкой
+	DamagedCount int64      `json:"damaged_count"`
+	DateTime     time.Time  `json:"dateTime"`
+	Id           *uuid.UUID `json:"id,omitempty"`
+
+	// OpenedCount Количество товаров, принятых со вскрытой упаковкой
+	OpenedCount int64     `json:"opened_count"`
+	PvzId       uuid.UUID `json:"pvzId"`
+
+	// Reconciliation Сверка принятых товаров с ожидаемым составом приемки
+	Reconciliation *Reconciliation       `json:"reconciliation,omitempty"`
+	Status         ClosedReceptionStatus `json:"status"`
+}
+
+// ClosedReceptionStatus defines model for ClosedReception.Status.
+type ClosedReceptionStatus string
+
+// Discrepancy defines model for Discrepancy.
+type Discrepancy struct {
+	Actual int `json:"actual"`
+
+	// Barcode Указан для расхождений по штрихкодам
+	Barcode  *string         `json:"barcode,omitempty"`
+	Expected int             `json:"expected"`
+	Kind     DiscrepancyKind `json:"kind"`
+
+	// Type Указан для расхождений по количеству товаров типа
+	Type *string `json:"type,omitempty"`
+}
+
+// DiscrepancyKind defines model for Discrepancy.Kind.
+type DiscrepancyKind string
+
+// DuplicateProductError defines model for DuplicateProductError.
+type DuplicateProductError struct {
+	Message string  `json:"message"`
+	Product Product `json:"product"`
+}
+
 // Error defines model for Error.
 type Error struct {
 	Message string `json:"message"`
 }
 
+// Invite defines model for Invite.
+type Invite struct {
+	Email     openapi_types.Email `json:"email"`
+	ExpiresAt time.Time           `json:"expires_at"`
+	Id        *uuid.UUID          `json:"id,omitempty"`
+	PvzIds    *[]uuid.UUID        `json:"pvz_ids,omitempty"`
+	Role      string              `json:"role"`
+}
+
+// LoginResult defines model for LoginResult.
+type LoginResult struct {
+	// MfaEnrollRequired Второй фактор обязателен для роли, но еще не подключен
+	MfaEnrollRequired *bool `json:"mfa_enroll_required,omitempty"`
+
+	// MfaToken Токен MFA-проверки, выдается вместо token, если требуется второй фактор
+	MfaToken *string `json:"mfa_token,omitempty"`
+	Token    *Token  `json:"token,omitempty"`
+}
+
 // PVZ defines model for PVZ.
 type PVZ struct {
-	City             PVZCity    `json:"city"`
+	// Capacity Сколько товаров может храниться в ПВЗ, без лимита если не задано
+	Capacity *int `json:"capacity,omitempty"`
+
+	// City Название города из справочника `/cities`, город должен быть включен
+	City             string     `json:"city"`
 	Id               *uuid.UUID `json:"id,omitempty"`
 	RegistrationDate *time.Time `json:"registrationDate,omitempty"`
+
+	// SoftCapacity Порог, после которого прием товаров только предупреждает о заполненности
+	SoftCapacity *int `json:"soft_capacity,omitempty"`
+
+	// Status Состояние ПВЗ. Приемки можно открывать только в `active`.
+	// Из `decommissioned` ПВЗ вернуть нельзя.
+	Status *PVZStatus `json:"status,omitempty"`
+
+	// StockCount Товары на хранении и ожидающие возврата
+	StockCount *int `json:"stock_count,omitempty"`
+}
+
+// PVZDetails defines model for PVZDetails.
+type PVZDetails struct {
+	LastClosedReception *Reception `json:"last_closed_reception,omitempty"`
+
+	// OpenReception Открытая приемка, отсутствует если приемка не ведется
+	OpenReception *struct {
+		Products  []Product `json:"products"`
+		Reception Reception `json:"reception"`
+	} `json:"open_reception,omitempty"`
+	Pvz   PVZ `json:"pvz"`
+	Stats struct {
+		// CapacityWarning Товаров на хранении не меньше `soft_capacity`
+		CapacityWarning bool `json:"capacity_warning"`
+
+		// ProductsExpiring Товары на хранении, срок хранения которых истекает в течение `products.storage.expiring_window`
+		ProductsExpiring int64 `json:"products_expiring"`
+
+		// ProductsToReturn Товары с истекшим сроком хранения, ожидающие возврата
+		ProductsToReturn int64 `json:"products_to_return"`
+		ProductsToday    int64 `json:"products_today"`
+		ReceptionsToday  int64 `json:"receptions_today"`
+
+		// Utilization Доля вместимости, занятая товарами, нет у ПВЗ без лимита
+		Utilization *float64 `json:"utilization,omitempty"`
+	} `json:"stats"`
 }
 
-// PVZCity defines model for PVZ.City.
-type PVZCity string
+// PVZStatus Состояние ПВЗ. Приемки можно открывать только в `active`.
+// Из `decommissioned` ПВЗ вернуть нельзя.
+type PVZStatus string
 
 // Product defines model for Product.
 type Product struct {
-	DateTime    *time.Time  `json:"dateTime,omitempty"`
-	Id          *uuid.UUID  `json:"id,omitempty"`
-	ReceptionId uuid.UUID   `json:"receptionId"`
-	Type        ProductType `json:"type"`
+	Attributes *map[string]string `json:"attributes,omitempty"`
+
+	// Barcode Штрихкод, пустой у товаров, принятых до введения штрихкодов
+	Barcode *string      `json:"barcode,omitempty"`
+	Cell    *StorageCell `json:"cell,omitempty"`
+
+	// CellId Ячейка хранения, пусто, если товар не размещен
+	CellId *uuid.UUID `json:"cell_id,omitempty"`
+
+	// Condition Состояние упаковки при приемке
+	Condition *ProductCondition `json:"condition,omitempty"`
+	DateTime  *time.Time        `json:"dateTime,omitempty"`
+	Id        *uuid.UUID        `json:"id,omitempty"`
+
+	// Notes Комментарий сотрудника, например, описание повреждений
+	Notes       *string           `json:"notes,omitempty"`
+	OrderId     *string           `json:"order_id,omitempty"`
+	ReceptionId uuid.UUID         `json:"receptionId"`
+	SizeClass   *ProductSizeClass `json:"size_class,omitempty"`
+	State       *ProductState     `json:"state,omitempty"`
+
+	// Type Название типа товара из справочника `/product-types`
+	Type string `json:"type"`
+}
+
+// ProductCondition Состояние упаковки при приемке
+type ProductCondition string
+
+// ProductSizeClass defines model for Product.SizeClass.
+type ProductSizeClass string
+
+// ProductState defines model for Product.State.
+type ProductState string
+
+// ProductAttachment defines model for ProductAttachment.
+type ProductAttachment struct {
+	ContentType string    `json:"content_type"`
+	CreatedAt   time.Time `json:"created_at"`
+	Id          uuid.UUID `json:"id"`
+	ProductId   uuid.UUID `json:"product_id"`
+
+	// Size Размер файла в байтах
+	Size       int64      `json:"size"`
+	UploadedBy *uuid.UUID `json:"uploaded_by,omitempty"`
+}
+
+// ProductAttribute defines model for ProductAttribute.
+type ProductAttribute struct {
+	Name string `json:"name"`
+
+	// Pattern Регулярное выражение, которому должно соответствовать значение
+	Pattern  *string `json:"pattern,omitempty"`
+	Required *bool   `json:"required,omitempty"`
+}
+
+// ProductEvent defines model for ProductEvent.
+type ProductEvent struct {
+	// ActorId Отсутствует у событий, созданных системой
+	ActorId   *uuid.UUID              `json:"actor_id,omitempty"`
+	CreatedAt time.Time               `json:"created_at"`
+	Details   *map[string]interface{} `json:"details,omitempty"`
+	Id        int64                   `json:"id"`
+	ProductId uuid.UUID               `json:"product_id"`
+	PvzId     uuid.UUID               `json:"pvz_id"`
+	Type      ProductEventType        `json:"type"`
 }
 
-// ProductType defines model for Product.Type.
-type ProductType string
+// ProductEventType defines model for ProductEvent.Type.
+type ProductEventType string
+
+// ProductType defines model for ProductType.
+type ProductType struct {
+	// Attributes Схема атрибутов товара этого типа
+	Attributes []ProductAttribute `json:"attributes"`
+	Code       string             `json:"code"`
+	CreatedAt  *time.Time         `json:"created_at,omitempty"`
+	Fragile    bool               `json:"fragile"`
+	HighValue  bool               `json:"high_value"`
+	Name       string             `json:"name"`
+	NameEn     *string            `json:"name_en,omitempty"`
+}
 
 // Reception defines model for Reception.
 type Reception struct {
@@ -110,26 +480,201 @@ type Reception struct {
 // ReceptionStatus defines model for Reception.Status.
 type ReceptionStatus string
 
+// ReceptionManifest Ожидаемый состав приемки от поставщика
+type ReceptionManifest struct {
+	// Barcodes Штрихкоды товаров, которые должны поступить
+	Barcodes *[]string `json:"barcodes,omitempty"`
+
+	// TypeCounts Ожидаемое количество товаров по типам
+	TypeCounts *map[string]int `json:"type_counts,omitempty"`
+}
+
+// ReceptionPage defines model for ReceptionPage.
+type ReceptionPage struct {
+	Items []ReceptionSummary `json:"items"`
+
+	// NextCursor Курсор следующей страницы, отсутствует на последней
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// ReceptionStatusChange defines model for ReceptionStatusChange.
+type ReceptionStatusChange struct {
+	Reason string `json:"reason"`
+}
+
+// ReceptionSummary defines model for ReceptionSummary.
+type ReceptionSummary struct {
+	// DamagedCount Количество товаров, принятых с поврежденной упаковкой
+	DamagedCount int64     `json:"damaged_count"`
+	DateTime     time.Time `json:"date_time"`
+	Id           uuid.UUID `json:"id"`
+
+	// OpenedCount Количество товаров, принятых со вскрытой упаковкой
+	OpenedCount int64 `json:"opened_count"`
+
+	// ProductCounts Количество товаров по типам
+	ProductCounts map[string]int64       `json:"product_counts"`
+	PvzId         uuid.UUID              `json:"pvz_id"`
+	Status        ReceptionSummaryStatus `json:"status"`
+}
+
+// ReceptionSummaryStatus defines model for ReceptionSummary.Status.
+type ReceptionSummaryStatus string
+
+// ReceptionWithProducts defines model for ReceptionWithProducts.
+type ReceptionWithProducts struct {
+	// Products Товары от последнего добавленного к первому
+	Products  []Product `json:"products"`
+	Reception Reception `json:"reception"`
+
+	// Reconciliation Сверка принятых товаров с ожидаемым составом приемки
+	Reconciliation *Reconciliation `json:"reconciliation,omitempty"`
+}
+
+// Reconciliation Сверка принятых товаров с ожидаемым составом приемки
+type Reconciliation struct {
+	Discrepancies []Discrepancy `json:"discrepancies"`
+
+	// Overridden Приемка закрыта модератором несмотря на расхождения
+	Overridden bool `json:"overridden"`
+}
+
+// ReconciliationConflict defines model for ReconciliationConflict.
+type ReconciliationConflict struct {
+	Message string `json:"message"`
+
+	// Reconciliation Сверка принятых товаров с ожидаемым составом приемки
+	Reconciliation Reconciliation `json:"reconciliation"`
+}
+
+// ReturnShipment defines model for ReturnShipment.
+type ReturnShipment struct {
+	CreatedAt time.Time  `json:"created_at"`
+	CreatedBy *uuid.UUID `json:"created_by,omitempty"`
+	Id        uuid.UUID  `json:"id"`
+	Products  []Product  `json:"products"`
+	PvzId     uuid.UUID  `json:"pvz_id"`
+}
+
+// StorageCell defines model for StorageCell.
+type StorageCell struct {
+	Capacity  int       `json:"capacity"`
+	CreatedAt time.Time `json:"created_at"`
+	Id        uuid.UUID `json:"id"`
+
+	// Label Адрес ячейки зона-стеллаж-полка
+	Label string `json:"label"`
+
+	// Occupied Количество товаров на хранении в ячейке
+	Occupied int       `json:"occupied"`
+	PvzId    uuid.UUID `json:"pvz_id"`
+	Rack     int       `json:"rack"`
+	Shelf    int       `json:"shelf"`
+
+	// SizeClass Товар помещается в ячейку того же или большего размера
+	SizeClass StorageCellSizeClass `json:"size_class"`
+	Zone      string               `json:"zone"`
+}
+
+// StorageCellSizeClass Товар помещается в ячейку того же или большего размера
+type StorageCellSizeClass string
+
 // Token defines model for Token.
 type Token = string
 
+// Transfer defines model for Transfer.
+type Transfer struct {
+	CreatedAt    time.Time  `json:"created_at"`
+	CreatedBy    *uuid.UUID `json:"created_by,omitempty"`
+	DispatchedAt *time.Time `json:"dispatched_at,omitempty"`
+	FromPvzId    uuid.UUID  `json:"from_pvz_id"`
+	Id           uuid.UUID  `json:"id"`
+	Products     []Product  `json:"products"`
+	ReceivedAt   *time.Time `json:"received_at,omitempty"`
+
+	// ReceptionId Приемка ПВЗ назначения, в которую приняты товары
+	ReceptionId *uuid.UUID     `json:"reception_id,omitempty"`
+	Status      TransferStatus `json:"status"`
+	ToPvzId     uuid.UUID      `json:"to_pvz_id"`
+}
+
+// TransferStatus defines model for Transfer.Status.
+type TransferStatus string
+
 // User defines model for User.
 type User struct {
-	Email openapi_types.Email `json:"email"`
-	Id    *uuid.UUID          `json:"id,omitempty"`
-	Role  UserRole            `json:"role"`
+	Active     *bool               `json:"active,omitempty"`
+	CreatedAt  *time.Time          `json:"created_at,omitempty"`
+	Email      openapi_types.Email `json:"email"`
+	Id         *uuid.UUID          `json:"id,omitempty"`
+	MfaEnabled *bool               `json:"mfa_enabled,omitempty"`
+
+	// Role Роль из справочника ролей (например, employee или moderator)
+	Role string `json:"role"`
+}
+
+// PostApiKeysJSONBody defines parameters for PostApiKeys.
+type PostApiKeysJSONBody struct {
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	Name        string     `json:"name"`
+	Permissions []string   `json:"permissions"`
+
+	// PvzIds ПВЗ, к которым будет ограничен доступ ключа
+	PvzIds *[]uuid.UUID `json:"pvz_ids,omitempty"`
+}
+
+// GetAuditParams defines parameters for GetAudit.
+type GetAuditParams struct {
+	// Action Тип события, например login.failure
+	Action  *string    `form:"action,omitempty" json:"action,omitempty"`
+	ActorId *uuid.UUID `form:"actor_id,omitempty" json:"actor_id,omitempty"`
+
+	// From Начало диапазона, включительно
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец диапазона, не включительно
+	To     *time.Time `form:"to,omitempty" json:"to,omitempty"`
+	Cursor *string    `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Количество записей на странице
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetCitiesParams defines parameters for GetCities.
+type GetCitiesParams struct {
+	// Enabled Фильтр по признаку включенности
+	Enabled *bool `form:"enabled,omitempty" json:"enabled,omitempty"`
 }
 
-// UserRole defines model for User.Role.
-type UserRole string
+// PostCitiesJSONBody defines parameters for PostCities.
+type PostCitiesJSONBody struct {
+	Code     string  `json:"code"`
+	Enabled  *bool   `json:"enabled,omitempty"`
+	Name     string  `json:"name"`
+	NameEn   *string `json:"name_en,omitempty"`
+	Region   *string `json:"region,omitempty"`
+	Timezone *string `json:"timezone,omitempty"`
+}
+
+// PatchCitiesCodeJSONBody defines parameters for PatchCitiesCode.
+type PatchCitiesCodeJSONBody struct {
+	Enabled bool `json:"enabled"`
+}
 
 // PostDummyLoginJSONBody defines parameters for PostDummyLogin.
 type PostDummyLoginJSONBody struct {
-	Role PostDummyLoginJSONBodyRole `json:"role"`
+	// Role Роль из справочника ролей (например, employee или moderator)
+	Role string `json:"role"`
 }
 
-// PostDummyLoginJSONBodyRole defines parameters for PostDummyLogin.
-type PostDummyLoginJSONBodyRole string
+// PostInvitesJSONBody defines parameters for PostInvites.
+type PostInvitesJSONBody struct {
+	Email openapi_types.Email `json:"email"`
+
+	// PvzIds ПВЗ, к которым будет привязан сотрудник
+	PvzIds *[]uuid.UUID `json:"pvz_ids,omitempty"`
+	Role   string       `json:"role"`
+}
 
 // PostLoginJSONBody defines parameters for PostLogin.
 type PostLoginJSONBody struct {
@@ -137,14 +682,129 @@ type PostLoginJSONBody struct {
 	Password string              `json:"password"`
 }
 
+// PostLoginMfaJSONBody defines parameters for PostLoginMfa.
+type PostLoginMfaJSONBody struct {
+	Code     string `json:"code"`
+	MfaToken string `json:"mfa_token"`
+}
+
+// PostMfaVerifyJSONBody defines parameters for PostMfaVerify.
+type PostMfaVerifyJSONBody struct {
+	Code string `json:"code"`
+}
+
+// PostPasswordForgotJSONBody defines parameters for PostPasswordForgot.
+type PostPasswordForgotJSONBody struct {
+	Email openapi_types.Email `json:"email"`
+}
+
+// PostPasswordResetJSONBody defines parameters for PostPasswordReset.
+type PostPasswordResetJSONBody struct {
+	// Code Одноразовый код из письма
+	Code     string `json:"code"`
+	Password string `json:"password"`
+}
+
+// PostProductTypesJSONBody defines parameters for PostProductTypes.
+type PostProductTypesJSONBody struct {
+	Attributes *[]ProductAttribute `json:"attributes,omitempty"`
+	Code       string              `json:"code"`
+	Fragile    *bool               `json:"fragile,omitempty"`
+	HighValue  *bool               `json:"high_value,omitempty"`
+	Name       string              `json:"name"`
+	NameEn     *string             `json:"name_en,omitempty"`
+}
+
+// PatchProductTypesCodeJSONBody defines parameters for PatchProductTypesCode.
+type PatchProductTypesCodeJSONBody struct {
+	Attributes *[]ProductAttribute `json:"attributes,omitempty"`
+	Fragile    *bool               `json:"fragile,omitempty"`
+	HighValue  *bool               `json:"high_value,omitempty"`
+	NameEn     *string             `json:"name_en,omitempty"`
+}
+
+// GetProductsParams defines parameters for GetProducts.
+type GetProductsParams struct {
+	Barcode string `form:"barcode" json:"barcode"`
+}
+
 // PostProductsJSONBody defines parameters for PostProducts.
 type PostProductsJSONBody struct {
-	PvzId uuid.UUID                `json:"pvzId"`
-	Type  PostProductsJSONBodyType `json:"type"`
+	// Attributes Атрибуты товара, проверяются по схеме его типа
+	Attributes *map[string]string `json:"attributes,omitempty"`
+
+	// Barcode Необязательный штрихкод, повторно в одну приемку его принять нельзя. Проверка повторного сканирования выполняется, только если штрихкод передан.
+	Barcode *string `json:"barcode,omitempty"`
+
+	// Condition Состояние упаковки, фото повреждений загружаются в `/products/{productId}/attachments`
+	Condition *PostProductsJSONBodyCondition `json:"condition,omitempty"`
+	Notes     *string                        `json:"notes,omitempty"`
+
+	// OrderId Номер заказа, если товар принят по заказу
+	OrderId *string `json:"order_id,omitempty"`
+
+	// PickupCode Код получения, без него товар нельзя выдать. Можно задать позже через PUT /products/{productId}/pickup-code.
+	PickupCode *string   `json:"pickup_code,omitempty"`
+	PvzId      uuid.UUID `json:"pvzId"`
+
+	// SizeClass Размер посылки, по нему подбирается ячейка хранения
+	SizeClass *PostProductsJSONBodySizeClass `json:"size_class,omitempty"`
+
+	// Type Название типа товара из справочника `/product-types`
+	Type string `json:"type"`
+}
+
+// PostProductsJSONBodyCondition defines parameters for PostProducts.
+type PostProductsJSONBodyCondition string
+
+// PostProductsJSONBodySizeClass defines parameters for PostProducts.
+type PostProductsJSONBodySizeClass string
+
+// PostProductsBatchJSONBody defines parameters for PostProductsBatch.
+type PostProductsBatchJSONBody struct {
+	// Products Не больше `products.batch_limit` товаров
+	Products []struct {
+		Attributes *map[string]string `json:"attributes,omitempty"`
+
+		// Barcode Необязательный, повторы проверяются, только если передан
+		Barcode    *string                                     `json:"barcode,omitempty"`
+		Condition  *PostProductsBatchJSONBodyProductsCondition `json:"condition,omitempty"`
+		Notes      *string                                     `json:"notes,omitempty"`
+		OrderId    *string                                     `json:"order_id,omitempty"`
+		PickupCode *string                                     `json:"pickup_code,omitempty"`
+
+		// SizeClass Размер посылки, по нему подбирается ячейка хранения
+		SizeClass *PostProductsBatchJSONBodyProductsSizeClass `json:"size_class,omitempty"`
+		Type      string                                      `json:"type"`
+	} `json:"products"`
+	PvzId uuid.UUID `json:"pvz_id"`
+}
+
+// PostProductsBatchJSONBodyProductsCondition defines parameters for PostProductsBatch.
+type PostProductsBatchJSONBodyProductsCondition string
+
+// PostProductsBatchJSONBodyProductsSizeClass defines parameters for PostProductsBatch.
+type PostProductsBatchJSONBodyProductsSizeClass string
+
+// PostProductsProductIdAttachmentsMultipartBody defines parameters for PostProductsProductIdAttachments.
+type PostProductsProductIdAttachmentsMultipartBody struct {
+	File openapi_types.File `json:"file"`
+}
+
+// PostProductsProductIdIssueJSONBody defines parameters for PostProductsProductIdIssue.
+type PostProductsProductIdIssueJSONBody struct {
+	PickupCode string `json:"pickup_code"`
+}
+
+// PostProductsProductIdMoveJSONBody defines parameters for PostProductsProductIdMove.
+type PostProductsProductIdMoveJSONBody struct {
+	CellId uuid.UUID `json:"cell_id"`
 }
 
-// PostProductsJSONBodyType defines parameters for PostProducts.
-type PostProductsJSONBodyType string
+// PutProductsProductIdPickupCodeJSONBody defines parameters for PutProductsProductIdPickupCode.
+type PutProductsProductIdPickupCodeJSONBody struct {
+	PickupCode string `json:"pickup_code"`
+}
 
 // GetPvzParams defines parameters for GetPvz.
 type GetPvzParams struct {
@@ -159,70 +819,381 @@ type GetPvzParams struct {
 
 	// Limit Количество элементов на странице
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Status Состояние ПВЗ
+	Status *PVZStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
+// PatchPvzPvzIdJSONBody defines parameters for PatchPvzPvzId.
+type PatchPvzPvzIdJSONBody struct {
+	Reason *string `json:"reason,omitempty"`
+
+	// Status Состояние ПВЗ. Приемки можно открывать только в `active`.
+	// Из `decommissioned` ПВЗ вернуть нельзя.
+	Status PVZStatus `json:"status"`
+}
+
+// PutPvzPvzIdCapacityJSONBody defines parameters for PutPvzPvzIdCapacity.
+type PutPvzPvzIdCapacityJSONBody struct {
+	Capacity     *int `json:"capacity,omitempty"`
+	SoftCapacity *int `json:"soft_capacity,omitempty"`
+}
+
+// PostPvzPvzIdCellsJSONBody defines parameters for PostPvzPvzIdCells.
+type PostPvzPvzIdCellsJSONBody struct {
+	// Capacity Сколько посылок помещается в ячейку
+	Capacity  int                                `json:"capacity"`
+	Rack      int                                `json:"rack"`
+	Shelf     int                                `json:"shelf"`
+	SizeClass PostPvzPvzIdCellsJSONBodySizeClass `json:"size_class"`
+	Zone      string                             `json:"zone"`
+}
+
+// PostPvzPvzIdCellsJSONBodySizeClass defines parameters for PostPvzPvzIdCells.
+type PostPvzPvzIdCellsJSONBodySizeClass string
+
+// PostPvzPvzIdCloseLastReceptionParams defines parameters for PostPvzPvzIdCloseLastReception.
+type PostPvzPvzIdCloseLastReceptionParams struct {
+	// Override Закрыть приемку несмотря на расхождения (только для модераторов)
+	Override *bool `form:"override,omitempty" json:"override,omitempty"`
+}
+
+// GetPvzPvzIdReceptionsParams defines parameters for GetPvzPvzIdReceptions.
+type GetPvzPvzIdReceptionsParams struct {
+	Status *GetPvzPvzIdReceptionsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// From Начало диапазона, включительно
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец диапазона, не включительно
+	To     *time.Time `form:"to,omitempty" json:"to,omitempty"`
+	Cursor *string    `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Limit Количество приемок на странице
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetPvzPvzIdReceptionsParamsStatus defines parameters for GetPvzPvzIdReceptions.
+type GetPvzPvzIdReceptionsParamsStatus string
+
+// GetPvzPvzIdStockParams defines parameters for GetPvzPvzIdStock.
+type GetPvzPvzIdStockParams struct {
+	// Page Номер страницы
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Количество элементов на странице
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
 // PostReceptionsJSONBody defines parameters for PostReceptions.
 type PostReceptionsJSONBody struct {
-	PvzId uuid.UUID `json:"pvzId"`
+	// Manifest Ожидаемый состав приемки от поставщика
+	Manifest *ReceptionManifest `json:"manifest,omitempty"`
+	PvzId    uuid.UUID          `json:"pvzId"`
 }
 
 // PostRegisterJSONBody defines parameters for PostRegister.
 type PostRegisterJSONBody struct {
-	Email    openapi_types.Email      `json:"email"`
-	Password string                   `json:"password"`
-	Role     PostRegisterJSONBodyRole `json:"role"`
+	Email    openapi_types.Email `json:"email"`
+	Password string              `json:"password"`
+
+	// Role Без приглашения можно зарегистрироваться только с ролью employee, для остальных ролей используйте /register/accept-invite
+	Role string `json:"role"`
+}
+
+// PostRegisterAcceptInviteJSONBody defines parameters for PostRegisterAcceptInvite.
+type PostRegisterAcceptInviteJSONBody struct {
+	Password string `json:"password"`
+
+	// Token Код приглашения из письма
+	Token string `json:"token"`
+}
+
+// PostTransfersJSONBody defines parameters for PostTransfers.
+type PostTransfersJSONBody struct {
+	FromPvzId  uuid.UUID   `json:"from_pvz_id"`
+	ProductIds []uuid.UUID `json:"product_ids"`
+	ToPvzId    uuid.UUID   `json:"to_pvz_id"`
+}
+
+// GetUsersParams defines parameters for GetUsers.
+type GetUsersParams struct {
+	Role   *string `form:"role,omitempty" json:"role,omitempty"`
+	Active *bool   `form:"active,omitempty" json:"active,omitempty"`
+
+	// Email Подстрока email
+	Email *string `form:"email,omitempty" json:"email,omitempty"`
+
+	// Page Номер страницы
+	Page *int `form:"page,omitempty" json:"page,omitempty"`
+
+	// Limit Количество элементов на странице
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PatchUsersUserIdJSONBody defines parameters for PatchUsersUserId.
+type PatchUsersUserIdJSONBody struct {
+	Active *bool `json:"active,omitempty"`
+
+	// PvzIds ПВЗ, к которым назначен пользователь, заменяют текущие. Сотрудник без ПВЗ не имеет доступа ни к одному ПВЗ
+	PvzIds *[]uuid.UUID `json:"pvz_ids,omitempty"`
+	Role   *string      `json:"role,omitempty"`
 }
 
-// PostRegisterJSONBodyRole defines parameters for PostRegister.
-type PostRegisterJSONBodyRole string
+// PostApiKeysJSONRequestBody defines body for PostApiKeys for application/json ContentType.
+type PostApiKeysJSONRequestBody PostApiKeysJSONBody
+
+// PostCitiesJSONRequestBody defines body for PostCities for application/json ContentType.
+type PostCitiesJSONRequestBody PostCitiesJSONBody
+
+// PatchCitiesCodeJSONRequestBody defines body for PatchCitiesCode for application/json ContentType.
+type PatchCitiesCodeJSONRequestBody PatchCitiesCodeJSONBody
 
 // PostDummyLoginJSONRequestBody defines body for PostDummyLogin for application/json ContentType.
 type PostDummyLoginJSONRequestBody PostDummyLoginJSONBody
 
+// PostInvitesJSONRequestBody defines body for PostInvites for application/json ContentType.
+type PostInvitesJSONRequestBody PostInvitesJSONBody
+
 // PostLoginJSONRequestBody defines body for PostLogin for application/json ContentType.
 type PostLoginJSONRequestBody PostLoginJSONBody
 
+// PostLoginMfaJSONRequestBody defines body for PostLoginMfa for application/json ContentType.
+type PostLoginMfaJSONRequestBody PostLoginMfaJSONBody
+
+// PostMfaVerifyJSONRequestBody defines body for PostMfaVerify for application/json ContentType.
+type PostMfaVerifyJSONRequestBody PostMfaVerifyJSONBody
+
+// PostPasswordForgotJSONRequestBody defines body for PostPasswordForgot for application/json ContentType.
+type PostPasswordForgotJSONRequestBody PostPasswordForgotJSONBody
+
+// PostPasswordResetJSONRequestBody defines body for PostPasswordReset for application/json ContentType.
+type PostPasswordResetJSONRequestBody PostPasswordResetJSONBody
+
+// PostProductTypesJSONRequestBody defines body for PostProductTypes for application/json ContentType.
+type PostProductTypesJSONRequestBody PostProductTypesJSONBody
+
+// PatchProductTypesCodeJSONRequestBody defines body for PatchProductTypesCode for application/json ContentType.
+type PatchProductTypesCodeJSONRequestBody PatchProductTypesCodeJSONBody
+
 // PostProductsJSONRequestBody defines body for PostProducts for application/json ContentType.
 type PostProductsJSONRequestBody PostProductsJSONBody
 
+// PostProductsBatchJSONRequestBody defines body for PostProductsBatch for application/json ContentType.
+type PostProductsBatchJSONRequestBody PostProductsBatchJSONBody
+
+// PostProductsProductIdAttachmentsMultipartRequestBody defines body for PostProductsProductIdAttachments for multipart/form-data ContentType.
+type PostProductsProductIdAttachmentsMultipartRequestBody PostProductsProductIdAttachmentsMultipartBody
+
+// PostProductsProductIdIssueJSONRequestBody defines body for PostProductsProductIdIssue for application/json ContentType.
+type PostProductsProductIdIssueJSONRequestBody PostProductsProductIdIssueJSONBody
+
+// PostProductsProductIdMoveJSONRequestBody defines body for PostProductsProductIdMove for application/json ContentType.
+type PostProductsProductIdMoveJSONRequestBody PostProductsProductIdMoveJSONBody
+
+// PutProductsProductIdPickupCodeJSONRequestBody defines body for PutProductsProductIdPickupCode for application/json ContentType.
+type PutProductsProductIdPickupCodeJSONRequestBody PutProductsProductIdPickupCodeJSONBody
+
 // PostPvzJSONRequestBody defines body for PostPvz for application/json ContentType.
 type PostPvzJSONRequestBody = PVZ
 
+// PatchPvzPvzIdJSONRequestBody defines body for PatchPvzPvzId for application/json ContentType.
+type PatchPvzPvzIdJSONRequestBody PatchPvzPvzIdJSONBody
+
+// PutPvzPvzIdCapacityJSONRequestBody defines body for PutPvzPvzIdCapacity for application/json ContentType.
+type PutPvzPvzIdCapacityJSONRequestBody PutPvzPvzIdCapacityJSONBody
+
+// PostPvzPvzIdCellsJSONRequestBody defines body for PostPvzPvzIdCells for application/json ContentType.
+type PostPvzPvzIdCellsJSONRequestBody PostPvzPvzIdCellsJSONBody
+
 // PostReceptionsJSONRequestBody defines body for PostReceptions for application/json ContentType.
 type PostReceptionsJSONRequestBody PostReceptionsJSONBody
 
+// PostReceptionsReceptionIdCancelJSONRequestBody defines body for PostReceptionsReceptionIdCancel for application/json ContentType.
+type PostReceptionsReceptionIdCancelJSONRequestBody = ReceptionStatusChange
+
+// PostReceptionsReceptionIdReopenJSONRequestBody defines body for PostReceptionsReceptionIdReopen for application/json ContentType.
+type PostReceptionsReceptionIdReopenJSONRequestBody = ReceptionStatusChange
+
 // PostRegisterJSONRequestBody defines body for PostRegister for application/json ContentType.
 type PostRegisterJSONRequestBody PostRegisterJSONBody
 
+// PostRegisterAcceptInviteJSONRequestBody defines body for PostRegisterAcceptInvite for application/json ContentType.
+type PostRegisterAcceptInviteJSONRequestBody PostRegisterAcceptInviteJSONBody
+
+// PostTransfersJSONRequestBody defines body for PostTransfers for application/json ContentType.
+type PostTransfersJSONRequestBody PostTransfersJSONBody
+
+// PatchUsersUserIdJSONRequestBody defines body for PatchUsersUserId for application/json ContentType.
+type PatchUsersUserIdJSONRequestBody PatchUsersUserIdJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Список API-ключей (только для модераторов)
+	// (GET /api-keys)
+	GetApiKeys(c *gin.Context)
+	// Создание API-ключа (только для модераторов)
+	// (POST /api-keys)
+	PostApiKeys(c *gin.Context)
+	// Отзыв API-ключа (только для модераторов)
+	// (DELETE /api-keys/{keyId})
+	DeleteApiKeysKeyId(c *gin.Context, keyId uuid.UUID)
+	// Журнал аудита с фильтрацией и курсорной пагинацией (только для модераторов)
+	// (GET /audit)
+	GetAudit(c *gin.Context, params GetAuditParams)
+	// Справочник городов
+	// (GET /cities)
+	GetCities(c *gin.Context, params GetCitiesParams)
+	// Добавление города (только для модераторов)
+	// (POST /cities)
+	PostCities(c *gin.Context)
+	// Включение или выключение города (только для модераторов)
+	// (PATCH /cities/{code})
+	PatchCitiesCode(c *gin.Context, code string)
 	// Получение тестового токена
 	// (POST /dummyLogin)
 	PostDummyLogin(c *gin.Context)
+	// Создание одноразового приглашения (только для модераторов)
+	// (POST /invites)
+	PostInvites(c *gin.Context)
 	// Авторизация пользователя
 	// (POST /login)
 	PostLogin(c *gin.Context)
+	// Завершение авторизации кодом TOTP или кодом восстановления
+	// (POST /login/mfa)
+	PostLoginMfa(c *gin.Context)
+	// Подключение TOTP, выдает секрет для приложения-аутентификатора
+	// (POST /mfa/enroll)
+	PostMfaEnroll(c *gin.Context)
+	// Подтверждение TOTP и включение второго фактора
+	// (POST /mfa/verify)
+	PostMfaVerify(c *gin.Context)
+	// Запрос кода для сброса пароля
+	// (POST /password/forgot)
+	PostPasswordForgot(c *gin.Context)
+	// Установка нового пароля по коду
+	// (POST /password/reset)
+	PostPasswordReset(c *gin.Context)
+	// Справочник типов товаров
+	// (GET /product-types)
+	GetProductTypes(c *gin.Context)
+	// Добавление типа товара (только для модераторов)
+	// (POST /product-types)
+	PostProductTypes(c *gin.Context)
+	// Удаление типа товара (только для модераторов)
+	// (DELETE /product-types/{code})
+	DeleteProductTypesCode(c *gin.Context, code string)
+	// Изменение типа товара (только для модераторов)
+	// (PATCH /product-types/{code})
+	PatchProductTypesCode(c *gin.Context, code string)
+	// Поиск товаров по штрихкоду
+	// (GET /products)
+	GetProducts(c *gin.Context, params GetProductsParams)
 	// Добавление товара в текущую приемку (только для сотрудников ПВЗ)
 	// (POST /products)
 	PostProducts(c *gin.Context)
+	// Добавление пакета товаров в текущую приемку (только для сотрудников ПВЗ)
+	// (POST /products/batch)
+	PostProductsBatch(c *gin.Context)
+	// Вложения товара
+	// (GET /products/{productId}/attachments)
+	GetProductsProductIdAttachments(c *gin.Context, productId uuid.UUID)
+	// Загрузка фото товара, например, повреждений упаковки (только для сотрудников ПВЗ)
+	// (POST /products/{productId}/attachments)
+	PostProductsProductIdAttachments(c *gin.Context, productId uuid.UUID)
+	// Скачивание вложения товара
+	// (GET /products/{productId}/attachments/{attachmentId})
+	GetProductsProductIdAttachmentsAttachmentId(c *gin.Context, productId uuid.UUID, attachmentId uuid.UUID)
+	// История движения товара
+	// (GET /products/{productId}/events)
+	GetProductsProductIdEvents(c *gin.Context, productId uuid.UUID)
+	// Выдача товара получателю по коду получения
+	// (POST /products/{productId}/issue)
+	PostProductsProductIdIssue(c *gin.Context, productId uuid.UUID)
+	// Перемещение товара в другую ячейку хранения (только для сотрудников ПВЗ)
+	// (POST /products/{productId}/move)
+	PostProductsProductIdMove(c *gin.Context, productId uuid.UUID)
+	// Задание кода получения товару, принятому без него
+	// (PUT /products/{productId}/pickup-code)
+	PutProductsProductIdPickupCode(c *gin.Context, productId uuid.UUID)
 	// Получение списка ПВЗ с фильтрацией по дате приемки и пагинацией
 	// (GET /pvz)
 	GetPvz(c *gin.Context, params GetPvzParams)
 	// Создание ПВЗ (только для модераторов)
 	// (POST /pvz)
 	PostPvz(c *gin.Context)
+	// Получение ПВЗ с текущим состоянием
+	// (GET /pvz/{pvzId})
+	GetPvzPvzId(c *gin.Context, pvzId uuid.UUID)
+	// Изменение состояния ПВЗ (только для модераторов)
+	// (PATCH /pvz/{pvzId})
+	PatchPvzPvzId(c *gin.Context, pvzId uuid.UUID)
+	// Изменение вместимости ПВЗ (только для модераторов)
+	// (PUT /pvz/{pvzId}/capacity)
+	PutPvzPvzIdCapacity(c *gin.Context, pvzId uuid.UUID)
+	// Ячейки хранения ПВЗ и их заполненность
+	// (GET /pvz/{pvzId}/cells)
+	GetPvzPvzIdCells(c *gin.Context, pvzId uuid.UUID)
+	// Добавление ячейки хранения (только для модераторов)
+	// (POST /pvz/{pvzId}/cells)
+	PostPvzPvzIdCells(c *gin.Context, pvzId uuid.UUID)
 	// Закрытие последней открытой приемки товаров в рамках ПВЗ
 	// (POST /pvz/{pvzId}/close_last_reception)
-	PostPvzPvzIdCloseLastReception(c *gin.Context, pvzId uuid.UUID)
+	PostPvzPvzIdCloseLastReception(c *gin.Context, pvzId uuid.UUID, params PostPvzPvzIdCloseLastReceptionParams)
 	// Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)
 	// (POST /pvz/{pvzId}/delete_last_product)
 	PostPvzPvzIdDeleteLastProduct(c *gin.Context, pvzId uuid.UUID)
+	// История приемок ПВЗ с фильтрацией и курсорной пагинацией
+	// (GET /pvz/{pvzId}/receptions)
+	GetPvzPvzIdReceptions(c *gin.Context, pvzId uuid.UUID, params GetPvzPvzIdReceptionsParams)
+	// Отправка возврата отправителю
+	// (POST /pvz/{pvzId}/return-shipments)
+	PostPvzPvzIdReturnShipments(c *gin.Context, pvzId uuid.UUID)
+	// Товары на хранении в ПВЗ
+	// (GET /pvz/{pvzId}/stock)
+	GetPvzPvzIdStock(c *gin.Context, pvzId uuid.UUID, params GetPvzPvzIdStockParams)
 	// Создание новой приемки товаров (только для сотрудников ПВЗ)
 	// (POST /receptions)
 	PostReceptions(c *gin.Context)
+	// Получение приемки с товарами
+	// (GET /receptions/{receptionId})
+	GetReceptionsReceptionId(c *gin.Context, receptionId uuid.UUID)
+	// Отмена приемки (только для модераторов)
+	// (POST /receptions/{receptionId}/cancel)
+	PostReceptionsReceptionIdCancel(c *gin.Context, receptionId uuid.UUID)
+	// Повторное открытие закрытой приемки (только для модераторов)
+	// (POST /receptions/{receptionId}/reopen)
+	PostReceptionsReceptionIdReopen(c *gin.Context, receptionId uuid.UUID)
 	// Регистрация пользователя
 	// (POST /register)
 	PostRegister(c *gin.Context)
+	// Регистрация по приглашению
+	// (POST /register/accept-invite)
+	PostRegisterAcceptInvite(c *gin.Context)
+	// Создание перемещения товаров в другой ПВЗ (только для сотрудников ПВЗ)
+	// (POST /transfers)
+	PostTransfers(c *gin.Context)
+	// Получение перемещения
+	// (GET /transfers/{transferId})
+	GetTransfersTransferId(c *gin.Context, transferId uuid.UUID)
+	// Отправка перемещения (только для сотрудников ПВЗ)
+	// (POST /transfers/{transferId}/dispatch)
+	PostTransfersTransferIdDispatch(c *gin.Context, transferId uuid.UUID)
+	// Прием перемещения в ПВЗ назначения (только для сотрудников ПВЗ)
+	// (POST /transfers/{transferId}/receive)
+	PostTransfersTransferIdReceive(c *gin.Context, transferId uuid.UUID)
+	// Получение списка пользователей с фильтрацией и пагинацией (только для модераторов)
+	// (GET /users)
+	GetUsers(c *gin.Context, params GetUsersParams)
+	// Получение пользователя (только для модераторов)
+	// (GET /users/{userId})
+	GetUsersUserId(c *gin.Context, userId uuid.UUID)
+	// Изменение роли, активности или ПВЗ пользователя (только для модераторов)
+	// (PATCH /users/{userId})
+	PatchUsersUserId(c *gin.Context, userId uuid.UUID)
+	// Сброс пароля пользователя на временный (только для модераторов)
+	// (POST /users/{userId}/reset-password)
+	PostUsersUserIdResetPassword(c *gin.Context, userId uuid.UUID)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -234,8 +1205,10 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
-// PostDummyLogin operation middleware
-func (siw *ServerInterfaceWrapper) PostDummyLogin(c *gin.Context) {
+// GetApiKeys operation middleware
+func (siw *ServerInterfaceWrapper) GetApiKeys(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -244,11 +1217,13 @@ func (siw *ServerInterfaceWrapper) PostDummyLogin(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostDummyLogin(c)
+	siw.Handler.GetApiKeys(c)
 }
 
-// PostLogin operation middleware
-func (siw *ServerInterfaceWrapper) PostLogin(c *gin.Context) {
+// PostApiKeys operation middleware
+func (siw *ServerInterfaceWrapper) PostApiKeys(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -257,11 +1232,22 @@ func (siw *ServerInterfaceWrapper) PostLogin(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostLogin(c)
+	siw.Handler.PostApiKeys(c)
 }
 
-// PostProducts operation middleware
-func (siw *ServerInterfaceWrapper) PostProducts(c *gin.Context) {
+// DeleteApiKeysKeyId operation middleware
+func (siw *ServerInterfaceWrapper) DeleteApiKeysKeyId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "keyId" -------------
+	var keyId uuid.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "keyId", c.Param("keyId"), &keyId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter keyId: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -272,40 +1258,56 @@ func (siw *ServerInterfaceWrapper) PostProducts(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostProducts(c)
+	siw.Handler.DeleteApiKeysKeyId(c, keyId)
 }
 
-// GetPvz operation middleware
-func (siw *ServerInterfaceWrapper) GetPvz(c *gin.Context) {
+// GetAudit operation middleware
+func (siw *ServerInterfaceWrapper) GetAudit(c *gin.Context) {
 
 	var err error
 
 	c.Set(BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetPvzParams
+	var params GetAuditParams
 
-	// ------------- Optional query parameter "startDate" -------------
+	// ------------- Optional query parameter "action" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "startDate", c.Request.URL.Query(), &params.StartDate)
+	err = runtime.BindQueryParameter("form", true, false, "action", c.Request.URL.Query(), &params.Action)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startDate: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter action: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "endDate" -------------
+	// ------------- Optional query parameter "actor_id" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "endDate", c.Request.URL.Query(), &params.EndDate)
+	err = runtime.BindQueryParameter("form", true, false, "actor_id", c.Request.URL.Query(), &params.ActorId)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endDate: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter actor_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "page" -------------
+	// ------------- Optional query parameter "from" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -324,14 +1326,29 @@ func (siw *ServerInterfaceWrapper) GetPvz(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetPvz(c, params)
+	siw.Handler.GetAudit(c, params)
 }
 
-// PostPvz operation middleware
-func (siw *ServerInterfaceWrapper) PostPvz(c *gin.Context) {
+// GetCities operation middleware
+func (siw *ServerInterfaceWrapper) GetCities(c *gin.Context) {
+
+	var err error
 
 	c.Set(BearerAuthScopes, []string{})
 
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCitiesParams
+
+	// ------------- Optional query parameter "enabled" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "enabled", c.Request.URL.Query(), &params.Enabled)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter enabled: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -339,22 +1356,11 @@ func (siw *ServerInterfaceWrapper) PostPvz(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostPvz(c)
+	siw.Handler.GetCities(c, params)
 }
 
-// PostPvzPvzIdCloseLastReception operation middleware
-func (siw *ServerInterfaceWrapper) PostPvzPvzIdCloseLastReception(c *gin.Context) {
-
-	var err error
-
-	// ------------- Path parameter "pvzId" -------------
-	var pvzId uuid.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
-		return
-	}
+// PostCities operation middleware
+func (siw *ServerInterfaceWrapper) PostCities(c *gin.Context) {
 
 	c.Set(BearerAuthScopes, []string{})
 
@@ -365,20 +1371,20 @@ func (siw *ServerInterfaceWrapper) PostPvzPvzIdCloseLastReception(c *gin.Context
 		}
 	}
 
-	siw.Handler.PostPvzPvzIdCloseLastReception(c, pvzId)
+	siw.Handler.PostCities(c)
 }
 
-// PostPvzPvzIdDeleteLastProduct operation middleware
-func (siw *ServerInterfaceWrapper) PostPvzPvzIdDeleteLastProduct(c *gin.Context) {
+// PatchCitiesCode operation middleware
+func (siw *ServerInterfaceWrapper) PatchCitiesCode(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "pvzId" -------------
-	var pvzId uuid.UUID
+	// ------------- Path parameter "code" -------------
+	var code string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "code", c.Param("code"), &code, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter code: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -391,13 +1397,11 @@ func (siw *ServerInterfaceWrapper) PostPvzPvzIdDeleteLastProduct(c *gin.Context)
 		}
 	}
 
-	siw.Handler.PostPvzPvzIdDeleteLastProduct(c, pvzId)
+	siw.Handler.PatchCitiesCode(c, code)
 }
 
-// PostReceptions operation middleware
-func (siw *ServerInterfaceWrapper) PostReceptions(c *gin.Context) {
-
-	c.Set(BearerAuthScopes, []string{})
+// PostDummyLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostDummyLogin(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -406,11 +1410,13 @@ func (siw *ServerInterfaceWrapper) PostReceptions(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostReceptions(c)
+	siw.Handler.PostDummyLogin(c)
 }
 
-// PostRegister operation middleware
-func (siw *ServerInterfaceWrapper) PostRegister(c *gin.Context) {
+// PostInvites operation middleware
+func (siw *ServerInterfaceWrapper) PostInvites(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -419,370 +1425,5102 @@ func (siw *ServerInterfaceWrapper) PostRegister(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostRegister(c)
+	siw.Handler.PostInvites(c)
 }
 
-// GinServerOptions provides options for the Gin server.
-type GinServerOptions struct {
-	BaseURL      string
-	Middlewares  []MiddlewareFunc
-	ErrorHandler func(*gin.Context, error, int)
-}
+// PostLogin operation middleware
+func (siw *ServerInterfaceWrapper) PostLogin(c *gin.Context) {
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router gin.IRouter, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostLogin(c)
 }
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
-	errorHandler := options.ErrorHandler
-	if errorHandler == nil {
-		errorHandler = func(c *gin.Context, err error, statusCode int) {
-			c.JSON(statusCode, gin.H{"msg": err.Error()})
+// PostLoginMfa operation middleware
+func (siw *ServerInterfaceWrapper) PostLoginMfa(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
 	}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandler:       errorHandler,
+	siw.Handler.PostLoginMfa(c)
+}
+
+// PostMfaEnroll operation middleware
+func (siw *ServerInterfaceWrapper) PostMfaEnroll(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	router.POST(options.BaseURL+"/dummyLogin", wrapper.PostDummyLogin)
-	router.POST(options.BaseURL+"/login", wrapper.PostLogin)
-	router.POST(options.BaseURL+"/products", wrapper.PostProducts)
-	router.GET(options.BaseURL+"/pvz", wrapper.GetPvz)
-	router.POST(options.BaseURL+"/pvz", wrapper.PostPvz)
-	router.POST(options.BaseURL+"/pvz/:pvzId/close_last_reception", wrapper.PostPvzPvzIdCloseLastReception)
-	router.POST(options.BaseURL+"/pvz/:pvzId/delete_last_product", wrapper.PostPvzPvzIdDeleteLastProduct)
-	router.POST(options.BaseURL+"/receptions", wrapper.PostReceptions)
-	router.POST(options.BaseURL+"/register", wrapper.PostRegister)
+	siw.Handler.PostMfaEnroll(c)
 }
 
-type PostDummyLoginRequestObject struct {
-	Body *PostDummyLoginJSONRequestBody
-}
+// PostMfaVerify operation middleware
+func (siw *ServerInterfaceWrapper) PostMfaVerify(c *gin.Context) {
 
-type PostDummyLoginResponseObject interface {
-	VisitPostDummyLoginResponse(w http.ResponseWriter) error
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostMfaVerify(c)
 }
 
-type PostDummyLogin200JSONResponse Token
+// PostPasswordForgot operation middleware
+func (siw *ServerInterfaceWrapper) PostPasswordForgot(c *gin.Context) {
 
-func (response PostDummyLogin200JSONResponse) VisitPostDummyLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.PostPasswordForgot(c)
 }
 
-type PostDummyLogin400JSONResponse Error
+// PostPasswordReset operation middleware
+func (siw *ServerInterfaceWrapper) PostPasswordReset(c *gin.Context) {
 
-func (response PostDummyLogin400JSONResponse) VisitPostDummyLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.PostPasswordReset(c)
 }
 
-type PostLoginRequestObject struct {
-	Body *PostLoginJSONRequestBody
-}
+// GetProductTypes operation middleware
+func (siw *ServerInterfaceWrapper) GetProductTypes(c *gin.Context) {
 
-type PostLoginResponseObject interface {
-	VisitPostLoginResponse(w http.ResponseWriter) error
-}
+	c.Set(BearerAuthScopes, []string{})
 
-type PostLogin200JSONResponse Token
+	c.Set(ApiKeyAuthScopes, []string{})
 
-func (response PostLogin200JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.GetProductTypes(c)
 }
 
-type PostLogin401JSONResponse Error
+// PostProductTypes operation middleware
+func (siw *ServerInterfaceWrapper) PostProductTypes(c *gin.Context) {
 
-func (response PostLogin401JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	c.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type PostProductsRequestObject struct {
-	Body *PostProductsJSONRequestBody
+	siw.Handler.PostProductTypes(c)
 }
 
-type PostProductsResponseObject interface {
-	VisitPostProductsResponse(w http.ResponseWriter) error
-}
+// DeleteProductTypesCode operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProductTypesCode(c *gin.Context) {
 
-type PostProducts201JSONResponse Product
+	var err error
 
-func (response PostProducts201JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	// ------------- Path parameter "code" -------------
+	var code string
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "code", c.Param("code"), &code, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter code: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostProducts400JSONResponse Error
+	c.Set(BearerAuthScopes, []string{})
 
-func (response PostProducts400JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.DeleteProductTypesCode(c, code)
 }
 
-type PostProducts403JSONResponse Error
+// PatchProductTypesCode operation middleware
+func (siw *ServerInterfaceWrapper) PatchProductTypesCode(c *gin.Context) {
 
-func (response PostProducts403JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "code" -------------
+	var code string
 
-type GetPvzRequestObject struct {
-	Params GetPvzParams
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "code", c.Param("code"), &code, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter code: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type GetPvzResponseObject interface {
-	VisitGetPvzResponse(w http.ResponseWriter) error
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PatchProductTypesCode(c, code)
 }
 
-type GetPvz200JSONResponse []struct {
-	Pvz        *PVZ `json:"pvz,omitempty"`
-	Receptions *[]struct {
-		Products  *[]Product `json:"products,omitempty"`
-		Reception *Reception `json:"reception,omitempty"`
-	} `json:"receptions,omitempty"`
-}
+// GetProducts operation middleware
+func (siw *ServerInterfaceWrapper) GetProducts(c *gin.Context) {
 
-func (response GetPvz200JSONResponse) VisitGetPvzResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	c.Set(BearerAuthScopes, []string{})
 
-type PostPvzRequestObject struct {
-	Body *PostPvzJSONRequestBody
-}
+	c.Set(ApiKeyAuthScopes, []string{})
 
-type PostPvzResponseObject interface {
-	VisitPostPvzResponse(w http.ResponseWriter) error
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetProductsParams
 
-type PostPvz201JSONResponse PVZ
+	// ------------- Required query parameter "barcode" -------------
 
-func (response PostPvz201JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	if paramValue := c.Query("barcode"); paramValue != "" {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument barcode is required, but not found"), http.StatusBadRequest)
+		return
+	}
 
-type PostPvz400JSONResponse Error
+	err = runtime.BindQueryParameter("form", true, true, "barcode", c.Request.URL.Query(), &params.Barcode)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter barcode: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response PostPvz400JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.GetProducts(c, params)
 }
 
-type PostPvz403JSONResponse Error
+// PostProducts operation middleware
+func (siw *ServerInterfaceWrapper) PostProducts(c *gin.Context) {
 
-func (response PostPvz403JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	c.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	c.Set(ApiKeyAuthScopes, []string{})
 
-type PostPvzPvzIdCloseLastReceptionRequestObject struct {
-	PvzId uuid.UUID `json:"pvzId"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type PostPvzPvzIdCloseLastReceptionResponseObject interface {
-	VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error
+	siw.Handler.PostProducts(c)
 }
 
-type PostPvzPvzIdCloseLastReception200JSONResponse Reception
+// PostProductsBatch operation middleware
+func (siw *ServerInterfaceWrapper) PostProductsBatch(c *gin.Context) {
 
-func (response PostPvzPvzIdCloseLastReception200JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	c.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostProductsBatch(c)
 }
 
-type PostPvzPvzIdCloseLastReception400JSONResponse Error
+// GetProductsProductIdAttachments operation middleware
+func (siw *ServerInterfaceWrapper) GetProductsProductIdAttachments(c *gin.Context) {
 
-func (response PostPvzPvzIdCloseLastReception400JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "productId" -------------
+	var productId uuid.UUID
 
-type PostPvzPvzIdCloseLastReception403JSONResponse Error
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", c.Param("productId"), &productId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter productId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response PostPvzPvzIdCloseLastReception403JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	c.Set(BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	c.Set(ApiKeyAuthScopes, []string{})
 
-type PostPvzPvzIdDeleteLastProductRequestObject struct {
-	PvzId uuid.UUID `json:"pvzId"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type PostPvzPvzIdDeleteLastProductResponseObject interface {
-	VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error
+	siw.Handler.GetProductsProductIdAttachments(c, productId)
 }
 
-type PostPvzPvzIdDeleteLastProduct200Response struct {
+// PostProductsProductIdAttachments operation middleware
+func (siw *ServerInterfaceWrapper) PostProductsProductIdAttachments(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "productId" -------------
+	var productId uuid.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", c.Param("productId"), &productId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter productId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostProductsProductIdAttachments(c, productId)
 }
 
-func (response PostPvzPvzIdDeleteLastProduct200Response) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+// GetProductsProductIdAttachmentsAttachmentId operation middleware
+func (siw *ServerInterfaceWrapper) GetProductsProductIdAttachmentsAttachmentId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "productId" -------------
+	var productId uuid.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", c.Param("productId"), &productId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter productId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentId uuid.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "attachmentId", c.Param("attachmentId"), &attachmentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter attachmentId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetProductsProductIdAttachmentsAttachmentId(c, productId, attachmentId)
 }
 
-type PostPvzPvzIdDeleteLastProduct400JSONResponse Error
+// GetProductsProductIdEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetProductsProductIdEvents(c *gin.Context) {
 
-func (response PostPvzPvzIdDeleteLastProduct400JSONResponse) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
+	// ------------- Path parameter "productId" -------------
+	var productId uuid.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", c.Param("productId"), &productId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter productId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetProductsProductIdEvents(c, productId)
 }
 
-type PostPvzPvzIdDeleteLastProduct403JSONResponse Error
+// PostProductsProductIdIssue operation middleware
+func (siw *ServerInterfaceWrapper) PostProductsProductIdIssue(c *gin.Context) {
 
-func (response PostPvzPvzIdDeleteLastProduct403JSONResponse) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
+	// ------------- Path parameter "productId" -------------
+	var productId uuid.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", c.Param("productId"), &productId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter productId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostProductsProductIdIssue(c, productId)
 }
 
-type PostReceptionsRequestObject struct {
-	Body *PostReceptionsJSONRequestBody
+// PostProductsProductIdMove operation middleware
+func (siw *ServerInterfaceWrapper) PostProductsProductIdMove(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "productId" -------------
+	var productId uuid.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", c.Param("productId"), &productId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter productId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostProductsProductIdMove(c, productId)
 }
 
-type PostReceptionsResponseObject interface {
-	VisitPostReceptionsResponse(w http.ResponseWriter) error
+// PutProductsProductIdPickupCode operation middleware
+func (siw *ServerInterfaceWrapper) PutProductsProductIdPickupCode(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "productId" -------------
+	var productId uuid.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "productId", c.Param("productId"), &productId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter productId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutProductsProductIdPickupCode(c, productId)
 }
 
-type PostReceptions201JSONResponse Reception
+// GetPvz operation middleware
+func (siw *ServerInterfaceWrapper) GetPvz(c *gin.Context) {
 
-func (response PostReceptions201JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPvzParams
+
+	// ------------- Optional query parameter "startDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "startDate", c.Request.URL.Query(), &params.StartDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter startDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "endDate" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "endDate", c.Request.URL.Query(), &params.EndDate)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter endDate: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPvz(c, params)
+}
+
+// PostPvz operation middleware
+func (siw *ServerInterfaceWrapper) PostPvz(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPvz(c)
+}
+
+// GetPvzPvzId operation middleware
+func (siw *ServerInterfaceWrapper) GetPvzPvzId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId uuid.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPvzPvzId(c, pvzId)
+}
+
+// PatchPvzPvzId operation middleware
+func (siw *ServerInterfaceWrapper) PatchPvzPvzId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId uuid.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PatchPvzPvzId(c, pvzId)
+}
+
+// PutPvzPvzIdCapacity operation middleware
+func (siw *ServerInterfaceWrapper) PutPvzPvzIdCapacity(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId uuid.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutPvzPvzIdCapacity(c, pvzId)
+}
+
+// GetPvzPvzIdCells operation middleware
+func (siw *ServerInterfaceWrapper) GetPvzPvzIdCells(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId uuid.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPvzPvzIdCells(c, pvzId)
+}
+
+// PostPvzPvzIdCells operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdCells(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId uuid.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPvzPvzIdCells(c, pvzId)
+}
+
+// PostPvzPvzIdCloseLastReception operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdCloseLastReception(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId uuid.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPvzPvzIdCloseLastReceptionParams
+
+	// ------------- Optional query parameter "override" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "override", c.Request.URL.Query(), &params.Override)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter override: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPvzPvzIdCloseLastReception(c, pvzId, params)
+}
+
+// PostPvzPvzIdDeleteLastProduct operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdDeleteLastProduct(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId uuid.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPvzPvzIdDeleteLastProduct(c, pvzId)
+}
+
+// GetPvzPvzIdReceptions operation middleware
+func (siw *ServerInterfaceWrapper) GetPvzPvzIdReceptions(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId uuid.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPvzPvzIdReceptionsParams
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPvzPvzIdReceptions(c, pvzId, params)
+}
+
+// PostPvzPvzIdReturnShipments operation middleware
+func (siw *ServerInterfaceWrapper) PostPvzPvzIdReturnShipments(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId uuid.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPvzPvzIdReturnShipments(c, pvzId)
+}
+
+// GetPvzPvzIdStock operation middleware
+func (siw *ServerInterfaceWrapper) GetPvzPvzIdStock(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "pvzId" -------------
+	var pvzId uuid.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pvzId", c.Param("pvzId"), &pvzId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pvzId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPvzPvzIdStockParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPvzPvzIdStock(c, pvzId, params)
+}
+
+// PostReceptions operation middleware
+func (siw *ServerInterfaceWrapper) PostReceptions(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostReceptions(c)
+}
+
+// GetReceptionsReceptionId operation middleware
+func (siw *ServerInterfaceWrapper) GetReceptionsReceptionId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId uuid.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", c.Param("receptionId"), &receptionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter receptionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetReceptionsReceptionId(c, receptionId)
+}
+
+// PostReceptionsReceptionIdCancel operation middleware
+func (siw *ServerInterfaceWrapper) PostReceptionsReceptionIdCancel(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId uuid.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", c.Param("receptionId"), &receptionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter receptionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostReceptionsReceptionIdCancel(c, receptionId)
+}
+
+// PostReceptionsReceptionIdReopen operation middleware
+func (siw *ServerInterfaceWrapper) PostReceptionsReceptionIdReopen(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "receptionId" -------------
+	var receptionId uuid.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "receptionId", c.Param("receptionId"), &receptionId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter receptionId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostReceptionsReceptionIdReopen(c, receptionId)
+}
+
+// PostRegister operation middleware
+func (siw *ServerInterfaceWrapper) PostRegister(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostRegister(c)
+}
+
+// PostRegisterAcceptInvite operation middleware
+func (siw *ServerInterfaceWrapper) PostRegisterAcceptInvite(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostRegisterAcceptInvite(c)
+}
+
+// PostTransfers operation middleware
+func (siw *ServerInterfaceWrapper) PostTransfers(c *gin.Context) {
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTransfers(c)
+}
+
+// GetTransfersTransferId operation middleware
+func (siw *ServerInterfaceWrapper) GetTransfersTransferId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "transferId" -------------
+	var transferId uuid.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "transferId", c.Param("transferId"), &transferId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter transferId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTransfersTransferId(c, transferId)
+}
+
+// PostTransfersTransferIdDispatch operation middleware
+func (siw *ServerInterfaceWrapper) PostTransfersTransferIdDispatch(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "transferId" -------------
+	var transferId uuid.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "transferId", c.Param("transferId"), &transferId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter transferId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTransfersTransferIdDispatch(c, transferId)
+}
+
+// PostTransfersTransferIdReceive operation middleware
+func (siw *ServerInterfaceWrapper) PostTransfersTransferIdReceive(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "transferId" -------------
+	var transferId uuid.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "transferId", c.Param("transferId"), &transferId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter transferId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	c.Set(ApiKeyAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTransfersTransferIdReceive(c, transferId)
+}
+
+// GetUsers operation middleware
+func (siw *ServerInterfaceWrapper) GetUsers(c *gin.Context) {
+
+	var err error
+
+	c.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersParams
+
+	// ------------- Optional query parameter "role" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "role", c.Request.URL.Query(), &params.Role)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter role: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "active" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "active", c.Request.URL.Query(), &params.Active)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter active: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "email" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "email", c.Request.URL.Query(), &params.Email)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter email: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", c.Request.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUsers(c, params)
+}
+
+// GetUsersUserId operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersUserId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId uuid.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUsersUserId(c, userId)
+}
+
+// PatchUsersUserId operation middleware
+func (siw *ServerInterfaceWrapper) PatchUsersUserId(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId uuid.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PatchUsersUserId(c, userId)
+}
+
+// PostUsersUserIdResetPassword operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersUserIdResetPassword(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "userId" -------------
+	var userId uuid.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(BearerAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersUserIdResetPassword(c, userId)
+}
+
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []MiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
+
+	router.GET(options.BaseURL+"/api-keys", wrapper.GetApiKeys)
+	router.POST(options.BaseURL+"/api-keys", wrapper.PostApiKeys)
+	router.DELETE(options.BaseURL+"/api-keys/:keyId", wrapper.DeleteApiKeysKeyId)
+	router.GET(options.BaseURL+"/audit", wrapper.GetAudit)
+	router.GET(options.BaseURL+"/cities", wrapper.GetCities)
+	router.POST(options.BaseURL+"/cities", wrapper.PostCities)
+	router.PATCH(options.BaseURL+"/cities/:code", wrapper.PatchCitiesCode)
+	router.POST(options.BaseURL+"/dummyLogin", wrapper.PostDummyLogin)
+	router.POST(options.BaseURL+"/invites", wrapper.PostInvites)
+	router.POST(options.BaseURL+"/login", wrapper.PostLogin)
+	router.POST(options.BaseURL+"/login/mfa", wrapper.PostLoginMfa)
+	router.POST(options.BaseURL+"/mfa/enroll", wrapper.PostMfaEnroll)
+	router.POST(options.BaseURL+"/mfa/verify", wrapper.PostMfaVerify)
+	router.POST(options.BaseURL+"/password/forgot", wrapper.PostPasswordForgot)
+	router.POST(options.BaseURL+"/password/reset", wrapper.PostPasswordReset)
+	router.GET(options.BaseURL+"/product-types", wrapper.GetProductTypes)
+	router.POST(options.BaseURL+"/product-types", wrapper.PostProductTypes)
+	router.DELETE(options.BaseURL+"/product-types/:code", wrapper.DeleteProductTypesCode)
+	router.PATCH(options.BaseURL+"/product-types/:code", wrapper.PatchProductTypesCode)
+	router.GET(options.BaseURL+"/products", wrapper.GetProducts)
+	router.POST(options.BaseURL+"/products", wrapper.PostProducts)
+	router.POST(options.BaseURL+"/products/batch", wrapper.PostProductsBatch)
+	router.GET(options.BaseURL+"/products/:productId/attachments", wrapper.GetProductsProductIdAttachments)
+	router.POST(options.BaseURL+"/products/:productId/attachments", wrapper.PostProductsProductIdAttachments)
+	router.GET(options.BaseURL+"/products/:productId/attachments/:attachmentId", wrapper.GetProductsProductIdAttachmentsAttachmentId)
+	router.GET(options.BaseURL+"/products/:productId/events", wrapper.GetProductsProductIdEvents)
+	router.POST(options.BaseURL+"/products/:productId/issue", wrapper.PostProductsProductIdIssue)
+	router.POST(options.BaseURL+"/products/:productId/move", wrapper.PostProductsProductIdMove)
+	router.PUT(options.BaseURL+"/products/:productId/pickup-code", wrapper.PutProductsProductIdPickupCode)
+	router.GET(options.BaseURL+"/pvz", wrapper.GetPvz)
+	router.POST(options.BaseURL+"/pvz", wrapper.PostPvz)
+	router.GET(options.BaseURL+"/pvz/:pvzId", wrapper.GetPvzPvzId)
+	router.PATCH(options.BaseURL+"/pvz/:pvzId", wrapper.PatchPvzPvzId)
+	router.PUT(options.BaseURL+"/pvz/:pvzId/capacity", wrapper.PutPvzPvzIdCapacity)
+	router.GET(options.BaseURL+"/pvz/:pvzId/cells", wrapper.GetPvzPvzIdCells)
+	router.POST(options.BaseURL+"/pvz/:pvzId/cells", wrapper.PostPvzPvzIdCells)
+	router.POST(options.BaseURL+"/pvz/:pvzId/close_last_reception", wrapper.PostPvzPvzIdCloseLastReception)
+	router.POST(options.BaseURL+"/pvz/:pvzId/delete_last_product", wrapper.PostPvzPvzIdDeleteLastProduct)
+	router.GET(options.BaseURL+"/pvz/:pvzId/receptions", wrapper.GetPvzPvzIdReceptions)
+	router.POST(options.BaseURL+"/pvz/:pvzId/return-shipments", wrapper.PostPvzPvzIdReturnShipments)
+	router.GET(options.BaseURL+"/pvz/:pvzId/stock", wrapper.GetPvzPvzIdStock)
+	router.POST(options.BaseURL+"/receptions", wrapper.PostReceptions)
+	router.GET(options.BaseURL+"/receptions/:receptionId", wrapper.GetReceptionsReceptionId)
+	router.POST(options.BaseURL+"/receptions/:receptionId/cancel", wrapper.PostReceptionsReceptionIdCancel)
+	router.POST(options.BaseURL+"/receptions/:receptionId/reopen", wrapper.PostReceptionsReceptionIdReopen)
+	router.POST(options.BaseURL+"/register", wrapper.PostRegister)
+	router.POST(options.BaseURL+"/register/accept-invite", wrapper.PostRegisterAcceptInvite)
+	router.POST(options.BaseURL+"/transfers", wrapper.PostTransfers)
+	router.GET(options.BaseURL+"/transfers/:transferId", wrapper.GetTransfersTransferId)
+	router.POST(options.BaseURL+"/transfers/:transferId/dispatch", wrapper.PostTransfersTransferIdDispatch)
+	router.POST(options.BaseURL+"/transfers/:transferId/receive", wrapper.PostTransfersTransferIdReceive)
+	router.GET(options.BaseURL+"/users", wrapper.GetUsers)
+	router.GET(options.BaseURL+"/users/:userId", wrapper.GetUsersUserId)
+	router.PATCH(options.BaseURL+"/users/:userId", wrapper.PatchUsersUserId)
+	router.POST(options.BaseURL+"/users/:userId/reset-password", wrapper.PostUsersUserIdResetPassword)
+}
+
+type GetApiKeysRequestObject struct {
+}
+
+type GetApiKeysResponseObject interface {
+	VisitGetApiKeysResponse(w http.ResponseWriter) error
+}
+
+type GetApiKeys200JSONResponse []APIKey
+
+func (response GetApiKeys200JSONResponse) VisitGetApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetApiKeys403JSONResponse Error
+
+func (response GetApiKeys403JSONResponse) VisitGetApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiKeysRequestObject struct {
+	Body *PostApiKeysJSONRequestBody
+}
+
+type PostApiKeysResponseObject interface {
+	VisitPostApiKeysResponse(w http.ResponseWriter) error
+}
+
+type PostApiKeys201JSONResponse APIKey
+
+func (response PostApiKeys201JSONResponse) VisitPostApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiKeys400JSONResponse Error
+
+func (response PostApiKeys400JSONResponse) VisitPostApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostApiKeys403JSONResponse Error
+
+func (response PostApiKeys403JSONResponse) VisitPostApiKeysResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiKeysKeyIdRequestObject struct {
+	KeyId uuid.UUID `json:"keyId"`
+}
+
+type DeleteApiKeysKeyIdResponseObject interface {
+	VisitDeleteApiKeysKeyIdResponse(w http.ResponseWriter) error
+}
+
+type DeleteApiKeysKeyId204Response struct {
+}
+
+func (response DeleteApiKeysKeyId204Response) VisitDeleteApiKeysKeyIdResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteApiKeysKeyId400JSONResponse Error
+
+func (response DeleteApiKeysKeyId400JSONResponse) VisitDeleteApiKeysKeyIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteApiKeysKeyId403JSONResponse Error
+
+func (response DeleteApiKeysKeyId403JSONResponse) VisitDeleteApiKeysKeyIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAuditRequestObject struct {
+	Params GetAuditParams
+}
+
+type GetAuditResponseObject interface {
+	VisitGetAuditResponse(w http.ResponseWriter) error
+}
+
+type GetAudit200JSONResponse AuditPage
+
+func (response GetAudit200JSONResponse) VisitGetAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAudit400JSONResponse Error
+
+func (response GetAudit400JSONResponse) VisitGetAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAudit403JSONResponse Error
+
+func (response GetAudit403JSONResponse) VisitGetAuditResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCitiesRequestObject struct {
+	Params GetCitiesParams
+}
+
+type GetCitiesResponseObject interface {
+	VisitGetCitiesResponse(w http.ResponseWriter) error
+}
+
+type GetCities200JSONResponse []City
+
+func (response GetCities200JSONResponse) VisitGetCitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCities403JSONResponse Error
+
+func (response GetCities403JSONResponse) VisitGetCitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCitiesRequestObject struct {
+	Body *PostCitiesJSONRequestBody
+}
+
+type PostCitiesResponseObject interface {
+	VisitPostCitiesResponse(w http.ResponseWriter) error
+}
+
+type PostCities201JSONResponse City
+
+func (response PostCities201JSONResponse) VisitPostCitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCities400JSONResponse Error
+
+func (response PostCities400JSONResponse) VisitPostCitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostCities403JSONResponse Error
+
+func (response PostCities403JSONResponse) VisitPostCitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchCitiesCodeRequestObject struct {
+	Code string `json:"code"`
+	Body *PatchCitiesCodeJSONRequestBody
+}
+
+type PatchCitiesCodeResponseObject interface {
+	VisitPatchCitiesCodeResponse(w http.ResponseWriter) error
+}
+
+type PatchCitiesCode200JSONResponse City
+
+func (response PatchCitiesCode200JSONResponse) VisitPatchCitiesCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchCitiesCode400JSONResponse Error
+
+func (response PatchCitiesCode400JSONResponse) VisitPatchCitiesCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchCitiesCode403JSONResponse Error
+
+func (response PatchCitiesCode403JSONResponse) VisitPatchCitiesCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchCitiesCode404JSONResponse Error
+
+func (response PatchCitiesCode404JSONResponse) VisitPatchCitiesCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDummyLoginRequestObject struct {
+	Body *PostDummyLoginJSONRequestBody
+}
+
+type PostDummyLoginResponseObject interface {
+	VisitPostDummyLoginResponse(w http.ResponseWriter) error
+}
+
+type PostDummyLogin200JSONResponse Token
+
+func (response PostDummyLogin200JSONResponse) VisitPostDummyLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostDummyLogin400JSONResponse Error
+
+func (response PostDummyLogin400JSONResponse) VisitPostDummyLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostInvitesRequestObject struct {
+	Body *PostInvitesJSONRequestBody
+}
+
+type PostInvitesResponseObject interface {
+	VisitPostInvitesResponse(w http.ResponseWriter) error
+}
+
+type PostInvites201JSONResponse Invite
+
+func (response PostInvites201JSONResponse) VisitPostInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostInvites400JSONResponse Error
+
+func (response PostInvites400JSONResponse) VisitPostInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostInvites403JSONResponse Error
+
+func (response PostInvites403JSONResponse) VisitPostInvitesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLoginRequestObject struct {
+	Body *PostLoginJSONRequestBody
+}
+
+type PostLoginResponseObject interface {
+	VisitPostLoginResponse(w http.ResponseWriter) error
+}
+
+type PostLogin200JSONResponse LoginResult
+
+func (response PostLogin200JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLogin401JSONResponse Error
+
+func (response PostLogin401JSONResponse) VisitPostLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLoginMfaRequestObject struct {
+	Body *PostLoginMfaJSONRequestBody
+}
+
+type PostLoginMfaResponseObject interface {
+	VisitPostLoginMfaResponse(w http.ResponseWriter) error
+}
+
+type PostLoginMfa200JSONResponse LoginResult
+
+func (response PostLoginMfa200JSONResponse) VisitPostLoginMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLoginMfa400JSONResponse Error
+
+func (response PostLoginMfa400JSONResponse) VisitPostLoginMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLoginMfa401JSONResponse Error
+
+func (response PostLoginMfa401JSONResponse) VisitPostLoginMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostLoginMfa429JSONResponse Error
+
+func (response PostLoginMfa429JSONResponse) VisitPostLoginMfaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostMfaEnrollRequestObject struct {
+}
+
+type PostMfaEnrollResponseObject interface {
+	VisitPostMfaEnrollResponse(w http.ResponseWriter) error
+}
+
+type PostMfaEnroll200JSONResponse struct {
+	Secret string `json:"secret"`
+
+	// Url otpauth:// ссылка для QR-кода
+	Url string `json:"url"`
+}
+
+func (response PostMfaEnroll200JSONResponse) VisitPostMfaEnrollResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostMfaEnroll400JSONResponse Error
+
+func (response PostMfaEnroll400JSONResponse) VisitPostMfaEnrollResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostMfaEnroll401JSONResponse Error
+
+func (response PostMfaEnroll401JSONResponse) VisitPostMfaEnrollResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostMfaVerifyRequestObject struct {
+	Body *PostMfaVerifyJSONRequestBody
+}
+
+type PostMfaVerifyResponseObject interface {
+	VisitPostMfaVerifyResponse(w http.ResponseWriter) error
+}
+
+type PostMfaVerify200JSONResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+func (response PostMfaVerify200JSONResponse) VisitPostMfaVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostMfaVerify400JSONResponse Error
+
+func (response PostMfaVerify400JSONResponse) VisitPostMfaVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostMfaVerify401JSONResponse Error
+
+func (response PostMfaVerify401JSONResponse) VisitPostMfaVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostMfaVerify429JSONResponse Error
+
+func (response PostMfaVerify429JSONResponse) VisitPostMfaVerifyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPasswordForgotRequestObject struct {
+	Body *PostPasswordForgotJSONRequestBody
+}
+
+type PostPasswordForgotResponseObject interface {
+	VisitPostPasswordForgotResponse(w http.ResponseWriter) error
+}
+
+type PostPasswordForgot202Response struct {
+}
+
+func (response PostPasswordForgot202Response) VisitPostPasswordForgotResponse(w http.ResponseWriter) error {
+	w.WriteHeader(202)
+	return nil
+}
+
+type PostPasswordForgot400JSONResponse Error
+
+func (response PostPasswordForgot400JSONResponse) VisitPostPasswordForgotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPasswordForgot429JSONResponse Error
+
+func (response PostPasswordForgot429JSONResponse) VisitPostPasswordForgotResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPasswordResetRequestObject struct {
+	Body *PostPasswordResetJSONRequestBody
+}
+
+type PostPasswordResetResponseObject interface {
+	VisitPostPasswordResetResponse(w http.ResponseWriter) error
+}
+
+type PostPasswordReset200Response struct {
+}
+
+func (response PostPasswordReset200Response) VisitPostPasswordResetResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostPasswordReset400JSONResponse Error
+
+func (response PostPasswordReset400JSONResponse) VisitPostPasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPasswordReset429JSONResponse Error
+
+func (response PostPasswordReset429JSONResponse) VisitPostPasswordResetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductTypesRequestObject struct {
+}
+
+type GetProductTypesResponseObject interface {
+	VisitGetProductTypesResponse(w http.ResponseWriter) error
+}
+
+type GetProductTypes200JSONResponse []ProductType
+
+func (response GetProductTypes200JSONResponse) VisitGetProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductTypes403JSONResponse Error
+
+func (response GetProductTypes403JSONResponse) VisitGetProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductTypesRequestObject struct {
+	Body *PostProductTypesJSONRequestBody
+}
+
+type PostProductTypesResponseObject interface {
+	VisitPostProductTypesResponse(w http.ResponseWriter) error
+}
+
+type PostProductTypes201JSONResponse ProductType
+
+func (response PostProductTypes201JSONResponse) VisitPostProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductTypes400JSONResponse Error
+
+func (response PostProductTypes400JSONResponse) VisitPostProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductTypes403JSONResponse Error
+
+func (response PostProductTypes403JSONResponse) VisitPostProductTypesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProductTypesCodeRequestObject struct {
+	Code string `json:"code"`
+}
+
+type DeleteProductTypesCodeResponseObject interface {
+	VisitDeleteProductTypesCodeResponse(w http.ResponseWriter) error
+}
+
+type DeleteProductTypesCode204Response struct {
+}
+
+func (response DeleteProductTypesCode204Response) VisitDeleteProductTypesCodeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteProductTypesCode400JSONResponse Error
+
+func (response DeleteProductTypesCode400JSONResponse) VisitDeleteProductTypesCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProductTypesCode403JSONResponse Error
+
+func (response DeleteProductTypesCode403JSONResponse) VisitDeleteProductTypesCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProductTypesCode404JSONResponse Error
+
+func (response DeleteProductTypesCode404JSONResponse) VisitDeleteProductTypesCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProductTypesCodeRequestObject struct {
+	Code string `json:"code"`
+	Body *PatchProductTypesCodeJSONRequestBody
+}
+
+type PatchProductTypesCodeResponseObject interface {
+	VisitPatchProductTypesCodeResponse(w http.ResponseWriter) error
+}
+
+type PatchProductTypesCode200JSONResponse ProductType
+
+func (response PatchProductTypesCode200JSONResponse) VisitPatchProductTypesCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProductTypesCode400JSONResponse Error
+
+func (response PatchProductTypesCode400JSONResponse) VisitPatchProductTypesCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProductTypesCode403JSONResponse Error
+
+func (response PatchProductTypesCode403JSONResponse) VisitPatchProductTypesCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchProductTypesCode404JSONResponse Error
+
+func (response PatchProductTypesCode404JSONResponse) VisitPatchProductTypesCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductsRequestObject struct {
+	Params GetProductsParams
+}
+
+type GetProductsResponseObject interface {
+	VisitGetProductsResponse(w http.ResponseWriter) error
+}
+
+type GetProducts200JSONResponse []Product
+
+func (response GetProducts200JSONResponse) VisitGetProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProducts400JSONResponse Error
+
+func (response GetProducts400JSONResponse) VisitGetProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProducts403JSONResponse Error
+
+func (response GetProducts403JSONResponse) VisitGetProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsRequestObject struct {
+	Body *PostProductsJSONRequestBody
+}
+
+type PostProductsResponseObject interface {
+	VisitPostProductsResponse(w http.ResponseWriter) error
+}
+
+type PostProducts201JSONResponse Product
+
+func (response PostProducts201JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProducts400JSONResponse Error
+
+func (response PostProducts400JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProducts403JSONResponse Error
+
+func (response PostProducts403JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProducts409JSONResponse struct {
+	union json.RawMessage
+}
+
+func (response PostProducts409JSONResponse) VisitPostProductsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response.union)
+}
+
+type PostProductsBatchRequestObject struct {
+	Body *PostProductsBatchJSONRequestBody
+}
+
+type PostProductsBatchResponseObject interface {
+	VisitPostProductsBatchResponse(w http.ResponseWriter) error
+}
+
+type PostProductsBatch201JSONResponse BatchProducts
+
+func (response PostProductsBatch201JSONResponse) VisitPostProductsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsBatch400JSONResponse struct {
+	union json.RawMessage
+}
+
+func (response PostProductsBatch400JSONResponse) VisitPostProductsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response.union)
+}
+
+type PostProductsBatch403JSONResponse Error
+
+func (response PostProductsBatch403JSONResponse) VisitPostProductsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsBatch409JSONResponse struct {
+	union json.RawMessage
+}
+
+func (response PostProductsBatch409JSONResponse) VisitPostProductsBatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response.union)
+}
+
+type GetProductsProductIdAttachmentsRequestObject struct {
+	ProductId uuid.UUID `json:"productId"`
+}
+
+type GetProductsProductIdAttachmentsResponseObject interface {
+	VisitGetProductsProductIdAttachmentsResponse(w http.ResponseWriter) error
+}
+
+type GetProductsProductIdAttachments200JSONResponse []ProductAttachment
+
+func (response GetProductsProductIdAttachments200JSONResponse) VisitGetProductsProductIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductsProductIdAttachments403JSONResponse Error
+
+func (response GetProductsProductIdAttachments403JSONResponse) VisitGetProductsProductIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductsProductIdAttachments404JSONResponse Error
+
+func (response GetProductsProductIdAttachments404JSONResponse) VisitGetProductsProductIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdAttachmentsRequestObject struct {
+	ProductId uuid.UUID `json:"productId"`
+	Body      *multipart.Reader
+}
+
+type PostProductsProductIdAttachmentsResponseObject interface {
+	VisitPostProductsProductIdAttachmentsResponse(w http.ResponseWriter) error
+}
+
+type PostProductsProductIdAttachments201JSONResponse ProductAttachment
+
+func (response PostProductsProductIdAttachments201JSONResponse) VisitPostProductsProductIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdAttachments400JSONResponse Error
+
+func (response PostProductsProductIdAttachments400JSONResponse) VisitPostProductsProductIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdAttachments403JSONResponse Error
+
+func (response PostProductsProductIdAttachments403JSONResponse) VisitPostProductsProductIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdAttachments404JSONResponse Error
+
+func (response PostProductsProductIdAttachments404JSONResponse) VisitPostProductsProductIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdAttachments413JSONResponse Error
+
+func (response PostProductsProductIdAttachments413JSONResponse) VisitPostProductsProductIdAttachmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(413)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductsProductIdAttachmentsAttachmentIdRequestObject struct {
+	ProductId    uuid.UUID `json:"productId"`
+	AttachmentId uuid.UUID `json:"attachmentId"`
+}
+
+type GetProductsProductIdAttachmentsAttachmentIdResponseObject interface {
+	VisitGetProductsProductIdAttachmentsAttachmentIdResponse(w http.ResponseWriter) error
+}
+
+type GetProductsProductIdAttachmentsAttachmentId200ImageResponse struct {
+	Body          io.Reader
+	ContentType   string
+	ContentLength int64
+}
+
+func (response GetProductsProductIdAttachmentsAttachmentId200ImageResponse) VisitGetProductsProductIdAttachmentsAttachmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", response.ContentType)
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetProductsProductIdAttachmentsAttachmentId403JSONResponse Error
+
+func (response GetProductsProductIdAttachmentsAttachmentId403JSONResponse) VisitGetProductsProductIdAttachmentsAttachmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductsProductIdAttachmentsAttachmentId404JSONResponse Error
+
+func (response GetProductsProductIdAttachmentsAttachmentId404JSONResponse) VisitGetProductsProductIdAttachmentsAttachmentIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductsProductIdEventsRequestObject struct {
+	ProductId uuid.UUID `json:"productId"`
+}
+
+type GetProductsProductIdEventsResponseObject interface {
+	VisitGetProductsProductIdEventsResponse(w http.ResponseWriter) error
+}
+
+type GetProductsProductIdEvents200JSONResponse []ProductEvent
+
+func (response GetProductsProductIdEvents200JSONResponse) VisitGetProductsProductIdEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductsProductIdEvents403JSONResponse Error
+
+func (response GetProductsProductIdEvents403JSONResponse) VisitGetProductsProductIdEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProductsProductIdEvents404JSONResponse Error
+
+func (response GetProductsProductIdEvents404JSONResponse) VisitGetProductsProductIdEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdIssueRequestObject struct {
+	ProductId uuid.UUID `json:"productId"`
+	Body      *PostProductsProductIdIssueJSONRequestBody
+}
+
+type PostProductsProductIdIssueResponseObject interface {
+	VisitPostProductsProductIdIssueResponse(w http.ResponseWriter) error
+}
+
+type PostProductsProductIdIssue200JSONResponse Product
+
+func (response PostProductsProductIdIssue200JSONResponse) VisitPostProductsProductIdIssueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdIssue400JSONResponse Error
+
+func (response PostProductsProductIdIssue400JSONResponse) VisitPostProductsProductIdIssueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdIssue403JSONResponse Error
+
+func (response PostProductsProductIdIssue403JSONResponse) VisitPostProductsProductIdIssueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdIssue404JSONResponse Error
+
+func (response PostProductsProductIdIssue404JSONResponse) VisitPostProductsProductIdIssueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdIssue409JSONResponse Error
+
+func (response PostProductsProductIdIssue409JSONResponse) VisitPostProductsProductIdIssueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdIssue429JSONResponse Error
+
+func (response PostProductsProductIdIssue429JSONResponse) VisitPostProductsProductIdIssueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(429)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdMoveRequestObject struct {
+	ProductId uuid.UUID `json:"productId"`
+	Body      *PostProductsProductIdMoveJSONRequestBody
+}
+
+type PostProductsProductIdMoveResponseObject interface {
+	VisitPostProductsProductIdMoveResponse(w http.ResponseWriter) error
+}
+
+type PostProductsProductIdMove200JSONResponse Product
+
+func (response PostProductsProductIdMove200JSONResponse) VisitPostProductsProductIdMoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdMove400JSONResponse Error
+
+func (response PostProductsProductIdMove400JSONResponse) VisitPostProductsProductIdMoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdMove403JSONResponse Error
+
+func (response PostProductsProductIdMove403JSONResponse) VisitPostProductsProductIdMoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdMove404JSONResponse Error
+
+func (response PostProductsProductIdMove404JSONResponse) VisitPostProductsProductIdMoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProductsProductIdMove409JSONResponse Error
+
+func (response PostProductsProductIdMove409JSONResponse) VisitPostProductsProductIdMoveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutProductsProductIdPickupCodeRequestObject struct {
+	ProductId uuid.UUID `json:"productId"`
+	Body      *PutProductsProductIdPickupCodeJSONRequestBody
+}
+
+type PutProductsProductIdPickupCodeResponseObject interface {
+	VisitPutProductsProductIdPickupCodeResponse(w http.ResponseWriter) error
+}
+
+type PutProductsProductIdPickupCode200JSONResponse Product
+
+func (response PutProductsProductIdPickupCode200JSONResponse) VisitPutProductsProductIdPickupCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutProductsProductIdPickupCode400JSONResponse Error
+
+func (response PutProductsProductIdPickupCode400JSONResponse) VisitPutProductsProductIdPickupCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutProductsProductIdPickupCode403JSONResponse Error
+
+func (response PutProductsProductIdPickupCode403JSONResponse) VisitPutProductsProductIdPickupCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutProductsProductIdPickupCode404JSONResponse Error
+
+func (response PutProductsProductIdPickupCode404JSONResponse) VisitPutProductsProductIdPickupCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutProductsProductIdPickupCode409JSONResponse Error
+
+func (response PutProductsProductIdPickupCode409JSONResponse) VisitPutProductsProductIdPickupCodeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzRequestObject struct {
+	Params GetPvzParams
+}
+
+type GetPvzResponseObject interface {
+	VisitGetPvzResponse(w http.ResponseWriter) error
+}
+
+type GetPvz200JSONResponse []struct {
+	Pvz        *PVZ `json:"pvz,omitempty"`
+	Receptions *[]struct {
+		Products  *[]Product `json:"products,omitempty"`
+		Reception *Reception `json:"reception,omitempty"`
+	} `json:"receptions,omitempty"`
+}
+
+func (response GetPvz200JSONResponse) VisitGetPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvz403JSONResponse Error
+
+func (response GetPvz403JSONResponse) VisitGetPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzRequestObject struct {
+	Body *PostPvzJSONRequestBody
+}
+
+type PostPvzResponseObject interface {
+	VisitPostPvzResponse(w http.ResponseWriter) error
+}
+
+type PostPvz201JSONResponse PVZ
+
+func (response PostPvz201JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvz400JSONResponse Error
+
+func (response PostPvz400JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvz403JSONResponse Error
+
+func (response PostPvz403JSONResponse) VisitPostPvzResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdRequestObject struct {
+	PvzId uuid.UUID `json:"pvzId"`
+}
+
+type GetPvzPvzIdResponseObject interface {
+	VisitGetPvzPvzIdResponse(w http.ResponseWriter) error
+}
+
+type GetPvzPvzId200JSONResponse PVZDetails
+
+func (response GetPvzPvzId200JSONResponse) VisitGetPvzPvzIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzId403JSONResponse Error
+
+func (response GetPvzPvzId403JSONResponse) VisitGetPvzPvzIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzId404JSONResponse Error
+
+func (response GetPvzPvzId404JSONResponse) VisitGetPvzPvzIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchPvzPvzIdRequestObject struct {
+	PvzId uuid.UUID `json:"pvzId"`
+	Body  *PatchPvzPvzIdJSONRequestBody
+}
+
+type PatchPvzPvzIdResponseObject interface {
+	VisitPatchPvzPvzIdResponse(w http.ResponseWriter) error
+}
+
+type PatchPvzPvzId200JSONResponse PVZ
+
+func (response PatchPvzPvzId200JSONResponse) VisitPatchPvzPvzIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchPvzPvzId400JSONResponse Error
+
+func (response PatchPvzPvzId400JSONResponse) VisitPatchPvzPvzIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchPvzPvzId403JSONResponse Error
+
+func (response PatchPvzPvzId403JSONResponse) VisitPatchPvzPvzIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchPvzPvzId404JSONResponse Error
+
+func (response PatchPvzPvzId404JSONResponse) VisitPatchPvzPvzIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutPvzPvzIdCapacityRequestObject struct {
+	PvzId uuid.UUID `json:"pvzId"`
+	Body  *PutPvzPvzIdCapacityJSONRequestBody
+}
+
+type PutPvzPvzIdCapacityResponseObject interface {
+	VisitPutPvzPvzIdCapacityResponse(w http.ResponseWriter) error
+}
+
+type PutPvzPvzIdCapacity200JSONResponse PVZ
+
+func (response PutPvzPvzIdCapacity200JSONResponse) VisitPutPvzPvzIdCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutPvzPvzIdCapacity400JSONResponse Error
+
+func (response PutPvzPvzIdCapacity400JSONResponse) VisitPutPvzPvzIdCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutPvzPvzIdCapacity403JSONResponse Error
+
+func (response PutPvzPvzIdCapacity403JSONResponse) VisitPutPvzPvzIdCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PutPvzPvzIdCapacity404JSONResponse Error
+
+func (response PutPvzPvzIdCapacity404JSONResponse) VisitPutPvzPvzIdCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdCellsRequestObject struct {
+	PvzId uuid.UUID `json:"pvzId"`
+}
+
+type GetPvzPvzIdCellsResponseObject interface {
+	VisitGetPvzPvzIdCellsResponse(w http.ResponseWriter) error
+}
+
+type GetPvzPvzIdCells200JSONResponse []StorageCell
+
+func (response GetPvzPvzIdCells200JSONResponse) VisitGetPvzPvzIdCellsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdCells403JSONResponse Error
+
+func (response GetPvzPvzIdCells403JSONResponse) VisitGetPvzPvzIdCellsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdCells404JSONResponse Error
+
+func (response GetPvzPvzIdCells404JSONResponse) VisitGetPvzPvzIdCellsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCellsRequestObject struct {
+	PvzId uuid.UUID `json:"pvzId"`
+	Body  *PostPvzPvzIdCellsJSONRequestBody
+}
+
+type PostPvzPvzIdCellsResponseObject interface {
+	VisitPostPvzPvzIdCellsResponse(w http.ResponseWriter) error
+}
+
+type PostPvzPvzIdCells201JSONResponse StorageCell
+
+func (response PostPvzPvzIdCells201JSONResponse) VisitPostPvzPvzIdCellsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCells400JSONResponse Error
+
+func (response PostPvzPvzIdCells400JSONResponse) VisitPostPvzPvzIdCellsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCells403JSONResponse Error
+
+func (response PostPvzPvzIdCells403JSONResponse) VisitPostPvzPvzIdCellsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCells404JSONResponse Error
+
+func (response PostPvzPvzIdCells404JSONResponse) VisitPostPvzPvzIdCellsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCells409JSONResponse Error
+
+func (response PostPvzPvzIdCells409JSONResponse) VisitPostPvzPvzIdCellsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastReceptionRequestObject struct {
+	PvzId  uuid.UUID `json:"pvzId"`
+	Params PostPvzPvzIdCloseLastReceptionParams
+}
+
+type PostPvzPvzIdCloseLastReceptionResponseObject interface {
+	VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error
+}
+
+type PostPvzPvzIdCloseLastReception200JSONResponse ClosedReception
+
+func (response PostPvzPvzIdCloseLastReception200JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastReception400JSONResponse Error
+
+func (response PostPvzPvzIdCloseLastReception400JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastReception403JSONResponse Error
+
+func (response PostPvzPvzIdCloseLastReception403JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdCloseLastReception409JSONResponse ReconciliationConflict
+
+func (response PostPvzPvzIdCloseLastReception409JSONResponse) VisitPostPvzPvzIdCloseLastReceptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdDeleteLastProductRequestObject struct {
+	PvzId uuid.UUID `json:"pvzId"`
+}
+
+type PostPvzPvzIdDeleteLastProductResponseObject interface {
+	VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error
+}
+
+type PostPvzPvzIdDeleteLastProduct200Response struct {
+}
+
+func (response PostPvzPvzIdDeleteLastProduct200Response) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type PostPvzPvzIdDeleteLastProduct400JSONResponse Error
+
+func (response PostPvzPvzIdDeleteLastProduct400JSONResponse) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdDeleteLastProduct403JSONResponse Error
+
+func (response PostPvzPvzIdDeleteLastProduct403JSONResponse) VisitPostPvzPvzIdDeleteLastProductResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdReceptionsRequestObject struct {
+	PvzId  uuid.UUID `json:"pvzId"`
+	Params GetPvzPvzIdReceptionsParams
+}
+
+type GetPvzPvzIdReceptionsResponseObject interface {
+	VisitGetPvzPvzIdReceptionsResponse(w http.ResponseWriter) error
+}
+
+type GetPvzPvzIdReceptions200JSONResponse ReceptionPage
+
+func (response GetPvzPvzIdReceptions200JSONResponse) VisitGetPvzPvzIdReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdReceptions400JSONResponse Error
+
+func (response GetPvzPvzIdReceptions400JSONResponse) VisitGetPvzPvzIdReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdReceptions403JSONResponse Error
+
+func (response GetPvzPvzIdReceptions403JSONResponse) VisitGetPvzPvzIdReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdReceptions404JSONResponse Error
+
+func (response GetPvzPvzIdReceptions404JSONResponse) VisitGetPvzPvzIdReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdReturnShipmentsRequestObject struct {
+	PvzId uuid.UUID `json:"pvzId"`
+}
+
+type PostPvzPvzIdReturnShipmentsResponseObject interface {
+	VisitPostPvzPvzIdReturnShipmentsResponse(w http.ResponseWriter) error
+}
+
+type PostPvzPvzIdReturnShipments201JSONResponse ReturnShipment
+
+func (response PostPvzPvzIdReturnShipments201JSONResponse) VisitPostPvzPvzIdReturnShipmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdReturnShipments403JSONResponse Error
+
+func (response PostPvzPvzIdReturnShipments403JSONResponse) VisitPostPvzPvzIdReturnShipmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdReturnShipments404JSONResponse Error
+
+func (response PostPvzPvzIdReturnShipments404JSONResponse) VisitPostPvzPvzIdReturnShipmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPvzPvzIdReturnShipments409JSONResponse Error
+
+func (response PostPvzPvzIdReturnShipments409JSONResponse) VisitPostPvzPvzIdReturnShipmentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdStockRequestObject struct {
+	PvzId  uuid.UUID `json:"pvzId"`
+	Params GetPvzPvzIdStockParams
+}
+
+type GetPvzPvzIdStockResponseObject interface {
+	VisitGetPvzPvzIdStockResponse(w http.ResponseWriter) error
+}
+
+type GetPvzPvzIdStock200JSONResponse []Product
+
+func (response GetPvzPvzIdStock200JSONResponse) VisitGetPvzPvzIdStockResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdStock403JSONResponse Error
+
+func (response GetPvzPvzIdStock403JSONResponse) VisitGetPvzPvzIdStockResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPvzPvzIdStock404JSONResponse Error
+
+func (response GetPvzPvzIdStock404JSONResponse) VisitGetPvzPvzIdStockResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsRequestObject struct {
+	Body *PostReceptionsJSONRequestBody
+}
+
+type PostReceptionsResponseObject interface {
+	VisitPostReceptionsResponse(w http.ResponseWriter) error
+}
+
+type PostReceptions201JSONResponse Reception
+
+func (response PostReceptions201JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptions400JSONResponse Error
+
+func (response PostReceptions400JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptions403JSONResponse Error
+
+func (response PostReceptions403JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptions409JSONResponse Error
+
+func (response PostReceptions409JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionIdRequestObject struct {
+	ReceptionId uuid.UUID `json:"receptionId"`
+}
+
+type GetReceptionsReceptionIdResponseObject interface {
+	VisitGetReceptionsReceptionIdResponse(w http.ResponseWriter) error
+}
+
+type GetReceptionsReceptionId200JSONResponse ReceptionWithProducts
+
+func (response GetReceptionsReceptionId200JSONResponse) VisitGetReceptionsReceptionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionId403JSONResponse Error
+
+func (response GetReceptionsReceptionId403JSONResponse) VisitGetReceptionsReceptionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetReceptionsReceptionId404JSONResponse Error
+
+func (response GetReceptionsReceptionId404JSONResponse) VisitGetReceptionsReceptionIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdCancelRequestObject struct {
+	ReceptionId uuid.UUID `json:"receptionId"`
+	Body        *PostReceptionsReceptionIdCancelJSONRequestBody
+}
+
+type PostReceptionsReceptionIdCancelResponseObject interface {
+	VisitPostReceptionsReceptionIdCancelResponse(w http.ResponseWriter) error
+}
+
+type PostReceptionsReceptionIdCancel200JSONResponse Reception
+
+func (response PostReceptionsReceptionIdCancel200JSONResponse) VisitPostReceptionsReceptionIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdCancel400JSONResponse Error
+
+func (response PostReceptionsReceptionIdCancel400JSONResponse) VisitPostReceptionsReceptionIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdCancel403JSONResponse Error
+
+func (response PostReceptionsReceptionIdCancel403JSONResponse) VisitPostReceptionsReceptionIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdCancel404JSONResponse Error
+
+func (response PostReceptionsReceptionIdCancel404JSONResponse) VisitPostReceptionsReceptionIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdCancel409JSONResponse Error
+
+func (response PostReceptionsReceptionIdCancel409JSONResponse) VisitPostReceptionsReceptionIdCancelResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdReopenRequestObject struct {
+	ReceptionId uuid.UUID `json:"receptionId"`
+	Body        *PostReceptionsReceptionIdReopenJSONRequestBody
+}
+
+type PostReceptionsReceptionIdReopenResponseObject interface {
+	VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error
+}
+
+type PostReceptionsReceptionIdReopen200JSONResponse Reception
+
+func (response PostReceptionsReceptionIdReopen200JSONResponse) VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdReopen400JSONResponse Error
+
+func (response PostReceptionsReceptionIdReopen400JSONResponse) VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdReopen403JSONResponse Error
+
+func (response PostReceptionsReceptionIdReopen403JSONResponse) VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdReopen404JSONResponse Error
+
+func (response PostReceptionsReceptionIdReopen404JSONResponse) VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostReceptionsReceptionIdReopen409JSONResponse Error
+
+func (response PostReceptionsReceptionIdReopen409JSONResponse) VisitPostReceptionsReceptionIdReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRegisterRequestObject struct {
+	Body *PostRegisterJSONRequestBody
+}
+
+type PostRegisterResponseObject interface {
+	VisitPostRegisterResponse(w http.ResponseWriter) error
+}
+
+type PostRegister201JSONResponse User
+
+func (response PostRegister201JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRegister400JSONResponse Error
+
+func (response PostRegister400JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRegister403JSONResponse Error
+
+func (response PostRegister403JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRegisterAcceptInviteRequestObject struct {
+	Body *PostRegisterAcceptInviteJSONRequestBody
+}
+
+type PostRegisterAcceptInviteResponseObject interface {
+	VisitPostRegisterAcceptInviteResponse(w http.ResponseWriter) error
+}
+
+type PostRegisterAcceptInvite201JSONResponse User
+
+func (response PostRegisterAcceptInvite201JSONResponse) VisitPostRegisterAcceptInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRegisterAcceptInvite400JSONResponse Error
+
+func (response PostRegisterAcceptInvite400JSONResponse) VisitPostRegisterAcceptInviteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransfersRequestObject struct {
+	Body *PostTransfersJSONRequestBody
+}
+
+type PostTransfersResponseObject interface {
+	VisitPostTransfersResponse(w http.ResponseWriter) error
+}
+
+type PostTransfers201JSONResponse Transfer
+
+func (response PostTransfers201JSONResponse) VisitPostTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransfers400JSONResponse Error
+
+func (response PostTransfers400JSONResponse) VisitPostTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransfers403JSONResponse Error
+
+func (response PostTransfers403JSONResponse) VisitPostTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransfers404JSONResponse Error
+
+func (response PostTransfers404JSONResponse) VisitPostTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransfers409JSONResponse Error
+
+func (response PostTransfers409JSONResponse) VisitPostTransfersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransfersTransferIdRequestObject struct {
+	TransferId uuid.UUID `json:"transferId"`
+}
+
+type GetTransfersTransferIdResponseObject interface {
+	VisitGetTransfersTransferIdResponse(w http.ResponseWriter) error
+}
+
+type GetTransfersTransferId200JSONResponse Transfer
+
+func (response GetTransfersTransferId200JSONResponse) VisitGetTransfersTransferIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransfersTransferId403JSONResponse Error
+
+func (response GetTransfersTransferId403JSONResponse) VisitGetTransfersTransferIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTransfersTransferId404JSONResponse Error
+
+func (response GetTransfersTransferId404JSONResponse) VisitGetTransfersTransferIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransfersTransferIdDispatchRequestObject struct {
+	TransferId uuid.UUID `json:"transferId"`
+}
+
+type PostTransfersTransferIdDispatchResponseObject interface {
+	VisitPostTransfersTransferIdDispatchResponse(w http.ResponseWriter) error
+}
+
+type PostTransfersTransferIdDispatch200JSONResponse Transfer
+
+func (response PostTransfersTransferIdDispatch200JSONResponse) VisitPostTransfersTransferIdDispatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransfersTransferIdDispatch403JSONResponse Error
+
+func (response PostTransfersTransferIdDispatch403JSONResponse) VisitPostTransfersTransferIdDispatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransfersTransferIdDispatch404JSONResponse Error
+
+func (response PostTransfersTransferIdDispatch404JSONResponse) VisitPostTransfersTransferIdDispatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransfersTransferIdDispatch409JSONResponse Error
+
+func (response PostTransfersTransferIdDispatch409JSONResponse) VisitPostTransfersTransferIdDispatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransfersTransferIdReceiveRequestObject struct {
+	TransferId uuid.UUID `json:"transferId"`
+}
+
+type PostTransfersTransferIdReceiveResponseObject interface {
+	VisitPostTransfersTransferIdReceiveResponse(w http.ResponseWriter) error
+}
+
+type PostTransfersTransferIdReceive200JSONResponse Transfer
+
+func (response PostTransfersTransferIdReceive200JSONResponse) VisitPostTransfersTransferIdReceiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransfersTransferIdReceive400JSONResponse Error
+
+func (response PostTransfersTransferIdReceive400JSONResponse) VisitPostTransfersTransferIdReceiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransfersTransferIdReceive403JSONResponse Error
+
+func (response PostTransfersTransferIdReceive403JSONResponse) VisitPostTransfersTransferIdReceiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransfersTransferIdReceive404JSONResponse Error
+
+func (response PostTransfersTransferIdReceive404JSONResponse) VisitPostTransfersTransferIdReceiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTransfersTransferIdReceive409JSONResponse Error
+
+func (response PostTransfersTransferIdReceive409JSONResponse) VisitPostTransfersTransferIdReceiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersRequestObject struct {
+	Params GetUsersParams
+}
+
+type GetUsersResponseObject interface {
+	VisitGetUsersResponse(w http.ResponseWriter) error
+}
+
+type GetUsers200JSONResponse []User
+
+func (response GetUsers200JSONResponse) VisitGetUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsers403JSONResponse Error
+
+func (response GetUsers403JSONResponse) VisitGetUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserIdRequestObject struct {
+	UserId uuid.UUID `json:"userId"`
+}
+
+type GetUsersUserIdResponseObject interface {
+	VisitGetUsersUserIdResponse(w http.ResponseWriter) error
+}
+
+type GetUsersUserId200JSONResponse User
+
+func (response GetUsersUserId200JSONResponse) VisitGetUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserId403JSONResponse Error
+
+func (response GetUsersUserId403JSONResponse) VisitGetUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersUserId404JSONResponse Error
+
+func (response GetUsersUserId404JSONResponse) VisitGetUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersUserIdRequestObject struct {
+	UserId uuid.UUID `json:"userId"`
+	Body   *PatchUsersUserIdJSONRequestBody
+}
+
+type PatchUsersUserIdResponseObject interface {
+	VisitPatchUsersUserIdResponse(w http.ResponseWriter) error
+}
+
+type PatchUsersUserId200JSONResponse User
+
+func (response PatchUsersUserId200JSONResponse) VisitPatchUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersUserId400JSONResponse Error
+
+func (response PatchUsersUserId400JSONResponse) VisitPatchUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersUserId403JSONResponse Error
+
+func (response PatchUsersUserId403JSONResponse) VisitPatchUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchUsersUserId404JSONResponse Error
+
+func (response PatchUsersUserId404JSONResponse) VisitPatchUsersUserIdResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdResetPasswordRequestObject struct {
+	UserId uuid.UUID `json:"userId"`
+}
+
+type PostUsersUserIdResetPasswordResponseObject interface {
+	VisitPostUsersUserIdResetPasswordResponse(w http.ResponseWriter) error
+}
+
+type PostUsersUserIdResetPassword200JSONResponse struct {
+	TemporaryPassword string `json:"temporary_password"`
+}
+
+func (response PostUsersUserIdResetPassword200JSONResponse) VisitPostUsersUserIdResetPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdResetPassword403JSONResponse Error
+
+func (response PostUsersUserIdResetPassword403JSONResponse) VisitPostUsersUserIdResetPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersUserIdResetPassword404JSONResponse Error
+
+func (response PostUsersUserIdResetPassword404JSONResponse) VisitPostUsersUserIdResetPasswordResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Список API-ключей (только для модераторов)
+	// (GET /api-keys)
+	GetApiKeys(ctx context.Context, request GetApiKeysRequestObject) (GetApiKeysResponseObject, error)
+	// Создание API-ключа (только для модераторов)
+	// (POST /api-keys)
+	PostApiKeys(ctx context.Context, request PostApiKeysRequestObject) (PostApiKeysResponseObject, error)
+	// Отзыв API-ключа (только для модераторов)
+	// (DELETE /api-keys/{keyId})
+	DeleteApiKeysKeyId(ctx context.Context, request DeleteApiKeysKeyIdRequestObject) (DeleteApiKeysKeyIdResponseObject, error)
+	// Журнал аудита с фильтрацией и курсорной пагинацией (только для модераторов)
+	// (GET /audit)
+	GetAudit(ctx context.Context, request GetAuditRequestObject) (GetAuditResponseObject, error)
+	// Справочник городов
+	// (GET /cities)
+	GetCities(ctx context.Context, request GetCitiesRequestObject) (GetCitiesResponseObject, error)
+	// Добавление города (только для модераторов)
+	// (POST /cities)
+	PostCities(ctx context.Context, request PostCitiesRequestObject) (PostCitiesResponseObject, error)
+	// Включение или выключение города (только для модераторов)
+	// (PATCH /cities/{code})
+	PatchCitiesCode(ctx context.Context, request PatchCitiesCodeRequestObject) (PatchCitiesCodeResponseObject, error)
+	// Получение тестового токена
+	// (POST /dummyLogin)
+	PostDummyLogin(ctx context.Context, request PostDummyLoginRequestObject) (PostDummyLoginResponseObject, error)
+	// Создание одноразового приглашения (только для модераторов)
+	// (POST /invites)
+	PostInvites(ctx context.Context, request PostInvitesRequestObject) (PostInvitesResponseObject, error)
+	// Авторизация пользователя
+	// (POST /login)
+	PostLogin(ctx context.Context, request PostLoginRequestObject) (PostLoginResponseObject, error)
+	// Завершение авторизации кодом TOTP или кодом восстановления
+	// (POST /login/mfa)
+	PostLoginMfa(ctx context.Context, request PostLoginMfaRequestObject) (PostLoginMfaResponseObject, error)
+	// Подключение TOTP, выдает секрет для приложения-аутентификатора
+	// (POST /mfa/enroll)
+	PostMfaEnroll(ctx context.Context, request PostMfaEnrollRequestObject) (PostMfaEnrollResponseObject, error)
+	// Подтверждение TOTP и включение второго фактора
+	// (POST /mfa/verify)
+	PostMfaVerify(ctx context.Context, request PostMfaVerifyRequestObject) (PostMfaVerifyResponseObject, error)
+	// Запрос кода для сброса пароля
+	// (POST /password/forgot)
+	PostPasswordForgot(ctx context.Context, request PostPasswordForgotRequestObject) (PostPasswordForgotResponseObject, error)
+	// Установка нового пароля по коду
+	// (POST /password/reset)
+	PostPasswordReset(ctx context.Context, request PostPasswordResetRequestObject) (PostPasswordResetResponseObject, error)
+	// Справочник типов товаров
+	// (GET /product-types)
+	GetProductTypes(ctx context.Context, request GetProductTypesRequestObject) (GetProductTypesResponseObject, error)
+	// Добавление типа товара (только для модераторов)
+	// (POST /product-types)
+	PostProductTypes(ctx context.Context, request PostProductTypesRequestObject) (PostProductTypesResponseObject, error)
+	// Удаление типа товара (только для модераторов)
+	// (DELETE /product-types/{code})
+	DeleteProductTypesCode(ctx context.Context, request DeleteProductTypesCodeRequestObject) (DeleteProductTypesCodeResponseObject, error)
+	// Изменение типа товара (только для модераторов)
+	// (PATCH /product-types/{code})
+	PatchProductTypesCode(ctx context.Context, request PatchProductTypesCodeRequestObject) (PatchProductTypesCodeResponseObject, error)
+	// Поиск товаров по штрихкоду
+	// (GET /products)
+	GetProducts(ctx context.Context, request GetProductsRequestObject) (GetProductsResponseObject, error)
+	// Добавление товара в текущую приемку (только для сотрудников ПВЗ)
+	// (POST /products)
+	PostProducts(ctx context.Context, request PostProductsRequestObject) (PostProductsResponseObject, error)
+	// Добавление пакета товаров в текущую приемку (только для сотрудников ПВЗ)
+	// (POST /products/batch)
+	PostProductsBatch(ctx context.Context, request PostProductsBatchRequestObject) (PostProductsBatchResponseObject, error)
+	// Вложения товара
+	// (GET /products/{productId}/attachments)
+	GetProductsProductIdAttachments(ctx context.Context, request GetProductsProductIdAttachmentsRequestObject) (GetProductsProductIdAttachmentsResponseObject, error)
+	// Загрузка фото товара, например, повреждений упаковки (только для сотрудников ПВЗ)
+	// (POST /products/{productId}/attachments)
+	PostProductsProductIdAttachments(ctx context.Context, request PostProductsProductIdAttachmentsRequestObject) (PostProductsProductIdAttachmentsResponseObject, error)
+	// Скачивание вложения товара
+	// (GET /products/{productId}/attachments/{attachmentId})
+	GetProductsProductIdAttachmentsAttachmentId(ctx context.Context, request GetProductsProductIdAttachmentsAttachmentIdRequestObject) (GetProductsProductIdAttachmentsAttachmentIdResponseObject, error)
+	// История движения товара
+	// (GET /products/{productId}/events)
+	GetProductsProductIdEvents(ctx context.Context, request GetProductsProductIdEventsRequestObject) (GetProductsProductIdEventsResponseObject, error)
+	// Выдача товара получателю по коду получения
+	// (POST /products/{productId}/issue)
+	PostProductsProductIdIssue(ctx context.Context, request PostProductsProductIdIssueRequestObject) (PostProductsProductIdIssueResponseObject, error)
+	// Перемещение товара в другую ячейку хранения (только для сотрудников ПВЗ)
+	// (POST /products/{productId}/move)
+	PostProductsProductIdMove(ctx context.Context, request PostProductsProductIdMoveRequestObject) (PostProductsProductIdMoveResponseObject, error)
+	// Задание кода получения товару, принятому без него
+	// (PUT /products/{productId}/pickup-code)
+	PutProductsProductIdPickupCode(ctx context.Context, request PutProductsProductIdPickupCodeRequestObject) (PutProductsProductIdPickupCodeResponseObject, error)
+	// Получение списка ПВЗ с фильтрацией по дате приемки и пагинацией
+	// (GET /pvz)
+	GetPvz(ctx context.Context, request GetPvzRequestObject) (GetPvzResponseObject, error)
+	// Создание ПВЗ (только для модераторов)
+	// (POST /pvz)
+	PostPvz(ctx context.Context, request PostPvzRequestObject) (PostPvzResponseObject, error)
+	// Получение ПВЗ с текущим состоянием
+	// (GET /pvz/{pvzId})
+	GetPvzPvzId(ctx context.Context, request GetPvzPvzIdRequestObject) (GetPvzPvzIdResponseObject, error)
+	// Изменение состояния ПВЗ (только для модераторов)
+	// (PATCH /pvz/{pvzId})
+	PatchPvzPvzId(ctx context.Context, request PatchPvzPvzIdRequestObject) (PatchPvzPvzIdResponseObject, error)
+	// Изменение вместимости ПВЗ (только для модераторов)
+	// (PUT /pvz/{pvzId}/capacity)
+	PutPvzPvzIdCapacity(ctx context.Context, request PutPvzPvzIdCapacityRequestObject) (PutPvzPvzIdCapacityResponseObject, error)
+	// Ячейки хранения ПВЗ и их заполненность
+	// (GET /pvz/{pvzId}/cells)
+	GetPvzPvzIdCells(ctx context.Context, request GetPvzPvzIdCellsRequestObject) (GetPvzPvzIdCellsResponseObject, error)
+	// Добавление ячейки хранения (только для модераторов)
+	// (POST /pvz/{pvzId}/cells)
+	PostPvzPvzIdCells(ctx context.Context, request PostPvzPvzIdCellsRequestObject) (PostPvzPvzIdCellsResponseObject, error)
+	// Закрытие последней открытой приемки товаров в рамках ПВЗ
+	// (POST /pvz/{pvzId}/close_last_reception)
+	PostPvzPvzIdCloseLastReception(ctx context.Context, request PostPvzPvzIdCloseLastReceptionRequestObject) (PostPvzPvzIdCloseLastReceptionResponseObject, error)
+	// Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)
+	// (POST /pvz/{pvzId}/delete_last_product)
+	PostPvzPvzIdDeleteLastProduct(ctx context.Context, request PostPvzPvzIdDeleteLastProductRequestObject) (PostPvzPvzIdDeleteLastProductResponseObject, error)
+	// История приемок ПВЗ с фильтрацией и курсорной пагинацией
+	// (GET /pvz/{pvzId}/receptions)
+	GetPvzPvzIdReceptions(ctx context.Context, request GetPvzPvzIdReceptionsRequestObject) (GetPvzPvzIdReceptionsResponseObject, error)
+	// Отправка возврата отправителю
+	// (POST /pvz/{pvzId}/return-shipments)
+	PostPvzPvzIdReturnShipments(ctx context.Context, request PostPvzPvzIdReturnShipmentsRequestObject) (PostPvzPvzIdReturnShipmentsResponseObject, error)
+	// Товары на хранении в ПВЗ
+	// (GET /pvz/{pvzId}/stock)
+	GetPvzPvzIdStock(ctx context.Context, request GetPvzPvzIdStockRequestObject) (GetPvzPvzIdStockResponseObject, error)
+	// Создание новой приемки товаров (только для сотрудников ПВЗ)
+	// (POST /receptions)
+	PostReceptions(ctx context.Context, request PostReceptionsRequestObject) (PostReceptionsResponseObject, error)
+	// Получение приемки с товарами
+	// (GET /receptions/{receptionId})
+	GetReceptionsReceptionId(ctx context.Context, request GetReceptionsReceptionIdRequestObject) (GetReceptionsReceptionIdResponseObject, error)
+	// Отмена приемки (только для модераторов)
+	// (POST /receptions/{receptionId}/cancel)
+	PostReceptionsReceptionIdCancel(ctx context.Context, request PostReceptionsReceptionIdCancelRequestObject) (PostReceptionsReceptionIdCancelResponseObject, error)
+	// Повторное открытие закрытой приемки (только для модераторов)
+	// (POST /receptions/{receptionId}/reopen)
+	PostReceptionsReceptionIdReopen(ctx context.Context, request PostReceptionsReceptionIdReopenRequestObject) (PostReceptionsReceptionIdReopenResponseObject, error)
+	// Регистрация пользователя
+	// (POST /register)
+	PostRegister(ctx context.Context, request PostRegisterRequestObject) (PostRegisterResponseObject, error)
+	// Регистрация по приглашению
+	// (POST /register/accept-invite)
+	PostRegisterAcceptInvite(ctx context.Context, request PostRegisterAcceptInviteRequestObject) (PostRegisterAcceptInviteResponseObject, error)
+	// Создание перемещения товаров в другой ПВЗ (только для сотрудников ПВЗ)
+	// (POST /transfers)
+	PostTransfers(ctx context.Context, request PostTransfersRequestObject) (PostTransfersResponseObject, error)
+	// Получение перемещения
+	// (GET /transfers/{transferId})
+	GetTransfersTransferId(ctx context.Context, request GetTransfersTransferIdRequestObject) (GetTransfersTransferIdResponseObject, error)
+	// Отправка перемещения (только для сотрудников ПВЗ)
+	// (POST /transfers/{transferId}/dispatch)
+	PostTransfersTransferIdDispatch(ctx context.Context, request PostTransfersTransferIdDispatchRequestObject) (PostTransfersTransferIdDispatchResponseObject, error)
+	// Прием перемещения в ПВЗ назначения (только для сотрудников ПВЗ)
+	// (POST /transfers/{transferId}/receive)
+	PostTransfersTransferIdReceive(ctx context.Context, request PostTransfersTransferIdReceiveRequestObject) (PostTransfersTransferIdReceiveResponseObject, error)
+	// Получение списка пользователей с фильтрацией и пагинацией (только для модераторов)
+	// (GET /users)
+	GetUsers(ctx context.Context, request GetUsersRequestObject) (GetUsersResponseObject, error)
+	// Получение пользователя (только для модераторов)
+	// (GET /users/{userId})
+	GetUsersUserId(ctx context.Context, request GetUsersUserIdRequestObject) (GetUsersUserIdResponseObject, error)
+	// Изменение роли, активности или ПВЗ пользователя (только для модераторов)
+	// (PATCH /users/{userId})
+	PatchUsersUserId(ctx context.Context, request PatchUsersUserIdRequestObject) (PatchUsersUserIdResponseObject, error)
+	// Сброс пароля пользователя на временный (только для модераторов)
+	// (POST /users/{userId}/reset-password)
+	PostUsersUserIdResetPassword(ctx context.Context, request PostUsersUserIdResetPasswordRequestObject) (PostUsersUserIdResetPasswordResponseObject, error)
+}
+
+type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
+type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetApiKeys operation middleware
+func (sh *strictHandler) GetApiKeys(ctx *gin.Context) {
+	var request GetApiKeysRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetApiKeys(ctx, request.(GetApiKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetApiKeys")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetApiKeysResponseObject); ok {
+		if err := validResponse.VisitGetApiKeysResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostApiKeys operation middleware
+func (sh *strictHandler) PostApiKeys(ctx *gin.Context) {
+	var request PostApiKeysRequestObject
+
+	var body PostApiKeysJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostApiKeys(ctx, request.(PostApiKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostApiKeys")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostApiKeysResponseObject); ok {
+		if err := validResponse.VisitPostApiKeysResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteApiKeysKeyId operation middleware
+func (sh *strictHandler) DeleteApiKeysKeyId(ctx *gin.Context, keyId uuid.UUID) {
+	var request DeleteApiKeysKeyIdRequestObject
+
+	request.KeyId = keyId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteApiKeysKeyId(ctx, request.(DeleteApiKeysKeyIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteApiKeysKeyId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteApiKeysKeyIdResponseObject); ok {
+		if err := validResponse.VisitDeleteApiKeysKeyIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAudit operation middleware
+func (sh *strictHandler) GetAudit(ctx *gin.Context, params GetAuditParams) {
+	var request GetAuditRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAudit(ctx, request.(GetAuditRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAudit")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAuditResponseObject); ok {
+		if err := validResponse.VisitGetAuditResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCities operation middleware
+func (sh *strictHandler) GetCities(ctx *gin.Context, params GetCitiesParams) {
+	var request GetCitiesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCities(ctx, request.(GetCitiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCities")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetCitiesResponseObject); ok {
+		if err := validResponse.VisitGetCitiesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostCities operation middleware
+func (sh *strictHandler) PostCities(ctx *gin.Context) {
+	var request PostCitiesRequestObject
+
+	var body PostCitiesJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostCities(ctx, request.(PostCitiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostCities")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostCitiesResponseObject); ok {
+		if err := validResponse.VisitPostCitiesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchCitiesCode operation middleware
+func (sh *strictHandler) PatchCitiesCode(ctx *gin.Context, code string) {
+	var request PatchCitiesCodeRequestObject
+
+	request.Code = code
+
+	var body PatchCitiesCodeJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchCitiesCode(ctx, request.(PatchCitiesCodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchCitiesCode")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PatchCitiesCodeResponseObject); ok {
+		if err := validResponse.VisitPatchCitiesCodeResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostDummyLogin operation middleware
+func (sh *strictHandler) PostDummyLogin(ctx *gin.Context) {
+	var request PostDummyLoginRequestObject
+
+	var body PostDummyLoginJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostDummyLogin(ctx, request.(PostDummyLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostDummyLogin")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostDummyLoginResponseObject); ok {
+		if err := validResponse.VisitPostDummyLoginResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostInvites operation middleware
+func (sh *strictHandler) PostInvites(ctx *gin.Context) {
+	var request PostInvitesRequestObject
+
+	var body PostInvitesJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostInvites(ctx, request.(PostInvitesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostInvites")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostInvitesResponseObject); ok {
+		if err := validResponse.VisitPostInvitesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostLogin operation middleware
+func (sh *strictHandler) PostLogin(ctx *gin.Context) {
+	var request PostLoginRequestObject
+
+	var body PostLoginJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostLogin(ctx, request.(PostLoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostLogin")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostLoginResponseObject); ok {
+		if err := validResponse.VisitPostLoginResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostLoginMfa operation middleware
+func (sh *strictHandler) PostLoginMfa(ctx *gin.Context) {
+	var request PostLoginMfaRequestObject
+
+	var body PostLoginMfaJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostLoginMfa(ctx, request.(PostLoginMfaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostLoginMfa")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostLoginMfaResponseObject); ok {
+		if err := validResponse.VisitPostLoginMfaResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostMfaEnroll operation middleware
+func (sh *strictHandler) PostMfaEnroll(ctx *gin.Context) {
+	var request PostMfaEnrollRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostMfaEnroll(ctx, request.(PostMfaEnrollRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostMfaEnroll")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostMfaEnrollResponseObject); ok {
+		if err := validResponse.VisitPostMfaEnrollResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostMfaVerify operation middleware
+func (sh *strictHandler) PostMfaVerify(ctx *gin.Context) {
+	var request PostMfaVerifyRequestObject
+
+	var body PostMfaVerifyJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostMfaVerify(ctx, request.(PostMfaVerifyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostMfaVerify")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostMfaVerifyResponseObject); ok {
+		if err := validResponse.VisitPostMfaVerifyResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPasswordForgot operation middleware
+func (sh *strictHandler) PostPasswordForgot(ctx *gin.Context) {
+	var request PostPasswordForgotRequestObject
+
+	var body PostPasswordForgotJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPasswordForgot(ctx, request.(PostPasswordForgotRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPasswordForgot")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPasswordForgotResponseObject); ok {
+		if err := validResponse.VisitPostPasswordForgotResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPasswordReset operation middleware
+func (sh *strictHandler) PostPasswordReset(ctx *gin.Context) {
+	var request PostPasswordResetRequestObject
+
+	var body PostPasswordResetJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPasswordReset(ctx, request.(PostPasswordResetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPasswordReset")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPasswordResetResponseObject); ok {
+		if err := validResponse.VisitPostPasswordResetResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetProductTypes operation middleware
+func (sh *strictHandler) GetProductTypes(ctx *gin.Context) {
+	var request GetProductTypesRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProductTypes(ctx, request.(GetProductTypesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProductTypes")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetProductTypesResponseObject); ok {
+		if err := validResponse.VisitGetProductTypesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostProductTypes operation middleware
+func (sh *strictHandler) PostProductTypes(ctx *gin.Context) {
+	var request PostProductTypesRequestObject
+
+	var body PostProductTypesJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProductTypes(ctx, request.(PostProductTypesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProductTypes")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostProductTypesResponseObject); ok {
+		if err := validResponse.VisitPostProductTypesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteProductTypesCode operation middleware
+func (sh *strictHandler) DeleteProductTypesCode(ctx *gin.Context, code string) {
+	var request DeleteProductTypesCodeRequestObject
+
+	request.Code = code
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteProductTypesCode(ctx, request.(DeleteProductTypesCodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteProductTypesCode")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteProductTypesCodeResponseObject); ok {
+		if err := validResponse.VisitDeleteProductTypesCodeResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchProductTypesCode operation middleware
+func (sh *strictHandler) PatchProductTypesCode(ctx *gin.Context, code string) {
+	var request PatchProductTypesCodeRequestObject
+
+	request.Code = code
+
+	var body PatchProductTypesCodeJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchProductTypesCode(ctx, request.(PatchProductTypesCodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchProductTypesCode")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PatchProductTypesCodeResponseObject); ok {
+		if err := validResponse.VisitPatchProductTypesCodeResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetProducts operation middleware
+func (sh *strictHandler) GetProducts(ctx *gin.Context, params GetProductsParams) {
+	var request GetProductsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProducts(ctx, request.(GetProductsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProducts")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetProductsResponseObject); ok {
+		if err := validResponse.VisitGetProductsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostProducts operation middleware
+func (sh *strictHandler) PostProducts(ctx *gin.Context) {
+	var request PostProductsRequestObject
+
+	var body PostProductsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProducts(ctx, request.(PostProductsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProducts")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostProductsResponseObject); ok {
+		if err := validResponse.VisitPostProductsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostProductsBatch operation middleware
+func (sh *strictHandler) PostProductsBatch(ctx *gin.Context) {
+	var request PostProductsBatchRequestObject
+
+	var body PostProductsBatchJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProductsBatch(ctx, request.(PostProductsBatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProductsBatch")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostProductsBatchResponseObject); ok {
+		if err := validResponse.VisitPostProductsBatchResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetProductsProductIdAttachments operation middleware
+func (sh *strictHandler) GetProductsProductIdAttachments(ctx *gin.Context, productId uuid.UUID) {
+	var request GetProductsProductIdAttachmentsRequestObject
+
+	request.ProductId = productId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProductsProductIdAttachments(ctx, request.(GetProductsProductIdAttachmentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProductsProductIdAttachments")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetProductsProductIdAttachmentsResponseObject); ok {
+		if err := validResponse.VisitGetProductsProductIdAttachmentsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostProductsProductIdAttachments operation middleware
+func (sh *strictHandler) PostProductsProductIdAttachments(ctx *gin.Context, productId uuid.UUID) {
+	var request PostProductsProductIdAttachmentsRequestObject
+
+	request.ProductId = productId
+
+	if reader, err := ctx.Request.MultipartReader(); err == nil {
+		request.Body = reader
+	} else {
+		ctx.Error(err)
+		return
+	}
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProductsProductIdAttachments(ctx, request.(PostProductsProductIdAttachmentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProductsProductIdAttachments")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostProductsProductIdAttachmentsResponseObject); ok {
+		if err := validResponse.VisitPostProductsProductIdAttachmentsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetProductsProductIdAttachmentsAttachmentId operation middleware
+func (sh *strictHandler) GetProductsProductIdAttachmentsAttachmentId(ctx *gin.Context, productId uuid.UUID, attachmentId uuid.UUID) {
+	var request GetProductsProductIdAttachmentsAttachmentIdRequestObject
+
+	request.ProductId = productId
+	request.AttachmentId = attachmentId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProductsProductIdAttachmentsAttachmentId(ctx, request.(GetProductsProductIdAttachmentsAttachmentIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProductsProductIdAttachmentsAttachmentId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetProductsProductIdAttachmentsAttachmentIdResponseObject); ok {
+		if err := validResponse.VisitGetProductsProductIdAttachmentsAttachmentIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetProductsProductIdEvents operation middleware
+func (sh *strictHandler) GetProductsProductIdEvents(ctx *gin.Context, productId uuid.UUID) {
+	var request GetProductsProductIdEventsRequestObject
+
+	request.ProductId = productId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetProductsProductIdEvents(ctx, request.(GetProductsProductIdEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetProductsProductIdEvents")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetProductsProductIdEventsResponseObject); ok {
+		if err := validResponse.VisitGetProductsProductIdEventsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostProductsProductIdIssue operation middleware
+func (sh *strictHandler) PostProductsProductIdIssue(ctx *gin.Context, productId uuid.UUID) {
+	var request PostProductsProductIdIssueRequestObject
+
+	request.ProductId = productId
+
+	var body PostProductsProductIdIssueJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProductsProductIdIssue(ctx, request.(PostProductsProductIdIssueRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProductsProductIdIssue")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostProductsProductIdIssueResponseObject); ok {
+		if err := validResponse.VisitPostProductsProductIdIssueResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostProductsProductIdMove operation middleware
+func (sh *strictHandler) PostProductsProductIdMove(ctx *gin.Context, productId uuid.UUID) {
+	var request PostProductsProductIdMoveRequestObject
+
+	request.ProductId = productId
+
+	var body PostProductsProductIdMoveJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostProductsProductIdMove(ctx, request.(PostProductsProductIdMoveRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostProductsProductIdMove")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostProductsProductIdMoveResponseObject); ok {
+		if err := validResponse.VisitPostProductsProductIdMoveResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutProductsProductIdPickupCode operation middleware
+func (sh *strictHandler) PutProductsProductIdPickupCode(ctx *gin.Context, productId uuid.UUID) {
+	var request PutProductsProductIdPickupCodeRequestObject
+
+	request.ProductId = productId
+
+	var body PutProductsProductIdPickupCodeJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutProductsProductIdPickupCode(ctx, request.(PutProductsProductIdPickupCodeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutProductsProductIdPickupCode")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutProductsProductIdPickupCodeResponseObject); ok {
+		if err := validResponse.VisitPutProductsProductIdPickupCodeResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPvz operation middleware
+func (sh *strictHandler) GetPvz(ctx *gin.Context, params GetPvzParams) {
+	var request GetPvzRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPvz(ctx, request.(GetPvzRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPvz")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetPvzResponseObject); ok {
+		if err := validResponse.VisitGetPvzResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPvz operation middleware
+func (sh *strictHandler) PostPvz(ctx *gin.Context) {
+	var request PostPvzRequestObject
+
+	var body PostPvzJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPvz(ctx, request.(PostPvzRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPvz")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPvzResponseObject); ok {
+		if err := validResponse.VisitPostPvzResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPvzPvzId operation middleware
+func (sh *strictHandler) GetPvzPvzId(ctx *gin.Context, pvzId uuid.UUID) {
+	var request GetPvzPvzIdRequestObject
+
+	request.PvzId = pvzId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPvzPvzId(ctx, request.(GetPvzPvzIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPvzPvzId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetPvzPvzIdResponseObject); ok {
+		if err := validResponse.VisitGetPvzPvzIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchPvzPvzId operation middleware
+func (sh *strictHandler) PatchPvzPvzId(ctx *gin.Context, pvzId uuid.UUID) {
+	var request PatchPvzPvzIdRequestObject
+
+	request.PvzId = pvzId
+
+	var body PatchPvzPvzIdJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchPvzPvzId(ctx, request.(PatchPvzPvzIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchPvzPvzId")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PatchPvzPvzIdResponseObject); ok {
+		if err := validResponse.VisitPatchPvzPvzIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PutPvzPvzIdCapacity operation middleware
+func (sh *strictHandler) PutPvzPvzIdCapacity(ctx *gin.Context, pvzId uuid.UUID) {
+	var request PutPvzPvzIdCapacityRequestObject
+
+	request.PvzId = pvzId
+
+	var body PutPvzPvzIdCapacityJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PutPvzPvzIdCapacity(ctx, request.(PutPvzPvzIdCapacityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PutPvzPvzIdCapacity")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PutPvzPvzIdCapacityResponseObject); ok {
+		if err := validResponse.VisitPutPvzPvzIdCapacityResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPvzPvzIdCells operation middleware
+func (sh *strictHandler) GetPvzPvzIdCells(ctx *gin.Context, pvzId uuid.UUID) {
+	var request GetPvzPvzIdCellsRequestObject
+
+	request.PvzId = pvzId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPvzPvzIdCells(ctx, request.(GetPvzPvzIdCellsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPvzPvzIdCells")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetPvzPvzIdCellsResponseObject); ok {
+		if err := validResponse.VisitGetPvzPvzIdCellsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPvzPvzIdCells operation middleware
+func (sh *strictHandler) PostPvzPvzIdCells(ctx *gin.Context, pvzId uuid.UUID) {
+	var request PostPvzPvzIdCellsRequestObject
+
+	request.PvzId = pvzId
+
+	var body PostPvzPvzIdCellsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPvzPvzIdCells(ctx, request.(PostPvzPvzIdCellsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPvzPvzIdCells")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPvzPvzIdCellsResponseObject); ok {
+		if err := validResponse.VisitPostPvzPvzIdCellsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPvzPvzIdCloseLastReception operation middleware
+func (sh *strictHandler) PostPvzPvzIdCloseLastReception(ctx *gin.Context, pvzId uuid.UUID, params PostPvzPvzIdCloseLastReceptionParams) {
+	var request PostPvzPvzIdCloseLastReceptionRequestObject
+
+	request.PvzId = pvzId
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPvzPvzIdCloseLastReception(ctx, request.(PostPvzPvzIdCloseLastReceptionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPvzPvzIdCloseLastReception")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPvzPvzIdCloseLastReceptionResponseObject); ok {
+		if err := validResponse.VisitPostPvzPvzIdCloseLastReceptionResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPvzPvzIdDeleteLastProduct operation middleware
+func (sh *strictHandler) PostPvzPvzIdDeleteLastProduct(ctx *gin.Context, pvzId uuid.UUID) {
+	var request PostPvzPvzIdDeleteLastProductRequestObject
+
+	request.PvzId = pvzId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPvzPvzIdDeleteLastProduct(ctx, request.(PostPvzPvzIdDeleteLastProductRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPvzPvzIdDeleteLastProduct")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPvzPvzIdDeleteLastProductResponseObject); ok {
+		if err := validResponse.VisitPostPvzPvzIdDeleteLastProductResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPvzPvzIdReceptions operation middleware
+func (sh *strictHandler) GetPvzPvzIdReceptions(ctx *gin.Context, pvzId uuid.UUID, params GetPvzPvzIdReceptionsParams) {
+	var request GetPvzPvzIdReceptionsRequestObject
+
+	request.PvzId = pvzId
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPvzPvzIdReceptions(ctx, request.(GetPvzPvzIdReceptionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPvzPvzIdReceptions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetPvzPvzIdReceptionsResponseObject); ok {
+		if err := validResponse.VisitGetPvzPvzIdReceptionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPvzPvzIdReturnShipments operation middleware
+func (sh *strictHandler) PostPvzPvzIdReturnShipments(ctx *gin.Context, pvzId uuid.UUID) {
+	var request PostPvzPvzIdReturnShipmentsRequestObject
+
+	request.PvzId = pvzId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPvzPvzIdReturnShipments(ctx, request.(PostPvzPvzIdReturnShipmentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPvzPvzIdReturnShipments")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPvzPvzIdReturnShipmentsResponseObject); ok {
+		if err := validResponse.VisitPostPvzPvzIdReturnShipmentsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPvzPvzIdStock operation middleware
+func (sh *strictHandler) GetPvzPvzIdStock(ctx *gin.Context, pvzId uuid.UUID, params GetPvzPvzIdStockParams) {
+	var request GetPvzPvzIdStockRequestObject
+
+	request.PvzId = pvzId
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPvzPvzIdStock(ctx, request.(GetPvzPvzIdStockRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPvzPvzIdStock")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetPvzPvzIdStockResponseObject); ok {
+		if err := validResponse.VisitGetPvzPvzIdStockResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostReceptions400JSONResponse Error
+// PostReceptions operation middleware
+func (sh *strictHandler) PostReceptions(ctx *gin.Context) {
+	var request PostReceptionsRequestObject
 
-func (response PostReceptions400JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var body PostReceptionsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-	return json.NewEncoder(w).Encode(response)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostReceptions(ctx, request.(PostReceptionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostReceptions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostReceptionsResponseObject); ok {
+		if err := validResponse.VisitPostReceptionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostReceptions403JSONResponse Error
+// GetReceptionsReceptionId operation middleware
+func (sh *strictHandler) GetReceptionsReceptionId(ctx *gin.Context, receptionId uuid.UUID) {
+	var request GetReceptionsReceptionIdRequestObject
 
-func (response PostReceptions403JSONResponse) VisitPostReceptionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	request.ReceptionId = receptionId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetReceptionsReceptionId(ctx, request.(GetReceptionsReceptionIdRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetReceptionsReceptionId")
+	}
 
-type PostRegisterRequestObject struct {
-	Body *PostRegisterJSONRequestBody
-}
+	response, err := handler(ctx, request)
 
-type PostRegisterResponseObject interface {
-	VisitPostRegisterResponse(w http.ResponseWriter) error
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetReceptionsReceptionIdResponseObject); ok {
+		if err := validResponse.VisitGetReceptionsReceptionIdResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostRegister201JSONResponse User
+// PostReceptionsReceptionIdCancel operation middleware
+func (sh *strictHandler) PostReceptionsReceptionIdCancel(ctx *gin.Context, receptionId uuid.UUID) {
+	var request PostReceptionsReceptionIdCancelRequestObject
 
-func (response PostRegister201JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	request.ReceptionId = receptionId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var body PostReceptionsReceptionIdCancelJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-type PostRegister400JSONResponse Error
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostReceptionsReceptionIdCancel(ctx, request.(PostReceptionsReceptionIdCancelRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostReceptionsReceptionIdCancel")
+	}
 
-func (response PostRegister400JSONResponse) VisitPostRegisterResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostReceptionsReceptionIdCancelResponseObject); ok {
+		if err := validResponse.VisitPostReceptionsReceptionIdCancelResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Получение тестового токена
-	// (POST /dummyLogin)
-	PostDummyLogin(ctx context.Context, request PostDummyLoginRequestObject) (PostDummyLoginResponseObject, error)
-	// Авторизация пользователя
-	// (POST /login)
-	PostLogin(ctx context.Context, request PostLoginRequestObject) (PostLoginResponseObject, error)
-	// Добавление товара в текущую приемку (только для сотрудников ПВЗ)
-	// (POST /products)
-	PostProducts(ctx context.Context, request PostProductsRequestObject) (PostProductsResponseObject, error)
-	// Получение списка ПВЗ с фильтрацией по дате приемки и пагинацией
-	// (GET /pvz)
-	GetPvz(ctx context.Context, request GetPvzRequestObject) (GetPvzResponseObject, error)
-	// Создание ПВЗ (только для модераторов)
-	// (POST /pvz)
-	PostPvz(ctx context.Context, request PostPvzRequestObject) (PostPvzResponseObject, error)
-	// Закрытие последней открытой приемки товаров в рамках ПВЗ
-	// (POST /pvz/{pvzId}/close_last_reception)
-	PostPvzPvzIdCloseLastReception(ctx context.Context, request PostPvzPvzIdCloseLastReceptionRequestObject) (PostPvzPvzIdCloseLastReceptionResponseObject, error)
-	// Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)
-	// (POST /pvz/{pvzId}/delete_last_product)
-	PostPvzPvzIdDeleteLastProduct(ctx context.Context, request PostPvzPvzIdDeleteLastProductRequestObject) (PostPvzPvzIdDeleteLastProductResponseObject, error)
-	// Создание новой приемки товаров (только для сотрудников ПВЗ)
-	// (POST /receptions)
-	PostReceptions(ctx context.Context, request PostReceptionsRequestObject) (PostReceptionsResponseObject, error)
-	// Регистрация пользователя
-	// (POST /register)
-	PostRegister(ctx context.Context, request PostRegisterRequestObject) (PostRegisterResponseObject, error)
-}
+// PostReceptionsReceptionIdReopen operation middleware
+func (sh *strictHandler) PostReceptionsReceptionIdReopen(ctx *gin.Context, receptionId uuid.UUID) {
+	var request PostReceptionsReceptionIdReopenRequestObject
 
-type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
-type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+	request.ReceptionId = receptionId
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+	var body PostReceptionsReceptionIdReopenJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostReceptionsReceptionIdReopen(ctx, request.(PostReceptionsReceptionIdReopenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostReceptionsReceptionIdReopen")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostReceptionsReceptionIdReopenResponseObject); ok {
+		if err := validResponse.VisitPostReceptionsReceptionIdReopenResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// PostDummyLogin operation middleware
-func (sh *strictHandler) PostDummyLogin(ctx *gin.Context) {
-	var request PostDummyLoginRequestObject
+// PostRegister operation middleware
+func (sh *strictHandler) PostRegister(ctx *gin.Context) {
+	var request PostRegisterRequestObject
 
-	var body PostDummyLoginJSONRequestBody
+	var body PostRegisterJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -791,10 +6529,10 @@ func (sh *strictHandler) PostDummyLogin(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostDummyLogin(ctx, request.(PostDummyLoginRequestObject))
+		return sh.ssi.PostRegister(ctx, request.(PostRegisterRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostDummyLogin")
+		handler = middleware(handler, "PostRegister")
 	}
 
 	response, err := handler(ctx, request)
@@ -802,8 +6540,8 @@ func (sh *strictHandler) PostDummyLogin(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostDummyLoginResponseObject); ok {
-		if err := validResponse.VisitPostDummyLoginResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostRegisterResponseObject); ok {
+		if err := validResponse.VisitPostRegisterResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -811,11 +6549,11 @@ func (sh *strictHandler) PostDummyLogin(ctx *gin.Context) {
 	}
 }
 
-// PostLogin operation middleware
-func (sh *strictHandler) PostLogin(ctx *gin.Context) {
-	var request PostLoginRequestObject
+// PostRegisterAcceptInvite operation middleware
+func (sh *strictHandler) PostRegisterAcceptInvite(ctx *gin.Context) {
+	var request PostRegisterAcceptInviteRequestObject
 
-	var body PostLoginJSONRequestBody
+	var body PostRegisterAcceptInviteJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -824,10 +6562,10 @@ func (sh *strictHandler) PostLogin(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostLogin(ctx, request.(PostLoginRequestObject))
+		return sh.ssi.PostRegisterAcceptInvite(ctx, request.(PostRegisterAcceptInviteRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostLogin")
+		handler = middleware(handler, "PostRegisterAcceptInvite")
 	}
 
 	response, err := handler(ctx, request)
@@ -835,8 +6573,8 @@ func (sh *strictHandler) PostLogin(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostLoginResponseObject); ok {
-		if err := validResponse.VisitPostLoginResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostRegisterAcceptInviteResponseObject); ok {
+		if err := validResponse.VisitPostRegisterAcceptInviteResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -844,11 +6582,11 @@ func (sh *strictHandler) PostLogin(ctx *gin.Context) {
 	}
 }
 
-// PostProducts operation middleware
-func (sh *strictHandler) PostProducts(ctx *gin.Context) {
-	var request PostProductsRequestObject
+// PostTransfers operation middleware
+func (sh *strictHandler) PostTransfers(ctx *gin.Context) {
+	var request PostTransfersRequestObject
 
-	var body PostProductsJSONRequestBody
+	var body PostTransfersJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -857,10 +6595,10 @@ func (sh *strictHandler) PostProducts(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostProducts(ctx, request.(PostProductsRequestObject))
+		return sh.ssi.PostTransfers(ctx, request.(PostTransfersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostProducts")
+		handler = middleware(handler, "PostTransfers")
 	}
 
 	response, err := handler(ctx, request)
@@ -868,8 +6606,8 @@ func (sh *strictHandler) PostProducts(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostProductsResponseObject); ok {
-		if err := validResponse.VisitPostProductsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostTransfersResponseObject); ok {
+		if err := validResponse.VisitPostTransfersResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -877,17 +6615,17 @@ func (sh *strictHandler) PostProducts(ctx *gin.Context) {
 	}
 }
 
-// GetPvz operation middleware
-func (sh *strictHandler) GetPvz(ctx *gin.Context, params GetPvzParams) {
-	var request GetPvzRequestObject
+// GetTransfersTransferId operation middleware
+func (sh *strictHandler) GetTransfersTransferId(ctx *gin.Context, transferId uuid.UUID) {
+	var request GetTransfersTransferIdRequestObject
 
-	request.Params = params
+	request.TransferId = transferId
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetPvz(ctx, request.(GetPvzRequestObject))
+		return sh.ssi.GetTransfersTransferId(ctx, request.(GetTransfersTransferIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetPvz")
+		handler = middleware(handler, "GetTransfersTransferId")
 	}
 
 	response, err := handler(ctx, request)
@@ -895,8 +6633,8 @@ func (sh *strictHandler) GetPvz(ctx *gin.Context, params GetPvzParams) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetPvzResponseObject); ok {
-		if err := validResponse.VisitGetPvzResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetTransfersTransferIdResponseObject); ok {
+		if err := validResponse.VisitGetTransfersTransferIdResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -904,23 +6642,44 @@ func (sh *strictHandler) GetPvz(ctx *gin.Context, params GetPvzParams) {
 	}
 }
 
-// PostPvz operation middleware
-func (sh *strictHandler) PostPvz(ctx *gin.Context) {
-	var request PostPvzRequestObject
+// PostTransfersTransferIdDispatch operation middleware
+func (sh *strictHandler) PostTransfersTransferIdDispatch(ctx *gin.Context, transferId uuid.UUID) {
+	var request PostTransfersTransferIdDispatchRequestObject
 
-	var body PostPvzJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
+	request.TransferId = transferId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTransfersTransferIdDispatch(ctx, request.(PostTransfersTransferIdDispatchRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTransfersTransferIdDispatch")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
 		ctx.Error(err)
-		return
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostTransfersTransferIdDispatchResponseObject); ok {
+		if err := validResponse.VisitPostTransfersTransferIdDispatchResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
 	}
-	request.Body = &body
+}
+
+// PostTransfersTransferIdReceive operation middleware
+func (sh *strictHandler) PostTransfersTransferIdReceive(ctx *gin.Context, transferId uuid.UUID) {
+	var request PostTransfersTransferIdReceiveRequestObject
+
+	request.TransferId = transferId
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPvz(ctx, request.(PostPvzRequestObject))
+		return sh.ssi.PostTransfersTransferIdReceive(ctx, request.(PostTransfersTransferIdReceiveRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPvz")
+		handler = middleware(handler, "PostTransfersTransferIdReceive")
 	}
 
 	response, err := handler(ctx, request)
@@ -928,8 +6687,8 @@ func (sh *strictHandler) PostPvz(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostPvzResponseObject); ok {
-		if err := validResponse.VisitPostPvzResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostTransfersTransferIdReceiveResponseObject); ok {
+		if err := validResponse.VisitPostTransfersTransferIdReceiveResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -937,17 +6696,17 @@ func (sh *strictHandler) PostPvz(ctx *gin.Context) {
 	}
 }
 
-// PostPvzPvzIdCloseLastReception operation middleware
-func (sh *strictHandler) PostPvzPvzIdCloseLastReception(ctx *gin.Context, pvzId uuid.UUID) {
-	var request PostPvzPvzIdCloseLastReceptionRequestObject
+// GetUsers operation middleware
+func (sh *strictHandler) GetUsers(ctx *gin.Context, params GetUsersParams) {
+	var request GetUsersRequestObject
 
-	request.PvzId = pvzId
+	request.Params = params
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPvzPvzIdCloseLastReception(ctx, request.(PostPvzPvzIdCloseLastReceptionRequestObject))
+		return sh.ssi.GetUsers(ctx, request.(GetUsersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPvzPvzIdCloseLastReception")
+		handler = middleware(handler, "GetUsers")
 	}
 
 	response, err := handler(ctx, request)
@@ -955,8 +6714,8 @@ func (sh *strictHandler) PostPvzPvzIdCloseLastReception(ctx *gin.Context, pvzId
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostPvzPvzIdCloseLastReceptionResponseObject); ok {
-		if err := validResponse.VisitPostPvzPvzIdCloseLastReceptionResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetUsersResponseObject); ok {
+		if err := validResponse.VisitGetUsersResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -964,17 +6723,17 @@ func (sh *strictHandler) PostPvzPvzIdCloseLastReception(ctx *gin.Context, pvzId
 	}
 }
 
-// PostPvzPvzIdDeleteLastProduct operation middleware
-func (sh *strictHandler) PostPvzPvzIdDeleteLastProduct(ctx *gin.Context, pvzId uuid.UUID) {
-	var request PostPvzPvzIdDeleteLastProductRequestObject
+// GetUsersUserId operation middleware
+func (sh *strictHandler) GetUsersUserId(ctx *gin.Context, userId uuid.UUID) {
+	var request GetUsersUserIdRequestObject
 
-	request.PvzId = pvzId
+	request.UserId = userId
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPvzPvzIdDeleteLastProduct(ctx, request.(PostPvzPvzIdDeleteLastProductRequestObject))
+		return sh.ssi.GetUsersUserId(ctx, request.(GetUsersUserIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPvzPvzIdDeleteLastProduct")
+		handler = middleware(handler, "GetUsersUserId")
 	}
 
 	response, err := handler(ctx, request)
@@ -982,8 +6741,8 @@ func (sh *strictHandler) PostPvzPvzIdDeleteLastProduct(ctx *gin.Context, pvzId u
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostPvzPvzIdDeleteLastProductResponseObject); ok {
-		if err := validResponse.VisitPostPvzPvzIdDeleteLastProductResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetUsersUserIdResponseObject); ok {
+		if err := validResponse.VisitGetUsersUserIdResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -991,11 +6750,13 @@ func (sh *strictHandler) PostPvzPvzIdDeleteLastProduct(ctx *gin.Context, pvzId u
 	}
 }
 
-// PostReceptions operation middleware
-func (sh *strictHandler) PostReceptions(ctx *gin.Context) {
-	var request PostReceptionsRequestObject
+// PatchUsersUserId operation middleware
+func (sh *strictHandler) PatchUsersUserId(ctx *gin.Context, userId uuid.UUID) {
+	var request PatchUsersUserIdRequestObject
 
-	var body PostReceptionsJSONRequestBody
+	request.UserId = userId
+
+	var body PatchUsersUserIdJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -1004,10 +6765,10 @@ func (sh *strictHandler) PostReceptions(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostReceptions(ctx, request.(PostReceptionsRequestObject))
+		return sh.ssi.PatchUsersUserId(ctx, request.(PatchUsersUserIdRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostReceptions")
+		handler = middleware(handler, "PatchUsersUserId")
 	}
 
 	response, err := handler(ctx, request)
@@ -1015,8 +6776,8 @@ func (sh *strictHandler) PostReceptions(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostReceptionsResponseObject); ok {
-		if err := validResponse.VisitPostReceptionsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PatchUsersUserIdResponseObject); ok {
+		if err := validResponse.VisitPatchUsersUserIdResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1024,23 +6785,17 @@ func (sh *strictHandler) PostReceptions(ctx *gin.Context) {
 	}
 }
 
-// PostRegister operation middleware
-func (sh *strictHandler) PostRegister(ctx *gin.Context) {
-	var request PostRegisterRequestObject
+// PostUsersUserIdResetPassword operation middleware
+func (sh *strictHandler) PostUsersUserIdResetPassword(ctx *gin.Context, userId uuid.UUID) {
+	var request PostUsersUserIdResetPasswordRequestObject
 
-	var body PostRegisterJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
+	request.UserId = userId
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostRegister(ctx, request.(PostRegisterRequestObject))
+		return sh.ssi.PostUsersUserIdResetPassword(ctx, request.(PostUsersUserIdResetPasswordRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostRegister")
+		handler = middleware(handler, "PostUsersUserIdResetPassword")
 	}
 
 	response, err := handler(ctx, request)
@@ -1048,8 +6803,8 @@ func (sh *strictHandler) PostRegister(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostRegisterResponseObject); ok {
-		if err := validResponse.VisitPostRegisterResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostUsersUserIdResetPasswordResponseObject); ok {
+		if err := validResponse.VisitPostUsersUserIdResetPasswordResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -1060,36 +6815,205 @@ func (sh *strictHandler) PostRegister(ctx *gin.Context) {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xZb28TzRH/KqdtX1DJiZPSV37XNn0qKqRGT+Gp9KAoutgb58D3h911ikGWYruFItJS",
-	"VUhIqIhSvoBxYmKc+PIVZr9RNbN39p19jpPgJ5i8Sny3tzs785vf/Gb3CSv6buB73FOSFZ4wWdzhrk3/",
-	"/k4IX+A/gfADLpTD6bHLpbTLHP9VtYCzApNKOF6Z1es5JvjDqiN4iRXuDQdu5OKB/tZ9XlSsnmPrP/w4",
-	"OXPRUTX8y72qixPAfyDUDehDB9osx+A9tGEAfd1cgnfQ1U3o6j34qFt6Dw7w/RtowxGO0fuJRWPrcswp",
-	"4ezbvnBtxQqsWnVKbHxYjj1aKvtL0UMcsnz37q215PMlxw18oXAuz3b5aKbAVjuswMqO2qluLRd9N1/2",
-	"/XKF5+m9cU/ZkUrYyvG9NVvxlD0lW/El5bh8wqhxz5KjMt0q/FK1qCZdi3PfwanPueBiOKvIA/TUra9u",
-	"ipl2hEz9DziGLkJR70EIA+hB32A0hEPowic4jH9+1C3oZAJyLKj0Nr3rrBB/H7+/HkEOdh9//fBKZauq",
-	"TAbY8TYD4ZcFl5LlWLHiSz47gkP/x9sazpwVyDv+A+5lsGiO3ZU8g3e5azuVlKfMk8XMXb+SShjuBhW/",
-	"xtE1rl/iwla+mO3QeIM026QPMXK8WBWOqv0Jy5bx0xa3BRe/rqKJ8a/vYlf84c93MCo0mhWityPf7CgV",
-	"sDpO7HjbPuUUl0XhRAmHJQhrTgd6umHBIRzrl5ZuwanegzZ0iBMG0NMvLXgH/4bXFvQsetmDLpxAH0L4",
-	"bOkmhFjRiDk6uLajKmSMXXzAvZIludh1iuiqXS6kWXh1eWV5BUPrB9yzA4cV2E16ZIJBG8+Xqq5bu+2X",
-	"HcMNvqTQIYbsmEbZui/V2mic8TeX6jd+iWpv0fcU9+hDOwgqTpE+zd+XhnCMPJgE53ziPS3OqWFKVDk9",
-	"kIHvSbP8L1dWLmT8zwXfZgX2s/xI/OQj5ZM3eUmLjgX/g27AKXT132EAbQxyGzoYTQrwEbT1U4w9RulX",
-	"c7THyLAse95CFzoEyIF+AZ8tEj8It1A3THZUXdcWNRz7DkI41i39zEAUuhbpp0aExhAOIDTQ7NMIrF7K",
-	"LksMS1DdqjhFtoFT5iuz8TVfaF2A9wJbyr/4ojRbm8ZTDL+4HqhbvXLUdS0DKt2MfqLygYH5MQ7Cf2VZ",
-	"bsEpYXMfjiJibEIXmXUaAgMjcuXZIFyPR80LhwuhVK5QiJr9Xi4v5ofDuKPJQuL/4kKKoAvh46gGLwYH",
-	"W9CDY5QAA8wPTNy+bkIPOjAgJZCSBj1j880rsPkVGqebKFxG9nb1c+O5hKpihXtpPXVvo76RyuhXab/H",
-	"hSUWOG0LOlRooK9b+rlu6X+mdq1b1g0ajunfh3CoqRoQIqR1Cw4jUIfQiVTVLxLEEOuMTd+r1GJ+2H2M",
-	"vinzDGb4PVfru48p1YTtcsWFpE1ORLWtn0GbzIpY95CIqY3/9NBldNYQRpUSKyJ7WOWixnJxTktlC0VN",
-	"fi4RsfN1+xMGvaGluvrZpc3hXmlexryFEE4Q8xbBaI8Iv6ef6hdT1g7scnrhEt+2qxXFCqs55jqe4yKb",
-	"rQ7XdjzFy1xM9cQx9KjmoHrpoG4xLHiCEDTow5xrj5kH3SnmVRzXUVPsW8kx135kDLy5MsPajS8UCI7i",
-	"rsysPDNp8ocfWfLIRJ41XaJ+Doeci4OHW7aFsGupBWfNMTq2GJWxRDOcnvccIyZZ7T2cYluGCjYiigty",
-	"WYZEbkRz9jHTTEunG5b+KxK73jfgQhUDXaJzQ2AkYMbI3XSC0IYD6FEKRx+Rap2uYYiqLitfZuLliit5",
-	"vORY3GK3QghHRjwuSgf1Ddbj9yMvEoIj72YWWTgxIpFAbGR5CJ1kdR227mPlNf+ExGE9TwdjmxVbqs0U",
-	"E5wJ6XX89rf45W1bqhExTBRl4mrSxKNKEp2rpWGbWdOuXJ1/Mf2flz8zcijBNW2Dob7e0y9QIiyYFj5N",
-	"mapb8AlhmmHxN5Z5rxM7oMw7pcsr1CUoYalAhLo5HDPZAIydDJJ0RvFCntJ/i4vaTPE7zM4Sr3AVpWeQ",
-	"uBmamZxr9CFmZ1z6r2tuTu0oqfNoL1I3mTtnHznWdY6DanhoPdze6PjoG0u5D8k9ZKXcgSl26RZ1kDzs",
-	"HLapPThKNKqRnku59cbtW9/9MWfNvV1Na/bpyfn9aNw1OtIaO3tajEOnixTbpHBduGJLLbLeJy5I11g6",
-	"d01u5HrI3UF0mzGrtt74CdK47EgVXdyekcTRqMW6HZn3he1wqdyX3OnNL6HpRj27+cy6ethfyHY0fZfy",
-	"X6pvvfiI6zJ3KfX6/wMAAP//gaa8Lv4lAAA=",
+	"H4sIAAAAAAAC/+x9e3Mbx5XvV5nC3T/sW8OHYie10a37hyI5KW3iDa8kJ7cS6YIjoElNBMxgZwa0KRWr",
+	"+LAiuyiLu77Odcq1ttfJVuX+F4giRIgP6Cv0fKOtPqe7p7unZwCQIAlSrHJZIDCPfpw+z98553GlFjZb",
+	"YUCCJK5cfVyJaw9I04OP1+Zu/pIss0+tKGyRKPEJfF+LiJeQetVL2F8LYdRknyp1LyFTid8kFbeSLLdI",
+	"5WolTiI/WKysuBXyScuPSDzSPX5du7bd9uu5y9zKJ1OL4RT/kl0y/dFHN2+o30/5zVYYwXsDr0myJ7W8",
+	"5EHlamXRTx6070/XwubMYhguNsgM/L6y4lYe4vTrJK5Ffivxw6BytUK/poe0kz6lXXpIe7Tr0D26nz5P",
+	"n9KO69A3tE/3aIfuppt0m3ZoN11P19ItJ12nfbqfPqN7tO/QN+kq7TnpGu3TXbpDO/Cknm0RGl6cVNvx",
+	"iMuNE32c/6FFoqYfx34YwFb6CWnG1gv5F14UectwY0QW/E8sq/EtrEWH7tN+biXYF30283SV9ulBuuHQ",
+	"Ln3Jvj+gffqKHtK+k27QXVjQ9fSZbSqtpUdVvx5b3vw9/ZJ+7Tp0T3lNukkPHNqnL9NVXFXcJ4fu0H66",
+	"lq6nG/SNMsxph36fbrAfaJ++ZhvyhvZgW/acqdxNfYdup2u0y14BL6+42QqeJZ2amxWRpfDhSCQDN/1L",
+	"249IvXL19xV4L4xC7rxOO9m+uCo/uCcfHN7/A6klbDDX2nU/+SBIIgsr8Wq4mY8rJGg32Zsb4aIfTMft",
+	"Wo3E7OH494LnN9oRjDt8SIJpP47bhI2xHZNout1iM6vjoKb5cMRfXsur+clytfbACxbh64jUCBDRdNgi",
+	"gfFVrRHGxlcRsV3nBTXSaBjfxonXwDUL6+1aMl0nDcLHwr+RI49I0o6CavzAbzVJkCjDjpMw8hZJlT1f",
+	"+TqJvCBeIJHtq7oft7yk9kD/lo3LXyL1yr3cfrts6cOoevY8FscRhQ07w/JafvUhWZ6AgR5F7Bmj9oPk",
+	"J+9n1/lBQhZJBBe27OzaW26EHjzEq9d9RmNeY045QknUJpYzx84yiRO+arnHslNT9RZJkFh+trECfk5h",
+	"nNrt2quy8Q7HFOa8RZLnCZKjyg//EJGFytXKf5vJVJUZrqfMKNzFwggD8klSrbWjOIwsAuSbdCNdZdw+",
+	"XWWsf5926U66kT5PP6ddkAbpuhQjf0w3XYdJmXQt3YD/r9PtdIPJd4eJL5B34iH0kD1gMJeFCdqW52fs",
+	"MM8hy7hF4nYjya8TiSKclUXZ8uOEfR6wdvwFKN7x4/B3xImXtC1iOX7ot1qk7kyhyrNNO+kqCujVdJV2",
+	"6V66ziSy64D0p29oh+7hKjIRvsf0CFi+Q4f26O4U3WVru5Ouphv0Je2lT5THsn8rrhQdGV/0gyWvAQRZ",
+	"b7cafs1LSMUVI6vcG7QvfGqDNia2yTMmCYh66u6HYYN4AZ5KtpM2VeY/aJfuphtMQUzXQRfadOg20tRq",
+	"ukV32BoZM4cLdmkHlElGel1VHSnbRQt15c6OsSRyYtksbMtz3U9sBkNYh3NOPvGaLcbnK80wroUf2zjm",
+	"kYyLwLvfIHXLun7JNLZNqe8xjf2QaaIO00JhGXeYAs/ojenmu0xRz5TybdRKgVLhOd1M78vvrtC5s1nS",
+	"f4d92WMPKtLSqyTQb/qwcGkissiVpdxPbGUehQGxLMHfaAemtA0aLlDUVrrm3Lz2z9cqrvLeD9psz2aK",
+	"Xm/QA2ypVBHl67O9sFIH6Fa3hLYEB6bR+PVC5ervywk2u2XFNYmr7jW9RVKv1sJ2kFjm/w2zu9AOQKZN",
+	"+8ZBcrk9Rg/TLXbyGI9ZQ0tuG1jWK7ojSec1M1iQabFHsP8zRj+EiEclctzjFEbJHrN90vVjjTAitTCo",
+	"+Q3fE9szYFPUq00K0ffFmH6eOu6tuJUbflyLSMsLanZToe01FOpXBn7fiwSPMVb1r2iMMyHOzLl9dsBX",
+	"2YlIn9C+3NgePxlO+hmI/F76BNaNMYGDAncGqel8XhnOQz+oqzYNWE2gn7YDeadbCZdIlFsP0wI/7ozo",
+	"nklX6YYpSNJ12mMUM/DUw8SU2btiV2yn/YYQvVzSfCD0FX1fmySOuR6YV39H1EqM4YpHZw+yjXPkcRW8",
+	"xfbsm8GSn1h0XNL0/IYm4PCbC+M5U7w2k+khsdubxtaKTYGrta2wbfavmKuiSFtvLnhVEkRho1HN3pDX",
+	"V6Sz7LWTfsp4OH7BXFov0i127EF93qdd7fjDERcqdZfZL+xzF4XYjqr/WFUXNjhwrFiG9BdwaLLXffjz",
+	"a1OobNJt2k1X6R68kylGO4qnk27TA85r+g481WVjYqZRzwH22qUv0g3l+oJJ2yhbjrKMH9yBi1ZWLHs0",
+	"95vfWfRT7iOyzP4Huqf4bU0FHL2YzHhJn0hLcT19xuflSBflC6bfO2wF6AG7hHayJcGNYgwdHcH9iltp",
+	"+oHfZMLjik1OF4z1W5AL2zgM2lV13A4YU+DfhHFu0376FC7box1nfqbms7WYd5V7wPtJ9+krJLUX6Sbq",
+	"wtsGNQ2v7p49V2IadJxEoLDc8BKijaeUo8bhQlItoZPv+bK9dBVHgO7+fikd/+BBzstgIz4Azgj+6ZU4",
+	"YQ7tI628gc05FHopHLfeQMrJjPZSefqb393GC+GWsPawUGv9i5hEuoluEHkQUAvpOew/dkp6bArgWwHa",
+	"3AYri+nXjKN1wK706r8OGsuGU0uO3rRA2Ebcsx/xGyTx/IbFPodoCrp4q5FqhwxrfYAaq99qrMh3zIvB",
+	"lfEOYwNyy9lZK/YhSXagXc+5wzZ4lTjHrLjGrFqKP2IoB4DixsmHDkZfFWNnske42dBsO9VaejQEKQq6",
+	"jYsZd/VjLwq4w6uIQJFj20kU1viA/Z0+Sz+jXWdeO+/zVqEpplYFtWDA24uOh8u8jKsQb9J/SrcU7gG2",
+	"HgtMgfDfE6wAuEZXiUTOizFN8xjCtBhb9WM/qIcfzw9nCMqpJWEV4xQD5pauKcNLP2NiTk4M/C3m5Nwh",
+	"mcJog617y0P62yWNjnZbO/Eb/iOv4Oh/BSxc04CYvOfM2UXGDcY7cAZFAnToAdffQJnY4JqDTXFQF6Ue",
+	"tu83FHkVtJv3LawyN9ncotmo2UoGbv7M5U+28Xp2zMURLmDYtwucyfQHvnr9dEtoNbAyELjN+GRPjSij",
+	"IxliwcKDp0vXbWeeBTSWyPz03YD+me4683VSC5s8uknq83L9UdE9TDeEI1A6CqfvBorvGZ/HdoIw7cSL",
+	"/MZyVcYS9adbrf25zNQ1/B5JEvn32wmJi6NAxfH7bJWLfST/X/d5gJtJiYlvDHZDMVWRrRUXU5x/5Zwp",
+	"6K7PDZXFOQfJgdvIz66zS/ktPLhlTObvwA5fg+y0MB05M80myeIUTBLATbtwhD/nSu6Zxh3DAPd8qONh",
+	"+P6EPqGrFV2FcsOHFVc466SbzkqiTEG+4zfJefJDBCE/OBZv6wEX+etA2T3EfzDtjMWadoR1BFy5w5eP",
+	"0cQqyC7EiUhTK+8s7tkCgG4ljOokKorLSkZ988wXLvYfkWqt4cWx6smMm16jUXErTVL3280KgydFmusr",
+	"mwvj90S7NwkjjM4JCIQqVvADqTNZE5OgTiJ2ZVAFMIM/kofUtIOFe1MTuAMMYi76YBXjed3MhaARmmQD",
+	"Pabwq76xVhGIr7uWJF7tQZMEFjlQC4OEBElVTDobkM/O7swfWmRxXAG1CfAf4oJU/Yk4CNaIrZARq+i0",
+	"ek33GVVtM52tQ18DV3kynAbbbjHQBKlX7y+f7Wxt0A9lJ1ydCPnaDMR6ZMSNikyetvOhU79JfCsa0EsS",
+	"EgUFIfSXEELfYhob7YNBkW7C8X4lTCTXAkkUfi5AI7JQaR/Cb11hoXOmAQrgro791BjD//n97NRP7z2+",
+	"8uOVf7CHbzO3r2lNGgsP61GylB8sWVmECunKeyUsTod0AycMzj0msVwdk3oogozCtjswg4nnBIdVzxxC",
+	"uTUdGqM1OTwJoyuTEVPJBLyEGyoivhniFxg6qRcJegFZrFqBjFXlyQJReW8oCKvGvPiquUIoD8e27vBZ",
+	"llllplaePoGz0nGAbTDF8QU7e5mjVygh6RfpOncNK0HYUXx4GVe1OPPywBvSILUkCgO/Fo9LWViIvEW/",
+	"QWxsza088BcfVJe8Rrvg9zznT7+A6NYeLFxfqGRDw2c+KJtgKYhF2dJsUtoMbFSioVlMZMr5s5daS48m",
+	"wPaQ3iDBWvyg2orCxQgx4eBXYf9KDPZAbiD3QkzRLUP5yU390Av8BRLbIh/fCfclO+rpprAemZzsAHRP",
+	"d0/103UeGcIL0s8lYetUw/008WBHDfO7mp4ZNRGiq6g26aZ8O3gIejzdYvgEEPY3hoFKHVHl8aeyNUSN",
+	"bW8gDIojdTi7VPE5iv+xeEvHATqWD7vdbja9iwU9zuYG5+M6pE3kFywiXoxMr+kHvyLBYvJA3fOC1/K7",
+	"yt/L1/TquUX6MWZTTc4Z5598eKJQ5gYzoWH2aNTpDWY7k6KXn4D4hDdnZK3o0vxlud1xRwWAKhzgt35S",
+	"grFXo91l4dZM4iqcEUAYTCq+AKffvjzt8MMeu4EFfLbRNzCiLj6uePqYkbhDx+Nv5d6aCzcI4FfHctgN",
+	"QMuaEuLlOtKBpiMhEF/Tk3LaUF1Cgk1BXbYgKpDYsicMeBv59boV7/a9Dr/YpR3JvDoYZdyhXR6h5k4k",
+	"jNuusR8herDFY/0WWC6gNwZ4f/Q5a8MdvGvXw2Ch4dsCiWVA27HSWwa7NR5rHz1zB9zmWZDjSTYX95y1",
+	"O3WCHOljwQZNgnizenmEMFKIZQCrU+PJpXhQC/ryXAZUGt590rCwu39luXWMeznplgyd9xjfY76XzhR3",
+	"/O6z6AZ9NcVxh2i4Zl6Xa1PvTf3INvGwVmu3fCvWeRiVyw7X2lbH2rXrihOhiUVe7aGdiOIHpLFQ8JMW",
+	"eS3ScNBqQYyCir1WlkYgN0C1ecWM6x4iDF8gGIYh3eBHBfCwihs7erhXZJ8N45QVxxVpkt/LV0ssjbYO",
+	"GeqoopDUQA/uHQEWz432DvcsXzR5k7nPR3Thhs3qZByZiyUxReBipN2Q+ro9lGcoqByodkg7enAS4E7b",
+	"qkdwI31uaO0Kv003zzisl7da1XxuiQPJ1tQOCQmrk6qlqIdMHahiQw+vv3wU25gXRyFaIy1HSqwePk3t",
+	"7M8tZljJTPD8CoicrxxwAKRhKSCIp1gx1+w7eTgYabYa4TIhQsA2wzqJvCSM3h3oh9UyzKxI2pjU2pGf",
+	"LN9m3IZvc8v/JVm+1mYr8rjis1k8IB5GUvmq/e+pay1/ipXvypgS3AVQUOJFJBL3418/Fzv3T7+9w0gS",
+	"3la5yn/NnvIgSVpI4H6wEFp9BOhA6aVrMkFtQy7qfoZu54zLgCaCg9Cs8JD4SQMG49UekqDuxCRa8muk",
+	"4laWSBTji69Mz07PigwNr+VXrlbeg6+QdmDhZryWP/WQLMMfiwTIjJ0fT2DuKr8gyTVYpxgrHbTCIMZF",
+	"/9HsrALDwm3ABFc/DGb+wJ3hKBOGr16CJdby1RfyHsoflNJUSgrWa4kRZ7UxDHzKa/bk92ffG2ngZePF",
+	"XFnb8L5SS2WJqhQCQKvSMST7qxT4+3sr99xKLLz++kyvzd2c0mb7jg7lRgKzumW24fR5izH4JMSRrIYs",
+	"xYhlm7fC2EIAc2GsUQAUt/lZWF8eaQ2NbN8jpPCeQAW3IxRSewFIWMw7G7am2mQWRbMhrPTFtHBf7aYk",
+	"apOVHFO4MrazJXjBii06AWurgbPcHBhN2YPiOoRwUHr0kBt9yCBmT4FBfEu7MpuCRcvV0jWTwaaE+E43",
+	"lIVG7we6dDHpiqc7bwuvNmO7XLyJJ+CJGpntqcUguzrr64yN8a24mRicefyQLN+sryBPaBDEZuoM8QZ8",
+	"z1niL9nlcPoir0kSEsUwL1BB4ERKBeQhv1I/P66ygWeond/LHeP3rQ4qPHXIEgWk/PROjHz/IabzM4zx",
+	"jk6o6NaxjO+ciXyWNAuc6kSpnpWKUzS/XFnXDlc8EK7DUxMF20SwBdSBYid+z8EwFkrKaYd+hUMD/2i6",
+	"kVnhFnjH3cDEd4i4IwtTIni86ygAErRNZFb4JjzscxHOlHBlxJ/jLZiellduYQ1yBzjnZezRNxo0GLwJ",
+	"huHjmLU5gQ38S5tEyxkfkJUDM2rLWUSPi+5ENPOEMA23vPQtk6odgAZw17mr1krocTnyjBd4sE2YOQjs",
+	"ky0t3Gp1rDNh9Uf7oHhC+SgjS8Ijjcv2KKTOQfQwRKiA7srzClYQhAq0Y4UF8SxjaPhNP9GGUCcLHpRt",
+	"+fGsW2l6n3AI2+xseUEFiyAZn2DISmRazUFtph2HvmKIMjii+7RzqdYdWRT9v2wdGXyb2T9YsIWFyD6l",
+	"PazRCKLnj+C1eA0+jL0M0MfRY3DsXjJdW7l0nPIMi7aUuTKu4xWD2P1/ZrPiRcOQy4N9AXEkveqLVnDE",
+	"dsCEG85yyjPQw73TcLBAQcqR3StZ4RzmfzofVOzqfkGbV8V0bFomWuwUkbQ0Hp+ISE0oL+bJubJWEMaS",
+	"PVCWGnD0Mpr85ceojHnWLgWkfgul/V+9ypMChpsMySEN6awcFTd2AAP9eVbLkOm951LOfKWve75i1/hF",
+	"xcxjRpxg7UOU2HLQ2dd40q8jHQ+29DnBFxv65nm5Nza/anG0xwyzFFalHeZAzp7qgewhFAMBL5eKnHrA",
+	"2CjeP4VRKLth+l5GPOVfanpTTwHhmHWpT/T819vN5jLUp4RTFFozmpS1By+TWTSH6UZQG+OV8G2kT5w6",
+	"WWL6b0LiBPAGLGrtpF/QQ7oDvhCGNchquyt1A/Maxo1skOPiEJMY8i0K9Z4qIxIlMvO0/1e2HrSbfgZ2",
+	"y5bDFoZTGuNMYMqkW5PCl1a00/a97nzD2h+8FCnbXpFoyyua0o5yblrt+w2/xs+LD3V7Y/Ww5On1Jr9o",
+	"bOJseLTFMWN5SMrbWFCWnfRc3ZuJ7Ww0et3es1bCkUystI1QrpfMYwNgTE6zagmGviPc0TqEoo8eL5zp",
+	"pZJwdKyBHnQD6XoI3A59ptt65VZtr9KtsQrphimf8yxnvNJxFIbjxfHHYVQf/uzJO85a1KlluY8u8GTM",
+	"bZQC1nAirpz6uew6KAPTdf5nVs6Fdk2Z+a/22b7hZL0rqt9gGLxIYALtzjQXvCHo98MF7+TdSFpJ8wEF",
+	"/OWlvGLGxaDYSZIKV85iFHqQvJeumUTNj8RrRxa+PDSeka6XVr+Hyf3op6cwuR/YXNLPeDVdepDlrCpr",
+	"LfIub86JycOM3/DM6z0s0cnrwe+JD9aTzqAtJqf4GtSPbrqq6Co20qM98XQ21ju/vpMNJ/sarK01ngoK",
+	"cfUMG1rEZJoL3gz2UCixYlGnOgRTTZRMhyj20/yeSp4+qMeBLM69fZwGDVar98MF7wOc0zE5is4YY1KL",
+	"SGLv/hdZEsHCpOW1kwdXZ2Yc2JZNzPISU/hft6YExQw0cPmr8UV2XprHDDPXAuyWgXHbERWVNV8J++oQ",
+	"TjZvIsfKoazzLdvB6iac2hx2D3s23XWAgpZI5C8snx53LOzpIThTvkfHafJM8wj3BYxoJD36e3MOwB3Y",
+	"2dc7hDjpmrLXYndRt97Hbhq4v1Ms9oqtAgF/8im6ZfhAO2Usgm/wabIIVo466/qQJ1aZwdjNVuNQ6Gry",
+	"0QoOiIOLnuMidelrsxRhES/5DU7+hPUrW8zrNLQmswZNjeXGL1dlwaRh0dD5Cgnqg4biWYXHWt9+15HF",
+	"mspEXg6wqyDPJgWwuyfiE1KzyJ946S9nwCi2IJPBy85eTRuoiB2F4UqRlxWd7gqFK8eGkPeo3WhUsi1m",
+	"qcKQn1kIo8UwKeGr3wkgotLTaBsRlTwGIBOSXWsg18lIK297PgMEzkbW/g7o8sARDoc8O5zjI/85DvzU",
+	"3SZWz8jR2OSPLMv9p3Rt0Hrll9iV5zjnU5xMj+JkmVc2m0hiFqRJxdX+NfqC3wlmFuazlThR5EmLSEzK",
+	"Dlqma0DVGak6yDdg0a3uSHqH1d9zTIVEnMBbMKFxKyUm+zFdt4bYYghqqGT8jOl9x/Ny1gQ64vhOztze",
+	"il18dmZYgG/ssW+33JkiVQPZCegcHOC/avoY77iluv3VQyUbqu6kG4WHWO0cUAbOVOoLn06yqfLCkSGR",
+	"vO6dUcD4YqMjS+dc7OPO7es4WJ5eb3rsBaJPpqjzCLDMCQZRasfGQpc8WUbrLTLRoEqka33EbwO60t4H",
+	"ZpwRXI33K2jL8txKlWGcMOpyYM7jX6yksQNZJadKyH8CSnhmFKyhXWVs6aa1fv5bhlW0sZ9jghb/mm34",
+	"KRwcNwMjG1P7s9B+0y3pDtNfqmZNCruGK6ZbrpMJTdwf/izuh8YmDSzZ+48QsOmh8jjtFDeQUvTxXr4h",
+	"YN4CYvM6xdM9wYrGsfWJQrXhTCP1R9ENLvHdF5Fn/lnd1dNVN4axMuMCtmMk7okmocdTLE7Odh3KbjUa",
+	"E4OOAA2J8/1IDwDXrtSx4S2vBIw2XROxDLovXQOIoro8t2Mzvlksgzka9uwl941tSzdU+7u8RYozzyl6",
+	"HkW1BaTBG/ajIgn94PZAGYDAM3TeMHqq8KoLh6hqSP2DaSguOlxfwA3cWcrCFXv4Pe8u/j9Ex4l1mS0M",
+	"jtw9ns69KtFJ2Fo0CzWj7mLRg7jD2azAblk9UHEstSEUz8VJeS2G7l1slihWe2jpTW86rrE+WdgUSIcr",
+	"edm2KV22ZPXix9ht8GrlvR//5Kfv/ePsez/+yfvv/ePsT0dso/xtnr7SZ/zQmrvg5kiAJ9jsMFiLVhEv",
+	"3RBj10whQ/fEdth9rT6/QWMwfSuVYbxgk9d2PsyozDXITHZMNqZjKOJIX7ltNRoZi/RabEA8al9jl0Uv",
+	"4VwWNN9FNvWSJywp8fTtrL1rPPOYf7pZX5nxZPdV7Pg6coNk2Wy46X0iW+LMzs5aLlXbAOfoqM/rq2Dz",
+	"AQx3FvSrVkiCu6flPeiiNl/c8msP261qAQ1/I3bTKGLjyiqDh9lBUptmC0KUYSZGotMO/fesKzsbGP8B",
+	"37CLLqcMnjX30R3HvjU46ik26gLimozGZUbpbkHisn52SRNZ3p4LcXc9l+/mIbdROdziBRzcrJqbUgc9",
+	"3+78aIW8T7Op8lC9k3FnJ8QLXK5w5hy/jo4e7XKnVrrG1gbqsO9wDLe6k26uBb2DxfAhGXGeNe2Zf3cy",
+	"NFDXaHGZbqJmZOujCwWw1jnliOL0CuXAEvEqe4hL6dFtWUxFaxMzQfbqaNHNMCC/XgAdubR/TRufIVzD",
+	"fHzuULO4V06iAy0iCUvNRAtWEpfbSbu2izLkJLzIvRto1RD58qGKwZbvbnBsw6HAy69QFYTuulCd53O1",
+	"ALpQrazmeD4dEq0RmIdqlYssYJtRPnNfeDULTBWtWZXCN1Qlts8HcGDGsQ8gw/MFcInuVcBNMXbCcR+u",
+	"8sUh7UkM4bQjQUOsLVG6zt7xIt2UF+TE+h7YPavYkNVWe1CnElTRukrGN+3rCiVuikZLIqzeAfUNjbBs",
+	"HOmGDvoSnadY6TL2J5TeM/p5WQIFKkPVlEGdF+OdWicUNrr5TLLPu8Kmk6Wrc2yf9sSNIp+QTWqQ2fUz",
+	"IJhx2V4lbdIAKak0AXHmxcXTQLRVKJA2n6/DLV01YzHzxmNb6aYUQJlsBmGRKaNbLqOaLadmIwzS43O/",
+	"XyRVdAg10dYjoekHN5Fgr5yLXlayH0RJ54fTVXp/pgSw4gIU/JrJak1mmG4eRVUdTlEyBngMBWmAkjuU",
+	"WprVdlEkIfieRDA7L1LzsuqiK7fj27O/0Q5fWNPzmVdOFBNDUVIORbzY0khLxgA04j6kXSuFn4Amq9BQ",
+	"x5zhWSq2BT6zYaJQc+LOa8p9wwTE5SvPT0nxk4uCZYs3VDzsSz2zzvCfGyEupap19+0LC6u2z2jR4EEH",
+	"vGwTSuJZPFL9KYyE7Q+kEaG+CgD9jGNhwEMGkl9BeiMPaXETZR8H4GBVb1EAa145xNNeoxF+TOpV9NE5",
+	"7+BjN+BR+0AlbPzPnX+a++AXrjP3z79gD/8tuT/3rua1EkuoWRnqe5reJ1Wmo5a84sos8x/36L+9O+2o",
+	"iRauw6N3fSU2p8K9D7D0Be3r71fBYlrHQ7fAWoVFc9Kn6Xq2bZ/Cyw7SzUHW3AVndUUmarPdSPyWFyUs",
+	"Xaw5VfcSr8xKXeCgIDmd+37gRcsDfcNw34T4hFV2bOEq/4lnV49HnTb0J11XmIgrP2NzAiVxIjsv/ayE",
+	"BqitO8B5NrDuMz0QB+mtRV2WiAq38v6V905lEJwLqr7BTumedo8tyL6WVLyLpr4Iwxph+VzJxIIwrRnY",
+	"PQPNdeZx9gfvhnMURfaa8pCLweld67A9fZ7nVB33mbNu5r/r53CwGLKlSZkqV1fhtW8xYxTOEFXzpd08",
+	"wxyD+fwD1AZ5Cp4ZCZzaLlW5CxkDWRrZmv1g6dKQPYohC+s2ZC6i0oro0oI9Cwv2zxyRtQpbQHdYaYfR",
+	"T5cfxwjzLzB4v8zgQwiLRvSQphPoQUoz2oB+2F2OhGJ0kK5bnLXTDv0boE73hyrPIaHaIqD30lB5LB0y",
+	"aX9oI/EmLMvFNw9HimCWhrjM4Ily8b3JyAoZdEJlQYYJ67mxVwACvGSmuo03+9NTHYQIakiygQZz0PcR",
+	"8ka0HqY6ZBhZXkcpyyP4Yudc1EayANw7x/fIcknzNAdizChfYA2e67UfrIejUOQ1wyVSWswFAQgHPO26",
+	"Z5V9peJuW4m+C/AMrCwQjCgJj1USn8D/t2TUyPCGphvTjjakzzPFXXbcM3sKb4uaH6gdPNfWc2gZ+CFb",
+	"p0sRqFW6IY3GpMEUxJjOiZR9Y9LypABnNcAMpKGBe+slskeOmezlEP8cCNwtDl6/9S4HfWVt7obTkt5/",
+	"Vwdi4F9px7K9QsiLQeeY/RiS7Wy83UTNCmIEIaELFwPadVr+WiUFBPhkOynOXzmwZ50UGJHphn2pWbkr",
+	"S4RR6iSwfhwaNO0oGcBcgvNXG1XG9bwtm2xs50XjHEx+6PIBlzbiubYRi7KwFJK6zD++tAhLpMRAW14K",
+	"GoWixhAYVBrpGP0FtHcrrNfVUXocr6InG3LbaulRqVN+6dHARseyYXv6jKddoWygHUur9ILexnHiRckN",
+	"LyHjbNX+9MjDIUF9XIPJEk/1PurpZsG7W94isbdRvzKgb/pwLd7TL7ARBS8C30fddxxt3q+obd7fmx15",
+	"tPn8ZNRsimkmaccVd8izP/eb393GO8YYjjHk5tKjIUaB7ZJrBOYdlz1OSTY5Xj0P5YWDnnFLXrhiyybJ",
+	"VXwfdMWApuC4wRen4obRKHGNzxVMFbQ+Szrev6F9way6eRS8tff9gKqZwL6PqhQOpONTBob95nfWPRXL",
+	"mnU4udTjxlrE1Wjmh+s91pJLS49mHkNGugoTygGuVa887zAivLCANEXvuw23z+IwO5Z8xgPNTwAg157z",
+	"Ti61/F2WdvtGlAeHjMbn6XNc6pK3sodj2Hyde3J7+P0u4slADYOnWU1WVL/m2LIMZ5/yKy8kAGIAY7hB",
+	"Es9vxKX8QcntwDztNVPfoAeTcmZPy/bCtRk/rCEvCkfehqL6ld/wlOYOOsQOuNfPfIxwl3EbLt0qDqxM",
+	"OxC22hahIsVPvHs3SL+geyDH99MNtCNEOzbF5eTKVjPSl3xgZKhpAcJ0S2MWBfEcyOm6WCxgLP3Hicev",
+	"yCfoooY/gilgdFnDr8/cj1Wg6hRZSEb9S9EgaGLAD6LdxZPMV2sg3y8Z75jwZPmKmXnGeLIq3EzNa3k1",
+	"GH9RROFro24woxKme2GpG6HUCa6pufvllYhQlN3mGGtXK3EYmZ2HtHM3yDn0hL9OTWiSnSpeM9CExA+w",
+	"dcFPTN3kBUCy/i28DgdvIc7rWcIU7gac0/PBiquLohSc318Xi3jJ92XcXqGrMteSW4nDhaQ67OUrE8rs",
+	"v8wTX/qMnw6T4XcuTd6LzcXtrOjEOTlpNOIB8QHkVnDhW2+lDuWlvZ2EkbdI2JIN5S/9u1o3iVMceAo7",
+	"DE8ARLFxefDGc/DUtc5hMiTrZfbjkzz05FAw6YFO2Qt3ZsYt3nN2z57eoEJUUoIYQkGpERVgU3EHaAyR",
+	"V3s4hF7xgDQWhrhMKxN1hBpNj8JgCOQFXMWHLsamvdzN1vSss7o1pjcI02W6ai+1m8lismcB9FMrbGaS",
+	"T6ut2RXc9yQKYG6VSYYT078aYUyqDS9OqloAtwD0Lo3fjVz0ULoi+5BQC43jWcEN+jpzEHTotjuoNdRa",
+	"riY8c/OAaQu1SR2sOp1uYHyTzVzehGtX4JTtmXkXOhJeKW3Jy7LnejFD8bC7AURHEcvQh/3qOvNZuH36",
+	"fiOsPayGQbXux7WItLygtjxviRfBKNbAYyUT69Mt5nq4G3AoJHgu1BiQAYbMEwAs1hsZa+ryUR4486xT",
+	"euTXyf9kjHi+EN0vVAdGF7/y4iSL1p97PcK1eomypTW2CBA+a/SAI2K3OIjFumujnk8b2kRskB36suA1",
+	"YuLmuiydaNgLiKCu4jUsLFzPVMonKU2Qj1gbqoqkM0Z8Hov4DUDchEHNb/hw8/UwWGj4taRkOwt4c1Yi",
+	"fBuQIrx9CvAck+sfaFyfsaCxIBXFTvFCe0rAHAEuSpjeVunRUo4PGSWrQ/AkQ4OVwtwV8YkdIlF+cjBV",
+	"ifTkDfqK2uKZa23Bh74zHydhROrz77p2vEEXq1iqjExFFjiYgqKuEtidvawjvdl7xLx42lFLYFvEeT51",
+	"B8figuTsysaU2TIwFL6Wpa72rrRnbduF/CCpho07mVgTQLaL6lAqS0c9g7agYy3TilebR5knsKiUM0HJ",
+	"z8dkfWZrzxzre0n7FoZgOznY6yNDSHTzC/3Or27+/NeuM/4EIYVz6vhUOyDre337GSPaUavA97EWLMTC",
+	"GPfeU4uIHKge7S7IqL08WJke4DQlISG3lAXSoLHoV7KNvImI51sALTJwKU0Mtl6p/DWMJSCfJNVaO4rD",
+	"iFk0dBc3gD1oEx72udjRzCgB2AfeMwDKdStb2Yugsg8JzBY+MD9ggngxIuidYhos+9cLWKTBWtx9xS1O",
+	"OKB9C6TfVe3CXlbWnvYLNPuFKGyOM+3gj/ZBHdLuqCNLwiONy/YopM1KWcvJ4dIHFGYEztfxpA78WE0d",
+	"uDI7KHfgJM0qeUDnWCKGFYWjzbajocl0S6ZP9y5dlxcqMKspwuZZGAjwZ7lj6Ua6mq5JJf51Aaw/L5GT",
+	"dhRMxQ/8liwMXlTtSXelrfNaIIpJiA1tDJNGVGbf0Jpzpk8EfXdRgq/CXPMu0PkkrOIgwfyREJl1EPNP",
+	"Vc2Ad3rJ1VkRNhK7STQ7E3zy+SDr4Ra8+7ZcnwtoO1wZI5NTF6sAfqKSUD694pKZnFoc5NsSk0o/6mOo",
+	"4POdcvgQz6y/oOB45jlWnIS1h8Xmg+6mMNsByyoASmkmxqR6/Et1DLxdhAPVDHGUh7Sv6YEAuJZOMDAH",
+	"OKpGn1uOiwHpC6sFrRiJ7zsYoOrfhgW4gI75tyin9ZQV07G3bTd1VQsu1lIN5ZK/j0NZ/MtAh7H0zSD3",
+	"1L0uxQgizYcwHvBN0wv8BRIng1ZRvvpDccNktAnOd/2agPa2o8TmVAVr4mJzIyQyXcwY3UDmYWABXTOe",
+	"+Q5bzQPOiD/loqtPD941Ko6JWoVr6dax+U8uc1fJbCgNvI2/+FTG2WYey8+l6b4a87T7lkfysiNQkNH5",
+	"Nm8calPdMs56KxvlUCpcpF3/1uXgyuX6rZ+UNzc02V4uAfutUz8s1WQVNYR2TiIb1zj/tl0oO7cz6LIf",
+	"ogArVp3tyebFaro8ly6D0ui3ZRo9yxd0ZLRg+m4A1mqWKXFoEUh8RZV0Mwk4w3g3OuMt1VcZu3uK4Z30",
+	"CYuP6/2kQSDKwpE5KEZpNTpNiVNYzXVc1ovEcMZfe0SuFybxXn/gBcJTf3pZW+W63Q8Kvebj5Epyz+kp",
+	"et9b8VV97fx0LnlvjveeliJZQjJiszLKYdGFcaiIGfvs5GAGY8Q0F4qRiIQtEowmRgaJC80LyDi0EnSe",
+	"dmDKCqpUVjW9G+gTttR7MV4k04EKmqBvO4prIV2X5V8lTrk7uJvGd0IGmShqqzWhoLcUQXc3EA0WWXfx",
+	"dCP7CZv/95XArra8zEcxkvi6hdt5Kb4uxddpcGrNEyHVSdWdl2HWum9h3GpyhNufhAMJKi2ISuuoMG9p",
+	"HTHLvUsuFoB4AV15FSI9OQn5vQG6NfG5Jh1avCvjlaSLfpyQaJCDmF81LvcwaXp+Q2PH+I0ld7HlxfHH",
+	"YVS31ueJQuxMa5DHv2FlWly2l3SfdtLPcBfTLUVEw0qDOvASMQnselw4LEZuQW1DJg/8nT53hGvKlbsg",
+	"MPDs+kOI8OHlHK8BhZ/63JTb4ABBuQkzXo1x0Sk/WPITMrC9rlgyuUJ8Pc7aTf1RTIp8m3zqYoXRqn3L",
+	"ay1i1D3dRDVTJJ4JumHaIJDoC1XRslC26BqbsZr/0AgbsEAZsNXchS2FU7Ta9xt+zeAQBnEOxS+uwS03",
+	"BTmPqZp8GUNIwoeo/xdUa7dzBATlwlI9Y3rwwKOHr1GO3rk+c4yrATNxMOFSsLcMrJxbs9NW1AxSt2gf",
+	"fTeT2iqbxXkju+d6gcSf0X3aH/7U2JfiedHBSSIviBfAZBmqrdTnBQ0VB4R8zS6KIHSsSMIpK9JmK4+W",
+	"4TAZ0YRq3Wh8U5T0M63oygypjP0+5SHbAX4GvSgdbBPKJqtp1DxtV1uNPLSwyIS8Ixd8XKyGQbmrraVH",
+	"Z97dyRXFu6t+Xa/ffZZjavrBTRzHlXyF8CSciJUzGLe6o+oY9QU+a24uKLmAo1t7A6nx/gmr12h0xsq3",
+	"0HpLEUL51TnLNljDtCzJZKwuAZRAVy/vrLQIA+7BFBCFzvjBCRYRpTen5CnBqhe1tP7ZsQALUhmYeSw+",
+	"lsIV7IecyVBBvqgg5MfUoQfl8t6hvbK9KQChSuF6Rw5/KL9sol7+1sEYjsTILxEMduLPq/wngmSw8Y1K",
+	"ySGeqfuxLC5eoOdr6COs7N3LCips5SrylR1drfixUM817+W8H1RhgH4yr9SwxTwZxuemGIXZOhpKbLzk",
+	"40qlB3iTDnB3C0wBG6p+cOK+hcfcEGt7yWtOgNfk0x5o/5LZFDKb08OAWgWDArEw9iyvSqabPDaSVaE+",
+	"qV6luQQfu+Z1WjoVgAP8JTIsN9ZBWM+NGmFlwIBiHcp1sPWoaPsiO5C6WpjKwJXJ96nIsawgwSFWCWXU",
+	"+BozVdINlB/Q3umVgOGBxGAuKtdJnwKjl0nECGbYhE52ucBSN5/hlBVHL60XPAI/v8U355KdnwQ715o0",
+	"nqKLttzQe9vD5udFwHC/rwN9NLAxQQ5yJA12W1tssFw6oNseOOlnPJ76hHcZVUtrbhus5/iKvHhakfgZ",
+	"4JAYv3Rqx9z1X1Tr/KMYXdU2RmgkFEJYd0CtDdt9Xi3xl6x3ZiUF3ce2kNGOLHnJRJOINNveIX4bpRDI",
+	"ZRPTs8z3xFjhqJ01rWFj0TLyHBTdGq23ZuFsB5TjsNTeGCteB7jKzON2bPoP7ezlo3hoR107fls1rVFj",
+	"52+fAmOHEIye4TyE882KTRnbEVLaAFp6413AIzOOKDjXIizKA6Rqi5C0zShwIU9ShRcc5PSvol1/5iLN",
+	"80wraerKXo8McPCDqaHJtvQqbLcHD0HMfHakEMTagyFC31TR2F52CZ+EIHuu8zaHPOYBSmfadWpkANJZ",
+	"Ab8vy5qdPGe3dA3kaEbXqPsq/Eg61v6kxUBek5qJSEySKRVbWAxvVOTELXbbXAbAfdv1LF1yJISNw4uW",
+	"qyWgTRNamb/HDsfJ0XJHQBeZLs/AtH0E6TE/hvAIZI0f3yjXi2a7dNdM24UsMYaQZEbg7iV/GA9/+IFv",
+	"z5q6DcXQZLTFLZs4Nn6wsvJfAwAw5CY+Y10BAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
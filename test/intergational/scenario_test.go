@@ -138,6 +138,223 @@ func (s *IntegrationSuite) TestPVZPipeline() {
 	require.Equal(s.T(), http.StatusOK, r.StatusCode())
 }
 
+func (s *IntegrationSuite) createPvzHelper() uuid.UUID {
+	pvzID := uuid.New()
+	r, err := s.client.R().
+		SetHeader("Authorization", fmt.Sprintf("Bearer %s", s.moderatorToken)).
+		SetBody(map[string]interface{}{
+			"id":                pvzID,
+			"registration_date": time.Now().Format(time.RFC3339),
+			"city":              "Москва",
+		}).
+		Post("/pvz")
+	s.T().Logf("Create PVZ response: %s", r.Body())
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), http.StatusCreated, r.StatusCode())
+
+	return pvzID
+}
+
+func (s *IntegrationSuite) openReceptionHelper(pvzID uuid.UUID) string {
+	var receptionResp struct {
+		ID string `json:"id"`
+	}
+	r, err := s.client.R().
+		SetHeader("Authorization", fmt.Sprintf("Bearer %s", s.employeeToken)).
+		SetBody(map[string]interface{}{"pvz_id": pvzID}).
+		Post("/receptions")
+	s.T().Logf("Create Reception response: %s", r.Body())
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), http.StatusCreated, r.StatusCode())
+	require.NoError(s.T(), json.Unmarshal(r.Body(), &receptionResp))
+
+	return receptionResp.ID
+}
+
+func (s *IntegrationSuite) addProductHelper(pvzID uuid.UUID, barcode string) {
+	r, err := s.client.R().
+		SetHeader("Authorization", fmt.Sprintf("Bearer %s", s.employeeToken)).
+		SetBody(map[string]interface{}{
+			"type":    "одежда",
+			"pvz_id":  pvzID.String(),
+			"barcode": barcode,
+		}).
+		Post("/products")
+	s.T().Logf("Add Product response: %s", r.Body())
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), http.StatusCreated, r.StatusCode())
+}
+
+// TestDeleteLastProductAfterReopen checks that LIFO deletion
+// in reopened reception keeps products accepted before reopen.
+func (s *IntegrationSuite) TestDeleteLastProductAfterReopen() {
+	s.moderatorToken = s.dummyLoginHelper("moderator")
+	s.employeeToken = s.dummyLoginHelper("employee")
+	pvzID := s.createPvzHelper()
+	receptionID := s.openReceptionHelper(pvzID)
+
+	s.addProductHelper(pvzID, fmt.Sprintf("%s-before", pvzID.String()))
+
+	r, err := s.client.R().
+		SetHeader("Authorization", fmt.Sprintf("Bearer %s", s.employeeToken)).
+		Post(fmt.Sprintf("/pvz/%s/close_last_reception", pvzID.String()))
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), http.StatusOK, r.StatusCode())
+
+	r, err = s.client.R().
+		SetHeader("Authorization", fmt.Sprintf("Bearer %s", s.moderatorToken)).
+		SetBody(map[string]interface{}{"reason": "missed products"}).
+		Post(fmt.Sprintf("/receptions/%s/reopen", receptionID))
+	s.T().Logf("Reopen Reception response: %s", r.Body())
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), http.StatusOK, r.StatusCode())
+
+	deleteLastProduct := func() int {
+		r, err := s.client.R().
+			SetHeader("Authorization", fmt.Sprintf("Bearer %s", s.employeeToken)).
+			Post(fmt.Sprintf("/pvz/%s/delete_last_product", pvzID.String()))
+		s.T().Logf("Delete last Product response: %s", r.Body())
+		require.NoError(s.T(), err)
+		return r.StatusCode()
+	}
+
+	// product accepted before reopen is not deleted
+	require.Equal(s.T(), http.StatusBadRequest, deleteLastProduct())
+
+	s.addProductHelper(pvzID, fmt.Sprintf("%s-after", pvzID.String()))
+	require.Equal(s.T(), http.StatusOK, deleteLastProduct())
+	require.Equal(s.T(), http.StatusBadRequest, deleteLastProduct())
+}
+
+// TestBatchIntake checks that batch of products
+// is accepted into reception at once.
+func (s *IntegrationSuite) TestBatchIntake() {
+	s.moderatorToken = s.dummyLoginHelper("moderator")
+	s.employeeToken = s.dummyLoginHelper("employee")
+	pvzID := s.createPvzHelper()
+	s.openReceptionHelper(pvzID)
+
+	var batch []map[string]interface{}
+	for i := 1; i <= 50; i++ {
+		batch = append(batch, map[string]interface{}{
+			"type":    "одежда",
+			"barcode": fmt.Sprintf("%s-%d", pvzID.String(), i),
+		})
+	}
+	var batchResp struct {
+		Accepted bool `json:"accepted"`
+		Results  []struct {
+			Status string `json:"status"`
+		} `json:"results"`
+	}
+	r, err := s.client.R().
+		SetHeader("Authorization", fmt.Sprintf("Bearer %s", s.employeeToken)).
+		SetBody(map[string]interface{}{"pvz_id": pvzID.String(), "products": batch}).
+		Post("/products/batch")
+	s.T().Logf("Add Products batch response: %s", r.Body())
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), http.StatusCreated, r.StatusCode())
+	require.NoError(s.T(), json.Unmarshal(r.Body(), &batchResp))
+	require.True(s.T(), batchResp.Accepted)
+	require.Len(s.T(), batchResp.Results, len(batch))
+
+	// batch with already accepted barcode is rejected as a whole
+	r, err = s.client.R().
+		SetHeader("Authorization", fmt.Sprintf("Bearer %s", s.employeeToken)).
+		SetBody(map[string]interface{}{"pvz_id": pvzID.String(), "products": []map[string]interface{}{
+			{"type": "одежда", "barcode": fmt.Sprintf("%s-new", pvzID.String())},
+			batch[0],
+		}}).
+		Post("/products/batch")
+	s.T().Logf("Add Products batch with duplicate response: %s", r.Body())
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), http.StatusConflict, r.StatusCode())
+}
+
+// TestDuplicateBarcode checks that repeated scan of the
+// same barcode in PVZ is rejected.
+func (s *IntegrationSuite) TestDuplicateBarcode() {
+	s.moderatorToken = s.dummyLoginHelper("moderator")
+	s.employeeToken = s.dummyLoginHelper("employee")
+	pvzID := s.createPvzHelper()
+	s.openReceptionHelper(pvzID)
+
+	barcode := fmt.Sprintf("%s-1", pvzID.String())
+	s.addProductHelper(pvzID, barcode)
+
+	r, err := s.client.R().
+		SetHeader("Authorization", fmt.Sprintf("Bearer %s", s.employeeToken)).
+		SetBody(map[string]interface{}{
+			"type":    "одежда",
+			"pvz_id":  pvzID.String(),
+			"barcode": barcode,
+		}).
+		Post("/products")
+	s.T().Logf("Add duplicate Product response: %s", r.Body())
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), http.StatusConflict, r.StatusCode())
+
+	// other PVZ may receive the same barcode
+	otherPvzID := s.createPvzHelper()
+	s.openReceptionHelper(otherPvzID)
+	s.addProductHelper(otherPvzID, barcode)
+}
+
+// TestIdempotentRetry checks that failed request can be
+// retried with the same Idempotency-Key, retry of successful
+// one replays its response and the key can't be reused for
+// other request.
+func (s *IntegrationSuite) TestIdempotentRetry() {
+	s.moderatorToken = s.dummyLoginHelper("moderator")
+	s.employeeToken = s.dummyLoginHelper("employee")
+	pvzID := s.createPvzHelper()
+
+	body := map[string]interface{}{
+		"type":    "одежда",
+		"pvz_id":  pvzID.String(),
+		"barcode": fmt.Sprintf("%s-retry", pvzID.String()),
+	}
+
+	// no open reception yet, error is not stored
+	r, err := s.client.R().
+		SetHeader("Authorization", fmt.Sprintf("Bearer %s", s.employeeToken)).
+		SetHeader("Idempotency-Key", pvzID.String()).
+		SetBody(body).
+		Post("/products")
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), http.StatusBadRequest, r.StatusCode())
+	require.Empty(s.T(), r.Header().Get("Idempotent-Replayed"))
+
+	s.openReceptionHelper(pvzID)
+
+	var firstResp []byte
+	for i := 0; i < 2; i++ {
+		r, err := s.client.R().
+			SetHeader("Authorization", fmt.Sprintf("Bearer %s", s.employeeToken)).
+			SetHeader("Idempotency-Key", pvzID.String()).
+			SetBody(body).
+			Post("/products")
+		s.T().Logf("Add Product with idempotency key response: %s", r.Body())
+		require.NoError(s.T(), err)
+		require.Equal(s.T(), http.StatusCreated, r.StatusCode())
+		if i == 0 {
+			firstResp = r.Body()
+		} else {
+			require.JSONEq(s.T(), string(firstResp), string(r.Body()))
+			require.Equal(s.T(), "true", r.Header().Get("Idempotent-Replayed"))
+		}
+	}
+
+	body["barcode"] = fmt.Sprintf("%s-other", pvzID.String())
+	r, err = s.client.R().
+		SetHeader("Authorization", fmt.Sprintf("Bearer %s", s.employeeToken)).
+		SetHeader("Idempotency-Key", pvzID.String()).
+		SetBody(body).
+		Post("/products")
+	require.NoError(s.T(), err)
+	require.Equal(s.T(), http.StatusUnprocessableEntity, r.StatusCode())
+}
+
 func TestIntegrationSuite(t *testing.T) {
 	suite.Run(t, new(IntegrationSuite))
 }